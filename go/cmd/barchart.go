@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"cutlass/fcp"
+
+	"github.com/spf13/cobra"
+)
+
+var barChartCmd = &cobra.Command{
+	Use:   "barchart <data.csv>",
+	Short: "Generate an animated bar chart from label,value rows",
+	Long: `Build a bar chart where every bar grows from the baseline to its value, with its label
+beneath. The CSV holds one "label,value" row per bar; a header row is skipped. Heights are
+relative to the largest value.
+
+Examples:
+  cutlass barchart sales.csv -o chart.fcpxml
+  cutlass barchart sales.csv --duration 8 -o chart.fcpxml`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		csvPath := args[0]
+		output, _ := cmd.Flags().GetString("output")
+		duration, _ := cmd.Flags().GetFloat64("duration")
+
+		var filename string
+		if output != "" {
+			filename = output
+		} else {
+			// Generate default filename with unix timestamp
+			timestamp := time.Now().Unix()
+			filename = fmt.Sprintf("cutlass_%d.fcpxml", timestamp)
+		}
+
+		values, labels, err := fcp.ReadBarChartCSV(csvPath)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error reading chart data: %v\n", err)
+			return
+		}
+
+		fcpxml, err := fcp.GenerateBarChart(values, labels, duration)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error generating bar chart: %v\n", err)
+			return
+		}
+
+		err = writeFCPXML(fcpxml, filename)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error writing FCPXML: %v\n", err)
+			return
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "Generated bar chart with %d bars: %s\n", len(values), filename)
+	},
+}
+
+func init() {
+	barChartCmd.Flags().StringP("output", "o", "", "Output filename (defaults to cutlass_unixtime.fcpxml)")
+	barChartCmd.Flags().Float64P("duration", "d", fcp.DefaultBarChartSeconds, "Seconds the bars take to grow to their values")
+
+	rootCmd.AddCommand(barChartCmd)
+}
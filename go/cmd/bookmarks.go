@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"cutlass/fcp"
+
+	"github.com/spf13/cobra"
+)
+
+var bookmarksCmd = &cobra.Command{
+	Use:   "bookmarks <input.fcpxml>",
+	Short: "Regenerate the macOS security bookmarks of every asset",
+	Long: `Rebuild the macOS security bookmark of each asset whose media file exists, so Final Cut Pro
+can still resolve the media after a library or its files have been moved.
+Bookmarks are created with Swift; without it (e.g. on Linux) assets keep the bookmarks they have.
+Assets whose files are missing are skipped; use check-media to list them.
+
+Examples:
+  cutlass bookmarks moved_project.fcpxml -o fixed.fcpxml`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		input := args[0]
+		output, _ := cmd.Flags().GetString("output")
+
+		var filename string
+		if output != "" {
+			filename = output
+		} else {
+			// Generate default filename with unix timestamp
+			timestamp := time.Now().Unix()
+			filename = fmt.Sprintf("cutlass_%d.fcpxml", timestamp)
+		}
+
+		fcpxml, err := fcp.ReadFromFile(input)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error reading FCPXML file '%s': %v\n", input, err)
+			return
+		}
+
+		err = fcp.RegenerateBookmarks(fcpxml)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error regenerating bookmarks: %v\n", err)
+			return
+		}
+
+		err = writeFCPXML(fcpxml, filename)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error writing FCPXML: %v\n", err)
+			return
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "Regenerated asset bookmarks: %s\n", filename)
+	},
+}
+
+func init() {
+	bookmarksCmd.Flags().StringP("output", "o", "", "Output filename (defaults to cutlass_unixtime.fcpxml)")
+
+	rootCmd.AddCommand(bookmarksCmd)
+}
@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"cutlass/fcp"
+
+	"github.com/spf13/cobra"
+)
+
+var checkMediaCmd = &cobra.Command{
+	Use:   "check-media <file.fcpxml>",
+	Short: "List referenced media files that are missing on disk",
+	Long: `Check that every asset in an FCPXML file points at a media file that exists, so the
+project won't open with offline media. Relative paths are resolved against the library
+location. The file is only read, never modified.
+
+Exits with status 1 when any media is missing.
+
+Examples:
+  cutlass check-media project.fcpxml`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		input := args[0]
+
+		fcpxml, err := fcp.ReadFromFile(input)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error reading FCPXML file '%s': %v\n", input, err)
+			os.Exit(1)
+		}
+
+		missing := fcp.CheckMediaOnline(fcpxml)
+		if len(missing) == 0 {
+			fmt.Fprintf(cmd.OutOrStdout(), "All %d assets are online\n", len(fcpxml.Resources.Assets))
+			return
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "Missing %d media files:\n", len(missing))
+		for _, path := range missing {
+			fmt.Fprintf(cmd.OutOrStdout(), "  %s\n", path)
+		}
+		os.Exit(1)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(checkMediaCmd)
+}
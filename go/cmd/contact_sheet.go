@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"cutlass/fcp"
+
+	"github.com/spf13/cobra"
+)
+
+var contactSheetCmd = &cobra.Command{
+	Use:   "contact-sheet [input-fcpxml]",
+	Short: "Build a contact sheet FCPXML from all assets referenced by a project",
+	Long: `Build a new FCPXML that shows every image and video asset of an existing project
+laid out in a grid on a single frame, each cell labeled with the asset name.
+
+Assets whose source file is missing on disk are skipped with a warning.
+
+Examples:
+  cutlass contact-sheet project.fcpxml -o sheet.fcpxml`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		input := args[0]
+		output, _ := cmd.Flags().GetString("output")
+
+		var filename string
+		if output != "" {
+			filename = output
+		} else {
+			// Generate default filename with unix timestamp
+			timestamp := time.Now().Unix()
+			filename = fmt.Sprintf("cutlass_%d.fcpxml", timestamp)
+		}
+
+		fcpxml, err := fcp.ReadFromFile(input)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error reading FCPXML file '%s': %v\n", input, err)
+			return
+		}
+
+		sheet, err := fcp.GenerateContactSheet(fcpxml)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error generating contact sheet: %v\n", err)
+			return
+		}
+
+		err = writeFCPXML(sheet, filename)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error writing FCPXML: %v\n", err)
+			return
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "Generated contact sheet: %s\n", filename)
+	},
+}
+
+func init() {
+	contactSheetCmd.Flags().StringP("output", "o", "", "Output filename (defaults to cutlass_unixtime.fcpxml)")
+
+	rootCmd.AddCommand(contactSheetCmd)
+}
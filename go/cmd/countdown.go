@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"cutlass/fcp"
+
+	"github.com/spf13/cobra"
+)
+
+var countdownCmd = &cobra.Command{
+	Use:   "countdown <from>",
+	Short: "Generate a 5-4-3-2-1 style countdown intro",
+	Long: `Build a countdown that ticks one centered number at a time, each number pulsing
+up in scale while it is on screen. Counts down to 1, or to 0 with --zero.
+
+Examples:
+  cutlass countdown 5 -o countdown.fcpxml --per 1
+  cutlass countdown 10 --zero --background "0.8 0.1 0.1 1"`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		from, err := strconv.Atoi(args[0])
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error parsing countdown start '%s': %v\n", args[0], err)
+			return
+		}
+		output, _ := cmd.Flags().GetString("output")
+		per, _ := cmd.Flags().GetFloat64("per")
+		zero, _ := cmd.Flags().GetBool("zero")
+		background, _ := cmd.Flags().GetString("background")
+
+		var filename string
+		if output != "" {
+			filename = output
+		} else {
+			// Generate default filename with unix timestamp
+			timestamp := time.Now().Unix()
+			filename = fmt.Sprintf("cutlass_%d.fcpxml", timestamp)
+		}
+
+		fcpxml, err := fcp.GenerateCountdownWithOptions(from, per, fcp.CountdownOptions{IncludeZero: zero, BackgroundColor: background})
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error generating countdown: %v\n", err)
+			return
+		}
+
+		err = writeFCPXML(fcpxml, filename)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error writing FCPXML: %v\n", err)
+			return
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "Generated countdown from %d: %s\n", from, filename)
+	},
+}
+
+func init() {
+	countdownCmd.Flags().StringP("output", "o", "", "Output filename (defaults to cutlass_unixtime.fcpxml)")
+	countdownCmd.Flags().Float64("per", fcp.DefaultCountdownSecondsPerNumber, "Seconds each number stays on screen")
+	countdownCmd.Flags().Bool("zero", false, "Count down to 0 instead of 1")
+	countdownCmd.Flags().String("background", "", "Solid background color as 'r g b a' (0.0-1.0); none by default")
+
+	rootCmd.AddCommand(countdownCmd)
+}
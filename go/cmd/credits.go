@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"cutlass/fcp"
+
+	"github.com/spf13/cobra"
+)
+
+var creditsCmd = &cobra.Command{
+	Use:   "credits <credits.csv>",
+	Short: "Generate credit cards or a scrolling credits roll from a name,role CSV",
+	Long: `Read a CSV of name,role rows and build a credits sequence.
+
+By default each row becomes its own centered card, shown back to back.
+With --scroll all credits are stacked in one block that rolls up from the
+bottom of the frame to the top.
+
+Examples:
+  cutlass credits credits.csv -o credits.fcpxml
+  cutlass credits credits.csv --scroll --seconds 2 -o roll.fcpxml`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		csvPath := args[0]
+		output, _ := cmd.Flags().GetString("output")
+		scroll, _ := cmd.Flags().GetBool("scroll")
+		seconds, _ := cmd.Flags().GetFloat64("seconds")
+
+		var filename string
+		if output != "" {
+			filename = output
+		} else {
+			// Generate default filename with unix timestamp
+			timestamp := time.Now().Unix()
+			filename = fmt.Sprintf("cutlass_%d.fcpxml", timestamp)
+		}
+
+		var fcpxml *fcp.FCPXML
+		var err error
+		if scroll {
+			fcpxml, err = fcp.GenerateScrollingCreditsFromCSV(csvPath, seconds)
+		} else {
+			fcpxml, err = fcp.GenerateCreditsFromCSV(csvPath, seconds)
+		}
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error generating credits: %v\n", err)
+			return
+		}
+
+		err = writeFCPXML(fcpxml, filename)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error writing FCPXML: %v\n", err)
+			return
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "Generated credits: %s\n", filename)
+	},
+}
+
+func init() {
+	creditsCmd.Flags().StringP("output", "o", "", "Output filename (defaults to cutlass_unixtime.fcpxml)")
+	creditsCmd.Flags().Bool("scroll", false, "Roll all credits upward in one continuous scroll instead of separate cards")
+	creditsCmd.Flags().Float64("seconds", fcp.DefaultCreditsSecondsPerRow, "Seconds per credit row (card length, or scroll time per row)")
+
+	rootCmd.AddCommand(creditsCmd)
+}
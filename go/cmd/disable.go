@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"cutlass/fcp"
+
+	"github.com/spf13/cobra"
+)
+
+var disableCmd = &cobra.Command{
+	Use:   "disable <input.fcpxml> <clip> [clip...]",
+	Short: "Turn timeline clips off without deleting them",
+	Long: `Disable clips for quick A/B comparisons in Final Cut Pro. A disabled clip keeps its
+place on the timeline, so nothing after it moves, but it doesn't render or play.
+
+Clips are numbered from 1 in timeline order, counting every clip, image, title and gap on
+the primary storyline; gaps can't be disabled. --enable turns the listed clips back on.
+
+Examples:
+  cutlass disable project.fcpxml 2 -o without_clip2.fcpxml
+  cutlass disable project.fcpxml 2 5 7
+  cutlass disable without_clip2.fcpxml 2 --enable -o project.fcpxml`,
+	Args: cobra.MinimumNArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		input := args[0]
+		output, _ := cmd.Flags().GetString("output")
+		enable, _ := cmd.Flags().GetBool("enable")
+
+		var filename string
+		if output != "" {
+			filename = output
+		} else {
+			// Generate default filename with unix timestamp
+			timestamp := time.Now().Unix()
+			filename = fmt.Sprintf("cutlass_%d.fcpxml", timestamp)
+		}
+
+		var clips []int
+		for _, arg := range args[1:] {
+			clip, err := strconv.Atoi(arg)
+			if err != nil || clip < 1 {
+				fmt.Fprintf(cmd.OutOrStdout(), "Error: clip number must be 1 or more, got '%s'\n", arg)
+				return
+			}
+			clips = append(clips, clip)
+		}
+
+		fcpxml, err := fcp.ReadFromFile(input)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error reading FCPXML file '%s': %v\n", input, err)
+			return
+		}
+
+		for _, clip := range clips {
+			if err := fcp.SetClipEnabled(fcpxml, clip-1, enable); err != nil {
+				fmt.Fprintf(cmd.OutOrStdout(), "Error setting clip %d: %v\n", clip, err)
+				return
+			}
+		}
+
+		err = writeFCPXML(fcpxml, filename)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error writing FCPXML: %v\n", err)
+			return
+		}
+
+		state := "Disabled"
+		if enable {
+			state = "Enabled"
+		}
+		fmt.Fprintf(cmd.OutOrStdout(), "%s clip %s: %s\n", state, strings.Join(args[1:], ", "), filename)
+	},
+}
+
+func init() {
+	disableCmd.Flags().Bool("enable", false, "Turn the listed clips back on instead")
+	disableCmd.Flags().StringP("output", "o", "", "Output filename (defaults to cutlass_unixtime.fcpxml)")
+
+	rootCmd.AddCommand(disableCmd)
+}
@@ -0,0 +1,22 @@
+package cmd
+
+import (
+	"cutlass/fcp"
+
+	"github.com/spf13/cobra"
+)
+
+// addEventFlag registers --event on a command that adds content to a timeline
+func addEventFlag(cmd *cobra.Command) {
+	cmd.Flags().String("event", "", "Library event to add to, created with its own project if missing (e.g. 'Day 2')")
+}
+
+// selectEventFlag routes new content into the --event event, creating it if missing. The returned
+// restore puts the library's event order back and must be called before writing.
+func selectEventFlag(cmd *cobra.Command, fcpxml *fcp.FCPXML) (restore func(), err error) {
+	name, _ := cmd.Flags().GetString("event")
+	if name == "" {
+		return func() {}, nil
+	}
+	return fcp.SelectEvent(fcpxml, name)
+}
@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"cutlass/fcp"
+
+	"github.com/spf13/cobra"
+)
+
+var exportJSONCmd = &cobra.Command{
+	Use:   "export-json <input.fcpxml>",
+	Short: "Export a simplified JSON view of an FCPXML timeline",
+	Long: `Write the first sequence of an FCPXML file as JSON for web UIs and other tools:
+sequence info plus every clip with its type, name, source path, offset and duration
+in seconds, lane and effects. Nested lanes appear as children of their clip.
+
+This is a read-only projection, not a full FCPXML mirror. JSON goes to stdout unless
+--output is given.
+
+Examples:
+  cutlass export-json project.fcpxml
+  cutlass export-json project.fcpxml -o timeline.json`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		input := args[0]
+		output, _ := cmd.Flags().GetString("output")
+
+		fcpxml, err := fcp.ReadFromFile(input)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error reading FCPXML file '%s': %v\n", input, err)
+			return
+		}
+
+		if output == "" {
+			if err := fcp.ExportTimelineJSON(fcpxml, os.Stdout); err != nil {
+				fmt.Fprintf(os.Stderr, "Error exporting JSON: %v\n", err)
+			}
+			return
+		}
+
+		file, err := os.Create(output)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error creating '%s': %v\n", output, err)
+			return
+		}
+		defer file.Close()
+
+		if err := fcp.ExportTimelineJSON(fcpxml, file); err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error exporting JSON: %v\n", err)
+			return
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "Exported timeline JSON: %s\n", output)
+	},
+}
+
+func init() {
+	exportJSONCmd.Flags().StringP("output", "o", "", "Output JSON file (defaults to stdout)")
+
+	rootCmd.AddCommand(exportJSONCmd)
+}
@@ -4,6 +4,7 @@ import (
 	"cutlass/fcp"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
@@ -27,7 +28,8 @@ Use 'cutlass fcp --help' to see all available subcommands.`,
 var createEmptyCmd = &cobra.Command{
 	Use:   "create-empty [filename]",
 	Short: "Generate an empty FCPXML file from structs",
-	Long:  `Generate a basic empty FCPXML file structure using the fcp package structs.`,
+	Long:  `Generate a basic empty FCPXML file structure using the fcp package structs.
+Use --audio-layout and --audio-rate so the sequence matches the audio you plan to add.`,
 	Args:  cobra.RangeArgs(0, 1),
 	Run: func(cmd *cobra.Command, args []string) {
 		// Get output filename from flag or generate default
@@ -44,12 +46,15 @@ var createEmptyCmd = &cobra.Command{
 			filename = fmt.Sprintf("cutlass_%d.fcpxml", timestamp)
 		}
 		
-		_, err := fcp.GenerateEmpty(filename)
+		audioLayout, _ := cmd.Flags().GetString("audio-layout")
+		audioRate, _ := cmd.Flags().GetString("audio-rate")
+		
+		_, err := fcp.GenerateEmptyWithAudio(filename, "horizontal", audioLayout, audioRate)
 		if err != nil {
-			fmt.Printf("Error generating FCPXML: %v\n", err)
+			fmt.Fprintf(cmd.OutOrStdout(), "Error generating FCPXML: %v\n", err)
 			return
 		}
-		fmt.Printf("Generated empty FCPXML: %s\n", filename)
+		fmt.Fprintf(cmd.OutOrStdout(), "Generated empty FCPXML: %s (audio: %s %s)\n", filename, audioLayout, audioRate)
 	},
 }
 
@@ -58,7 +63,23 @@ var addVideoCmd = &cobra.Command{
 	Short: "Add a video to an FCPXML file using structs",
 	Long:  `Add a video asset and asset-clip to an FCPXML file using the fcp package structs.
 If --input is specified, the video will be appended to an existing FCPXML file.
-Otherwise, a new FCPXML file is created.`,
+Otherwise, a new FCPXML file is created.
+With --captions, cues from a SubRip (.srt) file are nested in the clip as caption titles.
+With --auto-captions, the clip is transcribed by --transcriber (a whisper-style CLI) instead.
+--conform detects the clip's frame rate (with ffprobe) and, when it differs from the sequence,
+picks how it plays back: floor or nearest keep real time (frame sampling), preserve plays every
+frame (speed change).
+Use --letterbox 2.39 to overlay black bars for a cinematic aspect.
+Use --poster 3 to use the frame 3 seconds into the clip as its thumbnail. FCPXML has no poster
+attribute on clips, so it is written as a "Poster Frame" chapter marker at the clip's head;
+it shows up in FCP's chapter list and in exported chapters.
+Use --key-color "0 1 0 1" to key out a green screen with FCP's Keyer.
+Use --role music.score to put the clip's audio on a role other than dialogue.
+Use --gain -6 to set the clip's volume in dB.
+Use --curves motion.json to animate position/scale/rotation from JSON keyframes, e.g.
+{"position": [{"t": 0, "value": "0 0"}, {"t": 2, "value": "200 0"}]}.
+Use --punch "5,2,1.5" to punch in to 1.5x at 5s, hold for 2s, then snap back.
+Use --event "Day 2" to add the clip to that library event, creating it if needed.`,
 	Args:  cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		videoFile := args[0]
@@ -66,6 +87,10 @@ Otherwise, a new FCPXML file is created.`,
 		// Get input and output filenames from flags
 		input, _ := cmd.Flags().GetString("input")
 		output, _ := cmd.Flags().GetString("output")
+		captionsFile, _ := cmd.Flags().GetString("captions")
+		autoCaptions, _ := cmd.Flags().GetBool("auto-captions")
+		transcriberCommand, _ := cmd.Flags().GetString("transcriber")
+		conform, _ := cmd.Flags().GetString("conform")
 		var filename string
 		
 		if output != "" {
@@ -83,37 +108,155 @@ Otherwise, a new FCPXML file is created.`,
 		if input != "" {
 			fcpxml, err = fcp.ReadFromFile(input)
 			if err != nil {
-				fmt.Printf("Error reading FCPXML file '%s': %v\n", input, err)
+				fmt.Fprintf(cmd.OutOrStdout(), "Error reading FCPXML file '%s': %v\n", input, err)
 				return
 			}
-			fmt.Printf("Loaded existing FCPXML: %s\n", input)
+			fmt.Fprintf(cmd.OutOrStdout(), "Loaded existing FCPXML: %s\n", input)
 		} else {
 			// Generate empty FCPXML structure
 			fcpxml, err = fcp.GenerateEmpty("")
 			if err != nil {
-				fmt.Printf("Error creating FCPXML structure: %v\n", err)
+				fmt.Fprintf(cmd.OutOrStdout(), "Error creating FCPXML structure: %v\n", err)
 				return
 			}
 		}
 		
+		// Route the new content into a named event (created if missing)
+		restoreEvents, err := selectEventFlag(cmd, fcpxml)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error selecting event: %v\n", err)
+			return
+		}
+
 		// Add video to the structure
-		err = fcp.AddVideo(fcpxml, videoFile)
+		if captionsFile != "" || autoCaptions {
+			if autoCaptions {
+				err = fcp.AutoCaption(fcpxml, videoFile, fcp.CommandTranscriber{Command: transcriberCommand})
+			} else {
+				captions, captionErr := fcp.ReadSRTCaptions(captionsFile)
+				if captionErr != nil {
+					fmt.Fprintf(cmd.OutOrStdout(), "Error reading captions: %v\n", captionErr)
+					return
+				}
+				err = fcp.AddVideoWithCaptions(fcpxml, videoFile, captions)
+			}
+		} else {
+			err = fcp.AddVideo(fcpxml, videoFile)
+		}
+		if err == nil && conform != "" {
+			// Conforming probes the file with ffprobe, so it only runs when asked for
+			var warning string
+			warning, err = fcp.ConformAssetClip(fcpxml, fcp.TimelineElementCount(fcpxml)-1, videoFile, conform)
+			if warning != "" {
+				fmt.Fprintf(cmd.OutOrStdout(), "Warning: %s\n", warning)
+			}
+		}
 		if err != nil {
-			fmt.Printf("Error adding video: %v\n", err)
+			fmt.Fprintf(cmd.OutOrStdout(), "Error adding video: %v\n", err)
 			return
 		}
 		
+		// Put the clip's audio on its role (dialogue by default)
+		role, _ := cmd.Flags().GetString("role")
+		if role != "" {
+			err = fcp.SetAssetClipAudioRole(fcpxml, fcp.TimelineElementCount(fcpxml)-1, role)
+			if err != nil {
+				fmt.Fprintf(cmd.OutOrStdout(), "Error setting audio role: %v\n", err)
+				return
+			}
+		}
+		
+		// Set a constant clip volume
+		if cmd.Flags().Changed("gain") {
+			gain, _ := cmd.Flags().GetFloat64("gain")
+			err = fcp.SetClipVolume(fcpxml, fcp.TimelineElementCount(fcpxml)-1, gain)
+			if err != nil {
+				fmt.Fprintf(cmd.OutOrStdout(), "Error setting clip volume: %v\n", err)
+				return
+			}
+		}
+		
+		// Animate the clip from a JSON keyframe curves file
+		curvesFile, _ := cmd.Flags().GetString("curves")
+		if curvesFile != "" {
+			err = fcp.AddCustomAnimation(fcpxml, fcp.TimelineElementCount(fcpxml)-1, curvesFile)
+			if err != nil {
+				fmt.Fprintf(cmd.OutOrStdout(), "Error applying curves: %v\n", err)
+				return
+			}
+		}
+		
+		// Punch in: snap the zoom in, hold it, and snap back out
+		punch, _ := cmd.Flags().GetString("punch")
+		if punch != "" {
+			at, hold, zoom, punchErr := fcp.ParsePunchIn(punch)
+			if punchErr != nil {
+				fmt.Fprintf(cmd.OutOrStdout(), "Error parsing --punch: %v\n", punchErr)
+				return
+			}
+			err = fcp.AddPunchIn(fcpxml, fcp.TimelineElementCount(fcpxml)-1, at, hold, zoom)
+			if err != nil {
+				fmt.Fprintf(cmd.OutOrStdout(), "Error adding punch-in: %v\n", err)
+				return
+			}
+		}
+		
+		// Key out a green/blue screen so lower lanes show through
+		keyColor, _ := cmd.Flags().GetString("key-color")
+		if keyColor != "" {
+			color, colorErr := fcp.ParseRGBA(keyColor)
+			if colorErr != nil {
+				fmt.Fprintf(cmd.OutOrStdout(), "Error parsing --key-color: %v\n", colorErr)
+				return
+			}
+			err = fcp.AddChromaKey(fcpxml, fcp.TimelineElementCount(fcpxml)-1, color)
+			if err != nil {
+				fmt.Fprintf(cmd.OutOrStdout(), "Error adding chroma key: %v\n", err)
+				return
+			}
+		}
+		
+		// Pick the browser thumbnail frame of the new clip
+		if cmd.Flags().Changed("poster") {
+			poster, _ := cmd.Flags().GetFloat64("poster")
+			err = fcp.SetPosterFrame(fcpxml, fcp.TimelineElementCount(fcpxml)-1, poster)
+			if err != nil {
+				fmt.Fprintf(cmd.OutOrStdout(), "Error setting poster frame: %v\n", err)
+				return
+			}
+		}
+		
+		// Frame the timeline with black bars at a cinematic aspect
+		letterbox, _ := cmd.Flags().GetFloat64("letterbox")
+		if letterbox > 0 {
+			err = fcp.AddLetterbox(fcpxml, letterbox, 0)
+			if err != nil {
+				fmt.Fprintf(cmd.OutOrStdout(), "Error adding letterbox: %v\n", err)
+				return
+			}
+		}
+		
+		// Overlay title/action safe area outlines as a framing aid
+		err = addSafeGuides(cmd, fcpxml)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error adding safe area guides: %v\n", err)
+			return
+		}
+		
+		// Put the library's events back in their original order
+		restoreEvents()
+
 		// Write to file
-		err = fcp.WriteToFile(fcpxml, filename)
+		err = writeFCPXML(fcpxml, filename)
 		if err != nil {
-			fmt.Printf("Error writing FCPXML: %v\n", err)
+			fmt.Fprintf(cmd.OutOrStdout(), "Error writing FCPXML: %v\n", err)
 			return
 		}
 		
 		if input != "" {
-			fmt.Printf("Added video to existing FCPXML and saved to: %s\n", filename)
+			fmt.Fprintf(cmd.OutOrStdout(), "Added video to existing FCPXML and saved to: %s\n", filename)
 		} else {
-			fmt.Printf("Generated FCPXML with video: %s\n", filename)
+			fmt.Fprintf(cmd.OutOrStdout(), "Generated FCPXML with video: %s\n", filename)
 		}
 	},
 }
@@ -122,8 +265,21 @@ var addImageCmd = &cobra.Command{
 	Use:   "add-image [image-file]",
 	Short: "Add an image to an FCPXML file using structs",
 	Long:  `Add an image asset and asset-clip to an FCPXML file using the fcp package structs. Supports PNG, JPG, and JPEG files.
+Animated GIFs are exploded into PNG frames, written to .cutlass_gif_frames/<name>/ beside the GIF
+(keep that folder with the GIF), and played at their native frame timing.
 If --input is specified, the image will be appended to an existing FCPXML file.
-Otherwise, a new FCPXML file is created.`,
+Otherwise, a new FCPXML file is created.
+Use --gap to insert seconds of black/silence before the image for pacing.
+Use --slide-from right to slide the image in from that edge to center over one second.
+Use --static-scale 2 and/or --static-position "0 -20" to place the image without animation.
+Use --pan-from and --pan-to "x y width height" (fractions of the image) for a Ken Burns move
+between two regions; moves that would reveal the frame edge are clamped to the photo's real size.
+Use --letterbox 2.39 to overlay black bars for a cinematic aspect.
+Photos whose EXIF orientation says they are stored sideways are imported from an upright copy
+in .cutlass_oriented beside the photo; --no-auto-rotate references the original file as it is.
+The image starts one hour into its local time in the sequence's timebase; --image-start sets
+a different start in seconds. The image is a <video> element; --image-element asset-clip writes
+<asset-clip> instead, which has crashed FCP on import and is only for workflows that need it.`,
 	Args:  cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		imageFile := args[0]
@@ -132,13 +288,50 @@ Otherwise, a new FCPXML file is created.`,
 		durationStr, _ := cmd.Flags().GetString("duration")
 		duration, err := strconv.ParseFloat(durationStr, 64)
 		if err != nil {
-			fmt.Printf("Error parsing duration '%s': %v\n", durationStr, err)
+			fmt.Fprintf(cmd.OutOrStdout(), "Error parsing duration '%s': %v\n", durationStr, err)
 			return
 		}
 		
 		// Get slide animation flag
 		withSlide, _ := cmd.Flags().GetBool("with-slide")
 		
+		// Fixed placement without keyframes
+		staticScale, _ := cmd.Flags().GetString("static-scale")
+		staticPosition, _ := cmd.Flags().GetString("static-position")
+		if withSlide && (staticScale != "" || staticPosition != "") {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error: --with-slide cannot be combined with --static-scale or --static-position\n")
+			return
+		}
+		
+		// Ken Burns between two regions of the image
+		panFrom, _ := cmd.Flags().GetString("pan-from")
+		panTo, _ := cmd.Flags().GetString("pan-to")
+		panStart, panEnd := fcp.FullImageRect, fcp.FullImageRect
+		if panFrom != "" || panTo != "" {
+			slideFrom, _ := cmd.Flags().GetString("slide-from")
+			if withSlide || slideFrom != "" || staticScale != "" || staticPosition != "" || strings.ToLower(filepath.Ext(imageFile)) == ".gif" {
+				fmt.Fprintf(cmd.OutOrStdout(), "Error: --pan-from/--pan-to cannot be combined with --with-slide, --slide-from, static placement or GIFs\n")
+				return
+			}
+			if panFrom != "" {
+				if panStart, err = fcp.ParseKenBurnsRect(panFrom); err != nil {
+					fmt.Fprintf(cmd.OutOrStdout(), "Error parsing --pan-from: %v\n", err)
+					return
+				}
+			}
+			if panTo != "" {
+				if panEnd, err = fcp.ParseKenBurnsRect(panTo); err != nil {
+					fmt.Fprintf(cmd.OutOrStdout(), "Error parsing --pan-to: %v\n", err)
+					return
+				}
+			}
+		}
+		imageOpts, err := imageOptions(cmd)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error: %v\n", err)
+			return
+		}
+		
 		// Get input and output filenames from flags
 		input, _ := cmd.Flags().GetString("input")
 		output, _ := cmd.Flags().GetString("output")
@@ -158,37 +351,90 @@ Otherwise, a new FCPXML file is created.`,
 		if input != "" {
 			fcpxml, err = fcp.ReadFromFile(input)
 			if err != nil {
-				fmt.Printf("Error reading FCPXML file '%s': %v\n", input, err)
+				fmt.Fprintf(cmd.OutOrStdout(), "Error reading FCPXML file '%s': %v\n", input, err)
 				return
 			}
-			fmt.Printf("Loaded existing FCPXML: %s\n", input)
+			fmt.Fprintf(cmd.OutOrStdout(), "Loaded existing FCPXML: %s\n", input)
 		} else {
 			// Generate empty FCPXML structure
 			fcpxml, err = fcp.GenerateEmpty("")
 			if err != nil {
-				fmt.Printf("Error creating FCPXML structure: %v\n", err)
+				fmt.Fprintf(cmd.OutOrStdout(), "Error creating FCPXML structure: %v\n", err)
+				return
+			}
+		}
+		
+		// Route the new content into a named event (created if missing)
+		restoreEvents, err := selectEventFlag(cmd, fcpxml)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error selecting event: %v\n", err)
+			return
+		}
+
+		// Insert an intentional pause before the image
+		gap, _ := cmd.Flags().GetFloat64("gap")
+		if gap > 0 {
+			err = fcp.AddGap(fcpxml, gap)
+			if err != nil {
+				fmt.Fprintf(cmd.OutOrStdout(), "Error adding gap: %v\n", err)
+				return
+			}
+		}
+		
+		// Add image to the structure (animated GIFs become a timed frame sequence)
+		if strings.ToLower(filepath.Ext(imageFile)) == ".gif" {
+			err = fcp.AddAnimatedGIF(fcpxml, imageFile)
+		} else if staticScale != "" || staticPosition != "" {
+			err = fcp.AddImageWithStaticTransform(fcpxml, imageFile, duration, staticPosition, staticScale, imageOpts)
+		} else if panFrom != "" || panTo != "" {
+			var warnings []string
+			warnings, err = fcp.AddImageKenBurns(fcpxml, imageFile, duration, panStart, panEnd, imageOpts)
+			for _, warning := range warnings {
+				fmt.Fprintf(cmd.ErrOrStderr(), "Warning: %s\n", warning)
+			}
+		} else if slideFrom, _ := cmd.Flags().GetString("slide-from"); slideFrom != "" {
+			slideDistance, _ := cmd.Flags().GetFloat64("slide-distance")
+			err = fcp.AddImageSlideFrom(fcpxml, imageFile, duration, fcp.SlideDirection(slideFrom), slideDistance, imageOpts)
+		} else {
+			imageOpts.Slide = withSlide
+			err = fcp.AddImageWithOptions(fcpxml, imageFile, duration, imageOpts)
+		}
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error adding image: %v\n", err)
+			return
+		}
+		
+		// Frame the timeline with black bars at a cinematic aspect
+		letterbox, _ := cmd.Flags().GetFloat64("letterbox")
+		if letterbox > 0 {
+			err = fcp.AddLetterbox(fcpxml, letterbox, 0)
+			if err != nil {
+				fmt.Fprintf(cmd.OutOrStdout(), "Error adding letterbox: %v\n", err)
 				return
 			}
 		}
 		
-		// Add image to the structure
-		err = fcp.AddImageWithSlide(fcpxml, imageFile, duration, withSlide)
+		// Overlay title/action safe area outlines as a framing aid
+		err = addSafeGuides(cmd, fcpxml)
 		if err != nil {
-			fmt.Printf("Error adding image: %v\n", err)
+			fmt.Fprintf(cmd.OutOrStdout(), "Error adding safe area guides: %v\n", err)
 			return
 		}
 		
+		// Put the library's events back in their original order
+		restoreEvents()
+
 		// Write to file
-		err = fcp.WriteToFile(fcpxml, filename)
+		err = writeFCPXML(fcpxml, filename)
 		if err != nil {
-			fmt.Printf("Error writing FCPXML: %v\n", err)
+			fmt.Fprintf(cmd.OutOrStdout(), "Error writing FCPXML: %v\n", err)
 			return
 		}
 		
 		if input != "" {
-			fmt.Printf("Added image to existing FCPXML and saved to: %s (duration: %.1fs)\n", filename, duration)
+			fmt.Fprintf(cmd.OutOrStdout(), "Added image to existing FCPXML and saved to: %s (duration: %.1fs)\n", filename, duration)
 		} else {
-			fmt.Printf("Generated FCPXML with image: %s (duration: %.1fs)\n", filename, duration)
+			fmt.Fprintf(cmd.OutOrStdout(), "Generated FCPXML with image: %s (duration: %.1fs)\n", filename, duration)
 		}
 	},
 }
@@ -199,7 +445,10 @@ var addTextCmd = &cobra.Command{
 	Long:  `Add multiple text elements from a text file to an FCPXML file. Each line in the text file becomes a text element with progressive Y positioning and staggered timing.
 The first text element starts at the specified offset, and each subsequent element appears 6 seconds later with a 300px Y offset.
 If --input is specified, the text elements will be appended to an existing FCPXML file.
-Otherwise, a new FCPXML file is created.`,
+Otherwise, a new FCPXML file is created.
+Use --shadow and --outline-width for legibility over busy backgrounds.
+Use --stagger-axis horizontal to reveal lines left-to-right; --stagger-px and --stagger-time set the spacing.
+Use --autofit-text to keep every line of a long file inside the title-safe area.`,
 	Args:  cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		textFile := args[0]
@@ -208,7 +457,7 @@ Otherwise, a new FCPXML file is created.`,
 		offsetStr, _ := cmd.Flags().GetString("offset")
 		offset, err := strconv.ParseFloat(offsetStr, 64)
 		if err != nil {
-			fmt.Printf("Error parsing offset '%s': %v\n", offsetStr, err)
+			fmt.Fprintf(cmd.OutOrStdout(), "Error parsing offset '%s': %v\n", offsetStr, err)
 			return
 		}
 		
@@ -216,7 +465,7 @@ Otherwise, a new FCPXML file is created.`,
 		durationStr, _ := cmd.Flags().GetString("duration")
 		duration, err := strconv.ParseFloat(durationStr, 64)
 		if err != nil {
-			fmt.Printf("Error parsing duration '%s': %v\n", durationStr, err)
+			fmt.Fprintf(cmd.OutOrStdout(), "Error parsing duration '%s': %v\n", durationStr, err)
 			return
 		}
 		
@@ -239,38 +488,160 @@ Otherwise, a new FCPXML file is created.`,
 		if input != "" {
 			fcpxml, err = fcp.ReadFromFile(input)
 			if err != nil {
-				fmt.Printf("Error reading FCPXML file '%s': %v\n", input, err)
+				fmt.Fprintf(cmd.OutOrStdout(), "Error reading FCPXML file '%s': %v\n", input, err)
 				return
 			}
-			fmt.Printf("Loaded existing FCPXML: %s\n", input)
+			fmt.Fprintf(cmd.OutOrStdout(), "Loaded existing FCPXML: %s\n", input)
 		} else {
 			// Generate empty FCPXML structure
 			fcpxml, err = fcp.GenerateEmpty("")
 			if err != nil {
-				fmt.Printf("Error creating FCPXML structure: %v\n", err)
+				fmt.Fprintf(cmd.OutOrStdout(), "Error creating FCPXML structure: %v\n", err)
 				return
 			}
 		}
 		
+		// Drop shadow and outline keep titles readable over busy backgrounds
+		shadow, _ := cmd.Flags().GetBool("shadow")
+		shadowOffset, _ := cmd.Flags().GetString("shadow-offset")
+		outlineColor, _ := cmd.Flags().GetString("outline-color")
+		outlineWidth, _ := cmd.Flags().GetFloat64("outline-width")
+		styleOptions := fcp.TextStyleOptions{
+			ShadowOffset: shadowOffset,
+			OutlineColor: outlineColor,
+			OutlineWidth: outlineWidth,
+		}
+		if shadow {
+			styleOptions.ShadowColor = fcp.DefaultTextShadowColor
+		}
+		
+		// Stagger controls the reveal pattern: direction, spacing and delay between lines
+		staggerAxis, _ := cmd.Flags().GetString("stagger-axis")
+		stagger := fcp.StaggerConfig{Axis: staggerAxis}
+		// Only explicit spacings are passed on, so --stagger-px 0 / --stagger-time 0 mean zero
+		if cmd.Flags().Changed("stagger-px") {
+			staggerPx, _ := cmd.Flags().GetInt("stagger-px")
+			stagger.PixelStep = &staggerPx
+		}
+		if cmd.Flags().Changed("stagger-time") {
+			staggerTime, _ := cmd.Flags().GetFloat64("stagger-time")
+			stagger.TimeStepSeconds = &staggerTime
+		}
+		stagger.AutoFit, _ = cmd.Flags().GetBool("autofit-text")
+		
 		// Add text elements to the structure
-		err = fcp.AddTextFromFile(fcpxml, textFile, offset, duration)
+		err = fcp.AddTextFromFileStyled(fcpxml, textFile, offset, duration, styleOptions, stagger)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error adding text elements: %v\n", err)
+			return
+		}
+		
+		// Overlay title/action safe area outlines as a framing aid
+		err = addSafeGuides(cmd, fcpxml)
 		if err != nil {
-			fmt.Printf("Error adding text elements: %v\n", err)
+			fmt.Fprintf(cmd.OutOrStdout(), "Error adding safe area guides: %v\n", err)
 			return
 		}
 		
 		// Write to file
-		err = fcp.WriteToFile(fcpxml, filename)
+		err = writeFCPXML(fcpxml, filename)
 		if err != nil {
-			fmt.Printf("Error writing FCPXML: %v\n", err)
+			fmt.Fprintf(cmd.OutOrStdout(), "Error writing FCPXML: %v\n", err)
 			return
 		}
 		
 		if input != "" {
-			fmt.Printf("Added text elements to existing FCPXML and saved to: %s (offset: %.1fs, duration: %.1fs)\n", filename, offset, duration)
+			fmt.Fprintf(cmd.OutOrStdout(), "Added text elements to existing FCPXML and saved to: %s (offset: %.1fs, duration: %.1fs)\n", filename, offset, duration)
+		} else {
+			fmt.Fprintf(cmd.OutOrStdout(), "Generated FCPXML with text elements: %s (offset: %.1fs, duration: %.1fs)\n", filename, offset, duration)
+		}
+	},
+}
+
+var addTitleCmd = &cobra.Command{
+	Use:   "add-title [text]",
+	Short: "Add a single title, optionally styled by a JSON title template",
+	Long: `Add one title to an FCPXML file. With --template, the title's font, size, color,
+position, shadow/outline and entrance/exit animation come from a reusable JSON template.
+
+Example template (lower-third.json):
+  {"name": "lower-third", "font": "Helvetica Neue", "fontSize": 120, "color": "1 1 1 1",
+   "position": "0 -800", "entrance": "slide-up", "exit": "fade", "outlineWidth": 4}
+
+Without a template, --position places the title at a named spot in the frame: top-left,
+top-center, top-right, center-left, center, center-right, bottom-left, bottom-center or
+bottom-right. The coordinate is computed from the sequence's frame size and stays inside
+the title-safe area; --position-offset "x y" nudges it by that many pixels (+y is up).
+
+Examples:
+  cutlass fcp add-title "Jane Doe" --template lower-third.json -i project.fcpxml -t 2 -d 4
+  cutlass fcp add-title "Chapter One" -o chapter.fcpxml
+  cutlass fcp add-title "Subscribe" --position bottom-center --position-offset "0 40" -i project.fcpxml`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		text := args[0]
+		input, _ := cmd.Flags().GetString("input")
+		output, _ := cmd.Flags().GetString("output")
+		templatePath, _ := cmd.Flags().GetString("template")
+		offset, _ := cmd.Flags().GetFloat64("offset")
+		duration, _ := cmd.Flags().GetFloat64("duration")
+		position, _ := cmd.Flags().GetString("position")
+		positionOffset, _ := cmd.Flags().GetString("position-offset")
+		if templatePath != "" && (position != "" || positionOffset != "") {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error: --position and --position-offset can't be combined with --template; set the template's position instead\n")
+			return
+		}
+
+		var filename string
+		if output != "" {
+			filename = output
+		} else {
+			// Generate default filename with unix timestamp
+			timestamp := time.Now().Unix()
+			filename = fmt.Sprintf("cutlass_%d.fcpxml", timestamp)
+		}
+
+		var fcpxml *fcp.FCPXML
+		var err error
+		if input != "" {
+			fcpxml, err = fcp.ReadFromFile(input)
+			if err != nil {
+				fmt.Fprintf(cmd.OutOrStdout(), "Error reading FCPXML file '%s': %v\n", input, err)
+				return
+			}
 		} else {
-			fmt.Printf("Generated FCPXML with text elements: %s (offset: %.1fs, duration: %.1fs)\n", filename, offset, duration)
+			fcpxml, err = fcp.GenerateEmpty("")
+			if err != nil {
+				fmt.Fprintf(cmd.OutOrStdout(), "Error creating FCPXML structure: %v\n", err)
+				return
+			}
 		}
+
+		if templatePath != "" {
+			tmpl, tmplErr := fcp.LoadTitleTemplate(templatePath)
+			if tmplErr != nil {
+				fmt.Fprintf(cmd.OutOrStdout(), "Error loading title template: %v\n", tmplErr)
+				return
+			}
+			err = fcp.AddTextFromTemplate(fcpxml, text, tmpl, offset, duration)
+		} else {
+			err = fcp.AddSingleTextStyled(fcpxml, text, offset, duration, fcp.TextStyleOptions{
+				Position:       position,
+				PositionOffset: positionOffset,
+			})
+		}
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error adding title: %v\n", err)
+			return
+		}
+
+		err = writeFCPXML(fcpxml, filename)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error writing FCPXML: %v\n", err)
+			return
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "Added title '%s' at %.1fs for %.1fs: %s\n", text, offset, duration, filename)
 	},
 }
 
@@ -288,7 +659,7 @@ Requires an existing FCPXML file with video content.`,
 		// Parse offset
 		offset, err := strconv.ParseFloat(offsetStr, 64)
 		if err != nil {
-			fmt.Printf("Error parsing offset '%s': %v\n", offsetStr, err)
+			fmt.Fprintf(cmd.OutOrStdout(), "Error parsing offset '%s': %v\n", offsetStr, err)
 			return
 		}
 		
@@ -297,7 +668,7 @@ Requires an existing FCPXML file with video content.`,
 		output, _ := cmd.Flags().GetString("output")
 		
 		if input == "" {
-			fmt.Printf("Error: --input is required for add-slide command\n")
+			fmt.Fprintf(cmd.OutOrStdout(), "Error: --input is required for add-slide command\n")
 			return
 		}
 		
@@ -313,26 +684,26 @@ Requires an existing FCPXML file with video content.`,
 		// Load existing FCPXML
 		fcpxml, err := fcp.ReadFromFile(input)
 		if err != nil {
-			fmt.Printf("Error reading FCPXML file '%s': %v\n", input, err)
+			fmt.Fprintf(cmd.OutOrStdout(), "Error reading FCPXML file '%s': %v\n", input, err)
 			return
 		}
-		fmt.Printf("Loaded existing FCPXML: %s\n", input)
+		fmt.Fprintf(cmd.OutOrStdout(), "Loaded existing FCPXML: %s\n", input)
 		
 		// Add slide animation to video at offset
 		err = fcp.AddSlideToVideoAtOffset(fcpxml, offset)
 		if err != nil {
-			fmt.Printf("Error adding slide animation: %v\n", err)
+			fmt.Fprintf(cmd.OutOrStdout(), "Error adding slide animation: %v\n", err)
 			return
 		}
 		
 		// Write to file
-		err = fcp.WriteToFile(fcpxml, filename)
+		err = writeFCPXML(fcpxml, filename)
 		if err != nil {
-			fmt.Printf("Error writing FCPXML: %v\n", err)
+			fmt.Fprintf(cmd.OutOrStdout(), "Error writing FCPXML: %v\n", err)
 			return
 		}
 		
-		fmt.Printf("Added slide animation to video at offset %.1fs and saved to: %s\n", offset, filename)
+		fmt.Fprintf(cmd.OutOrStdout(), "Added slide animation to video at offset %.1fs and saved to: %s\n", offset, filename)
 	},
 }
 
@@ -342,7 +713,8 @@ var addAudioCmd = &cobra.Command{
 	Long:  `Add an audio asset and asset-clip to an FCPXML file as the main audio track starting at 00:00.
 Supports WAV, MP3, M4A, and other audio formats.
 If --input is specified, the audio will be added to an existing FCPXML file.
-Otherwise, a new FCPXML file is created.`,
+Otherwise, a new FCPXML file is created.
+Use --role music or --role effects.foley to assign an audio role (default dialogue).`,
 	Args:  cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		audioFile := args[0]
@@ -367,37 +739,52 @@ Otherwise, a new FCPXML file is created.`,
 		if input != "" {
 			fcpxml, err = fcp.ReadFromFile(input)
 			if err != nil {
-				fmt.Printf("Error reading FCPXML file '%s': %v\n", input, err)
+				fmt.Fprintf(cmd.OutOrStdout(), "Error reading FCPXML file '%s': %v\n", input, err)
 				return
 			}
-			fmt.Printf("Loaded existing FCPXML: %s\n", input)
+			fmt.Fprintf(cmd.OutOrStdout(), "Loaded existing FCPXML: %s\n", input)
 		} else {
 			// Generate empty FCPXML structure
 			fcpxml, err = fcp.GenerateEmpty("")
 			if err != nil {
-				fmt.Printf("Error creating FCPXML structure: %v\n", err)
+				fmt.Fprintf(cmd.OutOrStdout(), "Error creating FCPXML structure: %v\n", err)
 				return
 			}
 		}
 		
+		// Route the new content into a named event (created if missing)
+		restoreEvents, err := selectEventFlag(cmd, fcpxml)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error selecting event: %v\n", err)
+			return
+		}
+
 		// Add audio to the structure
-		err = fcp.AddAudio(fcpxml, audioFile)
+		role, _ := cmd.Flags().GetString("role")
+		if role != "" {
+			err = fcp.AddAudioWithRole(fcpxml, audioFile, role)
+		} else {
+			err = fcp.AddAudio(fcpxml, audioFile)
+		}
 		if err != nil {
-			fmt.Printf("Error adding audio: %v\n", err)
+			fmt.Fprintf(cmd.OutOrStdout(), "Error adding audio: %v\n", err)
 			return
 		}
 		
+		// Put the library's events back in their original order
+		restoreEvents()
+
 		// Write to file
-		err = fcp.WriteToFile(fcpxml, filename)
+		err = writeFCPXML(fcpxml, filename)
 		if err != nil {
-			fmt.Printf("Error writing FCPXML: %v\n", err)
+			fmt.Fprintf(cmd.OutOrStdout(), "Error writing FCPXML: %v\n", err)
 			return
 		}
 		
 		if input != "" {
-			fmt.Printf("Added audio to existing FCPXML and saved to: %s\n", filename)
+			fmt.Fprintf(cmd.OutOrStdout(), "Added audio to existing FCPXML and saved to: %s\n", filename)
 		} else {
-			fmt.Printf("Generated FCPXML with audio: %s\n", filename)
+			fmt.Fprintf(cmd.OutOrStdout(), "Generated FCPXML with audio: %s\n", filename)
 		}
 	},
 }
@@ -421,7 +808,7 @@ Requires an existing FCPXML file with at least one video element to nest the PIP
 		offsetStr, _ := cmd.Flags().GetString("offset")
 		offset, err := strconv.ParseFloat(offsetStr, 64)
 		if err != nil {
-			fmt.Printf("Error parsing offset '%s': %v\n", offsetStr, err)
+			fmt.Fprintf(cmd.OutOrStdout(), "Error parsing offset '%s': %v\n", offsetStr, err)
 			return
 		}
 		
@@ -430,7 +817,7 @@ Requires an existing FCPXML file with at least one video element to nest the PIP
 		output, _ := cmd.Flags().GetString("output")
 		
 		if input == "" {
-			fmt.Printf("Error: --input is required for add-pip-video command\n")
+			fmt.Fprintf(cmd.OutOrStdout(), "Error: --input is required for add-pip-video command\n")
 			return
 		}
 		
@@ -446,26 +833,26 @@ Requires an existing FCPXML file with at least one video element to nest the PIP
 		// Load existing FCPXML
 		fcpxml, err := fcp.ReadFromFile(input)
 		if err != nil {
-			fmt.Printf("Error reading FCPXML file '%s': %v\n", input, err)
+			fmt.Fprintf(cmd.OutOrStdout(), "Error reading FCPXML file '%s': %v\n", input, err)
 			return
 		}
-		fmt.Printf("Loaded existing FCPXML: %s\n", input)
+		fmt.Fprintf(cmd.OutOrStdout(), "Loaded existing FCPXML: %s\n", input)
 		
 		// Add PIP video to the structure
 		err = fcp.AddPipVideo(fcpxml, pipVideoFile, offset)
 		if err != nil {
-			fmt.Printf("Error adding PIP video: %v\n", err)
+			fmt.Fprintf(cmd.OutOrStdout(), "Error adding PIP video: %v\n", err)
 			return
 		}
 		
 		// Write to file
-		err = fcp.WriteToFile(fcpxml, filename)
+		err = writeFCPXML(fcpxml, filename)
 		if err != nil {
-			fmt.Printf("Error writing FCPXML: %v\n", err)
+			fmt.Fprintf(cmd.OutOrStdout(), "Error writing FCPXML: %v\n", err)
 			return
 		}
 		
-		fmt.Printf("Added PIP video to existing FCPXML and saved to: %s (offset: %.1fs)\n", filename, offset)
+		fmt.Fprintf(cmd.OutOrStdout(), "Added PIP video to existing FCPXML and saved to: %s (offset: %.1fs)\n", filename, offset)
 	},
 }
 
@@ -502,7 +889,7 @@ Examples:
 		offsetStr, _ := cmd.Flags().GetString("offset")
 		offset, err := strconv.ParseFloat(offsetStr, 64)
 		if err != nil {
-			fmt.Printf("Error parsing offset '%s': %v\n", offsetStr, err)
+			fmt.Fprintf(cmd.OutOrStdout(), "Error parsing offset '%s': %v\n", offsetStr, err)
 			return
 		}
 		
@@ -510,7 +897,7 @@ Examples:
 		durationStr, _ := cmd.Flags().GetString("duration")
 		duration, err := strconv.ParseFloat(durationStr, 64)
 		if err != nil {
-			fmt.Printf("Error parsing duration '%s': %v\n", durationStr, err)
+			fmt.Fprintf(cmd.OutOrStdout(), "Error parsing duration '%s': %v\n", durationStr, err)
 			return
 		}
 		
@@ -537,10 +924,10 @@ Examples:
            // Appending mode - read existing FCPXML
            fcpxml, err = fcp.ReadFromFile(input)
            if err != nil {
-               fmt.Printf("Error reading FCPXML file '%s': %v\n", input, err)
+               fmt.Fprintf(cmd.OutOrStdout(), "Error reading FCPXML file '%s': %v\n", input, err)
                return
            }
-           fmt.Printf("Loaded existing FCPXML: %s\n", input)
+           fmt.Fprintf(cmd.OutOrStdout(), "Loaded existing FCPXML: %s\n", input)
 
            // Append new text using appropriate method
            if originalText != "" {
@@ -551,36 +938,36 @@ Examples:
                err = fcp.AddImessageContinuation(fcpxml, textContent, offset, duration)
            }
            if err != nil {
-               fmt.Printf("Error adding message: %v\n", err)
+               fmt.Fprintf(cmd.OutOrStdout(), "Error adding message: %v\n", err)
                return
            }
        } else {
            // Creating new mode
            fcpxml, err = fcp.GenerateEmpty("")
            if err != nil {
-               fmt.Printf("Error creating FCPXML structure: %v\n", err)
+               fmt.Fprintf(cmd.OutOrStdout(), "Error creating FCPXML structure: %v\n", err)
                return
            }
 
            // Add initial text to the structure
            err = fcp.AddImessageText(fcpxml, textContent, offset, duration)
            if err != nil {
-               fmt.Printf("Error adding text: %v\n", err)
+               fmt.Fprintf(cmd.OutOrStdout(), "Error adding text: %v\n", err)
                return
            }
        }
 		
 		// Write to file
-		err = fcp.WriteToFile(fcpxml, filename)
+		err = writeFCPXML(fcpxml, filename)
 		if err != nil {
-			fmt.Printf("Error writing FCPXML: %v\n", err)
+			fmt.Fprintf(cmd.OutOrStdout(), "Error writing FCPXML: %v\n", err)
 			return
 		}
 		
 		if input != "" {
-			fmt.Printf("Added text to existing FCPXML and saved to: %s (offset: %.1fs, duration: %.1fs)\n", filename, offset, duration)
+			fmt.Fprintf(cmd.OutOrStdout(), "Added text to existing FCPXML and saved to: %s (offset: %.1fs, duration: %.1fs)\n", filename, offset, duration)
 		} else {
-			fmt.Printf("Generated FCPXML with text: %s (offset: %.1fs, duration: %.1fs)\n", filename, offset, duration)
+			fmt.Fprintf(cmd.OutOrStdout(), "Generated FCPXML with text: %s (offset: %.1fs, duration: %.1fs)\n", filename, offset, duration)
 		}
 	},
 }
@@ -614,19 +1001,19 @@ File format (messages.txt):
 		// Parse offset and duration
 		offset, err := strconv.ParseFloat(offsetStr, 64)
 		if err != nil {
-			fmt.Printf("Error parsing offset '%s': %v\n", offsetStr, err)
+			fmt.Fprintf(cmd.OutOrStdout(), "Error parsing offset '%s': %v\n", offsetStr, err)
 			return
 		}
 		duration, err := strconv.ParseFloat(durationStr, 64)
 		if err != nil {
-			fmt.Printf("Error parsing duration '%s': %v\n", durationStr, err)
+			fmt.Fprintf(cmd.OutOrStdout(), "Error parsing duration '%s': %v\n", durationStr, err)
 			return
 		}
 		
 		// Read conversation file
 		content, err := os.ReadFile(conversationFile)
 		if err != nil {
-			fmt.Printf("Error reading conversation file '%s': %v\n", conversationFile, err)
+			fmt.Fprintf(cmd.OutOrStdout(), "Error reading conversation file '%s': %v\n", conversationFile, err)
 			return
 		}
 		
@@ -641,7 +1028,7 @@ File format (messages.txt):
 		}
 		
 		if len(messages) == 0 {
-			fmt.Printf("No messages found in conversation file '%s'\n", conversationFile)
+			fmt.Fprintf(cmd.OutOrStdout(), "No messages found in conversation file '%s'\n", conversationFile)
 			return
 		}
 		
@@ -657,13 +1044,13 @@ File format (messages.txt):
 		// Create first message (blue bubble)
 		fcpxml, err := fcp.GenerateEmpty("")
 		if err != nil {
-			fmt.Printf("Error creating FCPXML structure: %v\n", err)
+			fmt.Fprintf(cmd.OutOrStdout(), "Error creating FCPXML structure: %v\n", err)
 			return
 		}
 		
 		err = fcp.AddImessageText(fcpxml, messages[0], offset, duration)
 		if err != nil {
-			fmt.Printf("Error adding first message: %v\n", err)
+			fmt.Fprintf(cmd.OutOrStdout(), "Error adding first message: %v\n", err)
 			return
 		}
 		
@@ -673,30 +1060,30 @@ File format (messages.txt):
 		for i := 1; i < len(messages); i++ {
 			if i%2 == 1 {
 				// Odd messages (1,3,5...): white bubbles with --original-text
-				fmt.Printf("DEBUG: Message %d ('%s') -> AddImessageReply\n", i+1, messages[i])
+				fmt.Fprintf(cmd.OutOrStdout(), "DEBUG: Message %d ('%s') -> AddImessageReply\n", i+1, messages[i])
 				err = fcp.AddImessageReply(fcpxml, messages[i-1], messages[i], offset, duration)
 			} else {
 				// Even messages (2,4,6...): attempt blue bubbles without --original-text
 				// This matches: ./cutlass fcp add-txt -i trip.fcpxml "u sure?" -o trip.fcpxml
 				// Even though AddImessageContinuation is broken, use it to match your sequence
-				fmt.Printf("DEBUG: Message %d ('%s') -> AddImessageContinuation\n", i+1, messages[i])
+				fmt.Fprintf(cmd.OutOrStdout(), "DEBUG: Message %d ('%s') -> AddImessageContinuation\n", i+1, messages[i])
 				err = fcp.AddImessageContinuation(fcpxml, messages[i], offset, duration)
 			}
 			
 			if err != nil {
-				fmt.Printf("Error adding message %d ('%s'): %v\n", i+1, messages[i], err)
+				fmt.Fprintf(cmd.OutOrStdout(), "Error adding message %d ('%s'): %v\n", i+1, messages[i], err)
 				return
 			}
 		}
 		
 		// Write to file
-		err = fcp.WriteToFile(fcpxml, filename)
+		err = writeFCPXML(fcpxml, filename)
 		if err != nil {
-			fmt.Printf("Error writing FCPXML: %v\n", err)
+			fmt.Fprintf(cmd.OutOrStdout(), "Error writing FCPXML: %v\n", err)
 			return
 		}
 		
-		fmt.Printf("Generated conversation FCPXML with %d messages: %s\n", len(messages), filename)
+		fmt.Fprintf(cmd.OutOrStdout(), "Generated conversation FCPXML with %d messages: %s\n", len(messages), filename)
 	},
 }
 
@@ -738,18 +1125,18 @@ that might not occur in normal usage.`,
 		
 		minDuration, err := strconv.ParseFloat(minDurationStr, 64)
 		if err != nil {
-			fmt.Printf("Error parsing min-duration '%s': %v\n", minDurationStr, err)
+			fmt.Fprintf(cmd.OutOrStdout(), "Error parsing min-duration '%s': %v\n", minDurationStr, err)
 			return
 		}
 		
 		maxDuration, err := strconv.ParseFloat(maxDurationStr, 64)
 		if err != nil {
-			fmt.Printf("Error parsing max-duration '%s': %v\n", maxDurationStr, err)
+			fmt.Fprintf(cmd.OutOrStdout(), "Error parsing max-duration '%s': %v\n", maxDurationStr, err)
 			return
 		}
 		
 		if minDuration >= maxDuration {
-			fmt.Printf("Error: min-duration (%.1f) must be less than max-duration (%.1f)\n", minDuration, maxDuration)
+			fmt.Fprintf(cmd.OutOrStdout(), "Error: min-duration (%.1f) must be less than max-duration (%.1f)\n", minDuration, maxDuration)
 			return
 		}
 		
@@ -757,23 +1144,23 @@ that might not occur in normal usage.`,
 		verbose, _ := cmd.Flags().GetBool("verbose")
 		
 		// Generate random complex timeline
-		fmt.Printf("Generating random complex timeline (%.1f-%.1f minutes)...\n", minDuration/60, maxDuration/60)
+		fmt.Fprintf(cmd.OutOrStdout(), "Generating random complex timeline (%.1f-%.1f minutes)...\n", minDuration/60, maxDuration/60)
 		
 		fcpxml, err := fcp.GenerateBaffleTimeline(minDuration, maxDuration, verbose)
 		if err != nil {
-			fmt.Printf("Error generating baffle timeline: %v\n", err)
+			fmt.Fprintf(cmd.OutOrStdout(), "Error generating baffle timeline: %v\n", err)
 			return
 		}
 		
 		// Write to file
-		err = fcp.WriteToFile(fcpxml, filename)
+		err = writeFCPXML(fcpxml, filename)
 		if err != nil {
-			fmt.Printf("Error writing FCPXML: %v\n", err)
+			fmt.Fprintf(cmd.OutOrStdout(), "Error writing FCPXML: %v\n", err)
 			return
 		}
 		
-		fmt.Printf("Generated complex baffle timeline: %s\n", filename)
-		fmt.Printf("Import this into Final Cut Pro to test for crashes and issues.\n")
+		fmt.Fprintf(cmd.OutOrStdout(), "Generated complex baffle timeline: %s\n", filename)
+		fmt.Fprintf(cmd.OutOrStdout(), "Import this into Final Cut Pro to test for crashes and issues.\n")
 	},
 }
 
@@ -836,33 +1223,33 @@ Examples:
 		// Parse duration
 		duration, err := strconv.ParseFloat(durationStr, 64)
 		if err != nil {
-			fmt.Printf("Error parsing duration '%s': %v\n", durationStr, err)
+			fmt.Fprintf(cmd.OutOrStdout(), "Error parsing duration '%s': %v\n", durationStr, err)
 			return
 		}
 		
 		// Parse total images
 		totalImages, err := strconv.Atoi(imagesStr)
 		if err != nil {
-			fmt.Printf("Error parsing images '%s': %v\n", imagesStr, err)
+			fmt.Fprintf(cmd.OutOrStdout(), "Error parsing images '%s': %v\n", imagesStr, err)
 			return
 		}
 		
 		// Parse complexity
 		complexity, err := strconv.ParseFloat(complexityStr, 64)
 		if err != nil {
-			fmt.Printf("Error parsing complexity '%s': %v\n", complexityStr, err)
+			fmt.Fprintf(cmd.OutOrStdout(), "Error parsing complexity '%s': %v\n", complexityStr, err)
 			return
 		}
 		
 		// Validate format parameter
 		if format != "horizontal" && format != "vertical" {
-			fmt.Printf("Error: format must be 'horizontal' or 'vertical', got '%s'\n", format)
+			fmt.Fprintf(cmd.OutOrStdout(), "Error: format must be 'horizontal' or 'vertical', got '%s'\n", format)
 			return
 		}
 		
 		// Validate complexity
 		if complexity < 0.0 || complexity > 1.0 {
-			fmt.Printf("Error: complexity must be between 0.0 and 1.0, got %.2f\n", complexity)
+			fmt.Fprintf(cmd.OutOrStdout(), "Error: complexity must be between 0.0 and 1.0, got %.2f\n", complexity)
 			return
 		}
 		
@@ -872,7 +1259,7 @@ Examples:
 		
 		if step == 1 {
 			// Step 1: 9 second video with 18 pixabay images, cuts every 0.5 seconds
-			fmt.Printf("Generating Step 1: 9 second video with 18 images, 0.5s cuts (Michael Bay style)...\n")
+			fmt.Fprintf(cmd.OutOrStdout(), "Generating Step 1: 9 second video with 18 images, 0.5s cuts (Michael Bay style)...\n")
 			
 			config = &fcp.StoryBaffleConfig{
 				Duration:      9.0,    // Fixed 9 seconds for step 1
@@ -896,25 +1283,25 @@ Examples:
 			}
 			
 			// Generate story-baffle timeline
-			fmt.Printf("Generating AI video creation story-baffle (%.1f minutes)...\n", duration/60)
+			fmt.Fprintf(cmd.OutOrStdout(), "Generating AI video creation story-baffle (%.1f minutes)...\n", duration/60)
 			
 			fcpxml, err = fcp.GenerateStoryBaffle(config, verbose)
 		}
 		if err != nil {
-			fmt.Printf("Error generating story-baffle timeline: %v\n", err)
+			fmt.Fprintf(cmd.OutOrStdout(), "Error generating story-baffle timeline: %v\n", err)
 			return
 		}
 		
 		// Write to file
-		err = fcp.WriteToFile(fcpxml, filename)
+		err = writeFCPXML(fcpxml, filename)
 		if err != nil {
-			fmt.Printf("Error writing FCPXML: %v\n", err)
+			fmt.Fprintf(cmd.OutOrStdout(), "Error writing FCPXML: %v\n", err)
 			return
 		}
 		
-		fmt.Printf("Generated AI video creation story-baffle: %s\n", filename)
-		fmt.Printf("Images saved to: %s\n", config.OutputDir)
-		fmt.Printf("Import this into Final Cut Pro for a wild ride!\n")
+		fmt.Fprintf(cmd.OutOrStdout(), "Generated AI video creation story-baffle: %s\n", filename)
+		fmt.Fprintf(cmd.OutOrStdout(), "Images saved to: %s\n", config.OutputDir)
+		fmt.Fprintf(cmd.OutOrStdout(), "Import this into Final Cut Pro for a wild ride!\n")
 	},
 }
 
@@ -924,8 +1311,8 @@ var pngPileCmd = &cobra.Command{
 	Long: `Generate a PNG pile effect similar to Info.fcpxml with:
 - Base track using 164240-830460859.mp4 video
 - 90 different PNG images sliding in from all directions
-- Black borders on all images like the reference
-- Increasing pace as more images appear
+- Black borders on all images like the reference (configurable with --border-color/--no-border)
+- Increasing pace as more images appear (configurable with --pace)
 - Progressive multi-lane composition up to 90 lanes
 - Themed story progression through the images
 
@@ -944,7 +1331,13 @@ Image Sources:
 Examples:
   cutlass fcp png-pile                               # Use existing PNGs
   cutlass fcp png-pile --download --api-key YOUR_KEY  # Download from Pixabay
-  cutlass fcp png-pile --duration 30 --images 90      # 30 seconds with 90 images`,
+  cutlass fcp png-pile --duration 30 --images 90      # 30 seconds with 90 images
+  cutlass fcp png-pile --border-color "1 1 1 1"       # White borders
+  cutlass fcp png-pile --no-border                    # No borders
+  cutlass fcp png-pile --optimize-images --max-edge 1920  # Downscale huge source images
+  cutlass fcp png-pile --pace linear                  # Evenly spaced images
+  cutlass fcp png-pile --download --attributions CREDITS.txt  # Credit downloaded photos
+  cutlass fcp png-pile --skip-bad-images             # Leave out black or blown-out images`,
 	Args: cobra.RangeArgs(0, 1),
 	Run: func(cmd *cobra.Command, args []string) {
 		// Get output filename
@@ -962,58 +1355,97 @@ Examples:
 		inputDir, _ := cmd.Flags().GetString("input-dir")
 		apiKey, _ := cmd.Flags().GetString("api-key")
 		download, _ := cmd.Flags().GetBool("download")
+		borderColorStr, _ := cmd.Flags().GetString("border-color")
+		noBorder, _ := cmd.Flags().GetBool("no-border")
+		optimizeImages, _ := cmd.Flags().GetBool("optimize-images")
+		maxEdge, _ := cmd.Flags().GetInt("max-edge")
+		pace, _ := cmd.Flags().GetString("pace")
+		attributionsPath, _ := cmd.Flags().GetString("attributions")
+		numbered, _ := cmd.Flags().GetBool("numbered")
+		strict, _ := cmd.Flags().GetBool("strict")
+		skipBadImages, _ := cmd.Flags().GetBool("skip-bad-images")
 		verbose, _ := cmd.Flags().GetBool("verbose")
 		
 		// Parse duration
 		duration, err := strconv.ParseFloat(durationStr, 64)
 		if err != nil {
-			fmt.Printf("Error parsing duration '%s': %v\n", durationStr, err)
+			fmt.Fprintf(cmd.OutOrStdout(), "Error parsing duration '%s': %v\n", durationStr, err)
 			return
 		}
 		
 		// Parse total images
 		totalImages, err := strconv.Atoi(imagesStr)
 		if err != nil {
-			fmt.Printf("Error parsing images '%s': %v\n", imagesStr, err)
+			fmt.Fprintf(cmd.OutOrStdout(), "Error parsing images '%s': %v\n", imagesStr, err)
 			return
 		}
 		
-		// Generate PNG pile timeline
-		fmt.Printf("Generating PNG pile timeline (%.1f seconds with %d images)...\n", duration, totalImages)
+		// Parse border color
+		borderColor, err := fcp.ParseRGBA(borderColorStr)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error parsing border-color '%s': %v\n", borderColorStr, err)
+			return
+		}
 		
-		var fcpxml *fcp.FCPXML
-		if download {
-			// Download themed images from Pixabay
-			config := &fcp.PngPileConfig{
-				Duration:      duration,
-				TotalImages:   totalImages,
-				OutputDir:     inputDir,
-				PixabayAPIKey: apiKey,
-				UseExisting:   false,
+		// Credits come from the download, and live next to the pile they belong to
+		if attributionsPath != "" {
+			if !download {
+				fmt.Fprintf(cmd.OutOrStdout(), "Error: --attributions needs --download (existing images have no credits)\n")
+				return
 			}
-			fcpxml, err = fcp.GeneratePngPileWithConfig(config, verbose)
-		} else {
-			// Use existing images
-			fcpxml, err = fcp.GeneratePngPile(duration, totalImages, inputDir, verbose)
+			if !filepath.IsAbs(attributionsPath) {
+				attributionsPath = filepath.Join(filepath.Dir(filename), attributionsPath)
+			}
+		}
+		
+		// Generate PNG pile timeline
+		fmt.Fprintf(cmd.OutOrStdout(), "Generating PNG pile timeline (%.1f seconds with %d images)...\n", duration, totalImages)
+		
+		// Download themed images from Pixabay, or use existing images
+		config := &fcp.PngPileConfig{
+			Duration:         duration,
+			TotalImages:      totalImages,
+			OutputDir:        inputDir,
+			PixabayAPIKey:    apiKey,
+			UseExisting:      !download,
+			BorderColor:      &borderColor,
+			NoBorder:         noBorder,
+			OptimizeImages:   optimizeImages,
+			MaxEdge:          maxEdge,
+			Pace:             fcp.PaceConfig{Curve: pace},
+			AttributionsPath: attributionsPath,
+			NumberOverlay:    numbered,
+			Limits:           fcp.RenderLimits{Strict: strict},
+			SkipBadImages:    skipBadImages,
+		}
+		fcpxml, err := fcp.GeneratePngPileWithConfig(config, verbose)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error generating PNG pile timeline: %v\n", err)
+			return
 		}
 		
+		// Overlay title/action safe area outlines as a framing aid
+		err = addSafeGuides(cmd, fcpxml)
 		if err != nil {
-			fmt.Printf("Error generating PNG pile timeline: %v\n", err)
+			fmt.Fprintf(cmd.OutOrStdout(), "Error adding safe area guides: %v\n", err)
 			return
 		}
 		
 		// Write to file
-		err = fcp.WriteToFile(fcpxml, filename)
+		err = writeFCPXML(fcpxml, filename)
 		if err != nil {
-			fmt.Printf("Error writing FCPXML: %v\n", err)
+			fmt.Fprintf(cmd.OutOrStdout(), "Error writing FCPXML: %v\n", err)
 			return
 		}
 		
-		fmt.Printf("Generated PNG pile timeline: %s\n", filename)
+		fmt.Fprintf(cmd.OutOrStdout(), "Generated PNG pile timeline: %s\n", filename)
 		if download {
-			fmt.Printf("Images downloaded to: %s\n", inputDir)
+			fmt.Fprintf(cmd.OutOrStdout(), "Images downloaded to: %s\n", inputDir)
+		}
+		if attributionsPath != "" {
+			fmt.Fprintf(cmd.OutOrStdout(), "Image credits written to: %s\n", attributionsPath)
 		}
-		fmt.Printf("Import this into Final Cut Pro to view the sliding PNG pile effect.\n")
+		fmt.Fprintf(cmd.OutOrStdout(), "Import this into Final Cut Pro to view the sliding PNG pile effect.\n")
 	},
 }
 
@@ -1066,27 +1498,27 @@ Examples:
 		// Parse duration
 		duration, err := strconv.ParseFloat(durationStr, 64)
 		if err != nil {
-			fmt.Printf("Error parsing duration '%s': %v\n", durationStr, err)
+			fmt.Fprintf(cmd.OutOrStdout(), "Error parsing duration '%s': %v\n", durationStr, err)
 			return
 		}
 		
 		// Parse total images
 		totalImages, err := strconv.Atoi(imagesStr)
 		if err != nil {
-			fmt.Printf("Error parsing images '%s': %v\n", imagesStr, err)
+			fmt.Fprintf(cmd.OutOrStdout(), "Error parsing images '%s': %v\n", imagesStr, err)
 			return
 		}
 		
 		// Parse images per word
 		imagesPerWord, err := strconv.Atoi(imagesPerWordStr)
 		if err != nil {
-			fmt.Printf("Error parsing images-per-word '%s': %v\n", imagesPerWordStr, err)
+			fmt.Fprintf(cmd.OutOrStdout(), "Error parsing images-per-word '%s': %v\n", imagesPerWordStr, err)
 			return
 		}
 		
 		// Validate format parameter
 		if format != "horizontal" && format != "vertical" {
-			fmt.Printf("Error: format must be 'horizontal' or 'vertical', got '%s'\n", format)
+			fmt.Fprintf(cmd.OutOrStdout(), "Error: format must be 'horizontal' or 'vertical', got '%s'\n", format)
 			return
 		}
 		
@@ -1104,54 +1536,206 @@ Examples:
 		}
 		
 		// Generate story timeline
-		fmt.Printf("Generating story timeline (%.1f minutes with %d images)...\n", duration/60, totalImages)
+		fmt.Fprintf(cmd.OutOrStdout(), "Generating story timeline (%.1f minutes with %d images)...\n", duration/60, totalImages)
 		
 		fcpxml, err := fcp.GenerateStoryTimeline(config, verbose)
 		if err != nil {
-			fmt.Printf("Error generating story timeline: %v\n", err)
+			fmt.Fprintf(cmd.OutOrStdout(), "Error generating story timeline: %v\n", err)
 			return
 		}
 		
 		// Write to file
-		err = fcp.WriteToFile(fcpxml, filename)
+		err = writeFCPXML(fcpxml, filename)
 		if err != nil {
-			fmt.Printf("Error writing FCPXML: %v\n", err)
+			fmt.Fprintf(cmd.OutOrStdout(), "Error writing FCPXML: %v\n", err)
 			return
 		}
 		
-		fmt.Printf("Generated story timeline: %s\n", filename)
-		fmt.Printf("Images saved to: %s\n", config.OutputDir)
-		fmt.Printf("Import this into Final Cut Pro to view your story.\n")
+		fmt.Fprintf(cmd.OutOrStdout(), "Generated story timeline: %s\n", filename)
+		fmt.Fprintf(cmd.OutOrStdout(), "Images saved to: %s\n", config.OutputDir)
+		fmt.Fprintf(cmd.OutOrStdout(), "Import this into Final Cut Pro to view your story.\n")
+	},
+}
+
+var syncAudioCmd = &cobra.Command{
+	Use:   "sync-audio [input-fcpxml] [audio-file]",
+	Short: "Connect separately recorded audio under a video clip",
+	Long: `Attach dual-system sound to a video clip as a connected audio clip below it.
+--offset is where the audio starts relative to the clip: positive if the recorder
+started after the camera, negative if it was already rolling.
+
+Examples:
+  cutlass fcp sync-audio project.fcpxml zoom.wav --clip 0 --offset 1.5 -o synced.fcpxml
+  cutlass fcp sync-audio project.fcpxml zoom.wav --offset -2.25`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		input := args[0]
+		audioFile := args[1]
+		output, _ := cmd.Flags().GetString("output")
+		clipIndex, _ := cmd.Flags().GetInt("clip")
+		offset, _ := cmd.Flags().GetFloat64("offset")
+
+		var filename string
+		if output != "" {
+			filename = output
+		} else {
+			// Generate default filename with unix timestamp
+			timestamp := time.Now().Unix()
+			filename = fmt.Sprintf("cutlass_%d.fcpxml", timestamp)
+		}
+
+		fcpxml, err := fcp.ReadFromFile(input)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error reading FCPXML file '%s': %v\n", input, err)
+			return
+		}
+
+		if err := fcp.SyncExternalAudio(fcpxml, clipIndex, audioFile, offset); err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error syncing audio: %v\n", err)
+			return
+		}
+
+		err = writeFCPXML(fcpxml, filename)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error writing FCPXML: %v\n", err)
+			return
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "Synced %s to clip %d: %s\n", audioFile, clipIndex, filename)
+	},
+}
+
+var beatSyncCmd = &cobra.Command{
+	Use:   "beat-sync [image1] [image2] ...",
+	Short: "Cut images on the beats of a music track",
+	Long: `Build a music-video timeline where every image cut lands on a beat of the audio.
+Images are cycled when the track has more beats than images.
+
+Beats are detected from the audio with ffmpeg. When detection isn't available or
+misses the rhythm, pass --beats with a text file holding one timestamp (seconds) per line.
+
+Examples:
+  cutlass fcp beat-sync a.png b.png c.png --audio song.wav
+  cutlass fcp beat-sync *.png --audio song.wav --beats beats.txt -o music_video.fcpxml`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		audioPath, _ := cmd.Flags().GetString("audio")
+		beatsPath, _ := cmd.Flags().GetString("beats")
+		output, _ := cmd.Flags().GetString("output")
+
+		if audioPath == "" {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error: --audio is required\n")
+			return
+		}
+
+		var filename string
+		if output != "" {
+			filename = output
+		} else {
+			// Generate default filename with unix timestamp
+			timestamp := time.Now().Unix()
+			filename = fmt.Sprintf("cutlass_%d.fcpxml", timestamp)
+		}
+
+		var fcpxml *fcp.FCPXML
+		var err error
+		if beatsPath != "" {
+			beats, readErr := fcp.ReadBeatsFile(beatsPath)
+			if readErr != nil {
+				fmt.Fprintf(cmd.OutOrStdout(), "Error reading beats file: %v\n", readErr)
+				return
+			}
+			fcpxml, err = fcp.GenerateBeatSyncWithBeats(args, audioPath, beats)
+		} else {
+			fcpxml, err = fcp.GenerateBeatSync(args, audioPath)
+		}
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error generating beat sync timeline: %v\n", err)
+			return
+		}
+
+		err = writeFCPXML(fcpxml, filename)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error writing FCPXML: %v\n", err)
+			return
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "Generated beat sync timeline: %s\n", filename)
 	},
 }
 
 func init() {
 	// Add output flag to create-empty subcommand
 	createEmptyCmd.Flags().StringP("output", "o", "", "Output filename (defaults to cutlass_unixtime.fcpxml)")
+	createEmptyCmd.Flags().String("audio-layout", "stereo", "Sequence audio layout: mono, stereo or surround")
+	createEmptyCmd.Flags().String("audio-rate", "48k", "Sequence audio sample rate: 32k, 44.1k, 48k, 88.2k, 96k, 176.4k or 192k")
 	
 	// Add flags to add-video subcommand
 	addVideoCmd.Flags().StringP("input", "i", "", "Input FCPXML file to append to (optional)")
+	addEventFlag(addVideoCmd)
 	addVideoCmd.Flags().StringP("output", "o", "", "Output filename (defaults to cutlass_unixtime.fcpxml)")
+	addVideoCmd.Flags().String("captions", "", "SubRip (.srt) file whose cues become caption titles on the clip")
+	addVideoCmd.Flags().Bool("auto-captions", false, "Transcribe the clip and nest the transcript as caption titles")
+	addVideoCmd.Flags().String("transcriber", fcp.DefaultTranscriberCommand, "Speech-to-text command used by --auto-captions (whisper-style CLI)")
+	addVideoCmd.Flags().String("conform", "", "Detect the clip's frame rate and conform a mismatch: floor, nearest, or preserve (default: import as is)")
+	addVideoCmd.Flags().String("key-color", "", "Chroma key color as 'r g b a' (0.0-1.0), e.g. '0 1 0 1' for green screen")
+	addVideoCmd.Flags().Float64("poster", 0, "Seconds into the clip of its thumbnail, stored as a \"Poster Frame\" chapter marker at the clip's head")
+	addVideoCmd.Flags().String("role", "", "Audio role or role.subrole for the clip, e.g. music.score (default dialogue)")
+	addVideoCmd.Flags().String("curves", "", "JSON file of position/scale/rotation keyframes ({param: [{t, value, curve}]}) to animate the clip")
+	addVideoCmd.Flags().String("punch", "", "Punch-in zoom as 'at,hold,zoom' in seconds and scale, e.g. '5,2,1.5'")
+	addVideoCmd.Flags().Float64("gain", 0, "Constant clip volume in dB, e.g. -6 (FCP allows -96 to +12)")
+	addVideoCmd.Flags().Float64("letterbox", 0, "Overlay black bars framing this aspect ratio (e.g. 2.39); bars are sides when narrower than the sequence")
+	addSafeGuidesFlag(addVideoCmd)
 	
 	// Add flags to add-image subcommand
 	addImageCmd.Flags().StringP("input", "i", "", "Input FCPXML file to append to (optional)")
+	addEventFlag(addImageCmd)
 	addImageCmd.Flags().StringP("output", "o", "", "Output filename (defaults to cutlass_unixtime.fcpxml)")
 	addImageCmd.Flags().StringP("duration", "d", "9", "Duration in seconds (default 9)")
 	addImageCmd.Flags().Bool("with-slide", false, "Add keyframe animation to slide the image from left to right over 1 second")
+	addImageCmd.Flags().String("slide-from", "", "Slide the image in to center from an edge: left, right, up or down")
+	addImageCmd.Flags().Float64("slide-distance", 0, "How far off center --slide-from starts, in FCP position units (default stock distance)")
+	addImageCmd.Flags().String("static-scale", "", "Fixed scale as 'x y' (or one value for both), written without keyframes")
+	addImageCmd.Flags().String("static-position", "", "Fixed position as 'x y', written without keyframes")
+	addImageCmd.Flags().String("pan-from", "", "Ken Burns start region as 'x y width height' fractions of the image (default whole image)")
+	addImageCmd.Flags().String("pan-to", "", "Ken Burns end region as 'x y width height' fractions of the image (default whole image)")
+	addImageCmd.Flags().Float64("gap", 0, "Seconds of gap (black/silence) to insert before the image")
+	addImageOptionFlags(addImageCmd)
+	addImageCmd.Flags().Float64("letterbox", 0, "Overlay black bars framing this aspect ratio (e.g. 2.39); bars are sides when narrower than the sequence")
+	addSafeGuidesFlag(addImageCmd)
 	
 	// Add flags to add-text subcommand
 	addTextCmd.Flags().StringP("input", "i", "", "Input FCPXML file to append to (optional)")
 	addTextCmd.Flags().StringP("output", "o", "", "Output filename (defaults to cutlass_unixtime.fcpxml)")
 	addTextCmd.Flags().StringP("offset", "t", "1", "Start time offset in seconds (default 1)")
 	addTextCmd.Flags().StringP("duration", "d", "9", "Duration of each text element in seconds (default 9)")
+	addSafeGuidesFlag(addTextCmd)
+	addTextCmd.Flags().Bool("shadow", false, "Add a drop shadow behind the text")
+	addTextCmd.Flags().String("shadow-offset", fcp.DefaultTextShadowOffset, "Drop shadow offset as 'distance angle'")
+	addTextCmd.Flags().String("outline-color", fcp.DefaultTextOutlineColor, "Outline color as 'r g b a' with values 0.0-1.0")
+	addTextCmd.Flags().Float64("outline-width", 0, "Outline width; 0 disables the outline")
+	addTextCmd.Flags().String("stagger-axis", fcp.StaggerAxisVertical, "Direction lines are spread in: vertical (downward) or horizontal (left-to-right)")
+	addTextCmd.Flags().Int("stagger-px", fcp.DefaultStaggerPixelStep, "Pixels between consecutive lines")
+	addTextCmd.Flags().Float64("stagger-time", 0, "Seconds between consecutive lines (default half the duration; 0 reveals all lines at once)")
+	addTextCmd.Flags().Bool("autofit-text", false, "Tighten line spacing (or wrap into columns) so long files stay inside the title-safe area")
 	
 	// Add flags to add-slide subcommand
+	addTitleCmd.Flags().StringP("input", "i", "", "Input FCPXML file to append to (optional)")
+	addTitleCmd.Flags().StringP("output", "o", "", "Output filename (defaults to cutlass_unixtime.fcpxml)")
+	addTitleCmd.Flags().String("template", "", "JSON title template with font, size, color, position, shadow/outline and entrance/exit")
+	addTitleCmd.Flags().Float64P("offset", "t", 1, "Start time offset in seconds")
+	addTitleCmd.Flags().Float64P("duration", "d", 5, "Duration of the title in seconds")
+	addTitleCmd.Flags().String("position", "", "Named screen position, e.g. bottom-center or top-left (default keeps the standard lower position)")
+	addTitleCmd.Flags().String("position-offset", "", "Nudge the position by \"x y\" pixels, +y up")
+
 	addSlideCmd.Flags().StringP("input", "i", "", "Input FCPXML file to read from (required)")
 	addSlideCmd.Flags().StringP("output", "o", "", "Output filename (defaults to cutlass_unixtime.fcpxml)")
 	
 	// Add flags to add-audio subcommand
 	addAudioCmd.Flags().StringP("input", "i", "", "Input FCPXML file to append to (optional)")
+	addEventFlag(addAudioCmd)
 	addAudioCmd.Flags().StringP("output", "o", "", "Output filename (defaults to cutlass_unixtime.fcpxml)")
+	addAudioCmd.Flags().String("role", "", "Audio role or role.subrole, e.g. music, effects.foley (default dialogue)")
 	
 	// Add flags to add-pip-video subcommand
 	addPipVideoCmd.Flags().StringP("input", "i", "", "Input FCPXML file to read from (required)")
@@ -1191,6 +1775,16 @@ func init() {
 	pngPileCmd.Flags().String("input-dir", "./png_pile_assets", "Directory containing PNG images (default ./png_pile_assets)")
 	pngPileCmd.Flags().String("api-key", "", "Pixabay API key for downloading images (optional)")
 	pngPileCmd.Flags().Bool("download", false, "Download themed images from Pixabay instead of using existing files")
+	pngPileCmd.Flags().String("border-color", "0 0 0 1", "Border color as 'r g b a' with values 0.0-1.0 (default black)")
+	pngPileCmd.Flags().Bool("no-border", false, "Leave out the border; otherwise Simple Border is drawn at its built-in width")
+	pngPileCmd.Flags().Bool("optimize-images", false, "Reference downscaled cached copies of images larger than --max-edge")
+	pngPileCmd.Flags().Int("max-edge", fcp.DefaultMaxImageEdge, "Longest image edge in pixels for --optimize-images")
+	pngPileCmd.Flags().String("pace", fcp.PaceAccelerate, "Image pacing: accelerate, decelerate, linear, or ease")
+	pngPileCmd.Flags().String("attributions", "", "With --download, write photographer/source credits for the images used to this file, next to the output (e.g. CREDITS.txt)")
+	pngPileCmd.Flags().Bool("numbered", false, "Label each PNG with a large sequential number (1..N) for countdown videos")
+	pngPileCmd.Flags().Bool("skip-bad-images", false, "Skip images that are almost entirely black, blown out or unreadable, with a warning")
+	pngPileCmd.Flags().Bool("strict", false, "Fail instead of warning when the pile exceeds 10,000 elements or 2 hours")
+	addSafeGuidesFlag(pngPileCmd)
 	pngPileCmd.Flags().BoolP("verbose", "v", false, "Verbose output showing generation details")
 
 	// Add flags to story subcommand
@@ -1204,11 +1798,22 @@ func init() {
 	storyCmd.Flags().String("input-file", "", "Text file with sentences (one per line) to use instead of random words")
 	storyCmd.Flags().String("format", "horizontal", "Video format: 'horizontal' (1280x720) or 'vertical' (1080x1920) (default 'horizontal')")
 	storyCmd.Flags().BoolP("verbose", "v", false, "Verbose output showing generation details")
+
+	// Add flags to beat-sync subcommand
+	beatSyncCmd.Flags().String("audio", "", "Music track to cut on (required)")
+	beatSyncCmd.Flags().String("beats", "", "Beats file with one timestamp in seconds per line (skips detection)")
+	beatSyncCmd.Flags().StringP("output", "o", "", "Output filename (defaults to cutlass_unixtime.fcpxml)")
+
+	// Add flags to sync-audio subcommand
+	syncAudioCmd.Flags().Int("clip", 0, "Timeline position of the video clip to sync under, counting from 0 with gaps included")
+	syncAudioCmd.Flags().Float64("offset", 0, "Seconds the audio starts after (positive) or before (negative) the clip")
+	syncAudioCmd.Flags().StringP("output", "o", "", "Output filename (defaults to cutlass_unixtime.fcpxml)")
 	
 	fcpCmd.AddCommand(createEmptyCmd)
 	fcpCmd.AddCommand(addVideoCmd)
 	fcpCmd.AddCommand(addImageCmd)
 	fcpCmd.AddCommand(addTextCmd)
+	fcpCmd.AddCommand(addTitleCmd)
 	fcpCmd.AddCommand(addSlideCmd)
 	fcpCmd.AddCommand(addAudioCmd)
 	fcpCmd.AddCommand(addPipVideoCmd)
@@ -1218,4 +1823,6 @@ func init() {
 	fcpCmd.AddCommand(storyBaffleCmd)
 	fcpCmd.AddCommand(pngPileCmd)
 	fcpCmd.AddCommand(storyCmd)
+	fcpCmd.AddCommand(beatSyncCmd)
+	fcpCmd.AddCommand(syncAudioCmd)
 }
@@ -0,0 +1,80 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"cutlass/fcp"
+
+	"github.com/spf13/cobra"
+)
+
+var fixFormatsCmd = &cobra.Command{
+	Use:   "fix-formats <input.fcpxml>",
+	Short: "Repoint asset-clips at their asset's format",
+	Long: `Validate an FCPXML, rewrite the format of every asset-clip that disagrees with its
+asset's format (a mismatch Final Cut Pro can crash on), then validate again and report
+what is left. Other violations are reported but not changed.
+
+Examples:
+  cutlass fix-formats broken.fcpxml -o fixed.fcpxml`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		input := args[0]
+		output, _ := cmd.Flags().GetString("output")
+
+		var filename string
+		if output != "" {
+			filename = output
+		} else {
+			// Generate default filename with unix timestamp
+			timestamp := time.Now().Unix()
+			filename = fmt.Sprintf("cutlass_%d.fcpxml", timestamp)
+		}
+
+		fcpxml, err := fcp.ReadFromFile(input)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error reading FCPXML file '%s': %v\n", input, err)
+			return
+		}
+
+		before := fcp.ValidateClaudeCompliance(fcpxml)
+		mismatches := 0
+		for _, violation := range before {
+			if strings.HasPrefix(violation, "Format mismatch") {
+				mismatches++
+			}
+		}
+		fmt.Fprintf(cmd.OutOrStdout(), "Found %d violations (%d format mismatches)\n", len(before), mismatches)
+
+		fixed := fcp.FixFormatMismatches(fcpxml)
+		fmt.Fprintf(cmd.OutOrStdout(), "Fixed %d asset-clip formats\n", fixed)
+
+		remaining, warnings := fcp.SplitValidationWarnings(fcp.ValidateClaudeCompliance(fcpxml))
+		for _, warning := range warnings {
+			fmt.Fprintln(cmd.OutOrStdout(), warning)
+		}
+		if len(remaining) > 0 {
+			fmt.Fprintf(cmd.OutOrStdout(), "%d violations remain and need a manual fix:\n", len(remaining))
+			for _, violation := range remaining {
+				fmt.Fprintf(cmd.OutOrStdout(), "  - %s\n", violation)
+			}
+			return
+		}
+
+		err = writeFCPXML(fcpxml, filename)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error writing FCPXML: %v\n", err)
+			return
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "Validation passes: %s\n", filename)
+	},
+}
+
+func init() {
+	fixFormatsCmd.Flags().StringP("output", "o", "", "Output filename (defaults to cutlass_unixtime.fcpxml)")
+
+	rootCmd.AddCommand(fixFormatsCmd)
+}
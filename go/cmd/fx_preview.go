@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"cutlass/utils"
+
+	"github.com/spf13/cobra"
+)
+
+var fxPreviewCmd = &cobra.Command{
+	Use:   "fx-preview <test-image>",
+	Short: "Storyboard every fx-static-image effect on one image, labeled by name",
+	Long: `Build a preview FCPXML that plays the test image once per effect, 3 seconds each,
+with a title naming the effect. Use it to pick an effect for fx-static-image.
+
+Examples:
+  cutlass fx-preview test.png -o preview.fcpxml`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		imagePath := args[0]
+		output, _ := cmd.Flags().GetString("output")
+
+		var filename string
+		if output != "" {
+			filename = output
+		} else {
+			// Generate default filename with unix timestamp
+			timestamp := time.Now().Unix()
+			filename = fmt.Sprintf("cutlass_%d.fcpxml", timestamp)
+		}
+
+		err := utils.GenerateFXPreview(imagePath, filename, writeOptions())
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error generating effect preview: %v\n", err)
+			return
+		}
+
+		effects := utils.FXPreviewEffects()
+		fmt.Fprintf(cmd.OutOrStdout(), "Generated effect preview with %d effects: %s\n", len(effects), filename)
+		fmt.Fprintf(cmd.OutOrStdout(), "Effects: %s\n", strings.Join(effects, ", "))
+	},
+}
+
+func init() {
+	fxPreviewCmd.Flags().StringP("output", "o", "", "Output filename (defaults to cutlass_unixtime.fcpxml)")
+
+	rootCmd.AddCommand(fxPreviewCmd)
+}
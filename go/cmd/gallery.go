@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"cutlass/fcp"
+
+	"github.com/spf13/cobra"
+)
+
+var galleryCmd = &cobra.Command{
+	Use:   "gallery <photo-dir>",
+	Short: "Turn a folder of photos into a dated timeline with date captions",
+	Long: `Build a chronological FCPXML from every PNG/JPG in a folder (searched recursively).
+
+Photos are ordered by their EXIF capture date; photos without EXIF data use the
+file modification time. Consecutive photos from the same day share one date caption.
+
+Examples:
+  cutlass gallery ./photos
+  cutlass gallery ./photos -o trip.fcpxml`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		dir := args[0]
+		output, _ := cmd.Flags().GetString("output")
+
+		var filename string
+		if output != "" {
+			filename = output
+		} else {
+			// Generate default filename with unix timestamp
+			timestamp := time.Now().Unix()
+			filename = fmt.Sprintf("cutlass_%d.fcpxml", timestamp)
+		}
+
+		fcpxml, err := fcp.GenerateDatedGallery(dir)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error generating gallery: %v\n", err)
+			return
+		}
+
+		err = writeFCPXML(fcpxml, filename)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error writing FCPXML: %v\n", err)
+			return
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "Generated dated gallery: %s\n", filename)
+	},
+}
+
+func init() {
+	galleryCmd.Flags().StringP("output", "o", "", "Output filename (defaults to cutlass_unixtime.fcpxml)")
+
+	rootCmd.AddCommand(galleryCmd)
+}
@@ -0,0 +1,32 @@
+package cmd
+
+import (
+	"fmt"
+
+	"cutlass/fcp"
+
+	"github.com/spf13/cobra"
+)
+
+// addImageOptionFlags registers the flags that imageOptions turns into fcp.ImageOptions
+func addImageOptionFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool("no-auto-rotate", false, "Import EXIF-rotated photos as stored instead of from an upright copy")
+	cmd.Flags().Float64("image-start", 0, "Local start time in seconds for the image (default one hour, in the sequence timebase)")
+	cmd.Flags().String("image-element", fcp.ImageElementVideo, "Spine element for the image: video, or asset-clip (may crash FCP on import)")
+}
+
+// imageOptions reads the flags registered by addImageOptionFlags, warning on stderr when the
+// image will be written as an asset-clip
+func imageOptions(cmd *cobra.Command) (fcp.ImageOptions, error) {
+	noAutoRotate, _ := cmd.Flags().GetBool("no-auto-rotate")
+	startSeconds, _ := cmd.Flags().GetFloat64("image-start")
+	element, _ := cmd.Flags().GetString("image-element")
+	if err := fcp.ValidateImageElementMode(element); err != nil {
+		return fcp.ImageOptions{}, err
+	}
+	if element == fcp.ImageElementAssetClip {
+		fmt.Fprintln(cmd.ErrOrStderr(), "⚠️  WARNING: --image-element asset-clip writes images as <asset-clip>, which has crashed")
+		fmt.Fprintln(cmd.ErrOrStderr(), "⚠️  Final Cut Pro on import (addAssetClip:toObject:parentFormatID). Check the result in FCP.")
+	}
+	return fcp.ImageOptions{AutoOrient: !noAutoRotate, StartSeconds: startSeconds, Element: element}, nil
+}
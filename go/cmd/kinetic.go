@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"cutlass/fcp"
+
+	"github.com/spf13/cobra"
+)
+
+var kineticCmd = &cobra.Command{
+	Use:   "kinetic <word,word,...>",
+	Short: "Generate kinetic typography that flashes one word at a time",
+	Long: `Build a sequence of punchy animated words for promos. Words are comma separated;
+each one scales up and fades in and out, back to back.
+
+Examples:
+  cutlass kinetic "fast,bold,free" -o k.fcpxml
+  cutlass kinetic "one,more,thing" --per 0.75`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		words := strings.Split(args[0], ",")
+		output, _ := cmd.Flags().GetString("output")
+		per, _ := cmd.Flags().GetFloat64("per")
+
+		var filename string
+		if output != "" {
+			filename = output
+		} else {
+			// Generate default filename with unix timestamp
+			timestamp := time.Now().Unix()
+			filename = fmt.Sprintf("cutlass_%d.fcpxml", timestamp)
+		}
+
+		fcpxml, err := fcp.GenerateEmpty("")
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error creating FCPXML structure: %v\n", err)
+			return
+		}
+
+		if err := fcp.AddKineticText(fcpxml, words, per); err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error adding kinetic text: %v\n", err)
+			return
+		}
+
+		err = writeFCPXML(fcpxml, filename)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error writing FCPXML: %v\n", err)
+			return
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "Generated kinetic typography: %s\n", filename)
+	},
+}
+
+func init() {
+	kineticCmd.Flags().StringP("output", "o", "", "Output filename (defaults to cutlass_unixtime.fcpxml)")
+	kineticCmd.Flags().Float64("per", fcp.DefaultKineticSecondsPerWord, "Seconds each word stays on screen")
+
+	rootCmd.AddCommand(kineticCmd)
+}
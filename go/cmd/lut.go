@@ -0,0 +1,81 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"cutlass/fcp"
+
+	"github.com/spf13/cobra"
+)
+
+var lutCmd = &cobra.Command{
+	Use:   "lut <input.fcpxml> [look.cube]",
+	Short: "Apply a LUT or color preset to every video clip",
+	Long: `Grade every video clip and still on the timeline with the same .cube file through
+Final Cut Pro's Custom LUT effect. Running it again with another LUT swaps the file instead
+of stacking a second LUT.
+
+Instead of a .cube file, --preset picks a built-in look (` + strings.Join(fcp.ColorPresetNames(), ", ") + `).
+Its .cube file is written as cutlass_look_<preset>.cube next to the output file; keep it
+there, since Final Cut Pro reads the LUT from that path.
+
+Examples:
+  cutlass lut edit.fcpxml look.cube -o graded.fcpxml
+  cutlass lut edit.fcpxml --preset warm -o graded.fcpxml`,
+	Args: cobra.RangeArgs(1, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+		input := args[0]
+		output, _ := cmd.Flags().GetString("output")
+		preset, _ := cmd.Flags().GetString("preset")
+
+		if (preset == "") == (len(args) == 1) {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error: give either a .cube file or --preset\n")
+			return
+		}
+
+		var filename string
+		if output != "" {
+			filename = output
+		} else {
+			// Generate default filename with unix timestamp
+			timestamp := time.Now().Unix()
+			filename = fmt.Sprintf("cutlass_%d.fcpxml", timestamp)
+		}
+
+		fcpxml, err := fcp.ReadFromFile(input)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error reading FCPXML file '%s': %v\n", input, err)
+			return
+		}
+
+		look := preset
+		if preset != "" {
+			err = fcp.ApplyColorPreset(fcpxml, preset, filepath.Dir(filename))
+		} else {
+			look = args[1]
+			err = fcp.ApplyLUT(fcpxml, args[1])
+		}
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error applying look: %v\n", err)
+			return
+		}
+
+		err = writeFCPXML(fcpxml, filename)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error writing FCPXML: %v\n", err)
+			return
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "Applied %s to every video clip: %s\n", look, filename)
+	},
+}
+
+func init() {
+	lutCmd.Flags().StringP("output", "o", "", "Output filename (defaults to cutlass_unixtime.fcpxml)")
+	lutCmd.Flags().String("preset", "", "Built-in look to apply instead of a .cube file: "+strings.Join(fcp.ColorPresetNames(), ", "))
+
+	rootCmd.AddCommand(lutCmd)
+}
@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"cutlass/fcp"
+
+	"github.com/spf13/cobra"
+)
+
+var manifestCmd = &cobra.Command{
+	Use:   "manifest <file.fcpxml>",
+	Short: "List every referenced media file with its size and md5",
+	Long: `Write a manifest of the media an FCPXML file references, for delivery and archival:
+asset id, name, path, online/offline status, size in bytes and md5 hash. Missing files
+are listed as offline. The FCPXML file is only read, never modified.
+
+The format follows the output extension (.json for JSON, anything else CSV) unless
+--format is given. The manifest goes to stdout when --output is omitted.
+
+Examples:
+  cutlass manifest project.fcpxml -o manifest.csv
+  cutlass manifest project.fcpxml --format json`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		input := args[0]
+		output, _ := cmd.Flags().GetString("output")
+		format, _ := cmd.Flags().GetString("format")
+		if format == "" {
+			format = fcp.ManifestCSV
+			if strings.EqualFold(filepath.Ext(output), ".json") {
+				format = fcp.ManifestJSON
+			}
+		}
+
+		fcpxml, err := fcp.ReadFromFile(input)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error reading FCPXML file '%s': %v\n", input, err)
+			return
+		}
+
+		if output == "" {
+			if err := fcp.WriteManifest(fcpxml, os.Stdout, format); err != nil {
+				fmt.Fprintf(os.Stderr, "Error writing manifest: %v\n", err)
+			}
+			return
+		}
+
+		file, err := os.Create(output)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error creating '%s': %v\n", output, err)
+			return
+		}
+		defer file.Close()
+
+		if err := fcp.WriteManifest(fcpxml, file, format); err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error writing manifest: %v\n", err)
+			return
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "Wrote media manifest: %s\n", output)
+	},
+}
+
+func init() {
+	manifestCmd.Flags().StringP("output", "o", "", "Output manifest file (defaults to stdout)")
+	manifestCmd.Flags().String("format", "", "Manifest format: csv or json (defaults from the output extension)")
+	rootCmd.AddCommand(manifestCmd)
+}
@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"cutlass/fcp"
+
+	"github.com/spf13/cobra"
+)
+
+var mdTitlesCmd = &cobra.Command{
+	Use:   "md-titles <script.md>",
+	Short: "Turn a Markdown script into a sequence of title cards",
+	Long: `Read a Markdown script and build one title card per block, shown back to back.
+
+Blank lines separate blocks. Headings (#, ##, ###) become large centered titles,
+list items (-, *, 1.) are revealed one line at a time on a shared card, and other
+lines become plain centered text. **bold** and __bold__ text is set in bold.
+
+Examples:
+  cutlass md-titles script.md -o titles.fcpxml
+  cutlass md-titles script.md --seconds 6 -o titles.fcpxml`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		mdPath := args[0]
+		output, _ := cmd.Flags().GetString("output")
+		seconds, _ := cmd.Flags().GetFloat64("seconds")
+
+		var filename string
+		if output != "" {
+			filename = output
+		} else {
+			// Generate default filename with unix timestamp
+			timestamp := time.Now().Unix()
+			filename = fmt.Sprintf("cutlass_%d.fcpxml", timestamp)
+		}
+
+		fcpxml, err := fcp.GenerateTitlesFromMarkdown(mdPath, seconds)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error generating titles: %v\n", err)
+			return
+		}
+
+		err = writeFCPXML(fcpxml, filename)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error writing FCPXML: %v\n", err)
+			return
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "Generated Markdown titles: %s\n", filename)
+	},
+}
+
+func init() {
+	mdTitlesCmd.Flags().StringP("output", "o", "", "Output filename (defaults to cutlass_unixtime.fcpxml)")
+	mdTitlesCmd.Flags().Float64("seconds", fcp.DefaultMarkdownSecondsPerBlock, "Seconds each Markdown block stays on screen")
+
+	rootCmd.AddCommand(mdTitlesCmd)
+}
@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"cutlass/fcp"
+
+	"github.com/spf13/cobra"
+)
+
+var montageCmd = &cobra.Command{
+	Use:   "montage <video-files or patterns...>",
+	Short: "Cut videos down to short highlights and play them back-to-back",
+	Long: `Build a fast montage: each source video is trimmed to a --seg second segment
+(its first seconds, or a random in-point with --random-in) and the segments play in order.
+--xfade adds a cross-dissolve between segments, shortening the montage by its length each time.
+Quoted patterns like "*.mp4" are expanded in name order.
+--record-params keeps the segment length, crossfade and seed in the project note.
+
+Examples:
+  cutlass montage "*.mp4" --seg 2 --xfade 0.5
+  cutlass montage a.mov b.mov c.mov --random-in --seed 42 -o montage.fcpxml`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		output, _ := cmd.Flags().GetString("output")
+		seg, _ := cmd.Flags().GetFloat64("seg")
+		xfade, _ := cmd.Flags().GetFloat64("xfade")
+		randomIn, _ := cmd.Flags().GetBool("random-in")
+		seed, _ := cmd.Flags().GetInt64("seed")
+
+		var videoPaths []string
+		for _, arg := range args {
+			matches, err := filepath.Glob(arg)
+			if err != nil {
+				fmt.Fprintf(cmd.OutOrStdout(), "Error expanding pattern '%s': %v\n", arg, err)
+				return
+			}
+			if len(matches) == 0 {
+				// Not a pattern (or nothing matched); let the generator report a missing file
+				matches = []string{arg}
+			}
+			sort.Strings(matches)
+			videoPaths = append(videoPaths, matches...)
+		}
+
+		var filename string
+		if output != "" {
+			filename = output
+		} else {
+			// Generate default filename with unix timestamp
+			timestamp := time.Now().Unix()
+			filename = fmt.Sprintf("cutlass_%d.fcpxml", timestamp)
+		}
+
+		options := fcp.MontageOptions{CrossfadeSeconds: xfade, RandomIn: randomIn, Seed: seed, RecordParams: recordParams(cmd)}
+		fcpxml, err := fcp.GenerateMontageWithOptions(videoPaths, seg, options)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error generating montage: %v\n", err)
+			return
+		}
+
+		err = writeFCPXML(fcpxml, filename)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error writing FCPXML: %v\n", err)
+			return
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "Generated montage of %d clips: %s\n", len(videoPaths), filename)
+	},
+}
+
+func init() {
+	montageCmd.Flags().StringP("output", "o", "", "Output filename (defaults to cutlass_unixtime.fcpxml)")
+	montageCmd.Flags().Float64("seg", fcp.DefaultMontageSegmentSeconds, "Seconds kept from each video")
+	montageCmd.Flags().Float64("xfade", 0, "Cross-dissolve seconds between segments (0 for hard cuts)")
+	montageCmd.Flags().Bool("random-in", false, "Start each segment at a random point in its video")
+	montageCmd.Flags().Int64("seed", fcp.DefaultMontageSeed, "Random seed for --random-in; the same seed picks the same in-points")
+	addRecordParamsFlag(montageCmd)
+
+	rootCmd.AddCommand(montageCmd)
+}
@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"cutlass/fcp"
+
+	"github.com/spf13/cobra"
+)
+
+var multiAspectCmd = &cobra.Command{
+	Use:   "multi-aspect <spec.json>",
+	Short: "Generate one project per aspect ratio from a single clip spec",
+	Long: `Lay the same clips out for several platforms at once. The spec lists clips in order:
+
+  {"name": "Promo", "clips": [{"path": "a.png", "duration": 4}, {"path": "b.mov", "fit": "fit"}]}
+
+Each aspect becomes its own project (16:9, 9:16, 1:1 or 4:5) in one event, sharing assets.
+Clips fill the frame by default; "fit" keeps the whole clip visible with bars.
+
+Examples:
+  cutlass multi-aspect spec.json --aspects 16:9,9:16
+  cutlass multi-aspect spec.json --aspects 16:9,1:1,9:16 -o variants.fcpxml`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		output, _ := cmd.Flags().GetString("output")
+		aspectsFlag, _ := cmd.Flags().GetString("aspects")
+
+		var filename string
+		if output != "" {
+			filename = output
+		} else {
+			// Generate default filename with unix timestamp
+			timestamp := time.Now().Unix()
+			filename = fmt.Sprintf("cutlass_%d.fcpxml", timestamp)
+		}
+
+		aspects, err := fcp.ParseAspects(aspectsFlag)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error: %v\n", err)
+			return
+		}
+
+		spec, err := fcp.ReadProjectSpec(args[0])
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error reading spec: %v\n", err)
+			return
+		}
+
+		fcpxml, err := fcp.GenerateMultiAspect(*spec, aspects)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error generating multi-aspect FCPXML: %v\n", err)
+			return
+		}
+
+		err = writeFCPXML(fcpxml, filename)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error writing FCPXML: %v\n", err)
+			return
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "Generated %d aspect variants: %s\n", len(aspects), filename)
+	},
+}
+
+func init() {
+	multiAspectCmd.Flags().StringP("output", "o", "", "Output filename (defaults to cutlass_unixtime.fcpxml)")
+	multiAspectCmd.Flags().String("aspects", "16:9,9:16", "Comma separated aspects: 16:9, 9:16, 1:1, 4:5")
+
+	rootCmd.AddCommand(multiAspectCmd)
+}
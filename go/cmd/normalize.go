@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"cutlass/fcp"
+
+	"github.com/spf13/cobra"
+)
+
+var normalizeCmd = &cobra.Command{
+	Use:   "normalize <input.fcpxml>",
+	Short: "Snap every timeline offset, duration and start to a frame boundary",
+	Long: `Rewrite the offsets, durations and starts of the clips on an FCPXML timeline to the
+nearest frame of the sequence's timebase, e.g. 21600000/24000s becomes 21599578/24000s at 23.976fps.
+Final Cut Pro rejects times that fall between frames, which hand edits easily introduce;
+values that are already on a frame are left exactly as written.
+
+Asset resources and audio-only clips (which FCP keeps sample-accurate) are left alone, and a
+clip's duration rounds down rather than past the end of its media.
+
+Examples:
+  cutlass normalize hand_edited.fcpxml -o fixed.fcpxml
+  cutlass normalize project.fcpxml -o project.fcpxml --force`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		input := args[0]
+		output, _ := cmd.Flags().GetString("output")
+
+		var filename string
+		if output != "" {
+			filename = output
+		} else {
+			// Generate default filename with unix timestamp
+			timestamp := time.Now().Unix()
+			filename = fmt.Sprintf("cutlass_%d.fcpxml", timestamp)
+		}
+
+		fcpxml, err := fcp.ReadFromFile(input)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error reading FCPXML file '%s': %v\n", input, err)
+			return
+		}
+
+		changed := fcp.NormalizeDurations(fcpxml)
+
+		err = writeFCPXML(fcpxml, filename)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error writing FCPXML: %v\n", err)
+			return
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "Normalized %d time values to frame boundaries: %s\n", changed, filename)
+	},
+}
+
+func init() {
+	normalizeCmd.Flags().StringP("output", "o", "", "Output filename (defaults to cutlass_unixtime.fcpxml)")
+
+	rootCmd.AddCommand(normalizeCmd)
+}
@@ -0,0 +1,91 @@
+package cmd
+
+import (
+	"fmt"
+	"math"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"cutlass/fcp"
+
+	"github.com/spf13/cobra"
+)
+
+var photoWallCmd = &cobra.Command{
+	Use:   "photo-wall <image-files or patterns...>",
+	Short: "Build a wall of photos that pop in one by one and stay",
+	Long: `Fill a grid with photos, one at a time: every --interval seconds the next photo pops in
+(left-to-right, top-to-bottom) and stays in its cell, until the whole wall is built.
+The finished wall holds for 3 seconds. Without --rows/--cols the grid is as square as possible.
+Quoted patterns like "*.jpg" are expanded in name order.
+
+Examples:
+  cutlass photo-wall "*.jpg" --rows 3 --cols 4 --interval 0.5
+  cutlass photo-wall a.png b.png c.png d.png -o wall.fcpxml`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		output, _ := cmd.Flags().GetString("output")
+		rows, _ := cmd.Flags().GetInt("rows")
+		cols, _ := cmd.Flags().GetInt("cols")
+		interval, _ := cmd.Flags().GetFloat64("interval")
+
+		var imagePaths []string
+		for _, arg := range args {
+			matches, err := filepath.Glob(arg)
+			if err != nil {
+				fmt.Fprintf(cmd.OutOrStdout(), "Error expanding pattern '%s': %v\n", arg, err)
+				return
+			}
+			if len(matches) == 0 {
+				// Not a pattern (or nothing matched); let the generator report a missing file
+				matches = []string{arg}
+			}
+			sort.Strings(matches)
+			imagePaths = append(imagePaths, matches...)
+		}
+
+		// Fill in whichever grid dimension wasn't given
+		switch {
+		case rows <= 0 && cols <= 0:
+			cols = int(math.Ceil(math.Sqrt(float64(len(imagePaths)))))
+			rows = (len(imagePaths) + cols - 1) / cols
+		case rows <= 0:
+			rows = (len(imagePaths) + cols - 1) / cols
+		case cols <= 0:
+			cols = (len(imagePaths) + rows - 1) / rows
+		}
+
+		var filename string
+		if output != "" {
+			filename = output
+		} else {
+			// Generate default filename with unix timestamp
+			timestamp := time.Now().Unix()
+			filename = fmt.Sprintf("cutlass_%d.fcpxml", timestamp)
+		}
+
+		fcpxml, err := fcp.GeneratePhotoWall(imagePaths, rows, cols, interval)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error generating photo wall: %v\n", err)
+			return
+		}
+
+		err = writeFCPXML(fcpxml, filename)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error writing FCPXML: %v\n", err)
+			return
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "Generated %dx%d photo wall of %d photos: %s\n", rows, cols, len(imagePaths), filename)
+	},
+}
+
+func init() {
+	photoWallCmd.Flags().StringP("output", "o", "", "Output filename (defaults to cutlass_unixtime.fcpxml)")
+	photoWallCmd.Flags().Int("rows", 0, "Grid rows (0 picks one from --cols or the photo count)")
+	photoWallCmd.Flags().Int("cols", 0, "Grid columns (0 picks one from --rows or the photo count)")
+	photoWallCmd.Flags().Float64("interval", 1.0, "Seconds between photos popping in")
+
+	rootCmd.AddCommand(photoWallCmd)
+}
@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"cutlass/fcp"
+
+	"github.com/spf13/cobra"
+)
+
+var pipCmd = &cobra.Command{
+	Use:   "pip <input.fcpxml> <pip-video>",
+	Short: "Overlay a picture-in-picture video in a corner of a clip",
+	Long: `Nest a video (e.g. a webcam recording) over spine clip --clip, scaled down and placed
+in a corner with a small margin. --at is measured from the start of that clip.
+
+Examples:
+  cutlass pip tutorial.fcpxml webcam.mov --corner bottom-right --scale 0.25 -o out.fcpxml
+  cutlass pip tutorial.fcpxml webcam.mov --clip 1 --corner top-left --at 2 --duration 30`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		input, pipPath := args[0], args[1]
+		output, _ := cmd.Flags().GetString("output")
+		clipIndex, _ := cmd.Flags().GetInt("clip")
+		corner, _ := cmd.Flags().GetString("corner")
+		scale, _ := cmd.Flags().GetFloat64("scale")
+		at, _ := cmd.Flags().GetFloat64("at")
+		duration, _ := cmd.Flags().GetFloat64("duration")
+
+		var filename string
+		if output != "" {
+			filename = output
+		} else {
+			// Generate default filename with unix timestamp
+			timestamp := time.Now().Unix()
+			filename = fmt.Sprintf("cutlass_%d.fcpxml", timestamp)
+		}
+
+		fcpxml, err := fcp.ReadFromFile(input)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error reading FCPXML file '%s': %v\n", input, err)
+			return
+		}
+
+		if err := fcp.AddPictureInPicture(fcpxml, clipIndex, pipPath, corner, scale, at, duration); err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error adding picture-in-picture: %v\n", err)
+			return
+		}
+
+		err = writeFCPXML(fcpxml, filename)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error writing FCPXML: %v\n", err)
+			return
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "Added %s picture-in-picture to clip %d: %s\n", corner, clipIndex, filename)
+	},
+}
+
+func init() {
+	pipCmd.Flags().StringP("output", "o", "", "Output filename (defaults to cutlass_unixtime.fcpxml)")
+	pipCmd.Flags().Int("clip", 0, "Timeline position of the clip to overlay, counting from 0 with gaps included")
+	pipCmd.Flags().String("corner", fcp.PIPBottomRight, "Corner: top-left, top-right, bottom-left or bottom-right")
+	pipCmd.Flags().Float64("scale", 0.25, "PIP size relative to the frame (0-1)")
+	pipCmd.Flags().Float64("at", 0, "Seconds into the clip where the PIP appears")
+	pipCmd.Flags().Float64("duration", 10, "Seconds the PIP stays on screen")
+
+	rootCmd.AddCommand(pipCmd)
+}
@@ -0,0 +1,82 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"cutlass/fcp"
+
+	"github.com/spf13/cobra"
+)
+
+var proxyCmd = &cobra.Command{
+	Use:   "proxy <input.fcpxml>",
+	Short: "Switch a timeline between low-res proxies and original media",
+	Long: `Generate downscaled copies of every video and image asset and point the timeline
+at them for faster editing. Both paths are kept in the asset metadata, so the same
+document can be switched back to full-resolution media before the final export.
+Video proxies require ffmpeg.
+
+Examples:
+  cutlass proxy project.fcpxml --dir proxies -o project_proxy.fcpxml
+  cutlass proxy project_proxy.fcpxml --originals -o project_final.fcpxml`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		input := args[0]
+		output, _ := cmd.Flags().GetString("output")
+		proxyDir, _ := cmd.Flags().GetString("dir")
+		maxEdge, _ := cmd.Flags().GetInt("max-edge")
+		originals, _ := cmd.Flags().GetBool("originals")
+
+		var filename string
+		if output != "" {
+			filename = output
+		} else {
+			// Generate default filename with unix timestamp
+			timestamp := time.Now().Unix()
+			filename = fmt.Sprintf("cutlass_%d.fcpxml", timestamp)
+		}
+
+		fcpxml, err := fcp.ReadFromFile(input)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error reading FCPXML file '%s': %v\n", input, err)
+			return
+		}
+
+		var switched int
+		if originals {
+			switched, err = fcp.SwitchToOriginals(fcpxml)
+		} else {
+			if err := fcp.GenerateProxiesWithEdge(fcpxml, proxyDir, maxEdge); err != nil {
+				fmt.Fprintf(cmd.OutOrStdout(), "Error generating proxies: %v\n", err)
+				return
+			}
+			switched, err = fcp.SwitchToProxies(fcpxml)
+		}
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error switching media: %v\n", err)
+			return
+		}
+
+		err = writeFCPXML(fcpxml, filename)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error writing FCPXML: %v\n", err)
+			return
+		}
+
+		if originals {
+			fmt.Fprintf(cmd.OutOrStdout(), "Switched %d assets back to original media: %s\n", switched, filename)
+		} else {
+			fmt.Fprintf(cmd.OutOrStdout(), "Switched %d assets to proxies in %s: %s\n", switched, proxyDir, filename)
+		}
+	},
+}
+
+func init() {
+	proxyCmd.Flags().String("dir", "proxies", "Directory to write proxy media to")
+	proxyCmd.Flags().Int("max-edge", fcp.DefaultProxyEdge, "Longest edge of generated proxies in pixels")
+	proxyCmd.Flags().Bool("originals", false, "Switch back to the original media instead of generating proxies")
+	proxyCmd.Flags().StringP("output", "o", "", "Output filename (defaults to cutlass_unixtime.fcpxml)")
+
+	rootCmd.AddCommand(proxyCmd)
+}
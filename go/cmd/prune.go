@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"cutlass/fcp"
+
+	"github.com/spf13/cobra"
+)
+
+var pruneCmd = &cobra.Command{
+	Use:   "prune <input.fcpxml>",
+	Short: "Remove resources no timeline element references",
+	Long: `Drop assets, formats, effects and media from the resources section when no clip,
+title, filter or nested lane refers to them anymore. Sequence formats and formats used
+by kept assets are always preserved. Sequences pointing at a format that no longer
+exists are repointed at a default 720p 23.976 format.
+
+Examples:
+  cutlass prune edited.fcpxml -o clean.fcpxml`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		input := args[0]
+		output, _ := cmd.Flags().GetString("output")
+
+		var filename string
+		if output != "" {
+			filename = output
+		} else {
+			// Generate default filename with unix timestamp
+			timestamp := time.Now().Unix()
+			filename = fmt.Sprintf("cutlass_%d.fcpxml", timestamp)
+		}
+
+		fcpxml, err := fcp.ReadFromFile(input)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error reading FCPXML file '%s': %v\n", input, err)
+			return
+		}
+
+		fixed, err := fcp.FixSequenceFormat(fcpxml)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error fixing sequence formats: %v\n", err)
+			return
+		}
+		for _, project := range fixed {
+			fmt.Fprintf(cmd.OutOrStdout(), "Restored missing sequence format in project '%s'\n", project)
+		}
+
+		pruned := fcp.PruneUnusedResources(fcpxml)
+
+		err = writeFCPXML(fcpxml, filename)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error writing FCPXML: %v\n", err)
+			return
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "Pruned %d unused resources: %s\n", pruned, filename)
+	},
+}
+
+func init() {
+	pruneCmd.Flags().StringP("output", "o", "", "Output filename (defaults to cutlass_unixtime.fcpxml)")
+
+	rootCmd.AddCommand(pruneCmd)
+}
@@ -0,0 +1,17 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// addRecordParamsFlag registers --record-params on a generator that records its parameters
+// with fcp.RecordGenerationParams; the command passes recordParams(cmd) in its options
+func addRecordParamsFlag(cmd *cobra.Command) {
+	cmd.Flags().Bool("record-params", false, "Record the generator's parameters (seed, date, version, ...) in the project note")
+}
+
+// recordParams reports whether --record-params was given
+func recordParams(cmd *cobra.Command) bool {
+	record, _ := cmd.Flags().GetBool("record-params")
+	return record
+}
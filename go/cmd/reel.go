@@ -0,0 +1,99 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"cutlass/fcp"
+
+	"github.com/spf13/cobra"
+)
+
+var reelCmd = &cobra.Command{
+	Use:   "reel <clips-dir> <music-file>",
+	Short: "Cut a folder of videos into a graded montage set to music",
+	Long: `One-shot highlight reel: every video in the folder is trimmed to a --seg second segment
+with --xfade dissolves between them, graded with a --lut .cube file, and
+set to the music, which fades out over its last --fade-out seconds. The reel is exactly as long
+as the song: sources are looped (from random in-points unless --random-in=false) until the
+music is covered and the last segment is trimmed to end with it.
+--record-params keeps the reel's settings and seed in the project note.
+
+Examples:
+  cutlass reel ./clips music.mp3 -o reel.fcpxml
+  cutlass reel ./clips music.mp3 --lut look.cube --seg 2 --xfade 0.25`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		clipsDir, musicPath := args[0], args[1]
+		output, _ := cmd.Flags().GetString("output")
+
+		options := fcp.DefaultReelOptions()
+		options.SegmentSeconds, _ = cmd.Flags().GetFloat64("seg")
+		options.CrossfadeSeconds, _ = cmd.Flags().GetFloat64("xfade")
+		options.FadeOutSeconds, _ = cmd.Flags().GetFloat64("fade-out")
+		options.LUTPath, _ = cmd.Flags().GetString("lut")
+		options.RandomIn, _ = cmd.Flags().GetBool("random-in")
+		options.Seed, _ = cmd.Flags().GetInt64("seed")
+		options.RecordParams = recordParams(cmd)
+
+		entries, err := os.ReadDir(clipsDir)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error reading clips folder '%s': %v\n", clipsDir, err)
+			return
+		}
+		var videoPaths []string
+		for _, entry := range entries {
+			switch strings.ToLower(filepath.Ext(entry.Name())) {
+			case ".mp4", ".mov", ".m4v":
+				if !entry.IsDir() {
+					videoPaths = append(videoPaths, filepath.Join(clipsDir, entry.Name()))
+				}
+			}
+		}
+		sort.Strings(videoPaths)
+		if len(videoPaths) == 0 {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error: no .mp4, .mov or .m4v videos in '%s'\n", clipsDir)
+			return
+		}
+
+		var filename string
+		if output != "" {
+			filename = output
+		} else {
+			// Generate default filename with unix timestamp
+			timestamp := time.Now().Unix()
+			filename = fmt.Sprintf("cutlass_%d.fcpxml", timestamp)
+		}
+
+		fcpxml, err := fcp.GenerateReel(videoPaths, musicPath, options)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error generating reel: %v\n", err)
+			return
+		}
+
+		err = writeFCPXML(fcpxml, filename)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error writing FCPXML: %v\n", err)
+			return
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "Generated reel of %d segments from %d videos: %s\n", len(fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine.AssetClips), len(videoPaths), filename)
+	},
+}
+
+func init() {
+	reelCmd.Flags().StringP("output", "o", "", "Output filename (defaults to cutlass_unixtime.fcpxml)")
+	reelCmd.Flags().Float64("seg", fcp.DefaultReelSegmentSeconds, "Seconds kept from each video segment")
+	reelCmd.Flags().Float64("xfade", fcp.DefaultReelCrossfadeSeconds, "Cross-dissolve seconds between segments (0 for hard cuts)")
+	reelCmd.Flags().Float64("fade-out", fcp.DefaultReelFadeOutSeconds, "Seconds the music fades out over at the end (0 for no fade)")
+	reelCmd.Flags().String("lut", "", ".cube LUT file to grade the footage with")
+	reelCmd.Flags().Bool("random-in", true, "Start each segment at a random point in its video")
+	reelCmd.Flags().Int64("seed", fcp.DefaultMontageSeed, "Random seed for --random-in; the same seed picks the same in-points")
+	addRecordParamsFlag(reelCmd)
+
+	rootCmd.AddCommand(reelCmd)
+}
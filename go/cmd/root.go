@@ -4,6 +4,9 @@ import (
 	"fmt"
 	"os"
 
+	"cutlass/fcp"
+	"cutlass/utils"
+
 	"github.com/spf13/cobra"
 )
 
@@ -12,9 +15,42 @@ var rootCmd = &cobra.Command{
 	Short: "A Swiss Army knife for generating FCPXML files",
 	Long: `Cutlass is a powerful CLI tool for generating FCPXML files from various sources.
 It provides a comprehensive set of commands organized into logical categories to help
-you create Final Cut Pro XML files for video editing workflows.`,
+you create Final Cut Pro XML files for video editing workflows.
+
+Commands that take -o/--output accept "-" to write the FCPXML to stdout for piping;
+status messages then go to stderr. An output path ending in .fcpxmld is
+written as a bundle directory with the timeline in Info.fcpxml, as FCP 10.6+ exports.
+
+Existing output files are not overwritten unless --force is given; --backup keeps a
+timestamped .bak copy of the old file and then overwrites it.
+
+Default flag values can be kept in a .cutlassrc in the working directory or, failing
+that, the home directory. It is JSON, or flat TOML:
+
+  seed = 42              # any command with a --seed flag
+  [fx-static-image]      # only this command
+  effect = "kaleido"
+
+An explicit flag beats the .cutlassrc value, which beats the built-in default.`,
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		// Fill flags that weren't given explicitly from .cutlassrc before anything reads them
+		rc, err := utils.LoadRCConfig()
+		if err == nil {
+			err = utils.ApplyRCDefaults(cmd.Flags(), cmd.Name(), rc)
+		}
+		if err != nil {
+			return err
+		}
+
+		// Keep stdout clean for the XML: commands print status to cmd.OutOrStdout()
+		if output, err := cmd.Flags().GetString("output"); err == nil && output == fcp.StdoutFilename {
+			cmd.SetOut(os.Stderr)
+		}
+		return nil
+	},
 }
 
+
 func Execute() {
 	if err := rootCmd.Execute(); err != nil {
 		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
@@ -0,0 +1,21 @@
+package cmd
+
+import (
+	"cutlass/fcp"
+
+	"github.com/spf13/cobra"
+)
+
+// addSafeGuidesFlag registers --safe-guides on a command that builds a timeline; the command
+// calls addSafeGuides once its clips are in place
+func addSafeGuidesFlag(cmd *cobra.Command) {
+	cmd.Flags().Bool("safe-guides", false, "Overlay action safe (90%) and title safe (80%) outlines for framing checks (not for final output)")
+}
+
+// addSafeGuides overlays the title/action safe area outlines when --safe-guides was given
+func addSafeGuides(cmd *cobra.Command, fcpxml *fcp.FCPXML) error {
+	if safeGuides, _ := cmd.Flags().GetBool("safe-guides"); !safeGuides {
+		return nil
+	}
+	return fcp.AddSafeAreaGuides(fcpxml, 0)
+}
@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"cutlass/fcp"
+
+	"github.com/spf13/cobra"
+)
+
+var shuffleCmd = &cobra.Command{
+	Use:   "shuffle <input.fcpxml>",
+	Short: "Randomize clip order with a reproducible seed",
+	Long: `Reorder the clips of the timeline randomly and close them up back to back.
+The same --seed always produces the same order, so a variation can be recreated
+later from its seed. Titles and other clips connected to a clip move with it.
+
+Examples:
+  cutlass shuffle slideshow.fcpxml --seed 42 -o variation42.fcpxml
+  cutlass shuffle slideshow.fcpxml --seed 7`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		input := args[0]
+		output, _ := cmd.Flags().GetString("output")
+		seed, _ := cmd.Flags().GetInt64("seed")
+
+		var filename string
+		if output != "" {
+			filename = output
+		} else {
+			// Generate default filename with unix timestamp
+			timestamp := time.Now().Unix()
+			filename = fmt.Sprintf("cutlass_%d.fcpxml", timestamp)
+		}
+
+		fcpxml, err := fcp.ReadFromFile(input)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error reading FCPXML file '%s': %v\n", input, err)
+			return
+		}
+
+		if err := fcp.ShuffleSpine(fcpxml, seed); err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error shuffling timeline: %v\n", err)
+			return
+		}
+
+		err = writeFCPXML(fcpxml, filename)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error writing FCPXML: %v\n", err)
+			return
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "Shuffled timeline with seed %d: %s\n", seed, filename)
+	},
+}
+
+func init() {
+	shuffleCmd.Flags().Int64("seed", 1, "Random seed; the same seed always gives the same order")
+	shuffleCmd.Flags().StringP("output", "o", "", "Output filename (defaults to cutlass_unixtime.fcpxml)")
+
+	rootCmd.AddCommand(shuffleCmd)
+}
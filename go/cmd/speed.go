@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"cutlass/fcp"
+
+	"github.com/spf13/cobra"
+)
+
+var speedCmd = &cobra.Command{
+	Use:   "speed <input.fcpxml> <clip-index> <percent>",
+	Short: "Play a clip at a constant speed such as 200% or 50%",
+	Long: `Retime the asset-clip at <clip-index> to a constant speed. Clips are numbered from 0 in
+timeline order, counting every element on the primary storyline, gaps included. 200 plays
+it twice as fast (half as long), 50 at half speed (twice as long), and 100 removes the retime. The clips
+after it move to stay back-to-back. Speed must be between 2 and 2000 percent.
+
+Examples:
+  cutlass speed in.fcpxml 2 200 -o out.fcpxml
+  cutlass speed in.fcpxml 0 50`,
+	Args: cobra.ExactArgs(3),
+	Run: func(cmd *cobra.Command, args []string) {
+		input := args[0]
+		clipIndex, err := strconv.Atoi(args[1])
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error parsing clip index '%s': %v\n", args[1], err)
+			return
+		}
+		percent, err := strconv.ParseFloat(args[2], 64)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error parsing speed '%s': %v\n", args[2], err)
+			return
+		}
+		output, _ := cmd.Flags().GetString("output")
+
+		var filename string
+		if output != "" {
+			filename = output
+		} else {
+			// Generate default filename with unix timestamp
+			timestamp := time.Now().Unix()
+			filename = fmt.Sprintf("cutlass_%d.fcpxml", timestamp)
+		}
+
+		fcpxml, err := fcp.ReadFromFile(input)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error reading FCPXML file '%s': %v\n", input, err)
+			return
+		}
+
+		if err := fcp.SetClipSpeed(fcpxml, clipIndex, percent); err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error setting clip speed: %v\n", err)
+			return
+		}
+
+		err = writeFCPXML(fcpxml, filename)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error writing FCPXML: %v\n", err)
+			return
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "Set clip %d to %g%% speed: %s\n", clipIndex, percent, filename)
+	},
+}
+
+func init() {
+	speedCmd.Flags().StringP("output", "o", "", "Output filename (defaults to cutlass_unixtime.fcpxml)")
+
+	rootCmd.AddCommand(speedCmd)
+}
@@ -0,0 +1,65 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"cutlass/fcp"
+
+	"github.com/spf13/cobra"
+)
+
+var splitScreenCmd = &cobra.Command{
+	Use:   "split-screen <video-file> <video-file> [video-file]",
+	Short: "Show two or three videos at once, side by side or stacked",
+	Long: `Play videos together for reaction and comparison videos. Each source is scaled
+and positioned to tile the frame without overlap:
+
+  2-up-horizontal  two videos left and right (default)
+  2-up-vertical    two videos top and bottom
+  3-up             three videos in columns
+
+The split screen lasts as long as the shortest video. Audio from every source is kept;
+--audio-from N keeps only the audio of the Nth video.
+
+Examples:
+  cutlass split-screen reaction.mp4 original.mp4
+  cutlass split-screen a.mp4 b.mp4 c.mp4 --layout 3-up --audio-from 1 -o compare.fcpxml`,
+	Args: cobra.RangeArgs(2, 3),
+	Run: func(cmd *cobra.Command, args []string) {
+		output, _ := cmd.Flags().GetString("output")
+		layout, _ := cmd.Flags().GetString("layout")
+		audioFrom, _ := cmd.Flags().GetInt("audio-from")
+
+		var filename string
+		if output != "" {
+			filename = output
+		} else {
+			// Generate default filename with unix timestamp
+			timestamp := time.Now().Unix()
+			filename = fmt.Sprintf("cutlass_%d.fcpxml", timestamp)
+		}
+
+		fcpxml, err := fcp.GenerateSplitScreenWithAudio(args, layout, audioFrom)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error generating split screen: %v\n", err)
+			return
+		}
+
+		err = writeFCPXML(fcpxml, filename)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error writing FCPXML: %v\n", err)
+			return
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "Generated %s split screen: %s\n", layout, filename)
+	},
+}
+
+func init() {
+	splitScreenCmd.Flags().StringP("output", "o", "", "Output filename (defaults to cutlass_unixtime.fcpxml)")
+	splitScreenCmd.Flags().String("layout", fcp.SplitScreen2UpHorizontal, "Layout: 2-up-horizontal, 2-up-vertical or 3-up")
+	splitScreenCmd.Flags().Int("audio-from", 0, "Keep only the audio of this video (1-based); 0 keeps all audio")
+
+	rootCmd.AddCommand(splitScreenCmd)
+}
@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"cutlass/fcp"
+
+	"github.com/spf13/cobra"
+)
+
+var textCmd = &cobra.Command{
+	Use:   "text <input.fcpxml>",
+	Short: "List all on-screen text of an FCPXML timeline for proofreading",
+	Long: `Print the text of every title in the first sequence - titles on the spine and
+titles connected to clips - in timeline order, one line each with its start and end time:
+
+  [00:00:01.001 - 00:00:04.004] Welcome to the show
+
+Empty titles are skipped. Multi-line titles are joined with " / ".
+
+Examples:
+  cutlass text captions.fcpxml
+  cutlass text project.fcpxml > proofread.txt`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		input := args[0]
+
+		fcpxml, err := fcp.ReadFromFile(input)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error reading FCPXML file '%s': %v\n", input, err)
+			return
+		}
+
+		occurrences := fcp.ExtractText(fcpxml)
+		if len(occurrences) == 0 {
+			fmt.Fprintf(os.Stderr, "No on-screen text found in %s\n", input)
+			return
+		}
+
+		if err := fcp.WriteTextReport(os.Stdout, occurrences); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(textCmd)
+}
@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"cutlass/fcp"
+
+	"github.com/spf13/cobra"
+)
+
+var timelapseCmd = &cobra.Command{
+	Use:   "timelapse <directory>",
+	Short: "Play a directory of numbered images as a timelapse",
+	Long: `Lay every image in a directory back-to-back, one frame each at the chosen rate.
+Files are ordered naturally, so IMG_2 comes before IMG_10. A warning is printed
+when the frames don't all share the same dimensions.
+
+Examples:
+  cutlass timelapse ./frames --fps 24 -o tl.fcpxml
+  cutlass timelapse ./frames --fps 12`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		dir := args[0]
+		output, _ := cmd.Flags().GetString("output")
+		fps, _ := cmd.Flags().GetFloat64("fps")
+
+		var filename string
+		if output != "" {
+			filename = output
+		} else {
+			// Generate default filename with unix timestamp
+			timestamp := time.Now().Unix()
+			filename = fmt.Sprintf("cutlass_%d.fcpxml", timestamp)
+		}
+
+		fcpxml, err := fcp.GenerateTimelapse(dir, fps)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error generating timelapse: %v\n", err)
+			return
+		}
+
+		err = writeFCPXML(fcpxml, filename)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error writing FCPXML: %v\n", err)
+			return
+		}
+
+		frames := len(fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine.Videos)
+		fmt.Fprintf(cmd.OutOrStdout(), "Generated %d-frame timelapse at %.3g fps: %s\n", frames, fps, filename)
+	},
+}
+
+func init() {
+	timelapseCmd.Flags().StringP("output", "o", "", "Output filename (defaults to cutlass_unixtime.fcpxml)")
+	timelapseCmd.Flags().Float64("fps", fcp.DefaultTimelapseFPS, "Frames per second the images play at")
+
+	rootCmd.AddCommand(timelapseCmd)
+}
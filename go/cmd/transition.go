@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"cutlass/fcp"
+
+	"github.com/spf13/cobra"
+)
+
+var transitionCmd = &cobra.Command{
+	Use:   "transition <input.fcpxml>",
+	Short: "Add a cross-dissolve on the cut after a clip",
+	Long: `Put a Final Cut Pro Cross Dissolve on the cut between spine clip --at and the clip after it.
+The transition is centred on the cut, so both clips need half its length of unused media
+beyond the cut; the timeline length does not change.
+
+Examples:
+  cutlass transition edit.fcpxml --at 0 -o out.fcpxml
+  cutlass transition edit.fcpxml --at 2 --duration 0.5 -o out.fcpxml`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		input := args[0]
+		output, _ := cmd.Flags().GetString("output")
+		clipIndex, _ := cmd.Flags().GetInt("at")
+		kind, _ := cmd.Flags().GetString("transition")
+		duration, _ := cmd.Flags().GetFloat64("duration")
+
+		var filename string
+		if output != "" {
+			filename = output
+		} else {
+			// Generate default filename with unix timestamp
+			timestamp := time.Now().Unix()
+			filename = fmt.Sprintf("cutlass_%d.fcpxml", timestamp)
+		}
+
+		fcpxml, err := fcp.ReadFromFile(input)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error reading FCPXML file '%s': %v\n", input, err)
+			return
+		}
+
+		if err := fcp.AddTransition(fcpxml, clipIndex, kind, duration); err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error adding transition: %v\n", err)
+			return
+		}
+
+		err = writeFCPXML(fcpxml, filename)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Error writing FCPXML: %v\n", err)
+			return
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "Added %s after clip %d: %s\n", kind, clipIndex, filename)
+	},
+}
+
+func init() {
+	transitionCmd.Flags().StringP("output", "o", "", "Output filename (defaults to cutlass_unixtime.fcpxml)")
+	transitionCmd.Flags().Int("at", 0, "Timeline position of the clip whose end gets the transition, counting from 0 with gaps included")
+	transitionCmd.Flags().String("transition", fcp.TransitionCrossDissolve, "Transition type (only cross-dissolve is available)")
+	transitionCmd.Flags().Float64("duration", 1.0, "Transition length in seconds")
+
+	rootCmd.AddCommand(transitionCmd)
+}
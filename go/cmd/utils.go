@@ -2,7 +2,10 @@ package cmd
 
 import (
 	"cutlass/creative"
+	"cutlass/fcp"
 	"cutlass/utils"
+	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
 )
@@ -176,7 +179,7 @@ cutlass utils add-shadow-text shadow.txt custom_output.fcpxml
 cutlass utils add-shadow-text shadow.txt output.fcpxml 30`,
 	Args: cobra.MinimumNArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		utils.HandleAddShadowTextCommand(args)
+		utils.HandleAddShadowTextCommand(args, writeOptions())
 		return nil
 	},
 }
@@ -217,7 +220,7 @@ This command creates dramatic video movement using advanced Final Cut Pro techni
 
 Effect Types:
 Standard: shake, perspective, flip, 360-tilt, 360-pan, light-rays, glow, cinematic (default)
-Creative: parallax, breathe, pendulum, elastic, spiral, figure8, heartbeat, wind
+Creative: parallax, breathe, pendulum, elastic, spiral, figure8, heartbeat, wind, pixel-reveal, zoom-to-face, vignette
 Advanced: inner-collapse (digital mind breakdown with complex multi-layer animation)
 Cinematic: shatter-archive (nostalgic stop-motion with analog photography decay)
 Special: 
@@ -238,13 +241,187 @@ cutlass utils fx-static-image a.png,b.png,c.png,d.png variety-pack
 
 Word-bounce with custom colors and duration:
 cutlass utils fx-static-image image.png word-bounce -c blue -o red -d 20
-WORDS='hello,world,test' cutlass utils fx-static-image image.png word-bounce -c green -o black -d 15`,
+WORDS='hello,world,test' cutlass utils fx-static-image image.png word-bounce -c green -o black -d 15
+
+Spin around the top-left corner instead of the center:
+cutlass utils fx-static-image photo.png 360-tilt --anchor "-0.5 0.5"
+
+Smooth out fast moves with a ghost trail of 3 fading copies:
+cutlass utils fx-static-image photo.png spiral --motion-blur 3
+
+Aim 10 sparkles up in a 90° cone that live 1-2 seconds:
+cutlass utils fx-static-image photo.png particle-emitter --particles 10 --spread 90 --lifetime 1-2
+
+Fit three 10-second images to a 45-second song by holding the last one:
+cutlass utils fx-static-image a.png,b.png,c.png slideshow.fcpxml cinematic --total 45
+
+Thin out the 50+ keyframes of inner-collapse, staying within half a pixel of the original motion:
+cutlass utils fx-static-image photo.png inner-collapse --simplify 0.5
+
+Lighter shake with about a third of the keyframes (4 position keyframes instead of 11):
+cutlass utils fx-static-image photo.png shake --quality low
+
+Build a slideshow from a downloaded folder, dropping black or blown-out frames:
+cutlass utils fx-static-image a.png,b.png,c.png slideshow.fcpxml cinematic --skip-bad-images
+
+Leave out photos that are byte-identical copies of one shown just before (repeats are always warned about):
+cutlass utils fx-static-image a.png,b.png,b-copy.png slideshow.fcpxml cinematic --dedupe
+
+Darken the edges for mood while keeping the Ken Burns move:
+cutlass utils fx-static-image photo.png cinematic --vignette-amount 0.5
+
+A variety pack where even repeated effects move differently, reproducible with the same seed:
+cutlass utils fx-static-image a.png,b.png,c.png,d.png pack.fcpxml variety-pack --jitter --seed 7
+
+Keep the effect and resolved seed in the project note so the file can be regenerated later:
+cutlass utils fx-static-image photo.png cinematic --record-params`,
 	Args: cobra.MinimumNArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		fontColor, _ := cmd.Flags().GetString("font-color")
 		outlineColor, _ := cmd.Flags().GetString("outline-color")
 		duration, _ := cmd.Flags().GetFloat64("duration")
-		utils.HandleFXStaticImageCommandWithColorAndDuration(args, fontColor, outlineColor, duration)
+		anchorStr, _ := cmd.Flags().GetString("anchor")
+		anchor, err := utils.ParseAnchor(anchorStr)
+		if err != nil {
+			return fmt.Errorf("invalid --anchor '%s': %v", anchorStr, err)
+		}
+		motionBlur, _ := cmd.Flags().GetInt("motion-blur")
+		if motionBlur < 0 || motionBlur > utils.MaxMotionTrailCopies {
+			return fmt.Errorf("invalid --motion-blur %d: must be between 0 and %d", motionBlur, utils.MaxMotionTrailCopies)
+		}
+		particles, _ := cmd.Flags().GetInt("particles")
+		minDist, _ := cmd.Flags().GetFloat64("min-dist")
+		maxDist, _ := cmd.Flags().GetFloat64("max-dist")
+		lifetime, _ := cmd.Flags().GetString("lifetime")
+		minLifetime, maxLifetime, err := utils.ParseLifetimeRange(lifetime)
+		if err != nil {
+			return fmt.Errorf("invalid --lifetime: %v", err)
+		}
+		particleOptions := utils.ParticleOptions{
+			Count:       particles,
+			MinDistance: minDist,
+			MaxDistance: maxDist,
+			MinLifetime: minLifetime,
+			MaxLifetime: maxLifetime,
+		}
+		// Only an explicit --spread is passed on, so --spread 0 means a 0° cone
+		if cmd.Flags().Changed("spread") {
+			spread, _ := cmd.Flags().GetFloat64("spread")
+			particleOptions.Spread = &spread
+		}
+		if err := particleOptions.Validate(); err != nil {
+			return fmt.Errorf("invalid particle options: %v", err)
+		}
+		strict, _ := cmd.Flags().GetBool("strict")
+		total, _ := cmd.Flags().GetFloat64("total")
+		totalGap, _ := cmd.Flags().GetBool("total-gap")
+		if total < 0 {
+			return fmt.Errorf("--total must not be negative, got %.2f", total)
+		}
+		qualityStr, _ := cmd.Flags().GetString("quality")
+		quality, err := utils.ParseEffectQuality(qualityStr)
+		if err != nil {
+			return fmt.Errorf("invalid --quality: %v", err)
+		}
+		simplify, _ := cmd.Flags().GetFloat64("simplify")
+		skipBad, _ := cmd.Flags().GetBool("skip-bad-images")
+		if simplify < 0 {
+			return fmt.Errorf("--simplify must not be negative, got %.2f", simplify)
+		}
+		vignetteAmount, _ := cmd.Flags().GetFloat64("vignette-amount")
+		if vignetteAmount < 0 || vignetteAmount > utils.MaxVignetteAmount {
+			return fmt.Errorf("invalid --vignette-amount %.2f: must be between 0 and %g", vignetteAmount, utils.MaxVignetteAmount)
+		}
+		jitter, _ := cmd.Flags().GetBool("jitter")
+		seed, _ := cmd.Flags().GetInt64("seed")
+		smooth, _ := cmd.Flags().GetBool("smooth")
+		dedupe, _ := cmd.Flags().GetBool("dedupe")
+		utils.HandleFXStaticImageCommandWithOptions(args, fontColor, outlineColor, duration, utils.FXOptions{
+			Anchor:         anchor,
+			MotionBlur:     motionBlur,
+			Particles:      particleOptions,
+			Limits:         fcp.RenderLimits{Strict: strict},
+			TotalSeconds:   total,
+			HoldWithGap:    totalGap,
+			Simplify:       simplify,
+			Quality:        quality,
+			SkipBad:        skipBad,
+			VignetteAmount: vignetteAmount,
+			Jitter:         jitter,
+			Seed:           seed,
+			Smooth:         smooth,
+			Dedupe:         dedupe,
+			RecordParams:   recordParams(cmd),
+			Write:          writeOptions(),
+		})
+		return nil
+	},
+}
+
+var fxBatchCmd = &cobra.Command{
+	Use:   "fx-batch <glob-pattern>",
+	Short: "Render one FCPXML per image with the same animated effect",
+	Long: `Render one FCPXML per matching image, each containing that single image with the chosen effect.
+Useful for A/B testing an effect across many images.
+
+Files are written to <outdir>/<name>_fx.fcpxml and rendered in parallel by a bounded
+worker pool (--jobs). A failing file is reported but does not stop the rest of the batch.
+--out-template names files from {name}, {effect}, {index} and {date} tokens; slashes
+create subdirectories, and a template that names two files the same is rejected.
+
+Quote the pattern so the shell does not expand it.
+
+Examples:
+cutlass utils fx-batch "*.jpg" --effect spiral --outdir out/
+cutlass utils fx-batch "photos/*.png" --effect heartbeat --outdir fx/ --jobs 8
+cutlass utils fx-batch "*.jpg" --effect glow --out-template "{date}/{effect}/{name}.fcpxml"`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		effect, _ := cmd.Flags().GetString("effect")
+		outDir, _ := cmd.Flags().GetString("outdir")
+		outTemplate, _ := cmd.Flags().GetString("out-template")
+		jobs, _ := cmd.Flags().GetInt("jobs")
+		duration, _ := cmd.Flags().GetFloat64("duration")
+		return utils.HandleFXBatchCommand(args[0], effect, outDir, outTemplate, jobs, duration, writeOptions())
+	},
+}
+
+var fxApplyAllCmd = &cobra.Command{
+	Use:   "fx-apply-all <input.fcpxml>",
+	Short: "Apply one animated effect to every image in an existing FCPXML",
+	Long: `Restyle a whole timeline in one shot: every image in the spine gets the chosen effect,
+animated over that image's own duration (so concat'ed timelines with mixed clip lengths work).
+
+Images that already have a transform are skipped unless --replace is given.
+
+Examples:
+cutlass utils fx-apply-all slideshow.fcpxml --effect glow
+cutlass utils fx-apply-all joined.fcpxml --effect breathe --replace -o styled.fcpxml`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		effect, _ := cmd.Flags().GetString("effect")
+		replace, _ := cmd.Flags().GetBool("replace")
+		output, _ := cmd.Flags().GetString("output")
+		if output == "" {
+			// Generate default filename with unix timestamp
+			output = fmt.Sprintf("cutlass_%d.fcpxml", time.Now().Unix())
+		}
+
+		fcpxml, err := fcp.ReadFromFile(args[0])
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %v", args[0], err)
+		}
+
+		applied, err := utils.ApplyEffectToAllWithOptions(fcpxml, effect, replace)
+		if err != nil {
+			return err
+		}
+
+		if err := writeFCPXML(fcpxml, output); err != nil {
+			return fmt.Errorf("failed to write FCPXML: %v", err)
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "🎬 Applied '%s' to %d images: %s\n", effect, applied, output)
 		return nil
 	},
 }
@@ -289,7 +466,7 @@ Sample Output:
 3. Time: 48.789s | Intensity: 0.95 | Type: combined`,
 	Args: cobra.MinimumNArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		return utils.HandleFindBeatsCommand(args)
+		return utils.HandleFindBeatsCommand(args, writeOptions())
 	},
 }
 
@@ -341,7 +518,7 @@ cutlass utils txt-convo messages.txt final.fcpxml 3.0
 Duration per message defaults to 2.5 seconds for natural pacing.`,
 	Args: cobra.MinimumNArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		utils.HandleTxtConvoCommand(args)
+		utils.HandleTxtConvoCommand(args, writeOptions())
 		return nil
 	},
 }
@@ -354,6 +531,8 @@ func init() {
 	utilsCmd.AddCommand(creativeTextCmd)
 	utilsCmd.AddCommand(addShadowTextCmd)
 	utilsCmd.AddCommand(fxStaticImageCmd)
+	utilsCmd.AddCommand(fxBatchCmd)
+	utilsCmd.AddCommand(fxApplyAllCmd)
 	utilsCmd.AddCommand(findBeatsCmd)
 	utilsCmd.AddCommand(txtConvoCmd)
 	
@@ -361,4 +540,35 @@ func init() {
 	fxStaticImageCmd.Flags().StringP("font-color", "c", "pink", "Font color as English name (red, blue, green, yellow, etc.) or RGBA values (0-1 format)")
 	fxStaticImageCmd.Flags().StringP("outline-color", "o", "black", "Outline color as English name (red, blue, green, yellow, etc.) or RGBA values (0-1 format)")
 	fxStaticImageCmd.Flags().Float64P("duration", "d", 9.0, "Duration in seconds for word-bounce effect (default: 9.0)")
+	fxStaticImageCmd.Flags().String("anchor", "0 0", "Rotation pivot as normalized 'x y' (-1 to 1) for 360-tilt, spiral and flip effects (default: center)")
+	fxStaticImageCmd.Flags().Int("motion-blur", 0, "Number of fading ghost copies trailing the animation (0 disables)")
+	fxStaticImageCmd.Flags().Int("particles", utils.DefaultParticleOptions.Count, fmt.Sprintf("Number of particle-emitter sparkles (1-%d)", utils.MaxParticles))
+	fxStaticImageCmd.Flags().Float64("spread", utils.DefaultParticleSpread, "Particle-emitter cone in degrees, aimed up (360 = full circle, 0 = straight up)")
+	fxStaticImageCmd.Flags().Float64("min-dist", utils.DefaultParticleOptions.MinDistance, "Shortest particle-emitter flight distance in pixels")
+	fxStaticImageCmd.Flags().Float64("max-dist", utils.DefaultParticleOptions.MaxDistance, "Longest particle-emitter flight distance in pixels")
+	fxStaticImageCmd.Flags().String("lifetime", "2-4", "Particle-emitter sparkle lifetime range in seconds (min-max)")
+	fxStaticImageCmd.Flags().Float64("total", 0, "Hold the last image so the timeline lasts exactly this many seconds (0 disables)")
+	fxStaticImageCmd.Flags().Bool("total-gap", false, "With --total, fill the remaining time with a gap instead of holding the last image")
+	fxStaticImageCmd.Flags().String("quality", string(utils.EffectQualityHigh), "Keyframe density of the effect: low, medium or high (lower is lighter but less smooth)")
+	fxStaticImageCmd.Flags().Float64("simplify", 0, "Remove keyframes within this distance (pixels for position) of a straight line between their neighbors (0 disables)")
+	fxStaticImageCmd.Flags().Bool("smooth", false, "Use smooth bezier curves on scale, rotation and anchor keyframes (position keyframes stay linear)")
+	fxStaticImageCmd.Flags().Bool("dedupe", false, "Skip images byte-identical to one of the few shown just before them")
+	fxStaticImageCmd.Flags().Bool("skip-bad-images", false, "Leave out images that are almost entirely black, blown out or unreadable, with a warning")
+	fxStaticImageCmd.Flags().Float64("vignette-amount", 0, "Darken the image edges with FCP's Vignette filter on top of the effect (0-1; 0 disables, the vignette effect defaults to 0.6)")
+	fxStaticImageCmd.Flags().Bool("jitter", false, "Vary each image's effect amplitude, speed and direction within tasteful bounds")
+	fxStaticImageCmd.Flags().Int64("seed", 0, "Seed for variety-pack picks and --jitter so runs are reproducible (0 uses the clock)")
+	fxStaticImageCmd.Flags().Bool("strict", false, "Fail instead of warning when the timeline exceeds 10,000 elements or 2 hours")
+	addRecordParamsFlag(fxStaticImageCmd)
+
+	// Add flags for fx-batch command
+	fxBatchCmd.Flags().String("effect", "cinematic", "Effect type applied to every image (default: cinematic)")
+	fxBatchCmd.Flags().String("outdir", "./data", "Directory for the generated <name>_fx.fcpxml files (default: ./data)")
+	fxBatchCmd.Flags().String("out-template", utils.DefaultFXBatchTemplate, "Output file name template under --outdir: {name}, {effect}, {index}, {date}")
+	fxBatchCmd.Flags().Int("jobs", 4, "Number of files rendered in parallel (default: 4)")
+	fxBatchCmd.Flags().Float64P("duration", "d", 10.0, "Duration in seconds of each image (default: 10.0)")
+
+	// Add flags for fx-apply-all command
+	fxApplyAllCmd.Flags().String("effect", "cinematic", "Effect type applied to every image (default: cinematic)")
+	fxApplyAllCmd.Flags().Bool("replace", false, "Restyle images that already have a transform")
+	fxApplyAllCmd.Flags().StringP("output", "o", "", "Output filename (defaults to cutlass_unixtime.fcpxml)")
 }
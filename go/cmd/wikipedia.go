@@ -46,7 +46,7 @@ generate FCPXML from the article's tables, just like the 'table' subcommand.`,
 			outputFile += ".fcpxml"
 		}
 
-		fmt.Printf("Using Wikipedia mode to create FCPXML from article tables...\n")
+		fmt.Fprintf(cmd.OutOrStdout(), "Using Wikipedia mode to create FCPXML from article tables...\n")
 		if err := wikipedia.GenerateFromWikipedia(articleTitle, outputFile); err != nil {
 			fmt.Fprintf(os.Stderr, "Error generating from Wikipedia: %v\n", err)
 			os.Exit(1)
@@ -72,7 +72,7 @@ an FCPXML file suitable for Final Cut Pro.`,
 			outputFile += ".fcpxml"
 		}
 
-		fmt.Printf("Using Wikipedia mode to create FCPXML from article tables...\n")
+		fmt.Fprintf(cmd.OutOrStdout(), "Using Wikipedia mode to create FCPXML from article tables...\n")
 		if err := wikipedia.GenerateFromWikipedia(articleTitle, outputFile); err != nil {
 			fmt.Fprintf(os.Stderr, "Error generating from Wikipedia: %v\n", err)
 			os.Exit(1)
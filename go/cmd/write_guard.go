@@ -0,0 +1,27 @@
+package cmd
+
+import (
+	"cutlass/fcp"
+)
+
+// Overwrite guard flags shared by every command that writes an FCPXML file
+var (
+	forceOverwrite  bool
+	backupOverwrite bool
+)
+
+// writeOptions returns the overwrite guard selected on the command line
+func writeOptions() fcp.WriteOptions {
+	return fcp.WriteOptions{Force: forceOverwrite, Backup: backupOverwrite}
+}
+
+// writeFCPXML writes like fcp.WriteToFile but refuses to clobber an existing file
+// unless --force or --backup was given
+func writeFCPXML(fcpxml *fcp.FCPXML, filename string) error {
+	return fcp.WriteToFileSafe(fcpxml, filename, writeOptions())
+}
+
+func init() {
+	rootCmd.PersistentFlags().BoolVar(&forceOverwrite, "force", false, "Overwrite existing output files")
+	rootCmd.PersistentFlags().BoolVar(&backupOverwrite, "backup", false, "Copy an existing output file to a timestamped .bak before overwriting it")
+}
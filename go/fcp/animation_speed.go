@@ -0,0 +1,102 @@
+package fcp
+
+import (
+	"fmt"
+	"math"
+)
+
+// ScaleAnimationSpeed retimes the keyframes of an existing clip's AdjustTransform in place.
+// Keyframe times are rescaled around the clip start by factor: 0.5 plays the animation twice
+// as fast (compressed into the first half of the clip), 2.0 plays it at half speed.
+//
+// clipIndex counts spine elements in timeline order, gaps included (see spineElementAt); it must
+// name a video or asset-clip.
+//
+// 🚨 CLAUDE.md Rules Applied Here:
+// - Keyframe times are in the clip's local time, which begins at its start (e.g. "86399313/24000s" for images)
+// - Keyframes written relative to 0s instead of the clip start are scaled around 0s
+// - Rescaled times are re-emitted frame-aligned → (frames*1001)/24000s
+func ScaleAnimationSpeed(fcpxml *FCPXML, clipIndex int, factor float64) error {
+	if factor <= 0 {
+		return fmt.Errorf("speed factor must be greater than 0, got %g", factor)
+	}
+	if len(fcpxml.Library.Events) == 0 || len(fcpxml.Library.Events[0].Projects) == 0 || len(fcpxml.Library.Events[0].Projects[0].Sequences) == 0 {
+		return fmt.Errorf("no sequence found")
+	}
+
+	spine := &fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine
+	element, err := spineElementAt(spine, clipIndex)
+	if err != nil {
+		return err
+	}
+
+	var transform *AdjustTransform
+	switch element.kind {
+	case spineKindVideo:
+		transform = spine.Videos[element.index].AdjustTransform
+	case spineKindAssetClip:
+		transform = spine.AssetClips[element.index].AdjustTransform
+	default:
+		return fmt.Errorf("clip index %d is a %s, which has no adjust-transform to retime", clipIndex, element.kind)
+	}
+	if transform == nil {
+		return fmt.Errorf("clip %d has no adjust-transform to retime", clipIndex)
+	}
+
+	clipStart := 0
+	if *element.start != "" {
+		start, err := parseKeyframeTime(*element.start)
+		if err != nil {
+			return fmt.Errorf("failed to parse clip start: %v", err)
+		}
+		clipStart = start
+	}
+
+	retimed := 0
+	for p := range transform.Params {
+		animation := transform.Params[p].KeyframeAnimation
+		if animation == nil {
+			continue
+		}
+		for k := range animation.Keyframes {
+			keyframe := &animation.Keyframes[k]
+			t, err := parseKeyframeTime(keyframe.Time)
+			if err != nil {
+				return fmt.Errorf("param %s keyframe %d: %v", transform.Params[p].Name, k, err)
+			}
+
+			origin := clipStart
+			if t < clipStart {
+				origin = 0
+			}
+			scaled := origin + int(math.Round(float64(t-origin)*factor))
+			keyframe.Time = formatFrameAlignedTime(scaled)
+			retimed++
+		}
+	}
+
+	if retimed == 0 {
+		return fmt.Errorf("clip %d has no keyframes to retime", clipIndex)
+	}
+
+	return nil
+}
+
+// parseKeyframeTime parses an FCP time ("N/Ds", "Ns" or "0s") into 1/24000s units
+func parseKeyframeTime(value string) (int, error) {
+	num, den, err := ParseFCPTime(value)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(math.Round(float64(num) * 24000 / float64(den))), nil
+}
+
+// formatFrameAlignedTime snaps a time in 1/24000s units to the nearest frame boundary
+func formatFrameAlignedTime(units int) string {
+	frames := int(math.Round(float64(units) / 1001))
+	if frames == 0 {
+		return "0s"
+	}
+	return fmt.Sprintf("%d/24000s", frames*1001)
+}
@@ -0,0 +1,66 @@
+package fcp
+
+import (
+	"testing"
+)
+
+// TestScaleAnimationSpeed tests retiming keyframes around the clip start and around 0s
+func TestScaleAnimationSpeed(t *testing.T) {
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("Failed to generate empty FCPXML: %v", err)
+	}
+
+	sequence := &fcpxml.Library.Events[0].Projects[0].Sequences[0]
+	sequence.Spine.Videos = append(sequence.Spine.Videos, Video{
+		Ref:      "r2",
+		Offset:   "0s",
+		Name:     "clip",
+		Start:    "86399313/24000s",
+		Duration: ConvertSecondsToFCPDuration(10),
+		AdjustTransform: &AdjustTransform{
+			Params: []Param{
+				{
+					Name: "position",
+					KeyframeAnimation: &KeyframeAnimation{
+						Keyframes: []Keyframe{
+							{Time: "86399313/24000s", Value: "0 0"},
+							{Time: "86639553/24000s", Value: "10 0"}, // start + 240 frames (~10s)
+						},
+					},
+				},
+				{
+					Name: "scale",
+					KeyframeAnimation: &KeyframeAnimation{
+						Keyframes: []Keyframe{
+							{Time: "0s", Value: "1 1", Curve: "linear"},
+							{Time: "10s", Value: "2 2", Curve: "linear"}, // relative to 0s
+						},
+					},
+				},
+			},
+		},
+	})
+
+	if err := ScaleAnimationSpeed(fcpxml, 0, 0.5); err != nil {
+		t.Fatalf("ScaleAnimationSpeed failed: %v", err)
+	}
+
+	params := sequence.Spine.Videos[0].AdjustTransform.Params
+	if got := params[0].KeyframeAnimation.Keyframes[0].Time; got != "86399313/24000s" {
+		t.Errorf("Expected first keyframe to stay at clip start, got %s", got)
+	}
+	if got := params[0].KeyframeAnimation.Keyframes[1].Time; got != "86519433/24000s" {
+		t.Errorf("Expected second keyframe at start + 120 frames, got %s", got)
+	}
+	if got := params[1].KeyframeAnimation.Keyframes[1].Time; got != ConvertSecondsToFCPDuration(5) {
+		t.Errorf("Expected 0s-relative keyframe at 5s, got %s", got)
+	}
+
+	if err := ScaleAnimationSpeed(fcpxml, 0, 0); err == nil {
+		t.Errorf("Expected error for zero factor")
+	}
+	if err := ScaleAnimationSpeed(fcpxml, 3, 0.5); err == nil {
+		t.Errorf("Expected error for out of range clip index")
+	}
+}
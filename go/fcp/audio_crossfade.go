@@ -0,0 +1,168 @@
+package fcp
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+// Crossfade volume levels. -60dB is the quietest level the keyframe validator accepts
+// and is inaudible under the incoming clip.
+const (
+	crossfadeFullVolume   = "0dB"
+	crossfadeSilentVolume = "-60dB"
+)
+
+// audioClipRef locates an audio asset-clip and the slice that holds it; offsets are only
+// comparable between clips that share a container. lowestLane is the lowest lane used by
+// anything connected to the container's parent (0 for the spine).
+type audioClipRef struct {
+	clip       *AssetClip
+	container  *[]AssetClip
+	index      int
+	lowestLane int
+}
+
+// audioClipsInOrder lists the asset-clips that reference audio-only assets: clips on the spine,
+// then clips nested in spine videos and asset-clips (where AddAudio places music)
+func audioClipsInOrder(fcpxml *FCPXML, sequence *Sequence) []audioClipRef {
+	audioAssets := map[string]bool{}
+	for _, asset := range fcpxml.Resources.Assets {
+		if asset.HasAudio == "1" && asset.HasVideo != "1" {
+			audioAssets[asset.ID] = true
+		}
+	}
+
+	var refs []audioClipRef
+	collect := func(container *[]AssetClip, lowestLane int) {
+		for i := range *container {
+			if audioAssets[(*container)[i].Ref] {
+				refs = append(refs, audioClipRef{&(*container)[i], container, i, lowestLane})
+			}
+		}
+	}
+
+	spine := &sequence.Spine
+	collect(&spine.AssetClips, 0)
+	for i := range spine.Videos {
+		video := &spine.Videos[i]
+		collect(&video.NestedAssetClips, lowestNestedLane(video.NestedVideos, video.NestedAssetClips, video.NestedTitles))
+	}
+	for i := range spine.AssetClips {
+		clip := &spine.AssetClips[i]
+		collect(&clip.NestedAssetClips, lowestNestedLane(clip.Videos, clip.NestedAssetClips, clip.Titles))
+	}
+
+	return refs
+}
+
+// AddAudioCrossfade overlaps audio clip B with the end of audio clip A by seconds and ramps
+// A's volume down while B's ramps up over the overlap. Clip indices count audio clips in
+// audioClipsInOrder order; B must directly follow A in the same container, which must be
+// connected clips (where AddAudio places music) since spine clips can't overlap.
+//
+// 🚨 CLAUDE.md Rules Applied Here:
+// - B is moved to start `seconds` before A ends, so A+B together get `seconds` shorter
+// - B moves to a new lane below everything else in the parent, so the overlap has its own lane
+// - Later audio clips in the container move up by the same amount
+// - Volume keyframes live in each clip's local time (start → start + duration)
+// - Frame-aligned times → ConvertSecondsToFCPDuration() function
+func AddAudioCrossfade(fcpxml *FCPXML, clipIndexA, clipIndexB int, seconds float64) error {
+	if seconds <= 0 {
+		return fmt.Errorf("crossfade length must be positive, got %g", seconds)
+	}
+
+	sequence, err := firstSequence(fcpxml)
+	if err != nil {
+		return err
+	}
+
+	clips := audioClipsInOrder(fcpxml, sequence)
+	for _, index := range []int{clipIndexA, clipIndexB} {
+		if index < 0 || index >= len(clips) {
+			return fmt.Errorf("audio clip index %d out of range (timeline has %d audio clips)", index, len(clips))
+		}
+	}
+	a, b := clips[clipIndexA], clips[clipIndexB]
+	if a.container != b.container || b.index != a.index+1 {
+		return fmt.Errorf("audio clips %d and %d are not adjacent", clipIndexA, clipIndexB)
+	}
+	if a.container == &sequence.Spine.AssetClips {
+		return fmt.Errorf("audio clips %d and %d are on the spine, where clips can't overlap - connect them to a clip (as AddAudio does) to crossfade", clipIndexA, clipIndexB)
+	}
+
+	fadeFrames := parseFCPDuration(ConvertSecondsToFCPDuration(seconds))
+	durationA := parseFCPDuration(a.clip.Duration)
+	durationB := parseFCPDuration(b.clip.Duration)
+	if fadeFrames > durationA || fadeFrames > durationB {
+		return fmt.Errorf("crossfade of %.3fs is longer than clip %d (%s) or clip %d (%s)", seconds, clipIndexA, a.clip.Duration, clipIndexB, b.clip.Duration)
+	}
+
+	// Move B (and everything after it in the container) so B starts fadeFrames before A ends
+	newOffsetB := parseFCPDuration(a.clip.Offset) + durationA - fadeFrames
+	shift := newOffsetB - parseFCPDuration(b.clip.Offset)
+	container := *b.container
+	for i := b.index; i < len(container); i++ {
+		container[i].Offset = formatFrameAlignedTime(parseFCPDuration(container[i].Offset) + shift)
+	}
+	b.clip.Lane = strconv.Itoa(b.lowestLane - 1)
+
+	startA := parseFCPDuration(a.clip.Start)
+	startB := parseFCPDuration(b.clip.Start)
+	addVolumeRamp(a.clip, startA+durationA-fadeFrames, startA+durationA, crossfadeFullVolume, crossfadeSilentVolume)
+	addVolumeRamp(b.clip, startB, startB+fadeFrames, crossfadeSilentVolume, crossfadeFullVolume)
+
+	return nil
+}
+
+// lowestNestedLane returns the lowest lane used by connected videos, asset-clips or titles, or 0
+func lowestNestedLane(videos []Video, clips []AssetClip, titles []Title) int {
+	lowest := 0
+	check := func(lane string) {
+		if n, err := strconv.Atoi(lane); err == nil && n < lowest {
+			lowest = n
+		}
+	}
+	for _, video := range videos {
+		check(video.Lane)
+	}
+	for _, clip := range clips {
+		check(clip.Lane)
+	}
+	for _, title := range titles {
+		check(title.Lane)
+	}
+	return lowest
+}
+
+// addVolumeRamp adds a two-keyframe volume change to the clip's adjust-volume "amount" param,
+// keeping any keyframes already there (e.g. a fade in at the other end of the clip) in time order
+func addVolumeRamp(clip *AssetClip, fromFrames, toFrames int, fromValue, toValue string) {
+	if clip.AdjustVolume == nil {
+		clip.AdjustVolume = &AdjustVolume{}
+	}
+
+	var amount *Param
+	for i := range clip.AdjustVolume.Params {
+		if clip.AdjustVolume.Params[i].Name == "amount" {
+			amount = &clip.AdjustVolume.Params[i]
+			break
+		}
+	}
+	if amount == nil {
+		clip.AdjustVolume.Params = append(clip.AdjustVolume.Params, Param{Name: "amount"})
+		amount = &clip.AdjustVolume.Params[len(clip.AdjustVolume.Params)-1]
+	}
+	if amount.KeyframeAnimation == nil {
+		amount.KeyframeAnimation = &KeyframeAnimation{}
+	}
+
+	amount.KeyframeAnimation.Keyframes = append(amount.KeyframeAnimation.Keyframes,
+		Keyframe{Time: formatFrameAlignedTime(fromFrames), Value: fromValue},
+		Keyframe{Time: formatFrameAlignedTime(toFrames), Value: toValue},
+	)
+	keyframes := amount.KeyframeAnimation.Keyframes
+	sort.SliceStable(keyframes, func(i, j int) bool {
+		return timeUnits(keyframes[i].Time) < timeUnits(keyframes[j].Time)
+	})
+}
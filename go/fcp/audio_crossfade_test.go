@@ -0,0 +1,107 @@
+package fcp
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestAddAudioCrossfade tests that a crossfade overlaps two audio clips with opposing volume ramps
+func TestAddAudioCrossfade(t *testing.T) {
+	tempDir := t.TempDir()
+	imagePath := filepath.Join(tempDir, "cover.png")
+	writeTestPNG(t, imagePath, 64, 64)
+
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("GenerateEmpty failed: %v", err)
+	}
+	if err := AddImage(fcpxml, imagePath, 120); err != nil {
+		t.Fatalf("AddImage failed: %v", err)
+	}
+	for _, name := range []string{"song_a.wav", "song_b.wav"} {
+		audioPath := filepath.Join(tempDir, name)
+		if err := os.WriteFile(audioPath, []byte("fake wav data"), 0644); err != nil {
+			t.Fatalf("Failed to create test audio: %v", err)
+		}
+		if err := AddAudio(fcpxml, audioPath); err != nil {
+			t.Fatalf("AddAudio failed: %v", err)
+		}
+	}
+
+	// A fade out already on B's tail must stay after the new fade in
+	sequence := &fcpxml.Library.Events[0].Projects[0].Sequences[0]
+	tailB := &sequence.Spine.Videos[0].NestedAssetClips[1]
+	endB := parseFCPDuration(tailB.Start) + parseFCPDuration(tailB.Duration)
+	addVolumeRamp(tailB, endB-24, endB, crossfadeFullVolume, crossfadeSilentVolume)
+
+	if err := AddAudioCrossfade(fcpxml, 0, 1, 2); err != nil {
+		t.Fatalf("AddAudioCrossfade failed: %v", err)
+	}
+
+	audioClips := fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine.Videos[0].NestedAssetClips
+	if len(audioClips) != 2 {
+		t.Fatalf("Expected 2 nested audio clips, got %d", len(audioClips))
+	}
+	a, b := audioClips[0], audioClips[1]
+	if a.Lane != "-1" || b.Lane != "-2" {
+		t.Errorf("Expected clip B on its own lane below clip A, got lanes %s and %s", a.Lane, b.Lane)
+	}
+
+	fade := parseFCPDuration(ConvertSecondsToFCPDuration(2))
+	endA := parseFCPDuration(a.Offset) + parseFCPDuration(a.Duration)
+	if parseFCPDuration(b.Offset) != endA-fade {
+		t.Errorf("Expected clip B to start 2s before clip A ends (%d), got %s", endA-fade, b.Offset)
+	}
+	combined := parseFCPDuration(b.Offset) + parseFCPDuration(b.Duration) - parseFCPDuration(a.Offset)
+	if combined != parseFCPDuration(a.Duration)+parseFCPDuration(b.Duration)-fade {
+		t.Errorf("Expected combined duration to shrink by 2s, got %d", combined)
+	}
+
+	rampA := volumeKeyframes(t, a, 2)
+	rampB := volumeKeyframes(t, b, 4)
+	for i := 1; i < len(rampB); i++ {
+		if parseFCPDuration(rampB[i].Time) < parseFCPDuration(rampB[i-1].Time) {
+			t.Errorf("Clip B volume keyframes out of time order: %v", rampB)
+		}
+	}
+	durationA := parseFCPDuration(a.Duration)
+	if parseFCPDuration(rampA[0].Time) != durationA-fade || parseFCPDuration(rampA[1].Time) != durationA {
+		t.Errorf("Clip A ramp should cover its last 2s, got %s → %s", rampA[0].Time, rampA[1].Time)
+	}
+	if rampB[0].Time != "0s" || parseFCPDuration(rampB[1].Time) != fade {
+		t.Errorf("Clip B ramp should cover its first 2s, got %s → %s", rampB[0].Time, rampB[1].Time)
+	}
+	if rampA[0].Value != rampB[1].Value || rampA[1].Value != rampB[0].Value || rampA[0].Value == rampA[1].Value {
+		t.Errorf("Expected opposing ramps, got A %s→%s and B %s→%s", rampA[0].Value, rampA[1].Value, rampB[0].Value, rampB[1].Value)
+	}
+
+	if err := AddAudioCrossfade(fcpxml, 0, 1, 90); err == nil {
+		t.Error("Expected error for a crossfade longer than the clips")
+	}
+	if err := AddAudioCrossfade(fcpxml, 1, 0, 2); err == nil {
+		t.Error("Expected error for clips given out of order")
+	}
+
+	// Spine clips play one after another and can't overlap
+	sequence.Spine.AssetClips = append(sequence.Spine.AssetClips,
+		AssetClip{Ref: a.Ref, Name: "spine_a", Offset: "0s", Duration: a.Duration},
+		AssetClip{Ref: b.Ref, Name: "spine_b", Offset: a.Duration, Duration: b.Duration},
+	)
+	if err := AddAudioCrossfade(fcpxml, 0, 1, 2); err == nil {
+		t.Error("Expected error for a crossfade between spine clips")
+	}
+}
+
+// volumeKeyframes returns the adjust-volume amount keyframes of an audio clip, which must have count of them
+func volumeKeyframes(t *testing.T, clip AssetClip, count int) []Keyframe {
+	t.Helper()
+	if clip.AdjustVolume == nil || len(clip.AdjustVolume.Params) != 1 || clip.AdjustVolume.Params[0].KeyframeAnimation == nil {
+		t.Fatalf("Clip %s has no volume keyframes", clip.Name)
+	}
+	keyframes := clip.AdjustVolume.Params[0].KeyframeAnimation.Keyframes
+	if len(keyframes) != count {
+		t.Fatalf("Clip %s: expected %d volume keyframes, got %d", clip.Name, count, len(keyframes))
+	}
+	return keyframes
+}
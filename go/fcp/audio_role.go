@@ -0,0 +1,93 @@
+package fcp
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// DefaultAudioRole is the role AddVideo and AddAudio give new clips
+const DefaultAudioRole = "dialogue"
+
+// builtInAudioRoles are FCP's standard audio roles; they are written in lowercase
+var builtInAudioRoles = []string{"dialogue", "music", "effects"}
+
+// audioRoleNamePattern matches one role or subrole name: letters, digits, spaces, '-' and '_'
+var audioRoleNamePattern = regexp.MustCompile(`^[\p{L}\p{N}][\p{L}\p{N} _-]*$`)
+
+// NormalizeAudioRole checks a "role" or "role.subrole" string (e.g. "music.score", "effects.foley",
+// "Sound Design") against FCP's role syntax and returns it with built-in roles lowercased.
+func NormalizeAudioRole(role string) (string, error) {
+	parts := strings.Split(strings.TrimSpace(role), ".")
+	if len(parts) > 2 {
+		return "", fmt.Errorf("invalid audio role '%s': use 'role' or 'role.subrole'", role)
+	}
+	for i, part := range parts {
+		part = strings.TrimSpace(part)
+		if !audioRoleNamePattern.MatchString(part) {
+			return "", fmt.Errorf("invalid audio role '%s': names may only use letters, digits, spaces, '-' and '_'", role)
+		}
+		parts[i] = part
+	}
+
+	for _, builtIn := range builtInAudioRoles {
+		if strings.EqualFold(parts[0], builtIn) {
+			parts[0] = builtIn
+		}
+	}
+	return strings.Join(parts, "."), nil
+}
+
+// SetAssetClipAudioRole sets the audio role of the spine asset-clip at clipIndex, counted in
+// timeline order with gaps included (see spineElementAt)
+func SetAssetClipAudioRole(fcpxml *FCPXML, clipIndex int, role string) error {
+	role, err := NormalizeAudioRole(role)
+	if err != nil {
+		return err
+	}
+	sequence, err := firstSequence(fcpxml)
+	if err != nil {
+		return err
+	}
+	clip, err := spineAssetClipAt(&sequence.Spine, clipIndex)
+	if err != nil {
+		return err
+	}
+
+	clip.AudioRole = role
+	return nil
+}
+
+// AddVideoWithRole is AddVideo with the clip's audio on role (e.g. "music.score") instead of dialogue
+func AddVideoWithRole(fcpxml *FCPXML, videoPath string, role string) error {
+	if _, err := NormalizeAudioRole(role); err != nil {
+		return err
+	}
+	if err := AddVideo(fcpxml, videoPath); err != nil {
+		return err
+	}
+	return SetAssetClipAudioRole(fcpxml, TimelineElementCount(fcpxml)-1, role)
+}
+
+// AddAudioWithRole is AddAudio with the audio clip on role (e.g. "effects.foley") instead of dialogue.
+// AddAudio nests the clip in the first spine video, so the role is set on its newest nested clip.
+func AddAudioWithRole(fcpxml *FCPXML, audioPath string, role string) error {
+	role, err := NormalizeAudioRole(role)
+	if err != nil {
+		return err
+	}
+	if err := AddAudio(fcpxml, audioPath); err != nil {
+		return err
+	}
+
+	sequence, err := firstSequence(fcpxml)
+	if err != nil {
+		return err
+	}
+	if len(sequence.Spine.Videos) == 0 || len(sequence.Spine.Videos[0].NestedAssetClips) == 0 {
+		return fmt.Errorf("audio clip not found after adding %s", audioPath)
+	}
+	nested := sequence.Spine.Videos[0].NestedAssetClips
+	nested[len(nested)-1].AudioRole = role
+	return nil
+}
@@ -0,0 +1,96 @@
+package fcp
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestAddVideoWithRole tests that a clip added with role effects.foley emits that role on the asset-clip
+func TestAddVideoWithRole(t *testing.T) {
+	dir := t.TempDir()
+	videoPath := filepath.Join(dir, "footsteps.mp4")
+	if err := os.WriteFile(videoPath, []byte("fake video"), 0644); err != nil {
+		t.Fatalf("Failed to create test video: %v", err)
+	}
+
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("GenerateEmpty failed: %v", err)
+	}
+	if err := AddVideoWithRole(fcpxml, videoPath, "effects.foley"); err != nil {
+		t.Fatalf("AddVideoWithRole failed: %v", err)
+	}
+
+	outputPath := filepath.Join(dir, "roles.fcpxml")
+	if err := WriteToFile(fcpxml, outputPath); err != nil {
+		t.Fatalf("WriteToFile failed: %v", err)
+	}
+	data, err := os.ReadFile(outputPath)
+	if err != nil {
+		t.Fatalf("Failed to read output: %v", err)
+	}
+	output := string(data)
+	if !strings.Contains(output, `audioRole="effects.foley"`) {
+		t.Errorf("Expected audioRole=\"effects.foley\" on the video asset-clip")
+	}
+	if strings.Contains(output, `audioRole="dialogue"`) {
+		t.Errorf("The default dialogue role should have been replaced")
+	}
+}
+
+// TestAddAudioWithRole tests that the nested audio clip carries the role, with built-in roles lowercased
+func TestAddAudioWithRole(t *testing.T) {
+	dir := t.TempDir()
+	imagePath := filepath.Join(dir, "cover.png")
+	writeTestPNG(t, imagePath, 64, 64)
+	audioPath := filepath.Join(dir, "score.wav")
+	if err := os.WriteFile(audioPath, []byte("fake audio"), 0644); err != nil {
+		t.Fatalf("Failed to create test audio: %v", err)
+	}
+
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("GenerateEmpty failed: %v", err)
+	}
+	if err := AddImage(fcpxml, imagePath, 5); err != nil {
+		t.Fatalf("AddImage failed: %v", err)
+	}
+	if err := AddAudioWithRole(fcpxml, audioPath, "Music.Score"); err != nil {
+		t.Fatalf("AddAudioWithRole failed: %v", err)
+	}
+
+	nested := fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine.Videos[0].NestedAssetClips
+	if len(nested) != 1 || nested[0].AudioRole != "music.Score" {
+		t.Fatalf("Expected one nested audio clip with role music.Score, got %+v", nested)
+	}
+
+	if err := AddAudioWithRole(fcpxml, audioPath, "music.score.extra"); err == nil {
+		t.Errorf("Expected an error for an invalid role")
+	}
+}
+
+// TestNormalizeAudioRole tests FCP role syntax validation
+func TestNormalizeAudioRole(t *testing.T) {
+	valid := map[string]string{
+		"dialogue":            "dialogue",
+		"MUSIC":               "music",
+		"music.score":         "music.score",
+		"Sound Design":        "Sound Design",
+		" effects.foley_2 ":   "effects.foley_2",
+		"Dialogue.Dialogue-1": "dialogue.Dialogue-1",
+	}
+	for input, expected := range valid {
+		role, err := NormalizeAudioRole(input)
+		if err != nil || role != expected {
+			t.Errorf("NormalizeAudioRole(%q) = %q, %v; expected %q", input, role, err, expected)
+		}
+	}
+
+	for _, invalid := range []string{"", "music.", ".score", "music.score.extra", "music/score", "<music>"} {
+		if _, err := NormalizeAudioRole(invalid); err == nil {
+			t.Errorf("Expected an error for role %q", invalid)
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package fcp
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// probeAudioDuration reports an audio file's length in seconds (a variable so tests can skip ffprobe)
+var probeAudioDuration = probeAudioDurationWithFFprobe
+
+// probeAudioDurationWithFFprobe reads the container duration with ffprobe
+func probeAudioDurationWithFFprobe(audioPath string) (float64, error) {
+	cmd := exec.Command("ffprobe", "-v", "quiet", "-show_entries", "format=duration", "-of", "csv=p=0", audioPath)
+	output, err := cmd.Output()
+	if err != nil {
+		return 0, fmt.Errorf("ffprobe failed: %v", err)
+	}
+
+	seconds, err := strconv.ParseFloat(strings.TrimSpace(string(output)), 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse ffprobe duration '%s': %v", strings.TrimSpace(string(output)), err)
+	}
+	return seconds, nil
+}
+
+// SyncExternalAudio connects separately recorded (dual-system) audio under the asset-clip at
+// timeline position videoClipIndex. audioOffsetSeconds is where the audio starts relative to the start of the clip: positive when the
+// recorder was started after the camera, negative when it was rolling first.
+//
+// 🚨 CLAUDE.md Rules Applied Here:
+// - Audio asset created through the Transaction with its probed duration (no video properties)
+// - Connected asset-clip on a negative lane below any audio already attached to the clip
+// - Offset is in the parent's local time; a negative offset trims the head of the audio via Start
+// - The connected clip is trimmed to the video's span, frame-aligned → ConvertSecondsToFCPDuration()
+func SyncExternalAudio(fcpxml *FCPXML, videoClipIndex int, audioPath string, audioOffsetSeconds float64) error {
+	sequence, err := firstSequence(fcpxml)
+	if err != nil {
+		return err
+	}
+
+	target, err := spineAssetClipAt(&sequence.Spine, videoClipIndex)
+	if err != nil {
+		return err
+	}
+
+	var targetAsset *Asset
+	for i := range fcpxml.Resources.Assets {
+		if fcpxml.Resources.Assets[i].ID == target.Ref {
+			targetAsset = &fcpxml.Resources.Assets[i]
+			break
+		}
+	}
+	if targetAsset == nil || targetAsset.HasVideo != "1" {
+		return fmt.Errorf("clip %d (%s) is not a video clip", videoClipIndex, target.Name)
+	}
+
+	if !isAudioFile(audioPath) {
+		return fmt.Errorf("file is not a supported audio format (WAV, MP3, M4A, AAC, FLAC): %s", audioPath)
+	}
+	absPath, err := filepath.Abs(audioPath)
+	if err != nil {
+		return fmt.Errorf("failed to get absolute path: %v", err)
+	}
+	if _, err := os.Stat(absPath); os.IsNotExist(err) {
+		return fmt.Errorf("audio file does not exist: %s", absPath)
+	}
+
+	audioSeconds, err := probeAudioDuration(absPath)
+	if err != nil {
+		return fmt.Errorf("failed to probe audio duration: %v", err)
+	}
+	audioFrames := parseFCPDuration(ConvertSecondsToFCPDuration(audioSeconds))
+	offsetFrames := parseFCPDuration(ConvertSecondsToFCPDuration(math.Abs(audioOffsetSeconds)))
+	clipFrames := parseFCPDuration(target.Duration)
+
+	// Work out where the audio lands inside the clip and which part of the recording is used
+	connectedOffset := parseFCPDuration(target.Start)
+	audioStart := 0
+	visibleFrames := clipFrames
+	if audioOffsetSeconds >= 0 {
+		if offsetFrames >= clipFrames {
+			return fmt.Errorf("audio offset %.3fs is past the end of clip %d (%s)", audioOffsetSeconds, videoClipIndex, target.Duration)
+		}
+		connectedOffset += offsetFrames
+		visibleFrames -= offsetFrames
+	} else {
+		if offsetFrames >= audioFrames {
+			return fmt.Errorf("audio ends before clip %d starts (offset %.3fs, audio %.3fs)", videoClipIndex, audioOffsetSeconds, audioSeconds)
+		}
+		audioStart = offsetFrames
+	}
+	connectedFrames := min(audioFrames-audioStart, visibleFrames)
+
+	registry := NewResourceRegistry(fcpxml)
+	tx := NewTransaction(registry)
+	defer tx.Rollback()
+
+	assetID := tx.ReserveIDs(1)[0]
+	audioName := strings.TrimSuffix(filepath.Base(absPath), filepath.Ext(absPath))
+	asset, err := tx.CreateAsset(assetID, absPath, audioName, formatFrameAlignedTime(audioFrames), "")
+	if err != nil {
+		return fmt.Errorf("failed to create audio asset: %v", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	// Stack below whatever is already connected under the clip
+	lane := -1
+	for _, nested := range target.NestedAssetClips {
+		if n, err := strconv.Atoi(nested.Lane); err == nil && n <= lane {
+			lane = n - 1
+		}
+	}
+
+	connected := AssetClip{
+		Ref:       asset.ID,
+		Lane:      strconv.Itoa(lane),
+		Offset:    formatFrameAlignedTime(connectedOffset),
+		Name:      audioName,
+		Duration:  formatFrameAlignedTime(connectedFrames),
+		TCFormat:  "NDF",
+		AudioRole: "dialogue",
+	}
+	if audioStart > 0 {
+		connected.Start = formatFrameAlignedTime(audioStart)
+	}
+	target.NestedAssetClips = append(target.NestedAssetClips, connected)
+
+	return nil
+}
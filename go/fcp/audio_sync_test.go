@@ -0,0 +1,85 @@
+package fcp
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestSyncExternalAudio validates connected audio placement for positive and negative sync offsets
+func TestSyncExternalAudio(t *testing.T) {
+	originalProbe := probeAudioDuration
+	probeAudioDuration = func(string) (float64, error) { return 20, nil }
+	defer func() { probeAudioDuration = originalProbe }()
+
+	dir := t.TempDir()
+	videoPath := filepath.Join(dir, "camera.mp4")
+	audioPath := filepath.Join(dir, "recorder.wav")
+	for _, path := range []string{videoPath, audioPath} {
+		if err := os.WriteFile(path, []byte("fake media"), 0644); err != nil {
+			t.Fatalf("Failed to create %s: %v", path, err)
+		}
+	}
+
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("GenerateEmpty failed: %v", err)
+	}
+	if err := AddVideo(fcpxml, videoPath); err != nil {
+		t.Fatalf("AddVideo failed: %v", err)
+	}
+	clip := &fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine.AssetClips[0]
+
+	if err := SyncExternalAudio(fcpxml, 0, audioPath, 1.5); err != nil {
+		t.Fatalf("SyncExternalAudio failed: %v", err)
+	}
+	if len(clip.NestedAssetClips) != 1 {
+		t.Fatalf("Expected one connected audio clip, got %d", len(clip.NestedAssetClips))
+	}
+	late := clip.NestedAssetClips[0]
+	if late.Lane != "-1" {
+		t.Errorf("Expected lane -1, got %s", late.Lane)
+	}
+	wantOffset := addDurations(clip.Start, "36036/24000s") // 1.5s into the clip's own time
+	if parseFCPDuration(late.Offset) != parseFCPDuration(wantOffset) {
+		t.Errorf("Expected offset %s, got %s", wantOffset, late.Offset)
+	}
+	if late.Start != "" {
+		t.Errorf("Expected audio to play from its beginning, got start %s", late.Start)
+	}
+	// Video is 10s, so only 8.5s of the 20s recording sits under the picture
+	if late.Duration != ConvertSecondsToFCPDuration(8.5) {
+		t.Errorf("Expected duration trimmed to the clip, got %s", late.Duration)
+	}
+
+	var audioAsset *Asset
+	for i := range fcpxml.Resources.Assets {
+		if fcpxml.Resources.Assets[i].ID == late.Ref {
+			audioAsset = &fcpxml.Resources.Assets[i]
+		}
+	}
+	if audioAsset == nil || audioAsset.HasAudio != "1" || audioAsset.HasVideo != "" || audioAsset.Duration != ConvertSecondsToFCPDuration(20) {
+		t.Errorf("Expected an audio-only asset with the probed duration, got %+v", audioAsset)
+	}
+
+	if err := SyncExternalAudio(fcpxml, 0, audioPath, -2); err != nil {
+		t.Fatalf("SyncExternalAudio with negative offset failed: %v", err)
+	}
+	early := clip.NestedAssetClips[1]
+	if early.Lane != "-2" {
+		t.Errorf("Expected second sync to stack on lane -2, got %s", early.Lane)
+	}
+	if parseFCPDuration(early.Offset) != parseFCPDuration(clip.Start) {
+		t.Errorf("Expected early audio to start with the clip, got offset %s", early.Offset)
+	}
+	if early.Start != "48048/24000s" {
+		t.Errorf("Expected the first 2s of audio to be skipped, got start %s", early.Start)
+	}
+
+	if err := SyncExternalAudio(fcpxml, 0, audioPath, 12); err == nil {
+		t.Errorf("Expected error for offset past the end of the clip")
+	}
+	if err := SyncExternalAudio(fcpxml, 3, audioPath, 0); err == nil {
+		t.Errorf("Expected error for out of range clip index")
+	}
+}
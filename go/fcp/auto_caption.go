@@ -0,0 +1,96 @@
+package fcp
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// DefaultTranscriberCommand is the whisper-style speech-to-text CLI CommandTranscriber runs by default
+const DefaultTranscriberCommand = "whisper"
+
+// Placeholders in CommandTranscriber.Args, replaced with the media path and a scratch directory
+const (
+	transcriberInputPlaceholder     = "{input}"
+	transcriberOutputDirPlaceholder = "{output_dir}"
+)
+
+// defaultTranscriberArgs ask OpenAI's whisper CLI for a SubRip file named after the input
+var defaultTranscriberArgs = []string{transcriberInputPlaceholder, "--output_format", "srt", "--output_dir", transcriberOutputDirPlaceholder}
+
+// Transcriber turns the speech in an audio or video file into captions timed from the file's start
+type Transcriber interface {
+	Transcribe(path string) ([]TimedCaption, error)
+}
+
+// CommandTranscriber transcribes by running an external CLI that writes a <name>.srt file for the
+// input into an output directory, as `whisper clip.mov --output_format srt --output_dir dir` does
+type CommandTranscriber struct {
+	Command string   // Executable name or path; empty uses DefaultTranscriberCommand
+	Args    []string // Arguments with {input} and {output_dir} placeholders; empty uses whisper's
+}
+
+// Transcribe runs the command on path and reads the SRT it wrote
+func (ct CommandTranscriber) Transcribe(path string) ([]TimedCaption, error) {
+	command := ct.Command
+	if command == "" {
+		command = DefaultTranscriberCommand
+	}
+	if _, err := exec.LookPath(command); err != nil {
+		return nil, fmt.Errorf("transcriber '%s' not found: install it or configure another command", command)
+	}
+
+	outputDir, err := os.MkdirTemp("", "cutlass-transcribe")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create transcription directory: %v", err)
+	}
+	defer os.RemoveAll(outputDir)
+
+	templateArgs := ct.Args
+	if len(templateArgs) == 0 {
+		templateArgs = defaultTranscriberArgs
+	}
+	args := make([]string, len(templateArgs))
+	for i, arg := range templateArgs {
+		arg = strings.ReplaceAll(arg, transcriberInputPlaceholder, path)
+		args[i] = strings.ReplaceAll(arg, transcriberOutputDirPlaceholder, outputDir)
+	}
+
+	if output, err := exec.Command(command, args...).CombinedOutput(); err != nil {
+		return nil, fmt.Errorf("transcriber '%s' failed: %v\n%s", command, err, strings.TrimSpace(string(output)))
+	}
+
+	srtPath := filepath.Join(outputDir, strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))+".srt")
+	return ReadSRTCaptions(srtPath)
+}
+
+// AutoCaption transcribes the speech in a video file and adds the video to the timeline with the
+// transcript nested in it as caption titles, as AddVideoWithCaptions does for an .srt file.
+// Audio-only files are rejected: captions are nested in the video clip, and audio has none.
+//
+// 🚨 CLAUDE.md Rules Applied Here:
+// - Captions go through AddVideoWithCaptions, so they are nested titles on lane 1 in clip time
+// - A nil transcriber is an error rather than a silently uncaptioned clip
+func AutoCaption(fcpxml *FCPXML, audioOrVideoPath string, transcriber Transcriber) error {
+	if transcriber == nil {
+		return fmt.Errorf("no transcriber configured for auto-captions")
+	}
+	if isAudioFile(audioOrVideoPath) {
+		return fmt.Errorf("auto-captions need a video clip to nest in; %s is audio-only", audioOrVideoPath)
+	}
+	if _, err := os.Stat(audioOrVideoPath); err != nil {
+		return fmt.Errorf("media file does not exist: %s", audioOrVideoPath)
+	}
+
+	captions, err := transcriber.Transcribe(audioOrVideoPath)
+	if err != nil {
+		return fmt.Errorf("failed to transcribe %s: %v", audioOrVideoPath, err)
+	}
+	if len(captions) == 0 {
+		return fmt.Errorf("no speech found in %s", audioOrVideoPath)
+	}
+
+	return AddVideoWithCaptions(fcpxml, audioOrVideoPath, captions)
+}
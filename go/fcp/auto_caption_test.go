@@ -0,0 +1,73 @@
+package fcp
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// stubTranscriber returns fixed cues and records the path it was asked to transcribe
+type stubTranscriber struct {
+	captions []TimedCaption
+	path     string
+}
+
+func (s *stubTranscriber) Transcribe(path string) ([]TimedCaption, error) {
+	s.path = path
+	return s.captions, nil
+}
+
+// TestAutoCaption tests that transcribed cues become nested titles at the cue times
+func TestAutoCaption(t *testing.T) {
+	tempDir := t.TempDir()
+	videoPath := filepath.Join(tempDir, "talk.mp4")
+	if err := os.WriteFile(videoPath, []byte("fake video data"), 0644); err != nil {
+		t.Fatalf("Failed to create test video: %v", err)
+	}
+
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("GenerateEmpty failed: %v", err)
+	}
+
+	if err := AutoCaption(fcpxml, videoPath, nil); err == nil || !strings.Contains(err.Error(), "no transcriber configured") {
+		t.Errorf("Expected missing transcriber error, got %v", err)
+	}
+
+	stub := &stubTranscriber{captions: []TimedCaption{
+		{Text: "First words", StartSeconds: 0.5, DurationSeconds: 2},
+		{Text: "Second thought", StartSeconds: 3, DurationSeconds: 1.5},
+	}}
+	if err := AutoCaption(fcpxml, videoPath, stub); err != nil {
+		t.Fatalf("AutoCaption failed: %v", err)
+	}
+	if stub.path != videoPath {
+		t.Errorf("Expected transcriber to receive %s, got %s", videoPath, stub.path)
+	}
+
+	clips := fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine.AssetClips
+	if len(clips) != 1 {
+		t.Fatalf("Expected 1 asset-clip, got %d", len(clips))
+	}
+	titles := clips[0].Titles
+	if len(titles) != 2 {
+		t.Fatalf("Expected 2 nested caption titles, got %d", len(titles))
+	}
+	clipStart := parseFCPDuration(clips[0].Start)
+	for i, caption := range stub.captions {
+		expectedOffset := clipStart + parseFCPDuration(ConvertSecondsToFCPDuration(caption.StartSeconds))
+		if parseFCPDuration(titles[i].Offset) != expectedOffset {
+			t.Errorf("Caption %d: expected offset %d, got %s", i, expectedOffset, titles[i].Offset)
+		}
+		if titles[i].Text.TextStyles[0].Text != caption.Text {
+			t.Errorf("Caption %d: expected text %q, got %q", i, caption.Text, titles[i].Text.TextStyles[0].Text)
+		}
+	}
+
+	// A transcriber CLI that is not installed fails with a clear message
+	missing := CommandTranscriber{Command: "cutlass-no-such-transcriber"}
+	if _, err := missing.Transcribe(videoPath); err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Errorf("Expected missing command error, got %v", err)
+	}
+}
@@ -0,0 +1,36 @@
+package fcp
+
+import (
+	"fmt"
+	"os"
+)
+
+// RegenerateBookmarks replaces the macOS security bookmark of every asset whose media file
+// exists, so FCP can still find the media after the library or its files have moved.
+//
+// 🚨 CLAUDE.md Rules Applied Here:
+// - Bookmarks come from generateBookmark(), the same Swift helper new assets use
+// - Assets whose file is missing are skipped; CheckMediaOnline reports those
+// - Without Swift (non-macOS) no bookmark can be made, so existing bookmarks are kept as-is
+func RegenerateBookmarks(fcpxml *FCPXML) error {
+	for i := range fcpxml.Resources.Assets {
+		asset := &fcpxml.Resources.Assets[i]
+		path := mediaSourcePath(asset.MediaRep.Src)
+		if path == "" {
+			continue
+		}
+		path = resolveMediaPath(fcpxml, path)
+		if _, err := os.Stat(path); err != nil {
+			continue
+		}
+
+		bookmark, err := generateBookmark(path)
+		if err != nil {
+			return fmt.Errorf("failed to generate bookmark for %s: %v", path, err)
+		}
+		if bookmark != "" {
+			asset.MediaRep.Bookmark = bookmark
+		}
+	}
+	return nil
+}
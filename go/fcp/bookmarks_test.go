@@ -0,0 +1,40 @@
+package fcp
+
+import (
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+// TestRegenerateBookmarks tests that an asset with a real file gets a bookmark when Swift is
+// available, and is left alone without it, while an asset with a missing file is skipped
+func TestRegenerateBookmarks(t *testing.T) {
+	dir := t.TempDir()
+	imagePath := filepath.Join(dir, "still.png")
+	writeTestPNG(t, imagePath, 64, 64)
+
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("GenerateEmpty failed: %v", err)
+	}
+	fcpxml.Resources.Assets = []Asset{
+		{ID: "r2", Name: "still", MediaRep: MediaRep{Kind: "original-media", Src: "file://" + imagePath, Bookmark: "stale"}},
+		{ID: "r3", Name: "gone", MediaRep: MediaRep{Kind: "original-media", Src: "file://" + filepath.Join(dir, "gone.png"), Bookmark: "stale"}},
+	}
+
+	if err := RegenerateBookmarks(fcpxml); err != nil {
+		t.Fatalf("RegenerateBookmarks failed: %v", err)
+	}
+
+	existing := fcpxml.Resources.Assets[0].MediaRep.Bookmark
+	if _, err := exec.LookPath("swift"); err == nil {
+		if existing == "" || existing == "stale" {
+			t.Errorf("Expected a fresh bookmark for the existing file, got %q", existing)
+		}
+	} else if existing != "stale" {
+		t.Errorf("Without Swift the existing bookmark should be kept, got %q", existing)
+	}
+	if missing := fcpxml.Resources.Assets[1].MediaRep.Bookmark; missing != "stale" {
+		t.Errorf("Asset with a missing file should be skipped, got bookmark %q", missing)
+	}
+}
@@ -0,0 +1,54 @@
+package fcp
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// BundleExtension marks an FCPXML bundle: a directory FCP imports like a single .fcpxml file
+const BundleExtension = ".fcpxmld"
+
+// bundleInfoFile is the document inside a bundle; FCP reads the timeline from it
+const bundleInfoFile = "Info.fcpxml"
+
+// IsBundlePath reports whether path names an .fcpxmld bundle rather than a plain .fcpxml file
+func IsBundlePath(path string) bool {
+	return strings.EqualFold(filepath.Ext(strings.TrimRight(path, `/\`)), BundleExtension)
+}
+
+// bundleInfoPath is where a bundle keeps its FCPXML document
+func bundleInfoPath(bundlePath string) string {
+	return filepath.Join(strings.TrimRight(bundlePath, `/\`), bundleInfoFile)
+}
+
+// WriteBundle writes fcpxml as an .fcpxmld bundle: the bundlePath directory (created if needed)
+// with the document as Info.fcpxml inside, the layout FCP 10.6+ exports and imports.
+//
+// 🚨 CLAUDE.md Rules Applied Here:
+// - The document goes through WriteTo, so it is validated exactly like a plain .fcpxml
+// - Marshaling happens before anything touches the disk, so a validation failure leaves no bundle
+func WriteBundle(fcpxml *FCPXML, bundlePath string) error {
+	if !IsBundlePath(bundlePath) {
+		return fmt.Errorf("bundle path must end in %s: %s", BundleExtension, bundlePath)
+	}
+
+	var buf bytes.Buffer
+	if err := WriteTo(fcpxml, &buf); err != nil {
+		return err
+	}
+
+	if info, err := os.Stat(bundlePath); err == nil && !info.IsDir() {
+		return fmt.Errorf("%s exists and is not a bundle directory", bundlePath)
+	}
+	if err := os.MkdirAll(bundlePath, 0755); err != nil {
+		return fmt.Errorf("failed to create bundle directory: %v", err)
+	}
+	if err := os.WriteFile(bundleInfoPath(bundlePath), buf.Bytes(), 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %v", bundleInfoFile, err)
+	}
+
+	return nil
+}
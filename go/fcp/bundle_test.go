@@ -0,0 +1,72 @@
+package fcp
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestWriteBundle tests that writing to out.fcpxmld creates a directory with a valid Info.fcpxml
+func TestWriteBundle(t *testing.T) {
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("GenerateEmpty failed: %v", err)
+	}
+	if err := AddKineticText(fcpxml, []string{"Bundle"}, 2.0); err != nil {
+		t.Fatalf("AddKineticText failed: %v", err)
+	}
+
+	bundlePath := filepath.Join(t.TempDir(), "out.fcpxmld")
+	if err := WriteToFile(fcpxml, bundlePath); err != nil {
+		t.Fatalf("WriteToFile failed: %v", err)
+	}
+
+	info, err := os.Stat(bundlePath)
+	if err != nil || !info.IsDir() {
+		t.Fatalf("Expected %s to be a directory: %v", bundlePath, err)
+	}
+	data, err := os.ReadFile(filepath.Join(bundlePath, "Info.fcpxml"))
+	if err != nil {
+		t.Fatalf("Expected Info.fcpxml inside the bundle: %v", err)
+	}
+	if !strings.HasPrefix(string(data), "<?xml") || !strings.Contains(string(data), "<fcpxml") {
+		t.Errorf("Info.fcpxml is not an FCPXML document")
+	}
+
+	// The bundle reads back like a plain file
+	roundTrip, err := ReadFromFile(bundlePath)
+	if err != nil {
+		t.Fatalf("ReadFromFile on the bundle failed: %v", err)
+	}
+	if titles := roundTrip.Library.Events[0].Projects[0].Sequences[0].Spine.Titles; len(titles) != 1 {
+		t.Errorf("Expected the title to survive the round trip, got %d titles", len(titles))
+	}
+
+	// Rewriting an existing bundle goes through the overwrite guard
+	if err := WriteToFileSafe(fcpxml, bundlePath, WriteOptions{}); err == nil {
+		t.Errorf("Expected the overwrite guard to refuse an existing bundle")
+	}
+	if err := WriteToFileSafe(fcpxml, bundlePath, WriteOptions{Force: true}); err != nil {
+		t.Errorf("Forced bundle overwrite failed: %v", err)
+	}
+}
+
+// TestWriteBundleRejectsFile tests that a plain file in the way of the bundle is not replaced
+func TestWriteBundleRejectsFile(t *testing.T) {
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("GenerateEmpty failed: %v", err)
+	}
+
+	bundlePath := filepath.Join(t.TempDir(), "taken.fcpxmld")
+	if err := os.WriteFile(bundlePath, []byte("not a bundle"), 0644); err != nil {
+		t.Fatalf("Failed to create file: %v", err)
+	}
+	if err := WriteBundle(fcpxml, bundlePath); err == nil {
+		t.Errorf("Expected an error when a file occupies the bundle path")
+	}
+	if err := WriteBundle(fcpxml, filepath.Join(t.TempDir(), "plain.fcpxml")); err == nil {
+		t.Errorf("Expected an error for a path without the .fcpxmld extension")
+	}
+}
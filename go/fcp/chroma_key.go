@@ -0,0 +1,89 @@
+package fcp
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// keyerEffectUID is FCP's built-in Keyer (see test_hsl_color_grading.fcpxml)
+const keyerEffectUID = "FFKeyer"
+
+// keyerKeyColorKey is the Keyer's sample color param
+const keyerKeyColorKey = "9999/999166631/999166646/2"
+
+// AddChromaKey keys keyColor out of the spine asset-clip at clipIndex so its background turns
+// transparent and whatever sits below it (e.g. a background plate on a negative lane) shows through.
+// clipIndex counts spine elements in timeline order, gaps included (see spineElementAt).
+//
+// 🚨 CLAUDE.md Rules Applied Here:
+// - Keyer effect created once through the Transaction and reused; its UID is checked against fictionalEffectUIDs
+// - The keyer is appended to the clip's FilterVideos, after any filters already on it
+// - Keying the same clip again updates its keyer's color instead of stacking keyers
+func AddChromaKey(fcpxml *FCPXML, clipIndex int, keyColor [4]float64) error {
+	for _, component := range keyColor {
+		if component < 0 || component > 1 {
+			return fmt.Errorf("key color components must be between 0.0 and 1.0, got %v", keyColor)
+		}
+	}
+	if fictionalEffectUIDs[keyerEffectUID] {
+		return fmt.Errorf("keyer UID '%s' is not a built-in FCP effect", keyerEffectUID)
+	}
+
+	sequence, err := firstSequence(fcpxml)
+	if err != nil {
+		return err
+	}
+	clip, err := spineAssetClipAt(&sequence.Spine, clipIndex)
+	if err != nil {
+		return err
+	}
+
+	parts := make([]string, len(keyColor))
+	for i, component := range keyColor {
+		parts[i] = strconv.FormatFloat(component, 'f', -1, 64)
+	}
+	colorValue := strings.Join(parts, " ")
+
+	keyerID := findEffectIDByUID(fcpxml, keyerEffectUID)
+	if keyerID == "" {
+		registry := NewResourceRegistry(fcpxml)
+		tx := NewTransaction(registry)
+		defer tx.Rollback()
+
+		keyerID = tx.ReserveIDs(1)[0]
+		if _, err := tx.CreateEffect(keyerID, "Keyer", keyerEffectUID); err != nil {
+			return fmt.Errorf("failed to create keyer effect: %v", err)
+		}
+		if err := tx.Commit(); err != nil {
+			return fmt.Errorf("failed to commit transaction: %v", err)
+		}
+	}
+
+	for i := range clip.FilterVideos {
+		if clip.FilterVideos[i].Ref == keyerID {
+			keyColorParam(&clip.FilterVideos[i]).Value = colorValue
+			return nil
+		}
+	}
+
+	clip.FilterVideos = append(clip.FilterVideos, FilterVideo{
+		Ref:  keyerID,
+		Name: "Keyer",
+		Params: []Param{
+			{Name: "Key Color", Key: keyerKeyColorKey, Value: colorValue},
+		},
+	})
+	return nil
+}
+
+// keyColorParam returns the keyer's Key Color param, adding it when missing
+func keyColorParam(filter *FilterVideo) *Param {
+	for i := range filter.Params {
+		if filter.Params[i].Key == keyerKeyColorKey {
+			return &filter.Params[i]
+		}
+	}
+	filter.Params = append(filter.Params, Param{Name: "Key Color", Key: keyerKeyColorKey})
+	return &filter.Params[len(filter.Params)-1]
+}
@@ -0,0 +1,55 @@
+package fcp
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestAddChromaKey validates that a keyer with the given color is appended to the clip's filters
+func TestAddChromaKey(t *testing.T) {
+	videoPath := filepath.Join(t.TempDir(), "talking_head.mp4")
+	if err := os.WriteFile(videoPath, []byte("fake video"), 0644); err != nil {
+		t.Fatalf("Failed to create test video: %v", err)
+	}
+
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("GenerateEmpty failed: %v", err)
+	}
+	if err := AddVideo(fcpxml, videoPath); err != nil {
+		t.Fatalf("AddVideo failed: %v", err)
+	}
+	clip := &fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine.AssetClips[0]
+	clip.FilterVideos = []FilterVideo{{Ref: "r99", Name: "Existing"}}
+
+	if err := AddChromaKey(fcpxml, 0, [4]float64{0, 1, 0, 1}); err != nil {
+		t.Fatalf("AddChromaKey failed: %v", err)
+	}
+
+	if len(clip.FilterVideos) != 2 {
+		t.Fatalf("Expected keyer appended after the existing filter, got %d filters", len(clip.FilterVideos))
+	}
+	keyer := clip.FilterVideos[1]
+	if keyer.Name != "Keyer" || len(keyer.Params) != 1 || keyer.Params[0].Value != "0 1 0 1" {
+		t.Errorf("Unexpected keyer filter: %+v", keyer)
+	}
+	if findEffectIDByUID(fcpxml, keyerEffectUID) != keyer.Ref {
+		t.Errorf("Keyer filter should reference the %s effect", keyerEffectUID)
+	}
+
+	// Re-keying updates the color instead of stacking another keyer
+	if err := AddChromaKey(fcpxml, 0, [4]float64{0, 0, 1, 1}); err != nil {
+		t.Fatalf("Second AddChromaKey failed: %v", err)
+	}
+	if len(clip.FilterVideos) != 2 || clip.FilterVideos[1].Params[0].Value != "0 0 1 1" {
+		t.Errorf("Expected the keyer color to be updated in place, got %+v", clip.FilterVideos)
+	}
+
+	if err := AddChromaKey(fcpxml, 0, [4]float64{0, 2, 0, 1}); err == nil {
+		t.Errorf("Expected an error for an out-of-range key color")
+	}
+	if err := AddChromaKey(fcpxml, 3, [4]float64{0, 1, 0, 1}); err == nil {
+		t.Errorf("Expected an error for a missing clip")
+	}
+}
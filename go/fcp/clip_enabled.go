@@ -0,0 +1,36 @@
+package fcp
+
+import "fmt"
+
+// clipEnabledDisabled is the enabled attribute value FCP reads as "clip turned off"
+const clipEnabledDisabled = "0"
+
+// SetClipEnabled turns the spine element at clipIndex on or off, like pressing V in FCP.
+// A disabled clip keeps its place and duration, so everything after it stays put, but it
+// neither renders nor plays audio. clipIndex counts spine elements in timeline order, gaps
+// included (see spineElementAt); a gap can't be disabled and is rejected.
+//
+// 🚨 CLAUDE.md Rules Applied Here:
+// - Disabled elements get enabled="0"; enabling removes the attribute instead of writing "1"
+// - Offsets and durations are left untouched, so the timeline stays frame-aligned
+func SetClipEnabled(fcpxml *FCPXML, clipIndex int, enabled bool) error {
+	sequence, err := firstSequence(fcpxml)
+	if err != nil {
+		return err
+	}
+
+	element, err := spineElementAt(&sequence.Spine, clipIndex)
+	if err != nil {
+		return err
+	}
+	if element.enabled == nil {
+		return fmt.Errorf("clip index %d is a %s, which can't be disabled", clipIndex, element.kind)
+	}
+
+	if enabled {
+		*element.enabled = ""
+	} else {
+		*element.enabled = clipEnabledDisabled
+	}
+	return nil
+}
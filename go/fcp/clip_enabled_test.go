@@ -0,0 +1,62 @@
+package fcp
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+// TestSetClipEnabled tests that disabling clip 2 emits enabled="0" on exactly that element
+func TestSetClipEnabled(t *testing.T) {
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("GenerateEmpty failed: %v", err)
+	}
+	if err := AddKineticText(fcpxml, []string{"One", "Two", "Three", "Four"}, 1.0); err != nil {
+		t.Fatalf("AddKineticText failed: %v", err)
+	}
+
+	if err := SetClipEnabled(fcpxml, 2, false); err != nil {
+		t.Fatalf("SetClipEnabled failed: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := WriteTo(fcpxml, &buf); err != nil {
+		t.Fatalf("WriteTo failed: %v", err)
+	}
+	xml := buf.String()
+	if count := strings.Count(xml, `enabled="0"`); count != 1 {
+		t.Fatalf("Expected exactly one enabled=\"0\", got %d", count)
+	}
+
+	// The disabled element is the third in timeline order, and it kept its place
+	titles := fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine.Titles
+	for i, title := range titles {
+		want := ""
+		if i == 2 {
+			want = "0"
+		}
+		if title.Enabled != want {
+			t.Errorf("Title %d (%s): expected enabled %q, got %q", i, title.Name, want, title.Enabled)
+		}
+	}
+	if titles[2].Offset != "48048/24000s" {
+		t.Errorf("Expected the disabled title to keep its offset, got %s", titles[2].Offset)
+	}
+
+	// Re-enabling drops the attribute rather than writing enabled="1"
+	if err := SetClipEnabled(fcpxml, 2, true); err != nil {
+		t.Fatalf("SetClipEnabled failed: %v", err)
+	}
+	buf.Reset()
+	if err := WriteTo(fcpxml, &buf); err != nil {
+		t.Fatalf("WriteTo failed: %v", err)
+	}
+	if strings.Contains(buf.String(), "enabled=") {
+		t.Errorf("Expected no enabled attribute after re-enabling")
+	}
+
+	if err := SetClipEnabled(fcpxml, 4, false); err == nil {
+		t.Errorf("Expected an error for an out-of-range clip index")
+	}
+}
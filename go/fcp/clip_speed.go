@@ -0,0 +1,112 @@
+package fcp
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+)
+
+// FCP's constant-speed retime range, in percent
+const (
+	MinClipSpeedPercent = 2.0
+	MaxClipSpeedPercent = 2000.0
+)
+
+// SetClipSpeed plays the spine asset-clip at clipIndex at a constant speedPercent (200 is double
+// speed, 50 half speed) over the same source media. The clip's duration becomes
+// sourceDuration / (speed/100), frame-aligned, and later spine elements ripple to follow it.
+// 100% removes the retime.
+// clipIndex counts spine elements in timeline order, gaps included (see spineElementAt).
+//
+// 🚨 CLAUDE.md Rules Applied Here:
+// - The retime is a two-point linear timeMap over the whole asset; start/duration are in retimed local time
+// - A clip that is already retimed keeps its source range; the new speed replaces the old one
+// - A transition on the clip's cuts blocks the retime (its handles would change); later ones ripple
+// - Sequence duration is recomputed with calculateTimelineDuration()
+func SetClipSpeed(fcpxml *FCPXML, clipIndex int, speedPercent float64) error {
+	if speedPercent < MinClipSpeedPercent || speedPercent > MaxClipSpeedPercent {
+		return fmt.Errorf("speed %g%% is outside FCP's range of %g%% to %g%%", speedPercent, MinClipSpeedPercent, MaxClipSpeedPercent)
+	}
+	sequence, err := firstSequence(fcpxml)
+	if err != nil {
+		return err
+	}
+	clip, err := spineAssetClipAt(&sequence.Spine, clipIndex)
+	if err != nil {
+		return err
+	}
+
+	for _, transition := range sequence.Spine.Transitions {
+		start := timeUnits(transition.Offset)
+		if start <= timeUnits(clip.Offset)+timeUnits(clip.Duration) && start+timeUnits(transition.Duration) >= timeUnits(clip.Offset) {
+			return fmt.Errorf("clip '%s' has a transition on its cut; remove it before changing the speed", clip.Name)
+		}
+	}
+
+	var assetDuration int
+	for _, asset := range fcpxml.Resources.Assets {
+		if asset.ID == clip.Ref {
+			assetDuration = timeUnits(asset.Duration)
+			break
+		}
+	}
+	if assetDuration <= 0 {
+		return fmt.Errorf("clip '%s' has no asset duration to retime", clip.Name)
+	}
+
+	// Work out the source range from the current speed, which is 1 without a time map
+	currentFactor := clipSpeedFactor(clip)
+	sourceStart := float64(timeUnits(clip.Start)) * currentFactor
+	sourceDuration := float64(timeUnits(clip.Duration)) * currentFactor
+
+	factor := speedPercent / 100
+	oldEnd := timeUnits(clip.Offset) + timeUnits(clip.Duration)
+	newDuration := int(math.Round(sourceDuration/factor/1001)) * 1001
+	if newDuration <= 0 {
+		return fmt.Errorf("clip '%s' would be shorter than a frame at %g%%", clip.Name, speedPercent)
+	}
+
+	if speedPercent == 100 {
+		clip.TimeMap = nil
+	} else {
+		clip.TimeMap = &TimeMap{
+			Timepts: []Timept{
+				{Time: "0s", Value: "0s", Interp: "linear"},
+				{Time: formatFrameAlignedTime(int(math.Round(float64(assetDuration) / factor))), Value: formatFrameAlignedTime(assetDuration), Interp: "linear"},
+			},
+		}
+	}
+	if clip.Start != "" || sourceStart != 0 {
+		clip.Start = formatFrameAlignedTime(int(math.Round(sourceStart / factor)))
+	}
+	clip.Duration = formatFrameAlignedTime(newDuration)
+
+	// Ripple everything after the clip by the change in its length
+	delta := newDuration - (oldEnd - timeUnits(clip.Offset))
+	shiftTransitionsFrom(&sequence.Spine, big.NewRat(int64(oldEnd), 24000), big.NewRat(int64(delta), 24000), DefaultFrameRate)
+	for _, element := range spineElementsInOrder(&sequence.Spine) {
+		if element.offset == &clip.Offset {
+			continue
+		}
+		if offset := timeUnits(*element.offset); offset >= oldEnd {
+			*element.offset = formatFrameAlignedTime(offset + delta)
+		}
+	}
+
+	sequence.Duration = calculateTimelineDuration(sequence)
+	return nil
+}
+
+// clipSpeedFactor returns how many source seconds a clip plays per timeline second, read from
+// the last point of its time map (1 without one)
+func clipSpeedFactor(clip *AssetClip) float64 {
+	if clip.TimeMap == nil || len(clip.TimeMap.Timepts) == 0 {
+		return 1
+	}
+	last := clip.TimeMap.Timepts[len(clip.TimeMap.Timepts)-1]
+	clipTime, sourceTime := timeUnits(last.Time), timeUnits(last.Value)
+	if clipTime <= 0 || sourceTime <= 0 {
+		return 1
+	}
+	return float64(sourceTime) / float64(clipTime)
+}
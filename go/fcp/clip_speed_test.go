@@ -0,0 +1,74 @@
+package fcp
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestSetClipSpeed validates that 200% halves a 10s clip, writes a matching time map and
+// ripples the following clip
+func TestSetClipSpeed(t *testing.T) {
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("GenerateEmpty failed: %v", err)
+	}
+	for _, name := range []string{"first.mp4", "second.mp4"} {
+		videoPath := filepath.Join(t.TempDir(), name)
+		if err := os.WriteFile(videoPath, []byte("fake video"), 0644); err != nil {
+			t.Fatalf("Failed to create test video: %v", err)
+		}
+		if err := AddVideo(fcpxml, videoPath); err != nil {
+			t.Fatalf("AddVideo failed: %v", err)
+		}
+	}
+	sequence := &fcpxml.Library.Events[0].Projects[0].Sequences[0]
+	clips := sequence.Spine.AssetClips
+	if clips[0].Duration != ConvertSecondsToFCPDuration(10) {
+		t.Fatalf("Expected a 10s first clip, got %s", clips[0].Duration)
+	}
+	assetDuration := fcpxml.Resources.Assets[0].Duration
+
+	if err := SetClipSpeed(fcpxml, 0, 200); err != nil {
+		t.Fatalf("SetClipSpeed failed: %v", err)
+	}
+
+	clip := sequence.Spine.AssetClips[0]
+	if clip.Duration != ConvertSecondsToFCPDuration(5) {
+		t.Errorf("Expected 5s at 200%%, got %s", clip.Duration)
+	}
+	if clip.TimeMap == nil || len(clip.TimeMap.Timepts) != 2 {
+		t.Fatalf("Expected a two-point time map, got %+v", clip.TimeMap)
+	}
+	first, last := clip.TimeMap.Timepts[0], clip.TimeMap.Timepts[1]
+	if first.Time != "0s" || first.Value != "0s" {
+		t.Errorf("Expected time map to start at 0s -> 0s, got %s -> %s", first.Time, first.Value)
+	}
+	if timeUnits(last.Value) != timeUnits(assetDuration) || 2*timeUnits(last.Time) != timeUnits(last.Value) {
+		t.Errorf("Expected the asset's %s to play in half the time, got %s -> %s", assetDuration, last.Time, last.Value)
+	}
+
+	second := sequence.Spine.AssetClips[1]
+	if second.Offset != clip.Duration {
+		t.Errorf("Expected the second clip to ripple to %s, got %s", clip.Duration, second.Offset)
+	}
+	if sequence.Duration != ConvertSecondsToFCPDuration(15) {
+		t.Errorf("Expected a 15s timeline, got %s", sequence.Duration)
+	}
+
+	// Back to 100% restores the original length and drops the time map
+	if err := SetClipSpeed(fcpxml, 0, 100); err != nil {
+		t.Fatalf("SetClipSpeed 100%% failed: %v", err)
+	}
+	clip = sequence.Spine.AssetClips[0]
+	if clip.Duration != ConvertSecondsToFCPDuration(10) || clip.TimeMap != nil {
+		t.Errorf("Expected 10s with no time map at 100%%, got %s and %+v", clip.Duration, clip.TimeMap)
+	}
+
+	if err := SetClipSpeed(fcpxml, 0, 0); err == nil {
+		t.Errorf("Expected an error for 0%% speed")
+	}
+	if err := SetClipSpeed(fcpxml, 5, 200); err == nil {
+		t.Errorf("Expected an error for a missing clip")
+	}
+}
@@ -0,0 +1,56 @@
+package fcp
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// FCP's clip volume range: -96dB is silence, +12dB the most boost the inspector allows
+const (
+	minClipGainDb = -96.0
+	maxClipGainDb = 12.0
+)
+
+// formatVolumeDb writes a gain the way adjust-volume amounts are written, e.g. "-6dB"
+func formatVolumeDb(gainDb float64) string {
+	return strconv.FormatFloat(gainDb, 'f', -1, 64) + "dB"
+}
+
+// SetClipVolume sets a constant volume of gainDb on the spine asset-clip at clipIndex, the
+// static counterpart to the keyframed ramps AddAudioCrossfade writes.
+// clipIndex counts spine elements in timeline order, gaps included (see spineElementAt).
+//
+// 🚨 CLAUDE.md Rules Applied Here:
+// - The clip's asset must have audio (hasAudio="1"); images and silent video are rejected
+// - The gain is the adjust-volume amount attribute, so existing volume keyframes are kept
+// - Gain is limited to FCP's -96dB..+12dB range
+func SetClipVolume(fcpxml *FCPXML, clipIndex int, gainDb float64) error {
+	if gainDb < minClipGainDb || gainDb > maxClipGainDb {
+		return fmt.Errorf("gain %gdB is outside FCP's range of %gdB to +%gdB", gainDb, minClipGainDb, maxClipGainDb)
+	}
+	sequence, err := firstSequence(fcpxml)
+	if err != nil {
+		return err
+	}
+	clip, err := spineAssetClipAt(&sequence.Spine, clipIndex)
+	if err != nil {
+		return err
+	}
+
+	hasAudio := false
+	for _, asset := range fcpxml.Resources.Assets {
+		if asset.ID == clip.Ref {
+			hasAudio = asset.HasAudio == "1"
+			break
+		}
+	}
+	if !hasAudio {
+		return fmt.Errorf("clip '%s' has no audio to set the volume of", clip.Name)
+	}
+
+	if clip.AdjustVolume == nil {
+		clip.AdjustVolume = &AdjustVolume{}
+	}
+	clip.AdjustVolume.Amount = formatVolumeDb(gainDb)
+	return nil
+}
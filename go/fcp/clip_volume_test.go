@@ -0,0 +1,55 @@
+package fcp
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestSetClipVolume tests that -6dB on a clip with audio emits that adjust-volume amount, and that
+// silent clips, out-of-range gains and bad indices are rejected
+func TestSetClipVolume(t *testing.T) {
+	dir := t.TempDir()
+	videoPath := filepath.Join(dir, "interview.mp4")
+	if err := os.WriteFile(videoPath, []byte("fake video"), 0644); err != nil {
+		t.Fatalf("Failed to create test video: %v", err)
+	}
+
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("GenerateEmpty failed: %v", err)
+	}
+	if err := AddVideo(fcpxml, videoPath); err != nil {
+		t.Fatalf("AddVideo failed: %v", err)
+	}
+	// The fake file has no audio stream to detect; mark the asset as ffprobe would for real footage
+	if err := SetClipVolume(fcpxml, 0, -6); err == nil {
+		t.Errorf("Expected an error for a clip without audio")
+	}
+	for i := range fcpxml.Resources.Assets {
+		fcpxml.Resources.Assets[i].HasAudio = "1"
+	}
+	if err := SetClipVolume(fcpxml, 0, -6); err != nil {
+		t.Fatalf("SetClipVolume failed: %v", err)
+	}
+
+	outputPath := filepath.Join(dir, "volume.fcpxml")
+	if err := WriteToFile(fcpxml, outputPath); err != nil {
+		t.Fatalf("WriteToFile failed: %v", err)
+	}
+	data, err := os.ReadFile(outputPath)
+	if err != nil {
+		t.Fatalf("Failed to read output: %v", err)
+	}
+	if !strings.Contains(string(data), `<adjust-volume amount="-6dB"`) {
+		t.Errorf("Expected adjust-volume amount=\"-6dB\" on the clip")
+	}
+
+	if err := SetClipVolume(fcpxml, 0, 20); err == nil {
+		t.Errorf("Expected an error for a gain above +12dB")
+	}
+	if err := SetClipVolume(fcpxml, 1, -6); err == nil {
+		t.Errorf("Expected an error for a clip index out of range")
+	}
+}
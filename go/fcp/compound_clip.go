@@ -0,0 +1,98 @@
+package fcp
+
+import (
+	"fmt"
+)
+
+// CreateCompoundClip builds a reusable sub-timeline as a <media> resource and returns its ID.
+// build receives an empty sequence in the main sequence's format and fills its spine; the
+// compound clip's duration is taken from whatever build adds.
+//
+// 🚨 CLAUDE.md Rules Applied Here:
+// - Media ID comes from the ResourceRegistry/Transaction system like every other resource
+// - The inner sequence is built with STRUCTS ONLY, through the caller's build func
+// - Reference the result with AddRefClip() as many times as needed - one media, many ref-clips
+func CreateCompoundClip(fcpxml *FCPXML, name string, build func(*Sequence)) (string, error) {
+	if build == nil {
+		return "", fmt.Errorf("compound clip '%s' needs a build function", name)
+	}
+
+	mainSequence, err := firstSequence(fcpxml)
+	if err != nil {
+		return "", err
+	}
+
+	inner := Sequence{
+		Format:      mainSequence.Format,
+		Duration:    "0s",
+		TCStart:     "0s",
+		TCFormat:    mainSequence.TCFormat,
+		AudioLayout: mainSequence.AudioLayout,
+		AudioRate:   mainSequence.AudioRate,
+	}
+	build(&inner)
+
+	inner.Duration = calculateTimelineDuration(&inner)
+	if inner.Duration == "0s" {
+		return "", fmt.Errorf("compound clip '%s' has no content", name)
+	}
+
+	registry := NewResourceRegistry(fcpxml)
+	tx := NewTransaction(registry)
+	defer tx.Rollback()
+
+	mediaID := tx.ReserveIDs(1)[0]
+	if _, err := tx.CreateMedia(mediaID, name, inner); err != nil {
+		return "", fmt.Errorf("failed to create compound clip media: %v", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return "", fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	return mediaID, nil
+}
+
+// AddRefClip places the compound clip mediaID on the main spine at offsetSeconds, playing its
+// first durationSeconds.
+//
+// 🚨 CLAUDE.md Rules Applied Here:
+// - The ref must point at an existing <media> resource, never an asset or effect
+// - Ref-clips are spine elements: no lane attribute
+// - Frame-aligned offset/duration → ConvertSecondsToFCPDuration() function
+func AddRefClip(fcpxml *FCPXML, mediaID string, offsetSeconds, durationSeconds float64) error {
+	if offsetSeconds < 0 || durationSeconds <= 0 {
+		return fmt.Errorf("invalid ref-clip timing (offset %.3fs, duration %.3fs)", offsetSeconds, durationSeconds)
+	}
+
+	var media *Media
+	for i := range fcpxml.Resources.Media {
+		if fcpxml.Resources.Media[i].ID == mediaID {
+			media = &fcpxml.Resources.Media[i]
+			break
+		}
+	}
+	if media == nil {
+		return fmt.Errorf("no compound clip media with ID '%s'", mediaID)
+	}
+
+	duration := ConvertSecondsToFCPDuration(durationSeconds)
+	if parseFCPDuration(duration) > parseFCPDuration(media.Sequence.Duration) {
+		return fmt.Errorf("ref-clip duration %s is longer than compound clip '%s' (%s)", duration, media.Name, media.Sequence.Duration)
+	}
+
+	sequence, err := firstSequence(fcpxml)
+	if err != nil {
+		return err
+	}
+
+	sequence.Spine.RefClips = append(sequence.Spine.RefClips, RefClip{
+		Ref:      mediaID,
+		Offset:   ConvertSecondsToFCPDuration(offsetSeconds),
+		Name:     media.Name,
+		Duration: duration,
+	})
+	sequence.Duration = calculateTimelineDuration(sequence)
+
+	return nil
+}
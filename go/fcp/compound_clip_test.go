@@ -0,0 +1,70 @@
+package fcp
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestCompoundClipReferencedTwice tests that one compound clip can back several ref-clips
+func TestCompoundClipReferencedTwice(t *testing.T) {
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("GenerateEmpty failed: %v", err)
+	}
+
+	mediaID, err := CreateCompoundClip(fcpxml, "Intro", func(inner *Sequence) {
+		inner.Spine.Gaps = append(inner.Spine.Gaps, Gap{
+			Name:     "Intro Gap",
+			Offset:   "0s",
+			Duration: ConvertSecondsToFCPDuration(3),
+		})
+	})
+	if err != nil {
+		t.Fatalf("CreateCompoundClip failed: %v", err)
+	}
+
+	if err := AddRefClip(fcpxml, mediaID, 0, 3); err != nil {
+		t.Fatalf("AddRefClip failed: %v", err)
+	}
+	if err := AddRefClip(fcpxml, mediaID, 10, 3); err != nil {
+		t.Fatalf("Second AddRefClip failed: %v", err)
+	}
+
+	if len(fcpxml.Resources.Media) != 1 {
+		t.Fatalf("Expected 1 media resource, got %d", len(fcpxml.Resources.Media))
+	}
+	if fcpxml.Resources.Media[0].Sequence.Duration != ConvertSecondsToFCPDuration(3) {
+		t.Errorf("Expected compound clip duration %s, got %s", ConvertSecondsToFCPDuration(3), fcpxml.Resources.Media[0].Sequence.Duration)
+	}
+
+	sequence := fcpxml.Library.Events[0].Projects[0].Sequences[0]
+	if len(sequence.Spine.RefClips) != 2 {
+		t.Fatalf("Expected 2 ref-clips, got %d", len(sequence.Spine.RefClips))
+	}
+	for i, refClip := range sequence.Spine.RefClips {
+		if refClip.Ref != mediaID {
+			t.Errorf("Ref-clip %d: expected ref %s, got %s", i, mediaID, refClip.Ref)
+		}
+	}
+	if sequence.Duration != ConvertSecondsToFCPDuration(13) {
+		t.Errorf("Expected sequence duration %s, got %s", ConvertSecondsToFCPDuration(13), sequence.Duration)
+	}
+
+	outputPath := filepath.Join(t.TempDir(), "compound.fcpxml")
+	if err := WriteToFile(fcpxml, outputPath); err != nil {
+		t.Fatalf("WriteToFile failed: %v", err)
+	}
+	data, err := os.ReadFile(outputPath)
+	if err != nil {
+		t.Fatalf("Failed to read output: %v", err)
+	}
+	if strings.Count(string(data), "<ref-clip ") != 2 || strings.Count(string(data), "<media ") != 1 {
+		t.Errorf("Expected 1 <media> and 2 <ref-clip> elements in output")
+	}
+
+	if err := AddRefClip(fcpxml, "r999", 0, 3); err == nil {
+		t.Error("Expected error for a ref-clip pointing at missing media")
+	}
+}
@@ -0,0 +1,154 @@
+package fcp
+
+import (
+	"fmt"
+	"math"
+	"os/exec"
+	"strconv"
+	"strings"
+)
+
+// Conform modes for clips whose frame rate differs from the sequence
+const (
+	ConformFloor    = "floor"    // Real-time playback, drop/repeat frames (FCP's default sampling)
+	ConformNearest  = "nearest"  // Real-time playback, nearest-neighbor frame sampling
+	ConformPreserve = "preserve" // Play every source frame; speed changes (e.g. 60fps → 40% slow motion)
+)
+
+// conformModes is the accepted --conform values, in help-text order
+var conformModes = []string{ConformFloor, ConformNearest, ConformPreserve}
+
+// conformSrcFrameRates are the srcFrameRate values the FCPXML DTD allows on conform-rate
+var conformSrcFrameRates = []struct {
+	label string
+	fps   float64
+}{
+	{"23.98", 24000.0 / 1001}, {"24", 24}, {"25", 25}, {"29.97", 30000.0 / 1001}, {"30", 30},
+	{"47.95", 48000.0 / 1001}, {"48", 48}, {"50", 50}, {"59.94", 60000.0 / 1001}, {"60", 60},
+	{"90", 90}, {"100", 100}, {"119.88", 120000.0 / 1001}, {"120", 120},
+}
+
+// detectSourceFrameRate reports a video file's frame rate in fps (a variable so tests can skip ffprobe)
+var detectSourceFrameRate = probeSourceFrameRate
+
+// probeSourceFrameRate reads the first video stream's average frame rate with ffprobe
+func probeSourceFrameRate(videoPath string) (float64, error) {
+	cmd := exec.Command("ffprobe", "-v", "quiet", "-select_streams", "v:0", "-show_entries", "stream=avg_frame_rate,r_frame_rate", "-of", "csv=p=0", videoPath)
+	output, err := cmd.Output()
+	if err != nil {
+		return 0, fmt.Errorf("ffprobe failed: %v", err)
+	}
+
+	for _, rate := range strings.Split(strings.TrimSpace(string(output)), ",") {
+		parts := strings.Split(strings.TrimSpace(rate), "/")
+		if len(parts) != 2 {
+			continue
+		}
+		numerator, err1 := strconv.ParseFloat(parts[0], 64)
+		denominator, err2 := strconv.ParseFloat(parts[1], 64)
+		if err1 == nil && err2 == nil && numerator > 0 && denominator > 0 {
+			return numerator / denominator, nil
+		}
+	}
+	return 0, fmt.Errorf("no frame rate found for %s", videoPath)
+}
+
+// validateConformMode checks a conform mode against conformModes
+func validateConformMode(mode string) error {
+	if !containsString(conformModes, mode) {
+		return fmt.Errorf("invalid conform mode '%s' (must be one of: %s)", mode, strings.Join(conformModes, ", "))
+	}
+	return nil
+}
+
+// ConformRateFor returns the conform-rate for a clip shot at sourceFPS in a sequence whose format
+// has sequenceFrameDuration (e.g. "1001/24000s"), or nil when the rates already match.
+//
+// 🚨 CLAUDE.md Rules Applied Here:
+// - srcFrameRate is snapped to the DTD's enumerated rates (59.94 vs 60 stays distinct)
+// - floor/nearest keep real-time playback (scaleEnabled="0") and only pick the frame sampling
+// - preserve sets scaleEnabled="1" so FCP plays every source frame (a speed change)
+func ConformRateFor(sourceFPS float64, sequenceFrameDuration string, mode string) (*ConformRate, error) {
+	if err := validateConformMode(mode); err != nil {
+		return nil, err
+	}
+
+	sequenceFPS := 0.0
+	if frameSeconds := fcpDurationToSeconds(sequenceFrameDuration); frameSeconds > 0 {
+		sequenceFPS = 1 / frameSeconds
+	}
+	if sourceFPS <= 0 || sequenceFPS <= 0 || math.Abs(sourceFPS-sequenceFPS) < 0.01 {
+		return nil, nil
+	}
+
+	label, nearest := "", math.MaxFloat64
+	for _, rate := range conformSrcFrameRates {
+		if diff := math.Abs(rate.fps - sourceFPS); diff < nearest {
+			label, nearest = rate.label, diff
+		}
+	}
+	// Variable or odd rates (e.g. 15fps screen captures) have no DTD value to declare
+	if nearest > 0.05 {
+		return nil, fmt.Errorf("source frame rate %.3f fps has no FCP conform rate", sourceFPS)
+	}
+
+	switch mode {
+	case ConformPreserve:
+		return &ConformRate{ScaleEnabled: "1", SrcFrameRate: label}, nil
+	case ConformNearest:
+		return &ConformRate{ScaleEnabled: "0", SrcFrameRate: label, FrameSampling: "nearest-neighbor"}, nil
+	default:
+		return &ConformRate{ScaleEnabled: "0", SrcFrameRate: label, FrameSampling: "floor"}, nil
+	}
+}
+
+// ConformAssetClip detects the frame rate of videoPath with ffprobe and sets the matching
+// conform-rate on the spine asset-clip at clipIndex (timeline order, gaps included; see
+// spineElementAt), clearing it when the rates match. Clips
+// whose rate can't be detected or declared (no ffprobe, placeholder files, 15fps captures) are
+// left as FCP would import them and explained in the returned warning.
+func ConformAssetClip(fcpxml *FCPXML, clipIndex int, videoPath string, mode string) (warning string, err error) {
+	if err := validateConformMode(mode); err != nil {
+		return "", err
+	}
+
+	sequence, err := firstSequence(fcpxml)
+	if err != nil {
+		return "", err
+	}
+	clip, err := spineAssetClipAt(&sequence.Spine, clipIndex)
+	if err != nil {
+		return "", err
+	}
+
+	sourceFPS, err := detectSourceFrameRate(videoPath)
+	if err != nil {
+		return fmt.Sprintf("leaving %s unconformed: %v", videoPath, err), nil
+	}
+
+	frameDuration := ""
+	for _, format := range fcpxml.Resources.Formats {
+		if format.ID == sequence.Format {
+			frameDuration = format.FrameDuration
+		}
+	}
+
+	conformRate, err := ConformRateFor(sourceFPS, frameDuration, mode)
+	if err != nil {
+		return fmt.Sprintf("leaving %s unconformed: %v", videoPath, err), nil
+	}
+	clip.ConformRate = conformRate
+	return "", nil
+}
+
+// AddVideoWithConform is AddVideo followed by ConformAssetClip on the new clip. AddVideo itself
+// never probes the file, so conforming is opt-in.
+func AddVideoWithConform(fcpxml *FCPXML, videoPath string, mode string) (warning string, err error) {
+	if err := validateConformMode(mode); err != nil {
+		return "", err
+	}
+	if err := AddVideo(fcpxml, videoPath); err != nil {
+		return "", err
+	}
+	return ConformAssetClip(fcpxml, TimelineElementCount(fcpxml)-1, videoPath, mode)
+}
@@ -0,0 +1,99 @@
+package fcp
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestAddVideoConformRate validates that a clip whose detected rate differs from the sequence gets a conform-rate
+func TestAddVideoConformRate(t *testing.T) {
+	originalDetect := detectSourceFrameRate
+	defer func() { detectSourceFrameRate = originalDetect }()
+
+	dir := t.TempDir()
+	videoPath := filepath.Join(dir, "action.mp4")
+	if err := os.WriteFile(videoPath, []byte("fake video"), 0644); err != nil {
+		t.Fatalf("Failed to create test video: %v", err)
+	}
+
+	cases := []struct {
+		fps      float64
+		mode     string
+		expected *ConformRate
+	}{
+		{60, ConformFloor, &ConformRate{ScaleEnabled: "0", SrcFrameRate: "60", FrameSampling: "floor"}},
+		{60000.0 / 1001, ConformNearest, &ConformRate{ScaleEnabled: "0", SrcFrameRate: "59.94", FrameSampling: "nearest-neighbor"}},
+		{25, ConformPreserve, &ConformRate{ScaleEnabled: "1", SrcFrameRate: "25"}},
+		{24000.0 / 1001, ConformFloor, nil}, // Matches the 1001/24000s sequence
+		{15, ConformFloor, nil},             // No DTD rate to declare
+	}
+
+	for _, c := range cases {
+		fps := c.fps
+		detectSourceFrameRate = func(string) (float64, error) { return fps, nil }
+
+		fcpxml, err := GenerateEmpty("")
+		if err != nil {
+			t.Fatalf("GenerateEmpty failed: %v", err)
+		}
+		if _, err := AddVideoWithConform(fcpxml, videoPath, c.mode); err != nil {
+			t.Fatalf("AddVideoWithConform(%g fps, %s) failed: %v", c.fps, c.mode, err)
+		}
+
+		got := fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine.AssetClips[0].ConformRate
+		if c.expected == nil {
+			if got != nil {
+				t.Errorf("%g fps: expected no conform-rate, got %+v", c.fps, got)
+			}
+			continue
+		}
+		if got == nil || *got != *c.expected {
+			t.Errorf("%g fps %s: expected %+v, got %+v", c.fps, c.mode, c.expected, got)
+		}
+	}
+
+	fcpxml, _ := GenerateEmpty("")
+	if _, err := AddVideoWithConform(fcpxml, videoPath, "blend"); err == nil {
+		t.Errorf("Expected error for unknown conform mode")
+	}
+
+	// Undeclarable and undetectable rates come back as warnings, not output
+	detectSourceFrameRate = func(string) (float64, error) { return 15, nil }
+	fcpxml, _ = GenerateEmpty("")
+	if warning, err := AddVideoWithConform(fcpxml, videoPath, ConformFloor); err != nil || !strings.Contains(warning, "no FCP conform rate") {
+		t.Errorf("Expected a warning for 15 fps, got %q (err %v)", warning, err)
+	}
+	detectSourceFrameRate = func(string) (float64, error) { return 0, fmt.Errorf("ffprobe failed") }
+	fcpxml, _ = GenerateEmpty("")
+	if warning, err := AddVideoWithConform(fcpxml, videoPath, ConformFloor); err != nil || !strings.Contains(warning, "ffprobe failed") {
+		t.Errorf("Expected a warning when detection fails, got %q (err %v)", warning, err)
+	}
+}
+
+// TestAddVideoDoesNotProbe validates that plain AddVideo leaves frame rate detection alone
+func TestAddVideoDoesNotProbe(t *testing.T) {
+	originalDetect := detectSourceFrameRate
+	defer func() { detectSourceFrameRate = originalDetect }()
+	detectSourceFrameRate = func(string) (float64, error) {
+		t.Error("AddVideo probed the frame rate")
+		return 60, nil
+	}
+
+	videoPath := filepath.Join(t.TempDir(), "action.mp4")
+	if err := os.WriteFile(videoPath, []byte("fake video"), 0644); err != nil {
+		t.Fatalf("Failed to create test video: %v", err)
+	}
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("GenerateEmpty failed: %v", err)
+	}
+	if err := AddVideo(fcpxml, videoPath); err != nil {
+		t.Fatalf("AddVideo failed: %v", err)
+	}
+	if conformRate := fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine.AssetClips[0].ConformRate; conformRate != nil {
+		t.Errorf("Expected no conform-rate from AddVideo, got %+v", conformRate)
+	}
+}
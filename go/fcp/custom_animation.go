@@ -0,0 +1,111 @@
+package fcp
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+
+// CustomKeyframe is one keyframe of a curves file: seconds into the clip, the param value
+// ("x y" for position/scale/anchor, degrees for rotation) and an optional linear/smooth curve
+type CustomKeyframe struct {
+	T     float64 `json:"t"`
+	Value string  `json:"value"`
+	Curve string  `json:"curve,omitempty"`
+}
+
+// customAnimationParams are the adjust-transform params a curves file may animate
+var customAnimationParams = map[string]bool{"position": true, "scale": true, "rotation": true, "anchor": true}
+
+// AddCustomAnimation animates the asset-clip at timeline position clipIndex from a JSON curves file shaped like
+// {"position": [{"t": 0, "value": "0 0"}, {"t": 2, "value": "100 0"}], "scale": [...]}.
+// Animated params replace the clip's static value or earlier animation of the same param.
+//
+// 🚨 CLAUDE.md Rules Applied Here:
+// - Position keyframes may not carry a curve; scale/rotation/anchor accept linear or smooth
+// - Times are seconds from the clip's start, frame-aligned and offset by its start attribute
+// - Keyframes go through AnimationBuilder, which validates values and chronological order
+func AddCustomAnimation(fcpxml *FCPXML, clipIndex int, curvesPath string) error {
+	data, err := os.ReadFile(curvesPath)
+	if err != nil {
+		return fmt.Errorf("failed to read curves file: %v", err)
+	}
+	var curves map[string][]CustomKeyframe
+	if err := json.Unmarshal(data, &curves); err != nil {
+		return fmt.Errorf("failed to parse curves file: %v", err)
+	}
+	if len(curves) == 0 {
+		return fmt.Errorf("curves file %s has no params", curvesPath)
+	}
+
+	sequence, err := firstSequence(fcpxml)
+	if err != nil {
+		return err
+	}
+	clip, err := spineAssetClipAt(&sequence.Spine, clipIndex)
+	if err != nil {
+		return err
+	}
+	clipStart := timeUnits(clip.Start)
+	clipSeconds := unitsToSeconds(timeUnits(clip.Duration))
+
+	// Params are built in a fixed order so the output doesn't depend on map iteration
+	names := make([]string, 0, len(curves))
+	for name := range curves {
+		if !customAnimationParams[name] {
+			return fmt.Errorf("unknown param '%s' in curves file (use position, scale, rotation or anchor)", name)
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var params []Param
+	for _, name := range names {
+		builder := NewAnimationBuilder(name)
+		for i, keyframe := range curves[name] {
+			if keyframe.T < 0 || keyframe.T > clipSeconds {
+				return fmt.Errorf("%s keyframe %d at %.3fs is outside the %.3fs clip", name, i, keyframe.T, clipSeconds)
+			}
+			var options []KeyframeOption
+			if keyframe.Curve != "" {
+				if name == "position" {
+					return fmt.Errorf("position keyframe %d has curve '%s'; FCP position keyframes take no curve", i, keyframe.Curve)
+				}
+				options = append(options, WithCurve(keyframe.Curve))
+			}
+			at := Time(formatFrameAlignedTime(clipStart + timeUnits(ConvertSecondsToFCPDuration(keyframe.T))))
+			if err := builder.AddKeyframe(at, strings.TrimSpace(keyframe.Value), options...); err != nil {
+				return fmt.Errorf("%s keyframe %d: %v", name, i, err)
+			}
+		}
+		param, err := builder.Build()
+		if err != nil {
+			return fmt.Errorf("%s: %v", name, err)
+		}
+		params = append(params, *param)
+	}
+
+	if clip.AdjustTransform == nil {
+		clip.AdjustTransform = &AdjustTransform{}
+	}
+	transform := clip.AdjustTransform
+	var kept []Param
+	for _, param := range transform.Params {
+		if _, replaced := curves[param.Name]; !replaced {
+			kept = append(kept, param)
+		}
+	}
+	transform.Params = append(kept, params...)
+	if _, ok := curves["position"]; ok {
+		transform.Position = ""
+	}
+	if _, ok := curves["scale"]; ok {
+		transform.Scale = ""
+	}
+	if _, ok := curves["rotation"]; ok {
+		transform.Rotation = ""
+	}
+	return nil
+}
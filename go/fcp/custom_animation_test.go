@@ -0,0 +1,80 @@
+package fcp
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestAddCustomAnimation tests that JSON curves become frame-aligned adjust-transform keyframes
+func TestAddCustomAnimation(t *testing.T) {
+	tempDir := t.TempDir()
+	videoPath := filepath.Join(tempDir, "clip.mp4")
+	if err := os.WriteFile(videoPath, []byte("fake video data"), 0644); err != nil {
+		t.Fatalf("Failed to create test video: %v", err)
+	}
+
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("GenerateEmpty failed: %v", err)
+	}
+	if err := AddVideo(fcpxml, videoPath); err != nil {
+		t.Fatalf("AddVideo failed: %v", err)
+	}
+	clip := &fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine.AssetClips[0]
+	clip.Start = ConvertSecondsToFCPDuration(1)
+
+	writeCurves := func(name, content string) string {
+		path := filepath.Join(tempDir, name)
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write curves: %v", err)
+		}
+		return path
+	}
+
+	curvesPath := writeCurves("curves.json", `{
+		"position": [{"t": 0, "value": "0 0"}, {"t": 2, "value": "100 -50"}],
+		"scale": [{"t": 0, "value": "1 1", "curve": "linear"}, {"t": 2, "value": "1.5 1.5", "curve": "smooth"}]
+	}`)
+	if err := AddCustomAnimation(fcpxml, 0, curvesPath); err != nil {
+		t.Fatalf("AddCustomAnimation failed: %v", err)
+	}
+
+	params := clip.AdjustTransform.Params
+	if len(params) != 2 || params[0].Name != "position" || params[1].Name != "scale" {
+		t.Fatalf("Expected position and scale params, got %+v", params)
+	}
+
+	// Keyframe times are in the clip's local time, which begins at its 1s start
+	startTime := ConvertSecondsToFCPDuration(1)
+	endTime := formatFrameAlignedTime(timeUnits(startTime) + timeUnits(ConvertSecondsToFCPDuration(2)))
+	expected := map[string][]Keyframe{
+		"position": {{Time: startTime, Value: "0 0"}, {Time: endTime, Value: "100 -50"}},
+		"scale":    {{Time: startTime, Value: "1 1", Curve: "linear"}, {Time: endTime, Value: "1.5 1.5", Curve: "smooth"}},
+	}
+	for _, param := range params {
+		keyframes := param.KeyframeAnimation.Keyframes
+		if len(keyframes) != 2 {
+			t.Fatalf("%s: expected 2 keyframes, got %d", param.Name, len(keyframes))
+		}
+		for i, keyframe := range keyframes {
+			if keyframe != expected[param.Name][i] {
+				t.Errorf("%s keyframe %d: expected %+v, got %+v", param.Name, i, expected[param.Name][i], keyframe)
+			}
+		}
+	}
+
+	badCurves := []struct {
+		name, content, message string
+	}{
+		{"curve.json", `{"position": [{"t": 0, "value": "0 0", "curve": "smooth"}]}`, "take no curve"},
+		{"param.json", `{"opacity": [{"t": 0, "value": "1"}]}`, "unknown param"},
+		{"late.json", `{"rotation": [{"t": 30, "value": "90"}]}`, "outside"},
+	}
+	for _, bad := range badCurves {
+		if err := AddCustomAnimation(fcpxml, 0, writeCurves(bad.name, bad.content)); err == nil || !strings.Contains(err.Error(), bad.message) {
+			t.Errorf("%s: expected error containing %q, got %v", bad.name, bad.message, err)
+		}
+	}
+}
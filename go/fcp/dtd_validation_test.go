@@ -1,10 +1,32 @@
 package fcp
 
 import (
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 )
 
+// fcpxmlDTDPath is resolved while the working directory is still the package directory, since
+// some tests chdir into temp dirs
+var fcpxmlDTDPath, _ = filepath.Abs(filepath.Join("..", "..", "FCPXMLv1_13.dtd"))
+
+// requireDTDValid checks a written FCPXML with xmllint --dtdvalid FCPXMLv1_13.dtd (skipped without xmllint)
+func requireDTDValid(t *testing.T, filename string) {
+	t.Helper()
+	if !IsXMLLintAvailable() {
+		t.Log("xmllint not available, skipping DTD validation")
+		return
+	}
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatalf("Failed to read %s: %v", filename, err)
+	}
+	if err := NewDTDValidator(fcpxmlDTDPath).ValidateXML(data); err != nil {
+		t.Errorf("%s is not DTD-valid: %v", filepath.Base(filename), err)
+	}
+}
+
 func TestDTDValidationIntegration(t *testing.T) {
 	tests := []struct {
 		name           string
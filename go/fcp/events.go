@@ -0,0 +1,132 @@
+package fcp
+
+import (
+	"fmt"
+	"time"
+)
+
+// projectModDateLayout is the modDate format FCP writes on projects
+const projectModDateLayout = "2006-01-02 15:04:05 -0700"
+
+// AddEvent appends a new, empty event to the library, e.g. one event per shooting day.
+// The returned pointer is only valid until the next event is added.
+//
+// 🚨 CLAUDE.md Rules Applied Here:
+// - Every event gets a fresh random UID so FCP never merges it with an existing event
+func AddEvent(fcpxml *FCPXML, name string) *Event {
+	fcpxml.Library.Events = append(fcpxml.Library.Events, Event{
+		Name: name,
+		UID:  generateRandomUID(),
+	})
+	return &fcpxml.Library.Events[len(fcpxml.Library.Events)-1]
+}
+
+// findEvent returns the index of the first event called name, or -1
+func findEvent(fcpxml *FCPXML, name string) int {
+	for i, event := range fcpxml.Library.Events {
+		if event.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// AddProject creates a project with an empty sequence in event. The sequence copies the format
+// and audio settings of the document's first sequence so new content lands on a matching timeline.
+func AddProject(fcpxml *FCPXML, event *Event, name string) *Project {
+	sequence := Sequence{
+		Format:      "r1",
+		Duration:    "0s",
+		TCStart:     "0s",
+		TCFormat:    "NDF",
+		AudioLayout: "stereo",
+		AudioRate:   "48k",
+	}
+	if first, err := firstSequence(fcpxml); err == nil {
+		sequence.Format = first.Format
+		sequence.TCStart = first.TCStart
+		sequence.TCFormat = first.TCFormat
+		sequence.AudioLayout = first.AudioLayout
+		sequence.AudioRate = first.AudioRate
+	}
+	sequence.Spine = Spine{AssetClips: []AssetClip{}}
+
+	event.Projects = append(event.Projects, Project{
+		Name:      name,
+		UID:       generateRandomUID(),
+		ModDate:   time.Now().Format(projectModDateLayout),
+		Sequences: []Sequence{sequence},
+	})
+	return &event.Projects[len(event.Projects)-1]
+}
+
+// MoveProject moves the first project called projectName into the event called toEvent
+func MoveProject(fcpxml *FCPXML, projectName, toEvent string) error {
+	to := findEvent(fcpxml, toEvent)
+	if to < 0 {
+		return fmt.Errorf("event '%s' not found", toEvent)
+	}
+	events := fcpxml.Library.Events
+	for from := range events {
+		for p, project := range events[from].Projects {
+			if project.Name != projectName {
+				continue
+			}
+			if from == to {
+				return nil
+			}
+			events[from].Projects = append(events[from].Projects[:p], events[from].Projects[p+1:]...)
+			events[to].Projects = append(events[to].Projects, project)
+			return nil
+		}
+	}
+	return fmt.Errorf("project '%s' not found", projectName)
+}
+
+// SelectEvent routes subsequent generation into the event called name, creating it (with a
+// project of the same name) when it doesn't exist yet. Generators always write to the first
+// event's first project, so the selected event (and its project) are moved to the front; the
+// returned restore puts both back where they were and must be called before writing, so the
+// library keeps its event order.
+//
+// 🚨 CLAUDE.md Rules Applied Here:
+// - Only the position of the event and project changes; their UIDs and contents are untouched
+// - restore assumes no events or projects were added or removed in between
+func SelectEvent(fcpxml *FCPXML, name string) (restore func(), err error) {
+	if name == "" {
+		return nil, fmt.Errorf("event name must not be empty")
+	}
+	index := findEvent(fcpxml, name)
+	if index < 0 {
+		AddEvent(fcpxml, name)
+		index = len(fcpxml.Library.Events) - 1
+	}
+	event := &fcpxml.Library.Events[index]
+	project := 0
+	if len(event.Projects) == 0 || len(event.Projects[0].Sequences) == 0 {
+		AddProject(fcpxml, event, name)
+		project = len(event.Projects) - 1
+	}
+
+	moveToFront(fcpxml.Library.Events, index)
+	moveToFront(fcpxml.Library.Events[0].Projects, project)
+
+	return func() {
+		moveFromFront(fcpxml.Library.Events[0].Projects, project)
+		moveFromFront(fcpxml.Library.Events, index)
+	}, nil
+}
+
+// moveToFront moves items[index] to the front, shifting the items before it back by one
+func moveToFront[T any](items []T, index int) {
+	selected := items[index]
+	copy(items[1:index+1], items[:index])
+	items[0] = selected
+}
+
+// moveFromFront undoes moveToFront, returning the front item to index
+func moveFromFront[T any](items []T, index int) {
+	selected := items[0]
+	copy(items[:index], items[1:index+1])
+	items[index] = selected
+}
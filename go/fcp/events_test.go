@@ -0,0 +1,103 @@
+package fcp
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestAddEvent tests that a library can hold several events with their own projects
+func TestAddEvent(t *testing.T) {
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("GenerateEmpty failed: %v", err)
+	}
+
+	AddEvent(fcpxml, "Day 1")
+	day2 := AddEvent(fcpxml, "Day 2")
+	project := AddProject(fcpxml, day2, "Interviews")
+
+	events := fcpxml.Library.Events
+	if len(events) != 3 {
+		t.Fatalf("Expected 3 events, got %d", len(events))
+	}
+	uids := map[string]bool{}
+	for _, event := range events {
+		if event.UID == "" || uids[event.UID] {
+			t.Errorf("Event '%s' has a missing or duplicate UID '%s'", event.Name, event.UID)
+		}
+		uids[event.UID] = true
+	}
+	if events[1].Name != "Day 1" || events[2].Name != "Day 2" {
+		t.Fatalf("Unexpected event order: %s, %s", events[1].Name, events[2].Name)
+	}
+	if len(events[2].Projects) != 1 || project.Name != "Interviews" || project.UID == "" {
+		t.Fatalf("Expected the Interviews project in Day 2, got %+v", events[2].Projects)
+	}
+	if project.Sequences[0].Format != "r1" {
+		t.Errorf("Expected new sequence to reuse format r1, got %s", project.Sequences[0].Format)
+	}
+
+	if err := MoveProject(fcpxml, "Interviews", "Day 1"); err != nil {
+		t.Fatalf("MoveProject failed: %v", err)
+	}
+	if len(events[1].Projects) != 1 || len(events[2].Projects) != 0 {
+		t.Errorf("Expected Interviews moved to Day 1, got %d and %d projects", len(events[1].Projects), len(events[2].Projects))
+	}
+	if err := MoveProject(fcpxml, "Interviews", "Day 9"); err == nil {
+		t.Error("Expected error moving to a missing event")
+	}
+}
+
+// TestSelectEvent tests that content generated after SelectEvent lands in that event and that
+// restore puts the library's events back in their original order
+func TestSelectEvent(t *testing.T) {
+	videoPath := filepath.Join(t.TempDir(), "clip.mp4")
+	if err := os.WriteFile(videoPath, []byte("fake video data"), 0644); err != nil {
+		t.Fatalf("Failed to create test video: %v", err)
+	}
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("GenerateEmpty failed: %v", err)
+	}
+	original := fcpxml.Library.Events[0].Name
+
+	restore, err := SelectEvent(fcpxml, "Day 2")
+	if err != nil {
+		t.Fatalf("SelectEvent failed: %v", err)
+	}
+	if err := AddVideo(fcpxml, videoPath); err != nil {
+		t.Fatalf("AddVideo failed: %v", err)
+	}
+	restore()
+
+	events := fcpxml.Library.Events
+	if len(events) != 2 || events[0].Name != original || events[1].Name != "Day 2" {
+		t.Fatalf("Expected %s then Day 2 after restore, got %+v", original, events)
+	}
+	if clips := events[1].Projects[0].Sequences[0].Spine.AssetClips; len(clips) != 1 {
+		t.Errorf("Expected the clip in Day 2, got %d clips", len(clips))
+	}
+	if clips := events[0].Projects[0].Sequences[0].Spine.AssetClips; len(clips) != 0 {
+		t.Errorf("Expected original event untouched, got %d clips", len(clips))
+	}
+
+	// Selecting an existing event reuses it; a project added to an event with an empty first
+	// project goes back to the end of that event's projects
+	events[1].Projects = append([]Project{{Name: "Empty"}}, events[1].Projects...)
+	restore, err = SelectEvent(fcpxml, "Day 2")
+	if err != nil {
+		t.Fatalf("SelectEvent failed: %v", err)
+	}
+	if len(fcpxml.Library.Events) != 2 || fcpxml.Library.Events[0].Name != "Day 2" {
+		t.Errorf("Expected Day 2 selected without a new event, got %+v", fcpxml.Library.Events)
+	}
+	if name := fcpxml.Library.Events[0].Projects[0].Name; name != "Day 2" {
+		t.Errorf("Expected a new Day 2 project to be targeted, got %s", name)
+	}
+	restore()
+	projects := fcpxml.Library.Events[1].Projects
+	if len(projects) != 3 || projects[0].Name != "Empty" || projects[2].Name != "Day 2" {
+		t.Errorf("Expected project order restored with the new project last, got %+v", projects)
+	}
+}
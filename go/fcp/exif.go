@@ -0,0 +1,227 @@
+package fcp
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+)
+
+// EXIF tags holding capture dates ("2006:01:02 15:04:05", no time zone)
+const (
+	exifTagOrientation       = 0x0112 // IFD0: how the stored pixels must be turned to display upright
+	exifTagDateTime          = 0x0132 // IFD0: last modification
+	exifTagExifIFDPointer    = 0x8769 // IFD0: offset of the Exif sub-IFD
+	exifTagDateTimeOriginal  = 0x9003 // Exif IFD: when the shutter fired
+	exifTagDateTimeDigitized = 0x9004 // Exif IFD: when the image was digitized
+	exifDateLayout           = "2006:01:02 15:04:05"
+	exifMaxHeaderBytes       = 256 * 1024 // EXIF lives in the first segments; never read whole photos
+)
+
+// ReadEXIFCaptureTime returns the capture date stored in a JPEG's APP1 segment or a PNG's eXIf chunk.
+// DateTimeOriginal is preferred, then DateTimeDigitized, then IFD0 DateTime. EXIF dates carry no
+// zone, so they are interpreted in local time.
+func ReadEXIFCaptureTime(imagePath string) (time.Time, error) {
+	tiff, err := readEXIFBlock(imagePath)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if tiff == nil {
+		return time.Time{}, fmt.Errorf("no EXIF data in %s", imagePath)
+	}
+
+	tags, err := readEXIFDateTags(tiff)
+	if err != nil {
+		return time.Time{}, err
+	}
+	for _, tag := range []uint16{exifTagDateTimeOriginal, exifTagDateTimeDigitized, exifTagDateTime} {
+		value := strings.TrimRight(tags[tag], "\x00 ")
+		if value == "" {
+			continue
+		}
+		if taken, err := time.ParseInLocation(exifDateLayout, value, time.Local); err == nil {
+			return taken, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("no capture date in EXIF data of %s", imagePath)
+}
+
+// ReadEXIFOrientation returns the EXIF Orientation (1-8) of a JPEG or PNG. Images without EXIF
+// data or without the tag report 1 (stored upright).
+func ReadEXIFOrientation(imagePath string) (int, error) {
+	tiff, err := readEXIFBlock(imagePath)
+	if err != nil || tiff == nil {
+		return 1, err
+	}
+
+	order, ifd0, err := tiffByteOrder(tiff)
+	if err != nil {
+		return 1, err
+	}
+	if int(ifd0)+2 > len(tiff) {
+		return 1, nil
+	}
+	count := int(order.Uint16(tiff[ifd0 : ifd0+2]))
+	for i := 0; i < count; i++ {
+		entry := int(ifd0) + 2 + i*12
+		if entry+12 > len(tiff) {
+			break
+		}
+		if order.Uint16(tiff[entry:entry+2]) != exifTagOrientation || order.Uint16(tiff[entry+2:entry+4]) != 3 { // SHORT
+			continue
+		}
+		if orientation := int(order.Uint16(tiff[entry+8 : entry+10])); orientation >= 1 && orientation <= 8 {
+			return orientation, nil
+		}
+	}
+	return 1, nil
+}
+
+// readEXIFBlock returns the TIFF block of a JPEG's APP1 segment or a PNG's eXIf chunk; nil when there is none
+func readEXIFBlock(imagePath string) ([]byte, error) {
+	file, err := os.Open(imagePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open image: %v", err)
+	}
+	defer file.Close()
+
+	header, err := io.ReadAll(io.LimitReader(file, exifMaxHeaderBytes))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read image header: %v", err)
+	}
+
+	switch {
+	case bytes.HasPrefix(header, []byte{0xFF, 0xD8}):
+		return findJPEGExif(header), nil
+	case bytes.HasPrefix(header, []byte("\x89PNG\r\n\x1a\n")):
+		return findPNGExif(header), nil
+	}
+	return nil, nil
+}
+
+// findJPEGExif returns the TIFF block of the first "Exif" APP1 segment, or nil
+func findJPEGExif(data []byte) []byte {
+	pos := 2
+	for pos+4 <= len(data) {
+		if data[pos] != 0xFF {
+			return nil
+		}
+		marker := data[pos+1]
+		if marker == 0xD8 || (marker >= 0xD0 && marker <= 0xD7) || marker == 0x01 {
+			pos += 2 // standalone markers carry no length
+			continue
+		}
+		if marker == 0xDA || marker == 0xD9 {
+			return nil // image data starts; metadata segments are over
+		}
+
+		length := int(binary.BigEndian.Uint16(data[pos+2 : pos+4]))
+		end := pos + 2 + length
+		if length < 2 || end > len(data) {
+			return nil
+		}
+		segment := data[pos+4 : end]
+		if marker == 0xE1 && bytes.HasPrefix(segment, []byte("Exif\x00\x00")) {
+			return segment[6:]
+		}
+		pos = end
+	}
+	return nil
+}
+
+// findPNGExif returns the contents of the eXIf chunk, or nil
+func findPNGExif(data []byte) []byte {
+	pos := 8
+	for pos+8 <= len(data) {
+		length := int(binary.BigEndian.Uint32(data[pos : pos+4]))
+		chunkType := string(data[pos+4 : pos+8])
+		end := pos + 8 + length + 4 // data + CRC
+		if end > len(data) {
+			return nil
+		}
+		if chunkType == "eXIf" {
+			return data[pos+8 : pos+8+length]
+		}
+		if chunkType == "IDAT" || chunkType == "IEND" {
+			return nil
+		}
+		pos = end
+	}
+	return nil
+}
+
+// readEXIFDateTags collects the ASCII date tags from IFD0 and the Exif sub-IFD
+func readEXIFDateTags(tiff []byte) (map[uint16]string, error) {
+	order, ifd0, err := tiffByteOrder(tiff)
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make(map[uint16]string)
+	exifIFD := readEXIFIFD(tiff, order, ifd0, tags)
+	if exifIFD > 0 {
+		readEXIFIFD(tiff, order, exifIFD, tags)
+	}
+	return tags, nil
+}
+
+// tiffByteOrder checks the TIFF header and returns its byte order and the IFD0 offset
+func tiffByteOrder(tiff []byte) (binary.ByteOrder, uint32, error) {
+	if len(tiff) < 8 {
+		return nil, 0, fmt.Errorf("EXIF data too short")
+	}
+
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return nil, 0, fmt.Errorf("invalid EXIF byte order")
+	}
+	if order.Uint16(tiff[2:4]) != 42 {
+		return nil, 0, fmt.Errorf("invalid EXIF header")
+	}
+	return order, order.Uint32(tiff[4:8]), nil
+}
+
+// readEXIFIFD stores ASCII date tags of one IFD in tags and returns the Exif sub-IFD offset (0 if absent)
+func readEXIFIFD(tiff []byte, order binary.ByteOrder, offset uint32, tags map[uint16]string) uint32 {
+	if int(offset)+2 > len(tiff) {
+		return 0
+	}
+	count := int(order.Uint16(tiff[offset : offset+2]))
+
+	var exifIFD uint32
+	for i := 0; i < count; i++ {
+		entry := int(offset) + 2 + i*12
+		if entry+12 > len(tiff) {
+			break
+		}
+		tag := order.Uint16(tiff[entry : entry+2])
+		valueType := order.Uint16(tiff[entry+2 : entry+4])
+		valueCount := int(order.Uint32(tiff[entry+4 : entry+8]))
+
+		switch tag {
+		case exifTagExifIFDPointer:
+			exifIFD = order.Uint32(tiff[entry+8 : entry+12])
+		case exifTagDateTime, exifTagDateTimeOriginal, exifTagDateTimeDigitized:
+			if valueType != 2 { // ASCII
+				continue
+			}
+			start := entry + 8 // values of 4 bytes or less are stored inline
+			if valueCount > 4 {
+				start = int(order.Uint32(tiff[entry+8 : entry+12]))
+			}
+			if start+valueCount <= len(tiff) {
+				tags[tag] = string(tiff[start : start+valueCount])
+			}
+		}
+	}
+	return exifIFD
+}
@@ -0,0 +1,44 @@
+package fcp
+
+// FixFormatMismatches points every asset-clip whose format differs from its asset's format at
+// the asset's format, the repair for ValidateClaudeCompliance's "Format mismatch" rule, and
+// returns how many clips it changed. Clips nested in spine clips and videos are fixed too.
+func FixFormatMismatches(fcpxml *FCPXML) int {
+	assetFormats := make(map[string]string, len(fcpxml.Resources.Assets))
+	for _, asset := range fcpxml.Resources.Assets {
+		assetFormats[asset.ID] = asset.Format
+	}
+
+	fixed := 0
+	var fixClips func(clips []AssetClip)
+	var fixVideos func(videos []Video)
+	fixVideos = func(videos []Video) {
+		for i := range videos {
+			fixClips(videos[i].NestedAssetClips)
+			fixVideos(videos[i].NestedVideos)
+		}
+	}
+	fixClips = func(clips []AssetClip) {
+		for i := range clips {
+			clip := &clips[i]
+			// Clips of undefined assets are a different problem (missing resource), left as-is
+			if format, ok := assetFormats[clip.Ref]; ok && clip.Format != format {
+				clip.Format = format
+				fixed++
+			}
+			fixClips(clip.NestedAssetClips)
+			fixVideos(clip.Videos)
+		}
+	}
+
+	for e := range fcpxml.Library.Events {
+		for p := range fcpxml.Library.Events[e].Projects {
+			for s := range fcpxml.Library.Events[e].Projects[p].Sequences {
+				spine := &fcpxml.Library.Events[e].Projects[p].Sequences[s].Spine
+				fixClips(spine.AssetClips)
+				fixVideos(spine.Videos)
+			}
+		}
+	}
+	return fixed
+}
@@ -0,0 +1,57 @@
+package fcp
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestFixFormatMismatches tests that a clip pointing at the wrong format is repointed at its asset's
+func TestFixFormatMismatches(t *testing.T) {
+	videoPath := filepath.Join(t.TempDir(), "clip.mp4")
+	if err := os.WriteFile(videoPath, []byte("fake video data"), 0644); err != nil {
+		t.Fatalf("Failed to create test video: %v", err)
+	}
+
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("GenerateEmpty failed: %v", err)
+	}
+	if err := AddVideo(fcpxml, videoPath); err != nil {
+		t.Fatalf("AddVideo failed: %v", err)
+	}
+
+	sequence := &fcpxml.Library.Events[0].Projects[0].Sequences[0]
+	clip := &sequence.Spine.AssetClips[0]
+	assetFormat := clip.Format
+	clip.Format = sequence.Format
+	if assetFormat == sequence.Format {
+		t.Fatal("Expected the clip's asset format to differ from the sequence format")
+	}
+
+	hasMismatch := func() bool {
+		for _, violation := range ValidateClaudeCompliance(fcpxml) {
+			if strings.Contains(violation, "Format mismatch") {
+				return true
+			}
+		}
+		return false
+	}
+	if !hasMismatch() {
+		t.Fatal("Expected validation to flag the mismatched clip")
+	}
+
+	if fixed := FixFormatMismatches(fcpxml); fixed != 1 {
+		t.Errorf("Expected 1 clip fixed, got %d", fixed)
+	}
+	if clip.Format != assetFormat {
+		t.Errorf("Expected clip format %s, got %s", assetFormat, clip.Format)
+	}
+	if violations := ValidateClaudeCompliance(fcpxml); len(violations) != 0 {
+		t.Errorf("Expected validation to pass after the fix, got %v", violations)
+	}
+	if fixed := FixFormatMismatches(fcpxml); fixed != 0 {
+		t.Errorf("Expected nothing left to fix, got %d", fixed)
+	}
+}
@@ -0,0 +1,237 @@
+package fcp
+
+import (
+	"encoding/csv"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// DefaultBarChartSeconds is how long the bars take to grow to their values
+const DefaultBarChartSeconds = 5.0
+
+// Bar chart layout in adjust-transform units (percent of frame height, origin at frame center)
+const (
+	barChartBaseline     = -30.0 // Bottom edge of every bar
+	barChartMaxHeight    = 65.0  // Height of the tallest bar
+	barChartSpanFraction = 0.8   // Share of the frame width the bars are spread across
+	barChartBarFraction  = 0.6   // Share of each bar's slot the bar fills; the rest is spacing
+	barChartLabelGap     = 6.0   // Distance from the baseline down to the label's center
+)
+
+// barChartColors are cycled through so neighbouring bars are easy to tell apart
+var barChartColors = []string{
+	"0.20 0.55 0.95 1",
+	"0.95 0.55 0.15 1",
+	"0.30 0.80 0.40 1",
+	"0.90 0.25 0.35 1",
+	"0.65 0.40 0.90 1",
+}
+
+// GenerateBarChart builds an animated bar chart: one colored bar per value, spread evenly across
+// the frame, growing from the baseline to its height over durationSeconds, with its label beneath.
+// Heights are relative to the largest value, which fills barChartMaxHeight.
+//
+// 🚨 CLAUDE.md Rules Applied Here:
+// - Bars are the verified Vivid generator, colored with Fill Color and sized with adjust-transform scale
+// - Scale-Y and position keyframe together so each bar's bottom edge stays on the baseline while it grows
+// - Bars and labels are connected to a dark Vivid background on the spine, one lane each
+// - Keyframe times are in the bar's local time (start 0s) → ConvertSecondsToFCPDuration() function
+func GenerateBarChart(values []float64, labels []string, durationSeconds float64) (*FCPXML, error) {
+	if len(values) == 0 {
+		return nil, fmt.Errorf("bar chart needs at least one value")
+	}
+	if len(labels) != len(values) {
+		return nil, fmt.Errorf("bar chart has %d values but %d labels", len(values), len(labels))
+	}
+	if durationSeconds <= 0 {
+		return nil, fmt.Errorf("bar chart duration must be positive, got %.2f", durationSeconds)
+	}
+	maxValue := 0.0
+	for i, value := range values {
+		if value < 0 {
+			return nil, fmt.Errorf("bar %d (%s) is negative: %g", i+1, labels[i], value)
+		}
+		if value > maxValue {
+			maxValue = value
+		}
+	}
+	if maxValue == 0 {
+		return nil, fmt.Errorf("bar chart values are all zero")
+	}
+
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create base FCPXML: %v", err)
+	}
+	sequence, err := firstSequence(fcpxml)
+	if err != nil {
+		return nil, err
+	}
+	width, height, err := sequenceFrameSize(fcpxml, sequence)
+	if err != nil {
+		return nil, err
+	}
+
+	registry := NewResourceRegistry(fcpxml)
+	tx := NewTransaction(registry)
+	defer tx.Rollback()
+
+	ids := tx.ReserveIDs(2)
+	vividID := ids[0]
+	textEffectID := ids[1]
+	if _, err := tx.CreateEffect(vividID, "Vivid", ".../Generators.localized/Solids.localized/Vivid.localized/Vivid.motn"); err != nil {
+		return nil, fmt.Errorf("failed to create Vivid generator: %v", err)
+	}
+	if _, err := tx.CreateEffect(textEffectID, "Text", ".../Titles.localized/Basic Text.localized/Text.localized/Text.moti"); err != nil {
+		return nil, fmt.Errorf("failed to create text effect: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	duration := ConvertSecondsToFCPDuration(durationSeconds)
+	frameWidth := 100 * width / height
+	slot := frameWidth * barChartSpanFraction / float64(len(values))
+	scaleX := slot * barChartBarFraction / frameWidth
+
+	background := Video{
+		Ref:      vividID,
+		Offset:   "0s",
+		Name:     "Bar Chart",
+		Start:    "0s",
+		Duration: duration,
+		Params: []Param{
+			{Name: "Fill Color", Value: "0.08 0.08 0.1 1"},
+		},
+	}
+
+	for i, value := range values {
+		x := -frameWidth*barChartSpanFraction/2 + slot*(float64(i)+0.5)
+		barHeight := barChartMaxHeight * value / maxValue
+
+		background.NestedVideos = append(background.NestedVideos, Video{
+			Ref:      vividID,
+			Lane:     strconv.Itoa(i + 1),
+			Offset:   "0s",
+			Name:     fmt.Sprintf("%s - Bar", labels[i]),
+			Start:    "0s",
+			Duration: duration,
+			Params: []Param{
+				{Name: "Fill Color", Value: barChartColors[i%len(barChartColors)]},
+			},
+			AdjustTransform: &AdjustTransform{
+				Params: []Param{
+					barChartKeyframes("position", duration,
+						fmt.Sprintf("%.4f %.4f", x, barChartBaseline),
+						fmt.Sprintf("%.4f %.4f", x, barChartBaseline+barHeight/2)),
+					barChartKeyframes("scale", duration,
+						fmt.Sprintf("%.4f 0", scaleX),
+						fmt.Sprintf("%.4f %.4f", scaleX, barHeight/100)),
+				},
+			},
+		})
+
+		// Title positions are in pixels rather than percent of frame height
+		textStyleID := GenerateTextStyleID(labels[i], fmt.Sprintf("bar_chart_%d", i))
+		background.NestedTitles = append(background.NestedTitles, Title{
+			Ref:      textEffectID,
+			Lane:     strconv.Itoa(len(values) + i + 1),
+			Offset:   "0s",
+			Name:     labels[i] + " - Label",
+			Duration: duration,
+			Params: []Param{
+				{
+					Name:  "Position",
+					Key:   "9999/10003/13260/3296672360/1/100/101",
+					Value: fmt.Sprintf("%.0f %.0f", x*height/100, (barChartBaseline-barChartLabelGap)*height/100),
+				},
+			},
+			Text: &TitleText{
+				TextStyles: []TextStyleRef{
+					{
+						Ref:  textStyleID,
+						Text: labels[i],
+					},
+				},
+			},
+			TextStyleDefs: []TextStyleDef{
+				{
+					ID: textStyleID,
+					TextStyle: TextStyle{
+						Font:      "Helvetica Neue",
+						FontSize:  "40",
+						FontColor: "1 1 1 1",
+						Alignment: "center",
+					},
+				},
+			},
+		})
+	}
+
+	sequence.Spine.Videos = append(sequence.Spine.Videos, background)
+	sequence.Duration = duration
+
+	violations, _ := SplitValidationWarnings(ValidateClaudeCompliance(fcpxml))
+	if len(violations) > 0 {
+		return nil, fmt.Errorf("ERROR: validation failed with %d violations:\n%s", len(violations), strings.Join(violations, "\n"))
+	}
+
+	return fcpxml, nil
+}
+
+// barChartKeyframes animates an adjust-transform param linearly from `from` at 0s to `to` at duration
+func barChartKeyframes(name, duration, from, to string) Param {
+	return Param{
+		Name: name,
+		KeyframeAnimation: &KeyframeAnimation{
+			Keyframes: []Keyframe{
+				{Time: "0s", Value: from, Curve: "linear"},
+				{Time: duration, Value: to, Curve: "linear"},
+			},
+		},
+	}
+}
+
+// ReadBarChartCSV reads "label,value" rows from a CSV file. A first row whose value isn't a
+// number is treated as a header and skipped.
+func ReadBarChartCSV(path string) ([]float64, []string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open CSV: %v", err)
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	rows, err := reader.ReadAll()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read CSV: %v", err)
+	}
+
+	var values []float64
+	var labels []string
+	for i, row := range rows {
+		if len(row) == 0 || (len(row) == 1 && strings.TrimSpace(row[0]) == "") {
+			continue
+		}
+		if len(row) < 2 {
+			return nil, nil, fmt.Errorf("CSV row %d needs a label and a value", i+1)
+		}
+		value, err := strconv.ParseFloat(strings.TrimSpace(row[1]), 64)
+		if err != nil {
+			if i == 0 {
+				continue
+			}
+			return nil, nil, fmt.Errorf("CSV row %d has an invalid value '%s'", i+1, row[1])
+		}
+		labels = append(labels, strings.TrimSpace(row[0]))
+		values = append(values, value)
+	}
+	if len(values) == 0 {
+		return nil, nil, fmt.Errorf("no label,value rows found in %s", path)
+	}
+	return values, labels, nil
+}
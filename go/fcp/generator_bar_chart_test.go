@@ -0,0 +1,95 @@
+package fcp
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestGenerateBarChart tests that each bar's final scale-Y is proportional to its value
+func TestGenerateBarChart(t *testing.T) {
+	values := []float64{10, 20, 40}
+	labels := []string{"Jan", "Feb", "Mar"}
+
+	fcpxml, err := GenerateBarChart(values, labels, 4)
+	if err != nil {
+		t.Fatalf("GenerateBarChart failed: %v", err)
+	}
+
+	background := fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine.Videos[0]
+	if len(background.NestedVideos) != 3 || len(background.NestedTitles) != 3 {
+		t.Fatalf("Expected 3 bars and 3 labels, got %d and %d", len(background.NestedVideos), len(background.NestedTitles))
+	}
+
+	finalScaleY := make([]float64, len(values))
+	previousX := -1e9
+	for i, bar := range background.NestedVideos {
+		var scale, position *Param
+		for j := range bar.AdjustTransform.Params {
+			switch bar.AdjustTransform.Params[j].Name {
+			case "scale":
+				scale = &bar.AdjustTransform.Params[j]
+			case "position":
+				position = &bar.AdjustTransform.Params[j]
+			}
+		}
+		if scale == nil || position == nil {
+			t.Fatalf("Bar %d: expected scale and position keyframes", i)
+		}
+
+		var startX, startY, endX, endY float64
+		keyframes := scale.KeyframeAnimation.Keyframes
+		fmt.Sscanf(keyframes[0].Value, "%g %g", &startX, &startY)
+		fmt.Sscanf(keyframes[len(keyframes)-1].Value, "%g %g", &endX, &endY)
+		if startY != 0 {
+			t.Errorf("Bar %d: expected scale-Y to start at 0, got %g", i, startY)
+		}
+		finalScaleY[i] = endY
+
+		// The bottom edge stays on the baseline: final center = baseline + half the height
+		var x, y float64
+		positions := position.KeyframeAnimation.Keyframes
+		fmt.Sscanf(positions[len(positions)-1].Value, "%g %g", &x, &y)
+		if diff := y - (barChartBaseline + endY*100/2); diff > 0.001 || diff < -0.001 {
+			t.Errorf("Bar %d: expected center %g, got %g", i, barChartBaseline+endY*100/2, y)
+		}
+		if x <= previousX {
+			t.Errorf("Bar %d: expected bars left to right, x %g after %g", i, x, previousX)
+		}
+		previousX = x
+
+		if background.NestedTitles[i].Text.TextStyles[0].Text != labels[i] {
+			t.Errorf("Bar %d: expected label %s", i, labels[i])
+		}
+	}
+
+	for i := range values {
+		ratio := finalScaleY[i] / finalScaleY[len(values)-1]
+		expected := values[i] / values[len(values)-1]
+		if diff := ratio - expected; diff > 0.001 || diff < -0.001 {
+			t.Errorf("Bar %d: expected height ratio %g, got %g", i, expected, ratio)
+		}
+	}
+
+	if _, err := GenerateBarChart([]float64{1, 2}, []string{"one"}, 4); err == nil {
+		t.Error("Expected an error when labels and values differ in length")
+	}
+}
+
+// TestReadBarChartCSV tests header skipping and label,value parsing
+func TestReadBarChartCSV(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "data.csv")
+	if err := os.WriteFile(path, []byte("month,sales\nJan, 10\nFeb,20.5\n\n"), 0644); err != nil {
+		t.Fatalf("Failed to write CSV: %v", err)
+	}
+
+	values, labels, err := ReadBarChartCSV(path)
+	if err != nil {
+		t.Fatalf("ReadBarChartCSV failed: %v", err)
+	}
+	if strings.Join(labels, ",") != "Jan,Feb" || len(values) != 2 || values[1] != 20.5 {
+		t.Errorf("Unexpected rows: %v %v", labels, values)
+	}
+}
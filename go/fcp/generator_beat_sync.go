@@ -0,0 +1,206 @@
+package fcp
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"os"
+	"os/exec"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Onset detection works on mono 16-bit PCM decoded by ffmpeg at beatSampleRate
+const (
+	beatSampleRate     = 22050
+	beatHopSize        = 512  // ~23ms between energy measurements
+	beatWindowSize     = 1024 // ~46ms energy window
+	beatThresholdSpan  = 20   // hops on each side used for the adaptive threshold
+	beatThresholdRatio = 1.5  // onset must exceed the local mean flux by this factor
+	beatMinSpacing     = 0.25 // seconds; suppresses double triggers inside one beat
+)
+
+// GenerateBeatSync builds a music-video timeline where every image cut lands on a beat of the audio.
+// Beats are detected with DetectBeats (requires ffmpeg); use GenerateBeatSyncWithBeats to supply them.
+func GenerateBeatSync(imagePaths []string, audioPath string) (*FCPXML, error) {
+	beats, err := DetectBeats(audioPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to detect beats (provide a beats file instead): %v", err)
+	}
+	return GenerateBeatSyncWithBeats(imagePaths, audioPath, beats)
+}
+
+// GenerateBeatSyncWithBeats lays images back to back so each cut lands on the given beat times (seconds).
+// The first image starts at 0s and runs to the first beat; images are cycled when there are more beats
+// than images. The timeline ends on the last beat.
+//
+// 🚨 CLAUDE.md Rules Applied Here:
+// - Beat times are snapped to frame boundaries first, so every cut is frame-aligned → (frames*1001)/24000s
+// - Clip durations are frame differences between consecutive cuts, so rounding never drifts off the beat
+// - Images go through AddImage → Video elements; repeated images reuse their asset
+// - Music goes through AddAudio → nested audio asset-clip
+func GenerateBeatSyncWithBeats(imagePaths []string, audioPath string, beats []float64) (*FCPXML, error) {
+	if len(imagePaths) == 0 {
+		return nil, fmt.Errorf("no images provided")
+	}
+
+	cuts := beatCutFrames(beats)
+	if len(cuts) == 0 {
+		return nil, fmt.Errorf("no beats after 0s to cut on")
+	}
+
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create base FCPXML: %v", err)
+	}
+
+	framesPerSecond := 24000.0 / 1001.0
+	prevCut := 0
+	for i, cut := range cuts {
+		imagePath := imagePaths[i%len(imagePaths)]
+		durationSeconds := float64(cut-prevCut) / framesPerSecond
+		if err := AddImage(fcpxml, imagePath, durationSeconds); err != nil {
+			return nil, fmt.Errorf("failed to add image %s: %v", imagePath, err)
+		}
+		prevCut = cut
+	}
+
+	if err := AddAudio(fcpxml, audioPath); err != nil {
+		return nil, fmt.Errorf("failed to add audio: %v", err)
+	}
+
+	return fcpxml, nil
+}
+
+// beatCutFrames converts beat times to sorted, de-duplicated frame numbers after frame 0
+func beatCutFrames(beats []float64) []int {
+	framesPerSecond := 24000.0 / 1001.0
+
+	sorted := append([]float64(nil), beats...)
+	sort.Float64s(sorted)
+
+	var cuts []int
+	for _, beat := range sorted {
+		frame := int(math.Round(beat * framesPerSecond))
+		if frame <= 0 || (len(cuts) > 0 && frame <= cuts[len(cuts)-1]) {
+			continue
+		}
+		cuts = append(cuts, frame)
+	}
+	return cuts
+}
+
+// ReadBeatsFile parses a beats file with one timestamp in seconds per line.
+// Blank lines and lines starting with # are ignored.
+func ReadBeatsFile(path string) ([]float64, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open beats file: %v", err)
+	}
+	defer file.Close()
+
+	var beats []float64
+	scanner := bufio.NewScanner(file)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		beat, err := strconv.ParseFloat(line, 64)
+		if err != nil || beat < 0 {
+			return nil, fmt.Errorf("invalid beat timestamp on line %d: %s", lineNumber, line)
+		}
+		beats = append(beats, beat)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read beats file: %v", err)
+	}
+	if len(beats) == 0 {
+		return nil, fmt.Errorf("beats file has no timestamps: %s", path)
+	}
+
+	return beats, nil
+}
+
+// DetectBeats finds onset times (seconds) in an audio file using an energy-flux onset detector.
+// ffmpeg decodes the audio to mono PCM; the detector itself runs in Go.
+func DetectBeats(audioPath string) ([]float64, error) {
+	if _, err := os.Stat(audioPath); err != nil {
+		return nil, fmt.Errorf("audio file does not exist: %s", audioPath)
+	}
+
+	cmd := exec.Command("ffmpeg", "-v", "quiet", "-i", audioPath, "-ac", "1", "-ar", strconv.Itoa(beatSampleRate), "-f", "s16le", "-")
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("ffmpeg failed to decode audio: %v", err)
+	}
+
+	samples := make([]float64, len(output)/2)
+	for i := range samples {
+		samples[i] = float64(int16(binary.LittleEndian.Uint16(output[i*2:]))) / 32768.0
+	}
+
+	beats := detectOnsets(samples, beatSampleRate)
+	if len(beats) == 0 {
+		return nil, fmt.Errorf("no beats detected in %s", audioPath)
+	}
+	return beats, nil
+}
+
+// detectOnsets returns times where the rise in short-time energy peaks above an adaptive threshold
+func detectOnsets(samples []float64, sampleRate int) []float64 {
+	if len(samples) < beatWindowSize {
+		return nil
+	}
+
+	var energies []float64
+	for start := 0; start+beatWindowSize <= len(samples); start += beatHopSize {
+		sum := 0.0
+		for _, s := range samples[start : start+beatWindowSize] {
+			sum += s * s
+		}
+		energies = append(energies, math.Sqrt(sum/beatWindowSize))
+	}
+
+	// Only energy increases mark an onset
+	flux := make([]float64, len(energies))
+	for i := 1; i < len(energies); i++ {
+		if rise := energies[i] - energies[i-1]; rise > 0 {
+			flux[i] = rise
+		}
+	}
+
+	hopSeconds := float64(beatHopSize) / float64(sampleRate)
+	var onsets []float64
+	lastOnset := -beatMinSpacing
+	for i := 1; i < len(flux)-1; i++ {
+		if flux[i] <= flux[i-1] || flux[i] < flux[i+1] {
+			continue
+		}
+
+		lo, hi := i-beatThresholdSpan, i+beatThresholdSpan
+		if lo < 0 {
+			lo = 0
+		}
+		if hi > len(flux)-1 {
+			hi = len(flux) - 1
+		}
+		mean := 0.0
+		for _, f := range flux[lo : hi+1] {
+			mean += f
+		}
+		mean /= float64(hi - lo + 1)
+
+		t := float64(i) * hopSeconds
+		if flux[i] > mean*beatThresholdRatio && t-lastOnset >= beatMinSpacing {
+			onsets = append(onsets, t)
+			lastOnset = t
+		}
+	}
+
+	return onsets
+}
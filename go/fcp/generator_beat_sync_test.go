@@ -0,0 +1,116 @@
+package fcp
+
+import (
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestGenerateBeatSyncWithBeatsFile tests that image cuts land on the beats from a hand-written beats file
+func TestGenerateBeatSyncWithBeatsFile(t *testing.T) {
+	tempDir := t.TempDir()
+
+	var images []string
+	for _, name := range []string{"a.png", "b.png"} {
+		path := filepath.Join(tempDir, name)
+		if err := os.WriteFile(path, []byte("fake png data"), 0644); err != nil {
+			t.Fatalf("Failed to create test image: %v", err)
+		}
+		images = append(images, path)
+	}
+
+	audioPath := filepath.Join(tempDir, "song.wav")
+	if err := os.WriteFile(audioPath, []byte("fake wav data"), 0644); err != nil {
+		t.Fatalf("Failed to create test audio: %v", err)
+	}
+
+	beatsPath := filepath.Join(tempDir, "beats.txt")
+	beatsFile := "# 120 bpm\n0.5\n1.0\n\n1.5\n2.0\n"
+	if err := os.WriteFile(beatsPath, []byte(beatsFile), 0644); err != nil {
+		t.Fatalf("Failed to create beats file: %v", err)
+	}
+
+	beats, err := ReadBeatsFile(beatsPath)
+	if err != nil {
+		t.Fatalf("ReadBeatsFile failed: %v", err)
+	}
+	if len(beats) != 4 {
+		t.Fatalf("Expected 4 beats, got %d", len(beats))
+	}
+
+	fcpxml, err := GenerateBeatSyncWithBeats(images, audioPath, beats)
+	if err != nil {
+		t.Fatalf("GenerateBeatSyncWithBeats failed: %v", err)
+	}
+
+	videos := fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine.Videos
+	if len(videos) != 4 {
+		t.Fatalf("Expected 4 image clips (images cycled per beat), got %d", len(videos))
+	}
+
+	// Each clip after the first starts on the previous beat, within one frame
+	frame := 1001.0 / 24000.0
+	for i := 1; i < len(videos); i++ {
+		offset := float64(parseFCPDuration(videos[i].Offset)) / 24000.0
+		if math.Abs(offset-beats[i-1]) > frame/2 {
+			t.Errorf("Clip %d starts at %.4fs, expected beat %.4fs", i, offset, beats[i-1])
+		}
+		if parseFCPDuration(videos[i].Offset)%1001 != 0 {
+			t.Errorf("Clip %d offset %s is not frame-aligned", i, videos[i].Offset)
+		}
+	}
+
+	// Images alternate a, b, a, b
+	if videos[0].Ref != videos[2].Ref || videos[1].Ref != videos[3].Ref || videos[0].Ref == videos[1].Ref {
+		t.Errorf("Expected images to cycle, got refs %s %s %s %s", videos[0].Ref, videos[1].Ref, videos[2].Ref, videos[3].Ref)
+	}
+
+	end := parseFCPDuration(videos[3].Offset) + parseFCPDuration(videos[3].Duration)
+	if math.Abs(float64(end)/24000.0-2.0) > frame/2 {
+		t.Errorf("Expected timeline to end on the last beat, got %.4fs", float64(end)/24000.0)
+	}
+
+	if len(videos[0].NestedAssetClips) != 1 {
+		t.Errorf("Expected music nested in the first clip, got %d audio clips", len(videos[0].NestedAssetClips))
+	}
+
+	if err := WriteToFile(fcpxml, filepath.Join(tempDir, "beat_sync.fcpxml")); err != nil {
+		t.Errorf("Beat sync timeline failed validation: %v", err)
+	}
+}
+
+// TestReadBeatsFileInvalid tests that malformed timestamps are reported with their line
+func TestReadBeatsFileInvalid(t *testing.T) {
+	beatsPath := filepath.Join(t.TempDir(), "beats.txt")
+	if err := os.WriteFile(beatsPath, []byte("0.5\nnot-a-beat\n"), 0644); err != nil {
+		t.Fatalf("Failed to create beats file: %v", err)
+	}
+
+	if _, err := ReadBeatsFile(beatsPath); err == nil {
+		t.Errorf("Expected error for invalid beat timestamp")
+	}
+}
+
+// TestDetectOnsets tests the onset detector on synthetic clicks
+func TestDetectOnsets(t *testing.T) {
+	sampleRate := beatSampleRate
+	samples := make([]float64, sampleRate*3)
+	for _, click := range []float64{0.5, 1.0, 1.5, 2.0, 2.5} {
+		start := int(click * float64(sampleRate))
+		for i := start; i < start+sampleRate/20; i++ {
+			samples[i] = 0.8 * math.Sin(float64(i-start)*0.3)
+		}
+	}
+
+	onsets := detectOnsets(samples, sampleRate)
+	if len(onsets) != 5 {
+		t.Fatalf("Expected 5 onsets, got %d: %v", len(onsets), onsets)
+	}
+	for i, onset := range onsets {
+		expected := 0.5 * float64(i+1)
+		if math.Abs(onset-expected) > 0.05 {
+			t.Errorf("Onset %d at %.3fs, expected %.3fs", i, onset, expected)
+		}
+	}
+}
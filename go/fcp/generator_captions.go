@@ -0,0 +1,216 @@
+package fcp
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// TimedCaption is a caption shown from StartSeconds for DurationSeconds, relative to its clip
+type TimedCaption struct {
+	Text            string
+	StartSeconds    float64
+	DurationSeconds float64
+}
+
+// AddVideoWithCaptions adds a video like AddVideo, then nests one caption title per entry in the
+// new asset-clip so the captions travel with the clip.
+//
+// 🚨 CLAUDE.md Rules Applied Here:
+// - Captions are nested titles inside the asset-clip (DTD-valid connected clips, lane 1)
+// - Caption offsets are in the clip's local time → start + ConvertSecondsToFCPDuration(caption start)
+// - A caption that runs past the end of the clip is an error, not silently clipped
+// - The Text effect is shared/reused and created via the ResourceRegistry/Transaction pattern
+func AddVideoWithCaptions(fcpxml *FCPXML, videoPath string, captions []TimedCaption) error {
+	for i, caption := range captions {
+		if strings.TrimSpace(caption.Text) == "" {
+			return fmt.Errorf("caption %d has no text", i+1)
+		}
+		if caption.StartSeconds < 0 || caption.DurationSeconds <= 0 {
+			return fmt.Errorf("caption %d has invalid timing (start %.3fs, duration %.3fs)", i+1, caption.StartSeconds, caption.DurationSeconds)
+		}
+	}
+
+	sequence := &fcpxml.Library.Events[0].Projects[0].Sequences[0]
+	previousDuration := sequence.Duration
+	previousAssets := len(fcpxml.Resources.Assets)
+	previousFormats := len(fcpxml.Resources.Formats)
+
+	if err := AddVideo(fcpxml, videoPath); err != nil {
+		return err
+	}
+
+	clipIndex := len(sequence.Spine.AssetClips) - 1
+	clip := &sequence.Spine.AssetClips[clipIndex]
+	clipFrames := parseFCPDuration(clip.Duration)
+
+	for i, caption := range captions {
+		captionStart := parseFCPDuration(ConvertSecondsToFCPDuration(caption.StartSeconds))
+		captionDuration := parseFCPDuration(ConvertSecondsToFCPDuration(caption.DurationSeconds))
+		if captionStart+captionDuration > clipFrames {
+			// Undo the clip and the asset and format AddVideo created, so a bad caption file
+			// doesn't leave an uncaptioned video or unused resources behind
+			sequence.Spine.AssetClips = sequence.Spine.AssetClips[:clipIndex]
+			sequence.Duration = previousDuration
+			fcpxml.Resources.Assets = fcpxml.Resources.Assets[:previousAssets]
+			fcpxml.Resources.Formats = fcpxml.Resources.Formats[:previousFormats]
+			return fmt.Errorf("caption %d (%.3fs-%.3fs) extends past the end of the video (%s)", i+1, caption.StartSeconds, caption.StartSeconds+caption.DurationSeconds, clip.Duration)
+		}
+	}
+
+	registry := NewResourceRegistry(fcpxml)
+	tx := NewTransaction(registry)
+	defer tx.Rollback()
+
+	textEffectID := findEffectIDByUID(fcpxml, ".../Titles.localized/Basic Text.localized/Text.localized/Text.moti")
+	if textEffectID == "" {
+		textEffectID = tx.ReserveIDs(1)[0]
+		if _, err := tx.CreateEffect(textEffectID, "Text", ".../Titles.localized/Basic Text.localized/Text.localized/Text.moti"); err != nil {
+			return fmt.Errorf("failed to create text effect: %v", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	clipStart := parseFCPDuration(clip.Start)
+	for i, caption := range captions {
+		offset := clipStart + parseFCPDuration(ConvertSecondsToFCPDuration(caption.StartSeconds))
+		clip.Titles = append(clip.Titles, createCaptionTitle(textEffectID, caption, formatFrameAlignedTime(offset), fmt.Sprintf("caption_%s_%d", clip.Name, i)))
+	}
+
+	return nil
+}
+
+// createCaptionTitle builds a bottom-centered subtitle-style title
+func createCaptionTitle(textEffectID string, caption TimedCaption, offset string, baseName string) Title {
+	textStyleID := GenerateTextStyleID(caption.Text, baseName)
+
+	return Title{
+		Ref:      textEffectID,
+		Lane:     "1",
+		Offset:   offset,
+		Name:     caption.Text + " - Caption",
+		Start:    "86486400/24000s",
+		Duration: ConvertSecondsToFCPDuration(caption.DurationSeconds),
+		Params: []Param{
+			{
+				Name:  "Position",
+				Key:   "9999/10003/13260/3296672360/1/100/101",
+				Value: "0 -1600", // Lower third, inside title safe
+			},
+			{
+				Name:  "Alignment",
+				Key:   "9999/10003/13260/3296672360/2/354/3296667315/401",
+				Value: "1 (Center)",
+			},
+		},
+		Text: &TitleText{
+			TextStyles: []TextStyleRef{
+				{
+					Ref:  textStyleID,
+					Text: caption.Text,
+				},
+			},
+		},
+		TextStyleDefs: []TextStyleDef{
+			{
+				ID: textStyleID,
+				TextStyle: TextStyle{
+					Font:         "Helvetica Neue",
+					FontSize:     "110",
+					FontFace:     "Medium",
+					FontColor:    "1 1 1 1",
+					Alignment:    "center",
+					ShadowColor:  DefaultTextShadowColor,
+					ShadowOffset: DefaultTextShadowOffset,
+				},
+			},
+		},
+	}
+}
+
+// ReadSRTCaptions parses a SubRip (.srt) file into timed captions.
+// Multi-line cue text is joined with newlines; cue numbers are optional.
+func ReadSRTCaptions(srtPath string) ([]TimedCaption, error) {
+	file, err := os.Open(srtPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open SRT file: %v", err)
+	}
+	defer file.Close()
+
+	var captions []TimedCaption
+	var current *TimedCaption
+	var lines []string
+
+	flush := func() {
+		if current != nil && len(lines) > 0 {
+			current.Text = strings.Join(lines, "\n")
+			captions = append(captions, *current)
+		}
+		current = nil
+		lines = nil
+	}
+
+	scanner := bufio.NewScanner(file)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(strings.TrimPrefix(scanner.Text(), "\ufeff"))
+
+		switch {
+		case line == "":
+			flush()
+		case strings.Contains(line, "-->"):
+			flush()
+			parts := strings.SplitN(line, "-->", 2)
+			start, err := parseSRTTimestamp(parts[0])
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %v", lineNumber, err)
+			}
+			endFields := strings.Fields(parts[1]) // Cue settings may follow the end time
+			if len(endFields) == 0 {
+				return nil, fmt.Errorf("line %d: missing cue end time", lineNumber)
+			}
+			end, err := parseSRTTimestamp(endFields[0])
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %v", lineNumber, err)
+			}
+			if end <= start {
+				return nil, fmt.Errorf("line %d: cue ends before it starts", lineNumber)
+			}
+			current = &TimedCaption{StartSeconds: start, DurationSeconds: end - start}
+		case current != nil:
+			lines = append(lines, line)
+		}
+		// Anything else outside a cue (the cue number) is ignored
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read SRT file: %v", err)
+	}
+	flush()
+
+	if len(captions) == 0 {
+		return nil, fmt.Errorf("no captions found in %s", srtPath)
+	}
+	return captions, nil
+}
+
+// parseSRTTimestamp parses "HH:MM:SS,mmm" (a '.' separator is accepted too) into seconds
+func parseSRTTimestamp(value string) (float64, error) {
+	value = strings.Replace(strings.TrimSpace(value), ",", ".", 1)
+	parts := strings.Split(value, ":")
+	if len(parts) != 3 {
+		return 0, fmt.Errorf("invalid SRT timestamp '%s'", value)
+	}
+
+	hours, err1 := strconv.Atoi(parts[0])
+	minutes, err2 := strconv.Atoi(parts[1])
+	seconds, err3 := strconv.ParseFloat(parts[2], 64)
+	if err1 != nil || err2 != nil || err3 != nil {
+		return 0, fmt.Errorf("invalid SRT timestamp '%s'", value)
+	}
+
+	return float64(hours*3600+minutes*60) + seconds, nil
+}
@@ -0,0 +1,95 @@
+package fcp
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestAddVideoWithCaptions tests that captions become nested titles at clip-relative offsets
+func TestAddVideoWithCaptions(t *testing.T) {
+	tempDir := t.TempDir()
+	videoPath := filepath.Join(tempDir, "interview.mp4")
+	if err := os.WriteFile(videoPath, []byte("fake video data"), 0644); err != nil {
+		t.Fatalf("Failed to create test video: %v", err)
+	}
+
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("GenerateEmpty failed: %v", err)
+	}
+
+	captions := []TimedCaption{
+		{Text: "Hello there", StartSeconds: 1, DurationSeconds: 2},
+		{Text: "Welcome back", StartSeconds: 4.5, DurationSeconds: 3},
+	}
+	if err := AddVideoWithCaptions(fcpxml, videoPath, captions); err != nil {
+		t.Fatalf("AddVideoWithCaptions failed: %v", err)
+	}
+
+	clips := fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine.AssetClips
+	if len(clips) != 1 {
+		t.Fatalf("Expected 1 asset-clip, got %d", len(clips))
+	}
+	titles := clips[0].Titles
+	if len(titles) != 2 {
+		t.Fatalf("Expected 2 nested caption titles, got %d", len(titles))
+	}
+
+	clipStart := parseFCPDuration(clips[0].Start)
+	for i, caption := range captions {
+		expectedOffset := clipStart + parseFCPDuration(ConvertSecondsToFCPDuration(caption.StartSeconds))
+		if parseFCPDuration(titles[i].Offset) != expectedOffset {
+			t.Errorf("Caption %d: expected offset %d, got %s", i, expectedOffset, titles[i].Offset)
+		}
+		if titles[i].Duration != ConvertSecondsToFCPDuration(caption.DurationSeconds) {
+			t.Errorf("Caption %d: expected duration %s, got %s", i, ConvertSecondsToFCPDuration(caption.DurationSeconds), titles[i].Duration)
+		}
+		if titles[i].Lane != "1" || titles[i].Text.TextStyles[0].Text != caption.Text {
+			t.Errorf("Caption %d: unexpected lane/text %s/%q", i, titles[i].Lane, titles[i].Text.TextStyles[0].Text)
+		}
+	}
+
+	// A caption past the end of the (10s) clip is rejected and neither the clip nor the new
+	// video's asset and format are left behind
+	assets, formats := len(fcpxml.Resources.Assets), len(fcpxml.Resources.Formats)
+	otherPath := filepath.Join(filepath.Dir(videoPath), "other.mp4")
+	if err := os.WriteFile(otherPath, []byte("other fake video data"), 0644); err != nil {
+		t.Fatalf("Failed to create test video: %v", err)
+	}
+	err = AddVideoWithCaptions(fcpxml, otherPath, []TimedCaption{{Text: "Too late", StartSeconds: 9, DurationSeconds: 5}})
+	if err == nil || !strings.Contains(err.Error(), "extends past the end") {
+		t.Errorf("Expected overlong caption error, got %v", err)
+	}
+	if len(fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine.AssetClips) != 1 {
+		t.Error("Expected the rejected clip to be removed again")
+	}
+	if len(fcpxml.Resources.Assets) != assets || len(fcpxml.Resources.Formats) != formats {
+		t.Errorf("Expected the rejected video's resources removed, got %d assets and %d formats (was %d and %d)",
+			len(fcpxml.Resources.Assets), len(fcpxml.Resources.Formats), assets, formats)
+	}
+}
+
+// TestReadSRTCaptions tests SubRip parsing of timestamps and multi-line cues
+func TestReadSRTCaptions(t *testing.T) {
+	srtPath := filepath.Join(t.TempDir(), "captions.srt")
+	content := "1\n00:00:01,000 --> 00:00:03,500\nFirst line\nsecond line\n\n2\n00:01:02,250 --> 00:01:04,000 X1:10\nLater\n"
+	if err := os.WriteFile(srtPath, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write SRT: %v", err)
+	}
+
+	captions, err := ReadSRTCaptions(srtPath)
+	if err != nil {
+		t.Fatalf("ReadSRTCaptions failed: %v", err)
+	}
+	if len(captions) != 2 {
+		t.Fatalf("Expected 2 captions, got %d", len(captions))
+	}
+	if captions[0].Text != "First line\nsecond line" || captions[0].StartSeconds != 1 || captions[0].DurationSeconds != 2.5 {
+		t.Errorf("Unexpected first caption: %+v", captions[0])
+	}
+	if captions[1].StartSeconds != 62.25 || captions[1].DurationSeconds != 1.75 {
+		t.Errorf("Unexpected second caption timing: %+v", captions[1])
+	}
+}
@@ -0,0 +1,289 @@
+package fcp
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// contactSheetDuration is how long the contact sheet grid stays on screen
+const contactSheetDuration = 5.0
+
+// GridCell describes one cell of a grid layout in adjust-transform units
+// (percent of frame height, origin at frame center, +Y up)
+type GridCell struct {
+	X      float64
+	Y      float64
+	Width  float64
+	Height float64
+	Scale  float64 // Scale that fits a full-frame clip inside the cell with a small margin
+}
+
+// calculateGridCells lays out count cells in a near-square grid that covers a frame
+// of the given aspect ratio (width/height). Cells are ordered left-to-right, top-to-bottom.
+func calculateGridCells(count int, aspect float64) []GridCell {
+	if count <= 0 {
+		return nil
+	}
+
+	cols := int(math.Ceil(math.Sqrt(float64(count))))
+	rows := int(math.Ceil(float64(count) / float64(cols)))
+	return gridCells(rows, cols, count, aspect)
+}
+
+// gridCells lays out the first count cells of a rows x cols grid covering a frame of the given
+// aspect ratio, left-to-right, top-to-bottom
+func gridCells(rows, cols, count int, aspect float64) []GridCell {
+	frameWidth := 100.0 * aspect
+	frameHeight := 100.0
+	cellWidth := frameWidth / float64(cols)
+	cellHeight := frameHeight / float64(rows)
+
+	// Full-frame clips have the frame's aspect, so the tighter of the two axes decides the fit
+	scale := math.Min(1.0/float64(cols), 1.0/float64(rows)) * 0.9
+
+	cells := make([]GridCell, count)
+	for i := 0; i < count; i++ {
+		col := i % cols
+		row := i / cols
+		cells[i] = GridCell{
+			X:      -frameWidth/2 + cellWidth*(float64(col)+0.5),
+			Y:      frameHeight/2 - cellHeight*(float64(row)+0.5),
+			Width:  cellWidth,
+			Height: cellHeight,
+			Scale:  scale,
+		}
+	}
+
+	return cells
+}
+
+// GenerateContactSheet builds a new FCPXML that shows every image and video asset of an
+// existing project laid out in a grid, each cell labeled with the asset name.
+//
+// 🚨 CLAUDE.md Rules Applied Here:
+// - Images are nested as <video> elements, videos as <asset-clip> elements (never AssetClip for images)
+// - Assets are re-created through the Transaction so the sheet gets fresh, valid resource IDs
+// - Offline assets (source file missing on disk) are skipped with a warning instead of referenced
+func GenerateContactSheet(source *FCPXML) (*FCPXML, error) {
+	if source == nil {
+		return nil, fmt.Errorf("source FCPXML is nil")
+	}
+
+	type sheetEntry struct {
+		asset  Asset
+		path   string
+		format *Format
+	}
+
+	sourceFormats := make(map[string]*Format)
+	for i := range source.Resources.Formats {
+		sourceFormats[source.Resources.Formats[i].ID] = &source.Resources.Formats[i]
+	}
+
+	var entries []sheetEntry
+	seenPaths := make(map[string]bool)
+	for _, asset := range source.Resources.Assets {
+		// Audio-only assets have nothing to show
+		if asset.HasVideo != "1" {
+			continue
+		}
+
+		path := strings.TrimPrefix(asset.MediaRep.Src, "file://")
+		if path == "" || seenPaths[path] {
+			continue
+		}
+
+		if _, err := os.Stat(path); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: skipping offline asset '%s' (%s): %v\n", asset.Name, path, err)
+			continue
+		}
+
+		seenPaths[path] = true
+		entries = append(entries, sheetEntry{asset: asset, path: path, format: sourceFormats[asset.Format]})
+	}
+
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("no online image or video assets found to build a contact sheet")
+	}
+
+	sheet, err := GenerateEmpty("")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create base FCPXML: %v", err)
+	}
+
+	registry := NewResourceRegistry(sheet)
+	tx := NewTransaction(registry)
+	defer tx.Rollback()
+
+	ids := tx.ReserveIDs(2)
+	backgroundID := ids[0]
+	textEffectID := ids[1]
+
+	if _, err := tx.CreateEffect(backgroundID, "Vivid", ".../Generators.localized/Solids.localized/Vivid.localized/Vivid.motn"); err != nil {
+		return nil, fmt.Errorf("failed to create background generator: %v", err)
+	}
+	if _, err := tx.CreateEffect(textEffectID, "Text", ".../Titles.localized/Basic Text.localized/Text.localized/Text.moti"); err != nil {
+		return nil, fmt.Errorf("failed to create text effect: %v", err)
+	}
+
+	sheetFormat := sheet.Resources.Formats[0]
+	aspect := 16.0 / 9.0
+	var frameHeightPixels float64 = 720
+	if w, h := parseFormatDimension(sheetFormat.Width), parseFormatDimension(sheetFormat.Height); w > 0 && h > 0 {
+		aspect = w / h
+		frameHeightPixels = h
+	}
+
+	cells := calculateGridCells(len(entries), aspect)
+	sheetDuration := ConvertSecondsToFCPDuration(contactSheetDuration)
+
+	var nestedVideos []Video
+	var nestedClips []AssetClip
+	var nestedTitles []Title
+	lane := 1
+
+	for i, entry := range entries {
+		cell := cells[i]
+		cellIDs := tx.ReserveIDs(2)
+		assetID := cellIDs[0]
+		formatID := cellIDs[1]
+
+		name := entry.asset.Name
+		if name == "" {
+			name = strings.TrimSuffix(filepath.Base(entry.path), filepath.Ext(entry.path))
+		}
+
+		transform := &AdjustTransform{
+			Position: fmt.Sprintf("%.4f %.4f", cell.X, cell.Y),
+			Scale:    fmt.Sprintf("%.4f %.4f", cell.Scale, cell.Scale),
+		}
+
+		if isImageFile(entry.path) {
+			width, height := "1280", "720"
+			if entry.format != nil && entry.format.Width != "" && entry.format.Height != "" {
+				width, height = entry.format.Width, entry.format.Height
+			}
+			if _, err := tx.CreateFormat(formatID, "FFVideoFormatRateUndefined", width, height, "1-13-1"); err != nil {
+				return nil, fmt.Errorf("failed to create image format for %s: %v", name, err)
+			}
+			if _, err := tx.CreateAsset(assetID, entry.path, name, "0s", formatID); err != nil {
+				return nil, fmt.Errorf("failed to create image asset for %s: %v", name, err)
+			}
+
+			nestedVideos = append(nestedVideos, Video{
+				Ref:             assetID,
+				Lane:            fmt.Sprintf("%d", lane),
+				Offset:          "0s",
+				Name:            name,
+				Duration:        sheetDuration,
+				AdjustTransform: transform,
+			})
+		} else {
+			assetDuration := entry.asset.Duration
+			if assetDuration == "" || assetDuration == "0s" {
+				assetDuration = sheetDuration
+			}
+			if err := tx.CreateVideoAssetWithDetection(assetID, entry.path, name, assetDuration, formatID); err != nil {
+				return nil, fmt.Errorf("failed to create video asset for %s: %v", name, err)
+			}
+
+			clipDuration := sheetDuration
+			if parseFCPDuration(assetDuration) < parseFCPDuration(sheetDuration) {
+				clipDuration = assetDuration
+			}
+
+			nestedClips = append(nestedClips, AssetClip{
+				Ref:             assetID,
+				Lane:            fmt.Sprintf("%d", lane),
+				Offset:          "0s",
+				Name:            name,
+				Duration:        clipDuration,
+				Format:          formatID,
+				TCFormat:        "NDF",
+				AdjustTransform: transform,
+			})
+		}
+		lane++
+
+		// Label sits near the bottom edge of the cell; title positions are in pixels
+		labelY := (cell.Y - cell.Height*0.4) * frameHeightPixels / 100.0
+		labelX := cell.X * frameHeightPixels / 100.0
+		textStyleID := GenerateTextStyleID(name, fmt.Sprintf("contact_sheet_%d", i))
+
+		nestedTitles = append(nestedTitles, Title{
+			Ref:      textEffectID,
+			Lane:     fmt.Sprintf("%d", lane),
+			Offset:   "0s",
+			Name:     name + " - Label",
+			Duration: sheetDuration,
+			Params: []Param{
+				{
+					Name:  "Position",
+					Key:   "9999/10003/13260/3296672360/1/100/101",
+					Value: fmt.Sprintf("%.0f %.0f", labelX, labelY),
+				},
+			},
+			Text: &TitleText{
+				TextStyles: []TextStyleRef{
+					{
+						Ref:  textStyleID,
+						Text: name,
+					},
+				},
+			},
+			TextStyleDefs: []TextStyleDef{
+				{
+					ID: textStyleID,
+					TextStyle: TextStyle{
+						Font:      "Helvetica Neue",
+						FontSize:  "36",
+						FontColor: "1 1 1 1",
+						Alignment: "center",
+					},
+				},
+			},
+		})
+		lane++
+	}
+
+	background := Video{
+		Ref:      backgroundID,
+		Offset:   "0s",
+		Name:     "Contact Sheet",
+		Start:    "0s",
+		Duration: sheetDuration,
+		Params: []Param{
+			{Name: "Fill Color", Value: "0.1 0.1 0.1"},
+		},
+		NestedVideos:     nestedVideos,
+		NestedAssetClips: nestedClips,
+		NestedTitles:     nestedTitles,
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	sequence := &sheet.Library.Events[0].Projects[0].Sequences[0]
+	sequence.Spine.Videos = append(sequence.Spine.Videos, background)
+	sequence.Duration = sheetDuration
+
+	violations, _ := SplitValidationWarnings(ValidateClaudeCompliance(sheet))
+	if len(violations) > 0 {
+		return nil, fmt.Errorf("ERROR: validation failed with %d violations:\n%s", len(violations), strings.Join(violations, "\n"))
+	}
+
+	return sheet, nil
+}
+
+// parseFormatDimension parses a format width/height attribute, returning 0 when unset or invalid
+func parseFormatDimension(value string) float64 {
+	var dimension float64
+	if _, err := fmt.Sscanf(value, "%g", &dimension); err != nil {
+		return 0
+	}
+	return dimension
+}
@@ -0,0 +1,131 @@
+package fcp
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestGenerateContactSheet tests that every asset of a project becomes a labeled grid cell
+func TestGenerateContactSheet(t *testing.T) {
+	tempDir := t.TempDir()
+
+	source, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("Failed to generate empty FCPXML: %v", err)
+	}
+
+	var names []string
+	for i := 0; i < 6; i++ {
+		name := fmt.Sprintf("still_%d", i+1)
+		imagePath := filepath.Join(tempDir, name+".png")
+		if err := os.WriteFile(imagePath, []byte("fake png data"), 0644); err != nil {
+			t.Fatalf("Failed to create test image: %v", err)
+		}
+		if err := AddImage(source, imagePath, 3.0); err != nil {
+			t.Fatalf("Failed to add image %d: %v", i+1, err)
+		}
+		names = append(names, name)
+	}
+
+	sheet, err := GenerateContactSheet(source)
+	if err != nil {
+		t.Fatalf("GenerateContactSheet failed: %v", err)
+	}
+
+	spine := sheet.Library.Events[0].Projects[0].Sequences[0].Spine
+	if len(spine.Videos) != 1 {
+		t.Fatalf("Expected 1 background video in spine, got %d", len(spine.Videos))
+	}
+
+	background := spine.Videos[0]
+	if len(background.NestedVideos) != 6 {
+		t.Fatalf("Expected 6 grid cells, got %d", len(background.NestedVideos))
+	}
+	if len(background.NestedTitles) != 6 {
+		t.Fatalf("Expected 6 name labels, got %d", len(background.NestedTitles))
+	}
+
+	positions := make(map[string]bool)
+	for i, cell := range background.NestedVideos {
+		if cell.AdjustTransform == nil || cell.AdjustTransform.Position == "" {
+			t.Fatalf("Cell %d has no position", i)
+		}
+		if positions[cell.AdjustTransform.Position] {
+			t.Errorf("Cell %d reuses position %s", i, cell.AdjustTransform.Position)
+		}
+		positions[cell.AdjustTransform.Position] = true
+
+		if cell.Name != names[i] {
+			t.Errorf("Expected cell %d to be %s, got %s", i, names[i], cell.Name)
+		}
+
+		label := background.NestedTitles[i]
+		if label.Text == nil || len(label.Text.TextStyles) == 0 || label.Text.TextStyles[0].Text != names[i] {
+			t.Errorf("Expected label %d to read %s", i, names[i])
+		}
+	}
+
+	if err := WriteToFile(sheet, filepath.Join(tempDir, "sheet.fcpxml")); err != nil {
+		t.Errorf("Contact sheet failed validation: %v", err)
+	}
+}
+
+// TestGenerateContactSheetSkipsOfflineAssets tests that missing media is skipped rather than referenced
+func TestGenerateContactSheetSkipsOfflineAssets(t *testing.T) {
+	tempDir := t.TempDir()
+
+	source, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("Failed to generate empty FCPXML: %v", err)
+	}
+
+	onlinePath := filepath.Join(tempDir, "online.png")
+	offlinePath := filepath.Join(tempDir, "offline.png")
+	for _, path := range []string{onlinePath, offlinePath} {
+		if err := os.WriteFile(path, []byte("fake png data"), 0644); err != nil {
+			t.Fatalf("Failed to create test image: %v", err)
+		}
+		if err := AddImage(source, path, 3.0); err != nil {
+			t.Fatalf("Failed to add image: %v", err)
+		}
+	}
+	os.Remove(offlinePath)
+
+	sheet, err := GenerateContactSheet(source)
+	if err != nil {
+		t.Fatalf("GenerateContactSheet failed: %v", err)
+	}
+
+	background := sheet.Library.Events[0].Projects[0].Sequences[0].Spine.Videos[0]
+	if len(background.NestedVideos) != 1 {
+		t.Fatalf("Expected only the online asset in the sheet, got %d cells", len(background.NestedVideos))
+	}
+	for _, asset := range sheet.Resources.Assets {
+		if strings.Contains(asset.MediaRep.Src, "offline.png") {
+			t.Errorf("Offline asset should not be referenced by the contact sheet")
+		}
+	}
+}
+
+// TestCalculateGridCells tests grid layout dimensions
+func TestCalculateGridCells(t *testing.T) {
+	cells := calculateGridCells(6, 16.0/9.0)
+	if len(cells) != 6 {
+		t.Fatalf("Expected 6 cells, got %d", len(cells))
+	}
+
+	// 6 cells -> 3 columns x 2 rows
+	if cells[0].Y <= cells[3].Y {
+		t.Errorf("Expected second row below first row, got %.2f vs %.2f", cells[0].Y, cells[3].Y)
+	}
+	if cells[0].X >= cells[1].X {
+		t.Errorf("Expected cells to advance left to right, got %.2f vs %.2f", cells[0].X, cells[1].X)
+	}
+
+	if calculateGridCells(0, 16.0/9.0) != nil {
+		t.Errorf("Expected no cells for zero count")
+	}
+}
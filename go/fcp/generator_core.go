@@ -125,14 +125,12 @@ func AddSlideToVideoAtOffset(fcpxml *FCPXML, offsetSeconds float64) error {
 			}
 		}
 
-		videoStartFrames := parseFCPDuration(targetVideo.Start)
-		if videoStartFrames == 0 {
-
-			videoStartFrames = 86399313
-			targetVideo.Start = "86399313/24000s"
+		imageStart, rate := sequenceImageStart(fcpxml, sequence, 0)
+		if parseFCPDuration(targetVideo.Start) == 0 {
+			targetVideo.Start = imageStart
 		}
 
-		targetVideo.AdjustTransform = createKenBurnsAnimation(targetVideo.Offset, 1.0)
+		targetVideo.AdjustTransform = createKenBurnsAnimationWithFormatIndex(targetVideo.Offset, 1.0, "horizontal", 0, targetVideo.Start, rate)
 	}
 
 	if targetClip != nil {
@@ -372,3 +370,32 @@ func addAudioAssetClipToSpine(fcpxml *FCPXML, asset *Asset) error {
 
 	return nil
 }
+
+// AddGap appends a gap (black video, silent audio) to the end of the spine for intentional pauses between clips.
+//
+// 🚨 CLAUDE.md Rules Applied Here:
+// - Uses STRUCTS ONLY → append to sequence.Spine.Gaps, no XML string templates
+// - Uses frame-aligned durations → ConvertSecondsToFCPDuration() function
+// - Gap offset is the current timeline end → calculateTimelineDuration(), so the next clip starts after it
+// - Spine.MarshalXML orders gaps chronologically with clips by offset
+func AddGap(fcpxml *FCPXML, durationSeconds float64) error {
+	if durationSeconds <= 0 {
+		return fmt.Errorf("gap duration must be greater than 0, got %g", durationSeconds)
+	}
+	if len(fcpxml.Library.Events) == 0 || len(fcpxml.Library.Events[0].Projects) == 0 || len(fcpxml.Library.Events[0].Projects[0].Sequences) == 0 {
+		return fmt.Errorf("no sequence found to add gap to")
+	}
+
+	sequence := &fcpxml.Library.Events[0].Projects[0].Sequences[0]
+	currentTimelineDuration := calculateTimelineDuration(sequence)
+	gapDuration := ConvertSecondsToFCPDuration(durationSeconds)
+
+	sequence.Spine.Gaps = append(sequence.Spine.Gaps, Gap{
+		Name:     "Gap",
+		Offset:   currentTimelineDuration,
+		Duration: gapDuration,
+	})
+	sequence.Duration = addDurations(currentTimelineDuration, gapDuration)
+
+	return nil
+}
@@ -0,0 +1,116 @@
+package fcp
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// DefaultCountdownSecondsPerNumber is how long each countdown number stays on screen
+const DefaultCountdownSecondsPerNumber = 1.0
+
+// maxCountdownFrom keeps countdowns to something an intro would actually use
+const maxCountdownFrom = 99
+
+// CountdownOptions tweaks GenerateCountdownWithOptions; the zero value counts down to 1 over black
+type CountdownOptions struct {
+	IncludeZero     bool   // Finish on "0" instead of "1"
+	BackgroundColor string // "r g b a" (0-1) solid fill behind the numbers; empty for none
+}
+
+// GenerateCountdown builds a "5-4-3-2-1" intro: one centered number title per step, counting
+// down from `from` to 1, each perNumberSeconds long with a kinetic scale pulse.
+func GenerateCountdown(from int, perNumberSeconds float64) (*FCPXML, error) {
+	return GenerateCountdownWithOptions(from, perNumberSeconds, CountdownOptions{})
+}
+
+// GenerateCountdownWithOptions is GenerateCountdown with an optional final zero and background color.
+//
+// 🚨 CLAUDE.md Rules Applied Here:
+// - Numbers are kinetic titles (AddKineticText): frame-aligned offsets, scale keyframes in local time
+// - A background is the verified Vivid generator on the spine with the numbers connected on lane 1
+func GenerateCountdownWithOptions(from int, perNumberSeconds float64, options CountdownOptions) (*FCPXML, error) {
+	if from < 1 || from > maxCountdownFrom {
+		return nil, fmt.Errorf("countdown must start between 1 and %d, got %d", maxCountdownFrom, from)
+	}
+	var background [4]float64
+	if options.BackgroundColor != "" {
+		color, err := ParseRGBA(options.BackgroundColor)
+		if err != nil {
+			return nil, fmt.Errorf("invalid background color: %v", err)
+		}
+		background = color
+	}
+
+	last := 1
+	if options.IncludeZero {
+		last = 0
+	}
+	var numbers []string
+	for n := from; n >= last; n-- {
+		numbers = append(numbers, strconv.Itoa(n))
+	}
+
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create base FCPXML: %v", err)
+	}
+	if err := AddKineticText(fcpxml, numbers, perNumberSeconds); err != nil {
+		return nil, err
+	}
+
+	sequence, err := firstSequence(fcpxml)
+	if err != nil {
+		return nil, err
+	}
+	for i := range sequence.Spine.Titles {
+		title := &sequence.Spine.Titles[i]
+		title.Name = numbers[i] + " - Countdown"
+	}
+
+	if options.BackgroundColor != "" {
+		if err := addCountdownBackground(fcpxml, sequence, background); err != nil {
+			return nil, err
+		}
+	}
+
+	return fcpxml, nil
+}
+
+// addCountdownBackground puts a solid Vivid clip on the spine and connects the number titles to it
+func addCountdownBackground(fcpxml *FCPXML, sequence *Sequence, color [4]float64) error {
+	registry := NewResourceRegistry(fcpxml)
+	tx := NewTransaction(registry)
+	defer tx.Rollback()
+
+	vividID := findEffectIDByUID(fcpxml, ".../Generators.localized/Solids.localized/Vivid.localized/Vivid.motn")
+	if vividID == "" {
+		vividID = tx.ReserveIDs(1)[0]
+		if _, err := tx.CreateEffect(vividID, "Vivid", ".../Generators.localized/Solids.localized/Vivid.localized/Vivid.motn"); err != nil {
+			return fmt.Errorf("failed to create Vivid generator: %v", err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	background := Video{
+		Ref:      vividID,
+		Offset:   "0s",
+		Name:     "Countdown Background",
+		Start:    "0s",
+		Duration: sequence.Duration,
+		Params: []Param{
+			{Name: "Fill Color", Value: formatRGBA(color)},
+		},
+	}
+
+	// The background starts at 0s in both timelines, so the title offsets carry over unchanged
+	for _, title := range sequence.Spine.Titles {
+		title.Lane = "1"
+		background.NestedTitles = append(background.NestedTitles, title)
+	}
+	sequence.Spine.Titles = nil
+	sequence.Spine.Videos = append(sequence.Spine.Videos, background)
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package fcp
+
+import (
+	"testing"
+)
+
+// TestGenerateCountdown tests that from=3 gives titles "3", "2", "1" at 0, 1 and 2 seconds with scale keyframes
+func TestGenerateCountdown(t *testing.T) {
+	fcpxml, err := GenerateCountdown(3, 1)
+	if err != nil {
+		t.Fatalf("GenerateCountdown failed: %v", err)
+	}
+
+	titles := fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine.Titles
+	expected := []string{"3", "2", "1"}
+	if len(titles) != len(expected) {
+		t.Fatalf("Expected %d titles, got %d", len(expected), len(titles))
+	}
+	for i, title := range titles {
+		if title.Text.TextStyles[0].Text != expected[i] {
+			t.Errorf("Title %d: expected %q, got %q", i, expected[i], title.Text.TextStyles[0].Text)
+		}
+		if title.Offset != formatFrameAlignedTime(parseFCPDuration(ConvertSecondsToFCPDuration(float64(i)))) {
+			t.Errorf("Title %d: expected offset %ds, got %s", i, i, title.Offset)
+		}
+
+		hasScale := false
+		for _, param := range title.Params {
+			if param.Name == "Rotation" {
+				t.Errorf("Title %d: countdown numbers should not rotate", i)
+			}
+			if param.Key == titleScaleKey && param.KeyframeAnimation != nil && len(param.KeyframeAnimation.Keyframes) >= 2 {
+				hasScale = true
+			}
+		}
+		if !hasScale {
+			t.Errorf("Title %d: expected scale keyframes", i)
+		}
+	}
+
+	if violations := ValidateClaudeCompliance(fcpxml); len(violations) > 0 {
+		t.Errorf("Countdown failed validation: %v", violations)
+	}
+}
+
+// TestGenerateCountdownWithBackground tests the zero option and that numbers connect to a solid background
+func TestGenerateCountdownWithBackground(t *testing.T) {
+	fcpxml, err := GenerateCountdownWithOptions(2, 0.5, CountdownOptions{IncludeZero: true, BackgroundColor: "0.1 0.2 0.3 1"})
+	if err != nil {
+		t.Fatalf("GenerateCountdownWithOptions failed: %v", err)
+	}
+
+	sequence := fcpxml.Library.Events[0].Projects[0].Sequences[0]
+	if len(sequence.Spine.Titles) != 0 || len(sequence.Spine.Videos) != 1 {
+		t.Fatalf("Expected a single background clip on the spine, got %d videos and %d titles", len(sequence.Spine.Videos), len(sequence.Spine.Titles))
+	}
+	background := sequence.Spine.Videos[0]
+	if background.Duration != sequence.Duration || background.Params[0].Value != "0.1 0.2 0.3 1" {
+		t.Errorf("Unexpected background: %+v", background)
+	}
+	if len(background.NestedTitles) != 3 || background.NestedTitles[2].Text.TextStyles[0].Text != "0" {
+		t.Fatalf("Expected numbers 2, 1, 0 connected to the background, got %d titles", len(background.NestedTitles))
+	}
+	for i, title := range background.NestedTitles {
+		if title.Lane != "1" {
+			t.Errorf("Title %d: expected lane 1, got %q", i, title.Lane)
+		}
+	}
+
+	if _, err := GenerateCountdown(0, 1); err == nil {
+		t.Errorf("Expected an error for a countdown from 0")
+	}
+}
@@ -0,0 +1,215 @@
+package fcp
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// DefaultCreditsSecondsPerRow is how long each credit stays on screen (or scrolls past) by default
+const DefaultCreditsSecondsPerRow = 3.0
+
+// Credit card layout in title coordinates
+const (
+	creditsNameFontSize = "160"
+	creditsRoleFontSize = "110"
+	creditsRowHeight    = 600  // Vertical space one name/role pair takes in the scrolling block
+	creditsScrollEdge   = 2400 // Just beyond the title margins, so the block enters and exits off screen
+)
+
+// CreditEntry is one name/role row of a credits CSV
+type CreditEntry struct {
+	Name string
+	Role string
+}
+
+// ReadCreditsCSV reads name,role rows. Blank rows are skipped, the role column is optional,
+// and a leading "name,role" header row is ignored.
+func ReadCreditsCSV(csvPath string) ([]CreditEntry, error) {
+	file, err := os.Open(csvPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open credits file: %v", err)
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	var credits []CreditEntry
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse credits line %d: %v", line, err)
+		}
+
+		entry := CreditEntry{Name: strings.TrimSpace(record[0])}
+		if len(record) > 1 {
+			entry.Role = strings.TrimSpace(record[1])
+		}
+		if entry.Name == "" && entry.Role == "" {
+			continue
+		}
+		if len(credits) == 0 && strings.EqualFold(entry.Name, "name") && strings.EqualFold(entry.Role, "role") {
+			continue
+		}
+		credits = append(credits, entry)
+	}
+
+	if len(credits) == 0 {
+		return nil, fmt.Errorf("no credits found in %s", csvPath)
+	}
+	return credits, nil
+}
+
+// GenerateCreditsFromCSV builds a credits sequence with one centered name/role card per CSV row.
+//
+// 🚨 CLAUDE.md Rules Applied Here:
+// - Cards are spine titles (no lane) placed back to back, each perRowSeconds long
+// - One shared Text effect created through the ResourceRegistry/Transaction pattern
+// - Frame-aligned offsets → ConvertSecondsToFCPDuration() function
+func GenerateCreditsFromCSV(csvPath string, perRowSeconds float64) (*FCPXML, error) {
+	return generateCredits(csvPath, perRowSeconds, false)
+}
+
+// GenerateScrollingCreditsFromCSV builds one tall title block holding every credit that scrolls
+// from below the frame to above it over perRowSeconds per row.
+//
+// 🚨 CLAUDE.md Rules Applied Here:
+// - The scroll is a Position keyframe animation with NO interp/curve attributes
+// - Keyframe times are in the title's local time (title start → start + duration)
+func GenerateScrollingCreditsFromCSV(csvPath string, perRowSeconds float64) (*FCPXML, error) {
+	return generateCredits(csvPath, perRowSeconds, true)
+}
+
+// generateCredits reads the CSV and lays the credits out as cards or as one scrolling block
+func generateCredits(csvPath string, perRowSeconds float64, scroll bool) (*FCPXML, error) {
+	if perRowSeconds <= 0 {
+		return nil, fmt.Errorf("seconds per row must be positive, got %g", perRowSeconds)
+	}
+
+	credits, err := ReadCreditsCSV(csvPath)
+	if err != nil {
+		return nil, err
+	}
+
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create base FCPXML: %v", err)
+	}
+
+	registry := NewResourceRegistry(fcpxml)
+	tx := NewTransaction(registry)
+	defer tx.Rollback()
+
+	textEffectID := tx.ReserveIDs(1)[0]
+	if _, err := tx.CreateEffect(textEffectID, "Text", ".../Titles.localized/Basic Text.localized/Text.localized/Text.moti"); err != nil {
+		return nil, fmt.Errorf("failed to create text effect: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	sequence := &fcpxml.Library.Events[0].Projects[0].Sequences[0]
+
+	if scroll {
+		sequence.Spine.Titles = append(sequence.Spine.Titles, createScrollingCreditsTitle(textEffectID, credits, perRowSeconds))
+	} else {
+		rowDuration := ConvertSecondsToFCPDuration(perRowSeconds)
+		rowFrames := parseFCPDuration(rowDuration)
+		for i, credit := range credits {
+			title := createCreditsTitle(textEffectID, []CreditEntry{credit}, fmt.Sprintf("credits_card_%d", i))
+			title.Offset = formatFrameAlignedTime(i * rowFrames)
+			title.Duration = rowDuration
+			sequence.Spine.Titles = append(sequence.Spine.Titles, title)
+		}
+	}
+
+	sequence.Duration = calculateTimelineDuration(sequence)
+	return fcpxml, nil
+}
+
+// createScrollingCreditsTitle stacks every credit in one title that moves bottom → top
+func createScrollingCreditsTitle(textEffectID string, credits []CreditEntry, perRowSeconds float64) Title {
+	title := createCreditsTitle(textEffectID, credits, "credits_scroll")
+	title.Offset = "0s"
+	title.Duration = ConvertSecondsToFCPDuration(perRowSeconds * float64(len(credits)))
+
+	travel := creditsScrollEdge + len(credits)*creditsRowHeight/2
+	title.Params = append([]Param{
+		{
+			Name: "Position",
+			Key:  "9999/10003/13260/3296672360/1/100/101",
+			KeyframeAnimation: &KeyframeAnimation{
+				Keyframes: []Keyframe{
+					{Time: title.Start, Value: fmt.Sprintf("0 %d", -travel)},
+					{Time: addDurations(title.Start, title.Duration), Value: fmt.Sprintf("0 %d", travel)},
+				},
+			},
+		},
+	}, title.Params...)
+
+	return title
+}
+
+// createCreditsTitle builds a centered title with a bold name line and a lighter role line per credit
+func createCreditsTitle(textEffectID string, credits []CreditEntry, baseName string) Title {
+	nameStyleID := GenerateTextStyleID(credits[0].Name, baseName+"_name")
+	roleStyleID := GenerateTextStyleID(credits[0].Role, baseName+"_role")
+
+	var runs []TextStyleRef
+	names := make([]string, 0, len(credits))
+	for i, credit := range credits {
+		separator := ""
+		if i > 0 {
+			separator = "\n\n"
+		}
+		runs = append(runs, TextStyleRef{Ref: nameStyleID, Text: separator + credit.Name})
+		if credit.Role != "" {
+			runs = append(runs, TextStyleRef{Ref: roleStyleID, Text: "\n" + credit.Role})
+		}
+		names = append(names, credit.Name)
+	}
+
+	return Title{
+		Ref:   textEffectID,
+		Name:  strings.Join(names, ", ") + " - Credits",
+		Start: "86486400/24000s",
+		Params: []Param{
+			{
+				Name:  "Alignment",
+				Key:   "9999/10003/13260/3296672360/2/354/3296667315/401",
+				Value: "1 (Center)",
+			},
+		},
+		Text: &TitleText{TextStyles: runs},
+		TextStyleDefs: []TextStyleDef{
+			{
+				ID: nameStyleID,
+				TextStyle: TextStyle{
+					Font:      "Helvetica Neue",
+					FontSize:  creditsNameFontSize,
+					FontFace:  "Bold",
+					FontColor: "1 1 1 1",
+					Bold:      "1",
+					Alignment: "center",
+				},
+			},
+			{
+				ID: roleStyleID,
+				TextStyle: TextStyle{
+					Font:      "Helvetica Neue",
+					FontSize:  creditsRoleFontSize,
+					FontFace:  "Regular",
+					FontColor: "0.8 0.8 0.8 1",
+					Alignment: "center",
+				},
+			},
+		},
+	}
+}
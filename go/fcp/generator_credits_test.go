@@ -0,0 +1,93 @@
+package fcp
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeCreditsCSV(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "credits.csv")
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write credits CSV: %v", err)
+	}
+	return path
+}
+
+// TestGenerateCreditsFromCSV tests that card mode yields one title per row at back-to-back offsets
+func TestGenerateCreditsFromCSV(t *testing.T) {
+	csvPath := writeCreditsCSV(t, "name,role\nAda Lovelace,Director\n\n\"Grace Hopper\", Editor\nAlan Turing\n")
+
+	fcpxml, err := GenerateCreditsFromCSV(csvPath, 2.5)
+	if err != nil {
+		t.Fatalf("GenerateCreditsFromCSV failed: %v", err)
+	}
+
+	sequence := fcpxml.Library.Events[0].Projects[0].Sequences[0]
+	titles := sequence.Spine.Titles
+	if len(titles) != 3 {
+		t.Fatalf("Expected 3 credit cards, got %d", len(titles))
+	}
+
+	rowFrames := parseFCPDuration(ConvertSecondsToFCPDuration(2.5))
+	expectedNames := []string{"Ada Lovelace", "Grace Hopper", "Alan Turing"}
+	for i, title := range titles {
+		if parseFCPDuration(title.Offset) != i*rowFrames {
+			t.Errorf("Card %d: expected offset %d, got %s", i, i*rowFrames, title.Offset)
+		}
+		if parseFCPDuration(title.Duration) != rowFrames {
+			t.Errorf("Card %d: expected duration %d, got %s", i, rowFrames, title.Duration)
+		}
+		if title.Lane != "" {
+			t.Errorf("Card %d: spine titles must not have a lane, got %s", i, title.Lane)
+		}
+		if title.Text.TextStyles[0].Text != expectedNames[i] {
+			t.Errorf("Card %d: expected name %q, got %q", i, expectedNames[i], title.Text.TextStyles[0].Text)
+		}
+	}
+
+	// The role is optional: Alan Turing has only a name run
+	if len(titles[2].Text.TextStyles) != 1 || len(titles[0].Text.TextStyles) != 2 {
+		t.Errorf("Expected name+role runs for Ada and a single run for Alan")
+	}
+
+	if parseFCPDuration(sequence.Duration) != 3*rowFrames {
+		t.Errorf("Expected sequence duration %d, got %s", 3*rowFrames, sequence.Duration)
+	}
+	if err := WriteToFile(fcpxml, filepath.Join(t.TempDir(), "credits.fcpxml")); err != nil {
+		t.Errorf("Credits failed validation: %v", err)
+	}
+}
+
+// TestGenerateScrollingCreditsFromCSV tests that scroll mode is one title with a bottom-to-top Position animation
+func TestGenerateScrollingCreditsFromCSV(t *testing.T) {
+	csvPath := writeCreditsCSV(t, "Ada Lovelace,Director\nGrace Hopper,Editor\n")
+
+	fcpxml, err := GenerateScrollingCreditsFromCSV(csvPath, 3)
+	if err != nil {
+		t.Fatalf("GenerateScrollingCreditsFromCSV failed: %v", err)
+	}
+
+	titles := fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine.Titles
+	if len(titles) != 1 {
+		t.Fatalf("Expected a single scrolling title, got %d", len(titles))
+	}
+	position := titles[0].Params[0]
+	if position.Name != "Position" || position.KeyframeAnimation == nil {
+		t.Fatalf("Expected an animated Position param first, got %+v", position)
+	}
+	keyframes := position.KeyframeAnimation.Keyframes
+	if len(keyframes) != 2 || keyframes[0].Value[0:2] != "0 " || keyframes[0].Value[2] != '-' || keyframes[1].Value[2] == '-' {
+		t.Errorf("Expected scroll from below to above the frame, got %+v", keyframes)
+	}
+	for _, keyframe := range keyframes {
+		if keyframe.Interp != "" || keyframe.Curve != "" {
+			t.Errorf("Position keyframes must not carry interp/curve: %+v", keyframe)
+		}
+	}
+
+	if _, err := GenerateCreditsFromCSV(writeCreditsCSV(t, "\n\n"), 3); err == nil {
+		t.Error("Expected error for a CSV without credits")
+	}
+}
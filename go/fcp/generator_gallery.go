@@ -0,0 +1,186 @@
+package fcp
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"time"
+)
+
+// DefaultGallerySecondsPerImage is how long each photo stays on screen in a dated gallery
+const DefaultGallerySecondsPerImage = 4.0
+
+// galleryDateLayout formats the date caption shown over each day's photos
+const galleryDateLayout = "January 2, 2006"
+
+// GalleryPhoto is one image of a dated gallery with its capture time
+type GalleryPhoto struct {
+	Path     string
+	Taken    time.Time
+	FromEXIF bool // false when the file modification time was used instead
+}
+
+// GenerateDatedGallery builds a chronological timeline of every image in dir with date captions.
+//
+// 🚨 CLAUDE.md Rules Applied Here:
+// - Images go through AddImage → Video elements, ordered by EXIF capture date (file mtime fallback)
+// - One date title per run of consecutive same-day photos, nested in the run's first image
+// - Titles span the whole run and use the verified Text effect UID
+func GenerateDatedGallery(dir string) (*FCPXML, error) {
+	photos, err := CollectGalleryPhotos(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create base FCPXML: %v", err)
+	}
+
+	// Group consecutive photos taken on the same calendar day
+	var groups [][]GalleryPhoto
+	for _, photo := range photos {
+		if n := len(groups); n > 0 && sameDay(groups[n-1][0].Taken, photo.Taken) {
+			groups[n-1] = append(groups[n-1], photo)
+			continue
+		}
+		groups = append(groups, []GalleryPhoto{photo})
+	}
+
+	for _, group := range groups {
+		firstVideoIndex := len(fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine.Videos)
+		for _, photo := range group {
+			if err := AddImage(fcpxml, photo.Path, DefaultGallerySecondsPerImage); err != nil {
+				return nil, fmt.Errorf("failed to add image %s: %v", photo.Path, err)
+			}
+		}
+
+		label := group[0].Taken.Format(galleryDateLayout)
+		groupDuration := ConvertSecondsToFCPDuration(DefaultGallerySecondsPerImage * float64(len(group)))
+		if err := addGalleryDateTitle(fcpxml, firstVideoIndex, label, groupDuration); err != nil {
+			return nil, fmt.Errorf("failed to add date caption %s: %v", label, err)
+		}
+	}
+
+	return fcpxml, nil
+}
+
+// CollectGalleryPhotos lists the images in dir (like getPngFiles) sorted by capture time.
+// Images without readable EXIF fall back to their file modification time.
+func CollectGalleryPhotos(dir string) ([]GalleryPhoto, error) {
+	paths, err := getPngFiles(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list images in %s: %v", dir, err)
+	}
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("no images found in %s", dir)
+	}
+
+	var photos []GalleryPhoto
+	for _, path := range paths {
+		photo := GalleryPhoto{Path: path}
+		if taken, err := ReadEXIFCaptureTime(path); err == nil {
+			photo.Taken = taken
+			photo.FromEXIF = true
+		} else {
+			info, err := os.Stat(path)
+			if err != nil {
+				return nil, fmt.Errorf("failed to stat %s: %v", path, err)
+			}
+			photo.Taken = info.ModTime()
+		}
+		photos = append(photos, photo)
+	}
+
+	// Paths are already sorted, so ties keep a stable name order
+	sort.SliceStable(photos, func(i, j int) bool {
+		return photos[i].Taken.Before(photos[j].Taken)
+	})
+
+	return photos, nil
+}
+
+// sameDay reports whether two times fall on the same local calendar day
+func sameDay(a, b time.Time) bool {
+	ay, am, ad := a.Local().Date()
+	by, bm, bd := b.Local().Date()
+	return ay == by && am == bm && ad == bd
+}
+
+// addGalleryDateTitle nests a small date caption in the spine video at videoIndex, anchored on
+// the lower-left corner of the sequence's title-safe area and starting at its title start
+func addGalleryDateTitle(fcpxml *FCPXML, videoIndex int, label string, duration string) error {
+	sequence := &fcpxml.Library.Events[0].Projects[0].Sequences[0]
+	if videoIndex >= len(sequence.Spine.Videos) {
+		return fmt.Errorf("no video at index %d to attach caption to", videoIndex)
+	}
+	video := &sequence.Spine.Videos[videoIndex]
+
+	registry := NewResourceRegistry(fcpxml)
+	tx := NewTransaction(registry)
+	defer tx.Rollback()
+
+	textEffectID := findEffectIDByUID(fcpxml, ".../Titles.localized/Basic Text.localized/Text.localized/Text.moti")
+	if textEffectID == "" {
+		textEffectID = tx.ReserveIDs(1)[0]
+		if _, err := tx.CreateEffect(textEffectID, "Text", ".../Titles.localized/Basic Text.localized/Text.localized/Text.moti"); err != nil {
+			return fmt.Errorf("failed to create text effect: %v", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	width, height := 1920.0, 1080.0
+	if w, h, err := sequenceFrameSize(fcpxml, sequence); err == nil {
+		width, height = w, h
+	}
+	position := fmt.Sprintf("%d %d", -int(width*titleSafeScale/2), -int(height*titleSafeScale/2))
+
+	textStyleID := GenerateTextStyleID(label, "gallery_date_"+video.Name)
+	video.NestedTitles = append(video.NestedTitles, Title{
+		Ref:      textEffectID,
+		Lane:     "1",
+		Offset:   video.Start, // Nested offsets are in the parent's local time
+		Name:     label + " - Date",
+		Start:    titleStartForTimebase(SequenceFrameRate(fcpxml, sequence)),
+		Duration: duration,
+		Params: []Param{
+			{
+				Name:  "Position",
+				Key:   "9999/10003/13260/3296672360/1/100/101",
+				Value: position,
+			},
+			{
+				Name:  "Alignment",
+				Key:   "9999/10003/13260/3296672360/2/354/3296667315/401",
+				Value: "0 (Left)",
+			},
+		},
+		Text: &TitleText{
+			TextStyles: []TextStyleRef{
+				{
+					Ref:  textStyleID,
+					Text: label,
+				},
+			},
+		},
+		TextStyleDefs: []TextStyleDef{
+			{
+				ID: textStyleID,
+				TextStyle: TextStyle{
+					Font:         "Helvetica Neue",
+					FontSize:     "90",
+					FontFace:     "Regular",
+					FontColor:    "1 1 1 1",
+					Alignment:    "left",
+					ShadowColor:  DefaultTextShadowColor,
+					ShadowOffset: DefaultTextShadowOffset,
+				},
+			},
+		},
+	})
+
+	return nil
+}
@@ -0,0 +1,118 @@
+package fcp
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/jpeg"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// writeEXIFJPEG writes a small JPEG whose EXIF DateTimeOriginal is taken
+func writeEXIFJPEG(t *testing.T, path string, taken time.Time) {
+	t.Helper()
+
+	var encoded bytes.Buffer
+	if err := jpeg.Encode(&encoded, image.NewGray(image.Rect(0, 0, 8, 8)), nil); err != nil {
+		t.Fatalf("Failed to encode JPEG: %v", err)
+	}
+
+	// Little-endian TIFF: IFD0 (Exif pointer) → Exif IFD (DateTimeOriginal) → date string
+	date := append([]byte(taken.Format(exifDateLayout)), 0)
+	var tiff bytes.Buffer
+	le := binary.LittleEndian
+	tiff.WriteString("II")
+	binary.Write(&tiff, le, uint16(42))
+	binary.Write(&tiff, le, uint32(8))
+	binary.Write(&tiff, le, uint16(1))
+	binary.Write(&tiff, le, [4]uint16{exifTagExifIFDPointer, 4, 1, 0})
+	binary.Write(&tiff, le, uint32(26))
+	binary.Write(&tiff, le, uint32(0))
+	binary.Write(&tiff, le, uint16(1))
+	binary.Write(&tiff, le, [2]uint16{exifTagDateTimeOriginal, 2})
+	binary.Write(&tiff, le, uint32(len(date)))
+	binary.Write(&tiff, le, uint32(44))
+	binary.Write(&tiff, le, uint32(0))
+	tiff.Write(date)
+
+	segment := append([]byte("Exif\x00\x00"), tiff.Bytes()...)
+	var out bytes.Buffer
+	out.Write([]byte{0xFF, 0xD8, 0xFF, 0xE1})
+	binary.Write(&out, binary.BigEndian, uint16(len(segment)+2))
+	out.Write(segment)
+	out.Write(encoded.Bytes()[2:]) // rest of the JPEG after its SOI marker
+
+	if err := os.WriteFile(path, out.Bytes(), 0644); err != nil {
+		t.Fatalf("Failed to write JPEG: %v", err)
+	}
+}
+
+// TestGenerateDatedGallery tests that photos are sorted by capture date with one caption per day
+func TestGenerateDatedGallery(t *testing.T) {
+	dir := t.TempDir()
+
+	july1 := time.Date(2024, 7, 1, 9, 0, 0, 0, time.Local)
+	july3Morning := time.Date(2024, 7, 3, 10, 0, 0, 0, time.Local)
+	july3Evening := time.Date(2024, 7, 3, 18, 0, 0, 0, time.Local)
+
+	// Names are deliberately out of date order
+	writeEXIFJPEG(t, filepath.Join(dir, "a_beach.jpg"), july3Morning)
+	writeEXIFJPEG(t, filepath.Join(dir, "b_airport.jpg"), july1)
+
+	// No EXIF: falls back to the file modification time
+	noEXIF := filepath.Join(dir, "c_sunset.png")
+	if err := os.WriteFile(noEXIF, []byte("fake png data"), 0644); err != nil {
+		t.Fatalf("Failed to create test image: %v", err)
+	}
+	if err := os.Chtimes(noEXIF, july3Evening, july3Evening); err != nil {
+		t.Fatalf("Failed to set modification time: %v", err)
+	}
+
+	taken, err := ReadEXIFCaptureTime(filepath.Join(dir, "b_airport.jpg"))
+	if err != nil || !taken.Equal(july1) {
+		t.Fatalf("Expected EXIF capture time %v, got %v (err %v)", july1, taken, err)
+	}
+
+	fcpxml, err := GenerateDatedGallery(dir)
+	if err != nil {
+		t.Fatalf("GenerateDatedGallery failed: %v", err)
+	}
+
+	videos := fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine.Videos
+	expectedOrder := []string{"b_airport", "a_beach", "c_sunset"}
+	if len(videos) != len(expectedOrder) {
+		t.Fatalf("Expected %d images, got %d", len(expectedOrder), len(videos))
+	}
+	for i, name := range expectedOrder {
+		if videos[i].Name != name {
+			t.Errorf("Expected image %d to be %s, got %s", i, name, videos[i].Name)
+		}
+	}
+
+	// July 1 gets its own caption; both July 3 photos share one
+	if len(videos[0].NestedTitles) != 1 || videos[0].NestedTitles[0].Text.TextStyles[0].Text != "July 1, 2024" {
+		t.Errorf("Expected 'July 1, 2024' caption on first image, got %+v", videos[0].NestedTitles)
+	}
+	if len(videos[1].NestedTitles) != 1 || videos[1].NestedTitles[0].Text.TextStyles[0].Text != "July 3, 2024" {
+		t.Errorf("Expected 'July 3, 2024' caption on second image, got %+v", videos[1].NestedTitles)
+	}
+	if len(videos[2].NestedTitles) != 0 {
+		t.Errorf("Expected same-day third image to share the previous caption")
+	}
+	if videos[1].NestedTitles[0].Duration != ConvertSecondsToFCPDuration(2*DefaultGallerySecondsPerImage) {
+		t.Errorf("Expected grouped caption to span both July 3 photos, got %s", videos[1].NestedTitles[0].Duration)
+	}
+
+	// The caption sits on the title-safe corner of the 1280x720 sequence, at the 23.976 title start
+	caption := videos[0].NestedTitles[0]
+	if caption.Params[0].Value != "-512 -288" || caption.Start != "86486400/24000s" {
+		t.Errorf("Expected caption at -512 -288 from 86486400/24000s, got %s from %s", caption.Params[0].Value, caption.Start)
+	}
+
+	if err := WriteToFile(fcpxml, filepath.Join(dir, "gallery.fcpxml")); err != nil {
+		t.Errorf("Gallery failed validation: %v", err)
+	}
+}
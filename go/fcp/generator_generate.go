@@ -334,16 +334,16 @@ func scanAssetsDirectory(assetsDir string) (*AssetCollection, error) {
 	return assets, nil
 }
 
-// generateRandomTimelineElements fills the timeline with random elements
-func generateRandomTimelineElements(fcpxml *FCPXML, tx *ResourceTransaction, assets *AssetCollection, totalDuration float64, verbose bool) error {
+// generateRandomTimelineElements fills the timeline with random elements picked by rng
+func generateRandomTimelineElements(fcpxml *FCPXML, tx *ResourceTransaction, assets *AssetCollection, totalDuration float64, verbose bool, rng *rand.Rand) error {
 
 	createdAssets := make(map[string]string)
 	createdFormats := make(map[string]string)
 
 	if len(assets.Videos) > 0 {
-		backgroundVideo := assets.Videos[rand.Intn(len(assets.Videos))]
+		backgroundVideo := assets.Videos[rng.Intn(len(assets.Videos))]
 
-		uniqueVideo, err := createUniqueMediaCopy(backgroundVideo, "background")
+		uniqueVideo, err := tx.createUniqueMediaCopy(backgroundVideo, "background")
 		if err != nil && verbose {
 			fmt.Printf("Warning: Failed to create unique background copy: %v\n", err)
 			uniqueVideo = backgroundVideo
@@ -356,9 +356,9 @@ func generateRandomTimelineElements(fcpxml *FCPXML, tx *ResourceTransaction, ass
 			fmt.Printf("  Added background video: %s (%.1fs @ 0s)\n", filepath.Base(uniqueVideo), totalDuration)
 		}
 	} else if len(assets.Images) > 0 {
-		backgroundImage := assets.Images[rand.Intn(len(assets.Images))]
+		backgroundImage := assets.Images[rng.Intn(len(assets.Images))]
 
-		uniqueImage, err := createUniqueMediaCopy(backgroundImage, "background")
+		uniqueImage, err := tx.createUniqueMediaCopy(backgroundImage, "background")
 		if err != nil && verbose {
 			fmt.Printf("Warning: Failed to create unique background copy: %v\n", err)
 			uniqueImage = backgroundImage
@@ -380,14 +380,14 @@ func generateRandomTimelineElements(fcpxml *FCPXML, tx *ResourceTransaction, ass
 
 	if len(assets.Videos) > 0 {
 
-		mainVideoPath := assets.Videos[rand.Intn(len(assets.Videos))]
-		uniqueMainVideo, err := createUniqueMediaCopy(mainVideoPath, "main_bg")
+		mainVideoPath := assets.Videos[rng.Intn(len(assets.Videos))]
+		uniqueMainVideo, err := tx.createUniqueMediaCopy(mainVideoPath, "main_bg")
 		if err != nil && verbose {
 			fmt.Printf("Warning: Failed to create unique main video copy: %v\n", err)
 			uniqueMainVideo = mainVideoPath
 		}
 
-		mainVideo, err := createNestedVideoElement(fcpxml, tx, uniqueMainVideo, totalDuration, verbose, assets, createdAssets, createdFormats)
+		mainVideo, err := createNestedVideoElement(fcpxml, tx, uniqueMainVideo, totalDuration, verbose, rng, assets, createdAssets, createdFormats)
 		if err != nil && verbose {
 			fmt.Printf("Warning: Failed to create main video element: %v\n", err)
 		} else {
@@ -400,28 +400,29 @@ func generateRandomTimelineElements(fcpxml *FCPXML, tx *ResourceTransaction, ass
 	}
 
 	// 🚨 EXTREME BAFFLE MODE: Push every possible limit
-	numMainElements := 15 + rand.Intn(35) // 15-50 elements instead of 3-8
-	maxLanes := 8 + rand.Intn(12) // 8-20 lanes (complex but valid)
+	numMainElements := 15 + rng.Intn(35) // 15-50 elements instead of 3-8
+	maxLanes := 8 + rng.Intn(12) // 8-20 lanes (complex but valid)
 	
 	if verbose {
 		fmt.Printf("🚨 EXTREME BAFFLE: Creating %d main spine elements across %d lanes...\n", numMainElements, maxLanes)
 	}
 
+	images := newImageBag(assets.Images, rng)
 	for i := 1; i <= numMainElements; i++ {
 		// 🚨 EXTREME: Random durations from 0.1s to entire timeline
-		duration := 0.1 + rand.Float64()*(totalDuration*1.5) // Can exceed timeline!
+		duration := 0.1 + rng.Float64()*(totalDuration*1.5) // Can exceed timeline!
 		
 		// 🚨 EXTREME: Completely random start times, massive overlaps
-		startTime := rand.Float64() * totalDuration * 2.0 // Can start way beyond end!
+		startTime := rng.Float64() * totalDuration * 2.0 // Can start way beyond end!
 		
 		// 🚨 EXTREME: Random lane assignments including negative and huge lanes
-		lane := -10 + rand.Intn(21) // Valid range: -10 to +10
+		lane := -10 + rng.Intn(21) // Valid range: -10 to +10
 		
 		// 🚨 EXTREME: No bounds checking - let validation catch it!
 
 		if i%2 == 0 && len(assets.Videos) > 0 {
-			videoPath := assets.Videos[rand.Intn(len(assets.Videos))]
-			uniqueVideo, err := createUniqueMediaCopy(videoPath, fmt.Sprintf("main_%d", i))
+			videoPath := assets.Videos[rng.Intn(len(assets.Videos))]
+			uniqueVideo, err := tx.createUniqueMediaCopy(videoPath, fmt.Sprintf("main_%d", i))
 			if err != nil && verbose {
 				fmt.Printf("Warning: Failed to create unique video copy: %v\n", err)
 				uniqueVideo = videoPath
@@ -437,8 +438,8 @@ func generateRandomTimelineElements(fcpxml *FCPXML, tx *ResourceTransaction, ass
 				}
 			}
 		} else if len(assets.Images) > 0 {
-			imagePath := assets.Images[rand.Intn(len(assets.Images))]
-			uniqueImage, err := createUniqueMediaCopy(imagePath, fmt.Sprintf("main_img_%d", i))
+			imagePath := images.Next()
+			uniqueImage, err := tx.createUniqueMediaCopy(imagePath, fmt.Sprintf("main_img_%d", i))
 			if err != nil && verbose {
 				fmt.Printf("Warning: Failed to create unique image copy: %v\n", err)
 				uniqueImage = imagePath
@@ -0,0 +1,168 @@
+package fcp
+
+import (
+	"fmt"
+	"image"
+	"image/draw"
+	"image/gif"
+	"image/png"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// maxGIFFrames caps how many GIF frames are exploded into the timeline.
+// Each frame becomes its own asset, so enormous GIFs would bloat the FCPXML.
+const maxGIFFrames = 300
+
+// defaultGIFDelay is used for frames with a 0 delay, matching how browsers play them (10/100s)
+const defaultGIFDelay = 10
+
+// gifFramesDir holds the extracted frames next to the GIF, one subdirectory per GIF, so the
+// FCPXML keeps pointing at files that live as long as the source does
+const gifFramesDir = ".cutlass_gif_frames"
+
+// addGIFFrame adds one extracted frame to the timeline (swapped out in tests to inject failures)
+var addGIFFrame = AddImage
+
+// AddAnimatedGIF adds an animated GIF as a rapid sequence of still frames at the GIF's native timing.
+//
+// 🚨 CLAUDE.md Rules Applied Here:
+// - FCP treats .gif as a still, so frames are decoded and written as numbered PNGs
+// - Each frame is added through AddImage → Video element (never AssetClip for images)
+// - Frame durations come from GIF delay metadata, snapped on the cumulative timeline so rounding never drifts
+//
+// Frames are extracted to .cutlass_gif_frames/<name>/ beside the GIF and the FCPXML references
+// those PNGs, so move that directory along with the GIF. Reruns rewrite the same frames in place.
+// On failure the document is rolled back to its state before the call, and the frames directory
+// is removed if this call created it.
+func AddAnimatedGIF(fcpxml *FCPXML, gifPath string) (err error) {
+	file, err := os.Open(gifPath)
+	if err != nil {
+		return fmt.Errorf("failed to open GIF: %v", err)
+	}
+	defer file.Close()
+
+	anim, err := gif.DecodeAll(file)
+	if err != nil {
+		return fmt.Errorf("failed to decode GIF: %v", err)
+	}
+	if len(anim.Image) == 0 {
+		return fmt.Errorf("GIF has no frames: %s", gifPath)
+	}
+
+	sequence, err := firstSequence(fcpxml)
+	if err != nil {
+		return err
+	}
+
+	frameCount := len(anim.Image)
+	if frameCount > maxGIFFrames {
+		fmt.Fprintf(os.Stderr, "Warning: GIF %s has %d frames, only the first %d will be used\n", gifPath, frameCount, maxGIFFrames)
+		frameCount = maxGIFFrames
+	}
+
+	gifName := strings.TrimSuffix(filepath.Base(gifPath), filepath.Ext(gifPath))
+	framesRoot := filepath.Join(filepath.Dir(gifPath), gifFramesDir)
+	framesDir := filepath.Join(framesRoot, gifName)
+	createdDir := "" // the outermost directory this call creates, removed again on failure
+	if _, statErr := os.Stat(framesRoot); os.IsNotExist(statErr) {
+		createdDir = framesRoot
+	} else if _, statErr := os.Stat(framesDir); os.IsNotExist(statErr) {
+		createdDir = framesDir
+	}
+	if err := os.MkdirAll(framesDir, 0755); err != nil {
+		return fmt.Errorf("failed to create frames directory: %v", err)
+	}
+
+	// AddImage only appends, so restoring the slice headers undoes every frame added so far
+	savedResources := fcpxml.Resources
+	savedSequence := *sequence
+	defer func() {
+		if err != nil {
+			fcpxml.Resources = savedResources
+			*sequence = savedSequence
+			if createdDir != "" {
+				os.RemoveAll(createdDir)
+			}
+		}
+	}()
+
+	framePaths, err := writeGIFFrames(anim, frameCount, framesDir, gifName)
+	if err != nil {
+		return err
+	}
+
+	framesPerSecond := 24000.0 / 1001.0
+	elapsedCentiseconds := 0
+	prevFrameEnd := 0
+	for i := 0; i < frameCount; i++ {
+		delay := anim.Delay[i]
+		if delay <= 0 {
+			delay = defaultGIFDelay
+		}
+		elapsedCentiseconds += delay
+
+		// Snap the cumulative end time so per-frame rounding never accumulates
+		frameEnd := int(float64(elapsedCentiseconds)/100.0*framesPerSecond + 0.5)
+		if frameEnd <= prevFrameEnd {
+			frameEnd = prevFrameEnd + 1 // every GIF frame gets at least one video frame
+		}
+		durationSeconds := float64(frameEnd-prevFrameEnd) / framesPerSecond
+		prevFrameEnd = frameEnd
+
+		if err = addGIFFrame(fcpxml, framePaths[i], durationSeconds); err != nil {
+			return fmt.Errorf("failed to add GIF frame %d: %v", i+1, err)
+		}
+	}
+
+	return nil
+}
+
+// writeGIFFrames composites each GIF frame onto a full canvas (GIF frames are often partial
+// updates) and writes them as numbered PNGs in dir.
+func writeGIFFrames(anim *gif.GIF, frameCount int, dir string, baseName string) ([]string, error) {
+	bounds := image.Rect(0, 0, anim.Config.Width, anim.Config.Height)
+	if bounds.Empty() {
+		bounds = anim.Image[0].Bounds()
+	}
+	canvas := image.NewRGBA(bounds)
+
+	var paths []string
+	for i := 0; i < frameCount; i++ {
+		frame := anim.Image[i]
+
+		var previous *image.RGBA
+		disposal := byte(0)
+		if i < len(anim.Disposal) {
+			disposal = anim.Disposal[i]
+		}
+		if disposal == gif.DisposalPrevious {
+			previous = image.NewRGBA(bounds)
+			draw.Draw(previous, bounds, canvas, bounds.Min, draw.Src)
+		}
+
+		draw.Draw(canvas, frame.Bounds(), frame, frame.Bounds().Min, draw.Over)
+
+		framePath := filepath.Join(dir, fmt.Sprintf("%s_%04d.png", baseName, i+1))
+		out, err := os.Create(framePath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create frame %d: %v", i+1, err)
+		}
+		err = png.Encode(out, canvas)
+		out.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode frame %d: %v", i+1, err)
+		}
+		paths = append(paths, framePath)
+
+		switch disposal {
+		case gif.DisposalBackground:
+			draw.Draw(canvas, frame.Bounds(), image.Transparent, image.Point{}, draw.Src)
+		case gif.DisposalPrevious:
+			canvas = previous
+		}
+	}
+
+	return paths, nil
+}
@@ -0,0 +1,146 @@
+package fcp
+
+import (
+	"encoding/xml"
+	"errors"
+	"image"
+	"image/color"
+	"image/gif"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeTestGIF writes a small animated GIF with one solid-color frame per delay
+func writeTestGIF(t *testing.T, path string, delays []int) {
+	t.Helper()
+
+	palette := color.Palette{color.Black, color.White, color.RGBA{255, 0, 0, 255}}
+	anim := &gif.GIF{}
+	for i, delay := range delays {
+		frame := image.NewPaletted(image.Rect(0, 0, 8, 8), palette)
+		for p := range frame.Pix {
+			frame.Pix[p] = uint8(i % len(palette))
+		}
+		anim.Image = append(anim.Image, frame)
+		anim.Delay = append(anim.Delay, delay)
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		t.Fatalf("Failed to create GIF: %v", err)
+	}
+	defer file.Close()
+	if err := gif.EncodeAll(file, anim); err != nil {
+		t.Fatalf("Failed to encode GIF: %v", err)
+	}
+}
+
+// TestAddAnimatedGIF tests that a 3-frame GIF becomes three timed video elements in order
+func TestAddAnimatedGIF(t *testing.T) {
+	tempDir := t.TempDir()
+	gifPath := filepath.Join(tempDir, "wave.gif")
+	writeTestGIF(t, gifPath, []int{50, 100, 25}) // 0.5s, 1s, 0.25s
+
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("Failed to generate empty FCPXML: %v", err)
+	}
+
+	if err := AddAnimatedGIF(fcpxml, gifPath); err != nil {
+		t.Fatalf("AddAnimatedGIF failed: %v", err)
+	}
+
+	videos := fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine.Videos
+	if len(videos) != 3 {
+		t.Fatalf("Expected 3 frame videos, got %d", len(videos))
+	}
+
+	if expected := ConvertSecondsToFCPDuration(0.5); videos[0].Duration != expected {
+		t.Errorf("Frame 1: expected duration %s, got %s", expected, videos[0].Duration)
+	}
+
+	expectedNames := []string{"wave_0001", "wave_0002", "wave_0003"}
+	for i, video := range videos {
+		if video.Name != expectedNames[i] {
+			t.Errorf("Frame %d: expected name %s, got %s", i+1, expectedNames[i], video.Name)
+		}
+		if i > 0 {
+			expectedOffset := addDurations(videos[i-1].Offset, videos[i-1].Duration)
+			if video.Offset != expectedOffset {
+				t.Errorf("Frame %d: expected offset %s, got %s", i+1, expectedOffset, video.Offset)
+			}
+		}
+	}
+
+	// Total length matches the GIF's total delay, frame-aligned without drift
+	end := addDurations(videos[2].Offset, videos[2].Duration)
+	if expected := ConvertSecondsToFCPDuration(1.75); end != expected {
+		t.Errorf("Expected sequence to end at %s, got %s", expected, end)
+	}
+
+	// Frames live beside the GIF, in a directory named after it, and the assets reference them there
+	framesDir := filepath.Join(tempDir, gifFramesDir, "wave")
+	for _, asset := range fcpxml.Resources.Assets {
+		framePath := strings.TrimPrefix(asset.MediaRep.Src, "file://")
+		if filepath.Dir(framePath) != framesDir {
+			t.Errorf("Expected frame %s in %s", framePath, framesDir)
+		}
+		if _, err := os.Stat(framePath); err != nil {
+			t.Errorf("Expected frame file %s: %v", framePath, err)
+		}
+	}
+
+	// A second import of the same GIF rewrites the same frames and still validates
+	if err := AddAnimatedGIF(fcpxml, gifPath); err != nil {
+		t.Fatalf("Second AddAnimatedGIF failed: %v", err)
+	}
+
+	if err := WriteToFile(fcpxml, filepath.Join(tempDir, "gif.fcpxml")); err != nil {
+		t.Errorf("GIF sequence failed validation: %v", err)
+	}
+}
+
+// TestAddAnimatedGIFRollback tests that a failed frame leaves neither timeline changes nor frame files behind
+func TestAddAnimatedGIFRollback(t *testing.T) {
+	tempDir := t.TempDir()
+	gifPath := filepath.Join(tempDir, "wave.gif")
+	writeTestGIF(t, gifPath, []int{50, 100, 25})
+
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("Failed to generate empty FCPXML: %v", err)
+	}
+	before, err := xml.Marshal(fcpxml)
+	if err != nil {
+		t.Fatalf("Failed to marshal FCPXML: %v", err)
+	}
+
+	original := addGIFFrame
+	t.Cleanup(func() { addGIFFrame = original })
+	added := 0
+	addGIFFrame = func(fcpxml *FCPXML, imagePath string, durationSeconds float64) error {
+		if added == 2 {
+			return errors.New("injected failure")
+		}
+		added++
+		return original(fcpxml, imagePath, durationSeconds)
+	}
+
+	if err := AddAnimatedGIF(fcpxml, gifPath); err == nil || !strings.Contains(err.Error(), "injected failure") {
+		t.Fatalf("Expected the injected failure, got %v", err)
+	}
+
+	after, err := xml.Marshal(fcpxml)
+	if err != nil {
+		t.Fatalf("Failed to marshal FCPXML: %v", err)
+	}
+	if string(after) != string(before) {
+		t.Errorf("Expected the document to be rolled back after a failed frame")
+	}
+
+	if _, err := os.Stat(filepath.Join(tempDir, gifFramesDir)); !os.IsNotExist(err) {
+		t.Errorf("Expected the frames directory this call created to be removed, got %v", err)
+	}
+}
@@ -0,0 +1,154 @@
+package fcp
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DefaultKineticSecondsPerWord is how long each kinetic typography word stays on screen
+const DefaultKineticSecondsPerWord = 0.5
+
+// Basic Text transform/opacity parameter keys animated by the kinetic preset, as seen in samples.
+// Rotation isn't animated: no sample carries a verified Basic Text rotation key.
+const (
+	titleScaleKey   = "9999/10003/13260/3296672360/1/200"
+	titleOpacityKey = "9999/10003/13260/3296672360/4/3296673134/1000/1044"
+)
+
+// Kinetic word motion: each word grows from kineticStartScale past full size,
+// fading in and out over kineticFadeFraction of its time
+const (
+	kineticStartScale   = 0.6
+	kineticEndScale     = 1.15
+	kineticFadeFraction = 0.2
+)
+
+// AddKineticText appends words to the end of the timeline as punchy one-at-a-time titles.
+// Every word scales up and fades in and out over perWordSeconds.
+//
+// 🚨 CLAUDE.md Rules Applied Here:
+// - One spine title (no lane) per word, back to back at frame-aligned offsets
+// - Scale keyframes carry NO interp/curve attributes; opacity uses plain keyframes too
+// - Keyframe times are in each title's local time (title start → start + duration)
+// - The shared Text effect is reused or created through the ResourceRegistry/Transaction pattern
+func AddKineticText(fcpxml *FCPXML, words []string, perWordSeconds float64) error {
+	if perWordSeconds <= 0 {
+		return fmt.Errorf("seconds per word must be positive, got %g", perWordSeconds)
+	}
+
+	var cleaned []string
+	for _, word := range words {
+		if word = strings.TrimSpace(word); word != "" {
+			cleaned = append(cleaned, word)
+		}
+	}
+	if len(cleaned) == 0 {
+		return fmt.Errorf("no words to animate")
+	}
+
+	sequence, err := firstSequence(fcpxml)
+	if err != nil {
+		return err
+	}
+
+	registry := NewResourceRegistry(fcpxml)
+	tx := NewTransaction(registry)
+	defer tx.Rollback()
+
+	textEffectID := findEffectIDByUID(fcpxml, ".../Titles.localized/Basic Text.localized/Text.localized/Text.moti")
+	if textEffectID == "" {
+		textEffectID = tx.ReserveIDs(1)[0]
+		if _, err := tx.CreateEffect(textEffectID, "Text", ".../Titles.localized/Basic Text.localized/Text.localized/Text.moti"); err != nil {
+			return fmt.Errorf("failed to create text effect: %v", err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	wordDuration := ConvertSecondsToFCPDuration(perWordSeconds)
+	wordFrames := parseFCPDuration(wordDuration)
+	timelineEnd := parseFCPDuration(calculateTimelineDuration(sequence))
+
+	for i, word := range cleaned {
+		title := createKineticWordTitle(textEffectID, word, wordDuration, i)
+		title.Offset = formatFrameAlignedTime(timelineEnd + i*wordFrames)
+		sequence.Spine.Titles = append(sequence.Spine.Titles, title)
+	}
+
+	sequence.Duration = calculateTimelineDuration(sequence)
+	return nil
+}
+
+// createKineticWordTitle builds the animated title for the word at index
+func createKineticWordTitle(textEffectID, word, duration string, index int) Title {
+	start := "86486400/24000s"
+	startFrames := parseFCPDuration(start)
+	durationFrames := parseFCPDuration(duration)
+	fadeFrames := int(float64(durationFrames) * kineticFadeFraction)
+
+	at := func(frames int) string {
+		return formatFrameAlignedTime(startFrames + frames)
+	}
+
+	textStyleID := GenerateTextStyleID(word, fmt.Sprintf("kinetic_word_%d", index))
+
+	return Title{
+		Ref:      textEffectID,
+		Name:     word + " - Kinetic",
+		Start:    start,
+		Duration: duration,
+		Params: []Param{
+			{
+				Name: "Scale",
+				Key:  titleScaleKey,
+				KeyframeAnimation: &KeyframeAnimation{
+					Keyframes: []Keyframe{
+						{Time: at(0), Value: fmt.Sprintf("%g %g", kineticStartScale, kineticStartScale)},
+						{Time: at(durationFrames), Value: fmt.Sprintf("%g %g", kineticEndScale, kineticEndScale)},
+					},
+				},
+			},
+			{
+				Name: "Opacity",
+				Key:  titleOpacityKey,
+				KeyframeAnimation: &KeyframeAnimation{
+					Keyframes: []Keyframe{
+						{Time: at(0), Value: "0"},
+						{Time: at(fadeFrames), Value: "1"},
+						{Time: at(durationFrames - fadeFrames), Value: "1"},
+						{Time: at(durationFrames), Value: "0"},
+					},
+				},
+			},
+			{
+				Name:  "Alignment",
+				Key:   "9999/10003/13260/3296672360/2/354/3296667315/401",
+				Value: "1 (Center)",
+			},
+		},
+		Text: &TitleText{
+			TextStyles: []TextStyleRef{
+				{
+					Ref:  textStyleID,
+					Text: word,
+				},
+			},
+		},
+		TextStyleDefs: []TextStyleDef{
+			{
+				ID: textStyleID,
+				TextStyle: TextStyle{
+					Font:         "Helvetica Neue",
+					FontSize:     "320",
+					FontFace:     "Heavy",
+					FontColor:    "1 1 1 1",
+					Bold:         "1",
+					Alignment:    "center",
+					ShadowColor:  DefaultTextShadowColor,
+					ShadowOffset: DefaultTextShadowOffset,
+				},
+			},
+		},
+	}
+}
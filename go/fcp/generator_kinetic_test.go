@@ -0,0 +1,58 @@
+package fcp
+
+import (
+	"testing"
+)
+
+// TestAddKineticText tests that each word becomes its own sequential title with scale keyframes
+func TestAddKineticText(t *testing.T) {
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("GenerateEmpty failed: %v", err)
+	}
+
+	words := []string{"fast", "bold", "free"}
+	if err := AddKineticText(fcpxml, words, 0.5); err != nil {
+		t.Fatalf("AddKineticText failed: %v", err)
+	}
+
+	sequence := fcpxml.Library.Events[0].Projects[0].Sequences[0]
+	titles := sequence.Spine.Titles
+	if len(titles) != len(words) {
+		t.Fatalf("Expected %d titles, got %d", len(words), len(titles))
+	}
+
+	wordFrames := parseFCPDuration(ConvertSecondsToFCPDuration(0.5))
+	for i, title := range titles {
+		if title.Text.TextStyles[0].Text != words[i] {
+			t.Errorf("Title %d: expected word %q, got %q", i, words[i], title.Text.TextStyles[0].Text)
+		}
+		if parseFCPDuration(title.Offset) != i*wordFrames {
+			t.Errorf("Title %d: expected offset %d, got %s", i, i*wordFrames, title.Offset)
+		}
+		if title.Lane != "" {
+			t.Errorf("Title %d: spine titles must not have a lane, got %s", i, title.Lane)
+		}
+
+		var scale *Param
+		for j := range title.Params {
+			if title.Params[j].Name == "Scale" {
+				scale = &title.Params[j]
+			}
+		}
+		if scale == nil || scale.KeyframeAnimation == nil || len(scale.KeyframeAnimation.Keyframes) < 2 {
+			t.Fatalf("Title %d: expected scale keyframes", i)
+		}
+		keyframes := scale.KeyframeAnimation.Keyframes
+		if keyframes[0].Time != title.Start || keyframes[len(keyframes)-1].Time != addDurations(title.Start, title.Duration) {
+			t.Errorf("Title %d: scale keyframes should span the title's local time, got %s → %s", i, keyframes[0].Time, keyframes[len(keyframes)-1].Time)
+		}
+	}
+
+	if sequence.Duration != formatFrameAlignedTime(3*wordFrames) {
+		t.Errorf("Expected sequence duration %s, got %s", formatFrameAlignedTime(3*wordFrames), sequence.Duration)
+	}
+	if violations := ValidateClaudeCompliance(fcpxml); len(violations) > 0 {
+		t.Errorf("Kinetic text failed validation: %v", violations)
+	}
+}
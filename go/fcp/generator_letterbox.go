@@ -0,0 +1,183 @@
+package fcp
+
+import (
+	"fmt"
+	"strings"
+)
+
+// letterboxBarPrefix names every bar so AddLetterbox can replace bars from an earlier call
+const letterboxBarPrefix = "Letterbox Bar"
+
+// LetterboxBars describes the two matte bars that frame a target aspect inside a sequence.
+// Sizes and positions are in adjust-transform units (percent of frame height, origin at
+// frame center), so they hold for any sequence resolution.
+type LetterboxBars struct {
+	Horizontal bool    // true for top/bottom bars (letterbox), false for side bars (pillarbox)
+	Thickness  float64 // Fraction of the frame height (letterbox) or width (pillarbox) covered by each bar
+	Offset     float64 // Distance of each bar's center from frame center along the bar axis
+}
+
+// CalculateLetterboxBars works out the bars that crop a sequence of sequenceAspect (width/height)
+// to targetAspect. ok is false when the aspects already match and no bars are needed.
+func CalculateLetterboxBars(sequenceAspect, targetAspect float64) (LetterboxBars, bool) {
+	if sequenceAspect <= 0 || targetAspect <= 0 || sequenceAspect == targetAspect {
+		return LetterboxBars{}, false
+	}
+
+	// Wider target: the picture keeps the full width and loses height to top/bottom bars
+	if targetAspect > sequenceAspect {
+		thickness := (1 - sequenceAspect/targetAspect) / 2
+		return LetterboxBars{Horizontal: true, Thickness: thickness, Offset: 50 - thickness*100/2}, true
+	}
+
+	// Narrower target: the picture keeps the full height and loses width to side bars
+	thickness := (1 - targetAspect/sequenceAspect) / 2
+	frameWidth := 100 * sequenceAspect
+	return LetterboxBars{Horizontal: false, Thickness: thickness, Offset: frameWidth/2 - thickness*frameWidth/2}, true
+}
+
+// AddLetterbox overlays black bars that frame the timeline at targetAspect (e.g. 2.39 for a
+// cinematic look on a 16:9 sequence, or 0.5625 for a 9:16 window). durationSeconds <= 0 spans
+// the whole sequence.
+//
+// 🚨 CLAUDE.md Rules Applied Here:
+// - Only verified UIDs: each bar is the Vivid generator filled black and squashed with its own adjust-transform
+// - Bars are connected clips nested in the first spine element, on lanes above all existing content
+// - Bar thickness comes from the sequence format's real width/height, not an assumed 16:9
+// - Calling it again replaces existing bars instead of stacking duplicates
+func AddLetterbox(fcpxml *FCPXML, targetAspect float64, durationSeconds float64) error {
+	if targetAspect <= 0 {
+		return fmt.Errorf("invalid letterbox aspect %.3f (must be positive, e.g. 2.39)", targetAspect)
+	}
+
+	sequence, err := firstSequence(fcpxml)
+	if err != nil {
+		return err
+	}
+
+	width, height, err := sequenceFrameSize(fcpxml, sequence)
+	if err != nil {
+		return err
+	}
+
+	bars, ok := CalculateLetterboxBars(width/height, targetAspect)
+	if !ok {
+		return fmt.Errorf("sequence is already %.3f:1, no letterbox needed", targetAspect)
+	}
+
+	duration := ConvertSecondsToFCPDuration(durationSeconds)
+	if durationSeconds <= 0 {
+		duration = sequence.Duration
+	}
+	if parseFCPDuration(duration) <= 0 {
+		return fmt.Errorf("letterbox bars need a duration (sequence is empty)")
+	}
+
+	removeLetterboxBars(sequence)
+
+	parentVideo, parentClip := timelineStartParent(sequence)
+	if parentVideo == nil && parentClip == nil {
+		return fmt.Errorf("no video or asset-clip in spine to attach letterbox bars to")
+	}
+	parentStart, highestLane := connectedParentLayout(parentVideo, parentClip)
+
+	registry := NewResourceRegistry(fcpxml)
+	tx := NewTransaction(registry)
+	defer tx.Rollback()
+
+	vividID := findEffectIDByUID(fcpxml, ".../Generators.localized/Solids.localized/Vivid.localized/Vivid.motn")
+	if vividID == "" {
+		vividID = tx.ReserveIDs(1)[0]
+		if _, err := tx.CreateEffect(vividID, "Vivid", ".../Generators.localized/Solids.localized/Vivid.localized/Vivid.motn"); err != nil {
+			return fmt.Errorf("failed to create Vivid generator: %v", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	type bar struct {
+		name            string
+		scale, position string
+	}
+	var layout []bar
+	if bars.Horizontal {
+		scale := fmt.Sprintf("1 %.4f", bars.Thickness)
+		layout = []bar{
+			{"Top", scale, fmt.Sprintf("0 %.4f", bars.Offset)},
+			{"Bottom", scale, fmt.Sprintf("0 %.4f", -bars.Offset)},
+		}
+	} else {
+		scale := fmt.Sprintf("%.4f 1", bars.Thickness)
+		layout = []bar{
+			{"Left", scale, fmt.Sprintf("%.4f 0", -bars.Offset)},
+			{"Right", scale, fmt.Sprintf("%.4f 0", bars.Offset)},
+		}
+	}
+
+	var barVideos []Video
+	for i, b := range layout {
+		barVideos = append(barVideos, Video{
+			Ref:      vividID,
+			Lane:     fmt.Sprintf("%d", highestLane+1+i),
+			Offset:   parentStart,
+			Name:     fmt.Sprintf("%s - %s (%.2f:1)", letterboxBarPrefix, b.name, targetAspect),
+			Start:    "0s",
+			Duration: duration,
+			Params: []Param{
+				{Name: "Fill Color", Value: "0 0 0 1"},
+			},
+			AdjustTransform: &AdjustTransform{
+				Position: b.position,
+				Scale:    b.scale,
+			},
+		})
+	}
+
+	if parentVideo != nil {
+		parentVideo.NestedVideos = append(parentVideo.NestedVideos, barVideos...)
+	} else {
+		parentClip.Videos = append(parentClip.Videos, barVideos...)
+	}
+
+	return nil
+}
+
+// sequenceFrameSize returns the width and height of the sequence's format
+func sequenceFrameSize(fcpxml *FCPXML, sequence *Sequence) (float64, float64, error) {
+	var sequenceFormat *Format
+	for i := range fcpxml.Resources.Formats {
+		if fcpxml.Resources.Formats[i].ID == sequence.Format {
+			sequenceFormat = &fcpxml.Resources.Formats[i]
+		}
+	}
+	if sequenceFormat == nil {
+		return 0, 0, fmt.Errorf("sequence format '%s' is not defined", sequence.Format)
+	}
+	width, height := parseFormatDimension(sequenceFormat.Width), parseFormatDimension(sequenceFormat.Height)
+	if width <= 0 || height <= 0 {
+		return 0, 0, fmt.Errorf("sequence format '%s' has no frame size", sequence.Format)
+	}
+	return width, height, nil
+}
+
+// removeLetterboxBars strips bars from an earlier AddLetterbox call out of the spine
+func removeLetterboxBars(sequence *Sequence) {
+	without := func(videos []Video) []Video {
+		var kept []Video
+		for _, video := range videos {
+			if !strings.HasPrefix(video.Name, letterboxBarPrefix) {
+				kept = append(kept, video)
+			}
+		}
+		return kept
+	}
+
+	for i := range sequence.Spine.Videos {
+		sequence.Spine.Videos[i].NestedVideos = without(sequence.Spine.Videos[i].NestedVideos)
+	}
+	for i := range sequence.Spine.AssetClips {
+		sequence.Spine.AssetClips[i].Videos = without(sequence.Spine.AssetClips[i].Videos)
+	}
+}
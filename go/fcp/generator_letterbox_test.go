@@ -0,0 +1,93 @@
+package fcp
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestAddLetterbox tests that a 2.39 target on a 16:9 sequence adds top and bottom bars of the computed height
+func TestAddLetterbox(t *testing.T) {
+	tempDir := t.TempDir()
+	imagePath := filepath.Join(tempDir, "frame.png")
+	if err := os.WriteFile(imagePath, []byte("fake png data"), 0644); err != nil {
+		t.Fatalf("Failed to create test image: %v", err)
+	}
+
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("Failed to generate empty FCPXML: %v", err)
+	}
+	if err := AddImage(fcpxml, imagePath, 10.0); err != nil {
+		t.Fatalf("Failed to add image: %v", err)
+	}
+
+	if err := AddLetterbox(fcpxml, 2.39, 0); err != nil {
+		t.Fatalf("AddLetterbox failed: %v", err)
+	}
+	// Adding again must replace rather than duplicate
+	if err := AddLetterbox(fcpxml, 2.39, 0); err != nil {
+		t.Fatalf("Second AddLetterbox failed: %v", err)
+	}
+
+	sequence := fcpxml.Library.Events[0].Projects[0].Sequences[0]
+	bars := sequence.Spine.Videos[0].NestedVideos
+	if len(bars) != 2 {
+		t.Fatalf("Expected 2 letterbox bars, got %d", len(bars))
+	}
+
+	// 1280x720 shows a 1280x535.56 picture at 2.39:1, leaving 92.22px (12.81% of the height) per bar
+	barHeight := (720 - 1280/2.39) / 2 / 720
+	if math.Abs(barHeight*720-92.22) > 0.01 {
+		t.Fatalf("Unexpected reference bar height %.2fpx", barHeight*720)
+	}
+	expectedScale := fmt.Sprintf("1 %.4f", barHeight)
+	expectedPositions := []string{
+		fmt.Sprintf("0 %.4f", 50-barHeight*50),
+		fmt.Sprintf("0 %.4f", -(50 - barHeight*50)),
+	}
+
+	for i, bar := range bars {
+		if !strings.HasPrefix(bar.Name, letterboxBarPrefix) {
+			t.Errorf("Bar %d: unexpected name %s", i, bar.Name)
+		}
+		if bar.AdjustTransform == nil || bar.AdjustTransform.Scale != expectedScale {
+			t.Errorf("Bar %d: expected scale %s, got %+v", i, expectedScale, bar.AdjustTransform)
+		} else if bar.AdjustTransform.Position != expectedPositions[i] {
+			t.Errorf("Bar %d: expected position %s, got %s", i, expectedPositions[i], bar.AdjustTransform.Position)
+		}
+		if bar.Lane == "" {
+			t.Errorf("Bar %d: expected a lane", i)
+		}
+		if bar.Duration != sequence.Duration {
+			t.Errorf("Bar %d: expected to span timeline %s, got %s", i, sequence.Duration, bar.Duration)
+		}
+	}
+
+	violations := ValidateClaudeCompliance(fcpxml)
+	if len(violations) > 0 {
+		t.Errorf("Letterbox produced violations: %v", violations)
+	}
+}
+
+// TestCalculateLetterboxBarsPillarbox tests that a narrower target produces side bars
+func TestCalculateLetterboxBarsPillarbox(t *testing.T) {
+	bars, ok := CalculateLetterboxBars(16.0/9.0, 1.0)
+	if !ok {
+		t.Fatalf("Expected bars for a 1:1 target on 16:9")
+	}
+	if bars.Horizontal {
+		t.Errorf("Expected side bars for a narrower target")
+	}
+	// A square picture keeps 9/16 of the width, leaving 7/32 per side
+	if math.Abs(bars.Thickness-7.0/32.0) > 1e-9 {
+		t.Errorf("Expected thickness %.5f, got %.5f", 7.0/32.0, bars.Thickness)
+	}
+
+	if _, ok := CalculateLetterboxBars(16.0/9.0, 16.0/9.0); ok {
+		t.Errorf("Expected no bars when the aspects match")
+	}
+}
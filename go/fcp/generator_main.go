@@ -8,6 +8,7 @@ import (
 	"os"
 	"path/filepath"
 	"sort"
+	"strconv"
 	"strings"
 )
 
@@ -98,8 +99,9 @@ func generateRandomPosition() string {
 	return fmt.Sprintf("%d %d", x, y)
 }
 
-// createNestedVideoElement creates a main video element with nested overlays (proper multi-lane structure)
-func createNestedVideoElement(fcpxml *FCPXML, tx *ResourceTransaction, videoPath string, duration float64, verbose bool, assets *AssetCollection, createdAssets, createdFormats map[string]string) (*Video, error) {
+// createNestedVideoElement creates a main video element with nested overlays (proper multi-lane structure).
+// Every random choice, including the image bag, comes from rng so a seeded run repeats.
+func createNestedVideoElement(fcpxml *FCPXML, tx *ResourceTransaction, videoPath string, duration float64, verbose bool, rng *rand.Rand, assets *AssetCollection, createdAssets, createdFormats map[string]string) (*Video, error) {
 	// Create main video asset
 	var assetID, formatID string
 	var err error
@@ -129,23 +131,24 @@ func createNestedVideoElement(fcpxml *FCPXML, tx *ResourceTransaction, videoPath
 	}
 
 	// 🚨 EXTREME NESTED CHAOS: 50-200 overlays per main video!
-	numOverlays := 50 + rand.Intn(150)
+	numOverlays := 50 + rng.Intn(150)
+	images := newImageBag(assets.Images, rng)
 
 	for i := 1; i <= numOverlays; i++ {
 		// 🚨 EXTREME: Overlays can start/end anywhere, even negative times
-		overlayStartTime := -duration + rand.Float64()*(duration*3.0)
-		overlayDuration := 0.01 + rand.Float64()*(duration*2.0) // Tiny to huge durations
+		overlayStartTime := -duration + rng.Float64()*(duration*3.0)
+		overlayDuration := 0.01 + rng.Float64()*(duration*2.0) // Tiny to huge durations
 		
 		// 🚨 EXTREME: Massive lane numbers, negatives, zero
-		lane := -10 + rand.Intn(21) // Valid range: -10 to +10
+		lane := -10 + rng.Intn(21) // Valid range: -10 to +10
 
-		overlayType := rand.Intn(3)
+		overlayType := rng.Intn(3)
 
 		switch overlayType {
 		case 0:
 			if len(assets.Images) > 0 {
-				imagePath := assets.Images[rand.Intn(len(assets.Images))]
-				uniqueImage, err := createUniqueMediaCopy(imagePath, fmt.Sprintf("overlay_img_%d", i))
+				imagePath := images.Next()
+				uniqueImage, err := tx.createUniqueMediaCopy(imagePath, fmt.Sprintf("overlay_img_%d", i))
 				if err != nil && verbose {
 					fmt.Printf("Warning: Failed to create unique image copy: %v\n", err)
 					uniqueImage = imagePath
@@ -161,8 +164,8 @@ func createNestedVideoElement(fcpxml *FCPXML, tx *ResourceTransaction, videoPath
 
 		case 1:
 			if len(assets.Videos) > 0 {
-				videoPath := assets.Videos[rand.Intn(len(assets.Videos))]
-				uniqueVideo, err := createUniqueMediaCopy(videoPath, fmt.Sprintf("overlay_vid_%d", i))
+				videoPath := assets.Videos[rng.Intn(len(assets.Videos))]
+				uniqueVideo, err := tx.createUniqueMediaCopy(videoPath, fmt.Sprintf("overlay_vid_%d", i))
 				if err != nil && verbose {
 					fmt.Printf("Warning: Failed to create unique video copy: %v\n", err)
 					uniqueVideo = videoPath
@@ -196,8 +199,8 @@ func createNestedVideoElement(fcpxml *FCPXML, tx *ResourceTransaction, videoPath
 	return mainVideo, nil
 }
 
-// createNestedAssetClipElement creates an asset-clip with nested overlays
-func createNestedAssetClipElement(fcpxml *FCPXML, tx *ResourceTransaction, videoPath string, startTime, duration float64, index int, verbose bool, assets *AssetCollection, createdAssets, createdFormats map[string]string) (*AssetClip, error) {
+// createNestedAssetClipElement creates an asset-clip with nested overlays, drawing from rng
+func createNestedAssetClipElement(fcpxml *FCPXML, tx *ResourceTransaction, videoPath string, startTime, duration float64, index int, verbose bool, rng *rand.Rand, assets *AssetCollection, createdAssets, createdFormats map[string]string) (*AssetClip, error) {
 	// Create video asset
 	var assetID, formatID string
 	var err error
@@ -226,19 +229,20 @@ func createNestedAssetClipElement(fcpxml *FCPXML, tx *ResourceTransaction, video
 		Name:     fmt.Sprintf("MainClip_%d", index),
 	}
 
-	numOverlays := 2 + rand.Intn(4)
+	numOverlays := 2 + rng.Intn(4)
+	images := newImageBag(assets.Images, rng)
 
 	for i := 1; i <= numOverlays; i++ {
-		overlayStartTime := rand.Float64() * (duration * 0.7)
-		overlayDuration := 2.0 + rand.Float64()*4.0
+		overlayStartTime := rng.Float64() * (duration * 0.7)
+		overlayDuration := 2.0 + rng.Float64()*4.0
 
 		if overlayStartTime+overlayDuration > duration {
 			overlayDuration = duration - overlayStartTime
 		}
 
-		if rand.Float32() < 0.6 && len(assets.Images) > 0 {
-			imagePath := assets.Images[rand.Intn(len(assets.Images))]
-			uniqueImage, err := createUniqueMediaCopy(imagePath, fmt.Sprintf("nested_img_%d_%d", index, i))
+		if rng.Float32() < 0.6 && len(assets.Images) > 0 {
+			imagePath := images.Next()
+			uniqueImage, err := tx.createUniqueMediaCopy(imagePath, fmt.Sprintf("nested_img_%d_%d", index, i))
 			if err != nil && verbose {
 				fmt.Printf("Warning: Failed to create unique image copy: %v\n", err)
 				uniqueImage = imagePath
@@ -377,14 +381,31 @@ func addBaffleImageElement(fcpxml *FCPXML, tx *ResourceTransaction, imagePath st
 }
 
 // PngPileConfig holds configuration for PNG pile generation  
+
 type PngPileConfig struct {
-	Duration      float64 // Total duration in seconds
-	TotalImages   int     // Number of images to download/use
-	OutputDir     string  // Directory to store downloaded images
-	PixabayAPIKey string  // Pixabay API key (optional)
-	UseExisting   bool    // Use existing images in OutputDir instead of downloading
+	Duration         float64      // Total duration in seconds
+	TotalImages      int          // Number of images to download/use
+	OutputDir        string       // Directory to store downloaded images
+	PixabayAPIKey    string       // Pixabay API key (optional)
+	UseExisting      bool         // Use existing images in OutputDir instead of downloading
+	BorderColor      *[4]float64  // Simple Border RGBA color (0.0-1.0 per channel); nil uses DefaultPngPileBorderColor
+	NoBorder         bool         // Omit the Simple Border filter entirely
+	OptimizeImages   bool         // Reference downscaled cached copies of images larger than MaxEdge
+	MaxEdge          int          // Longest edge in pixels for OptimizeImages (0 uses DefaultMaxImageEdge)
+	Pace             PaceConfig   // Spacing of image start times (zero value accelerates)
+	AttributionsPath string       // Write photographer/source credits for each downloaded image used here (e.g. CREDITS.txt); needs UseExisting false
+	NumberOverlay    bool         // Put a large 1..N number title in the corner of each PNG ("top 10" countdowns)
+	Limits           RenderLimits // Element/duration caps; an oversized pile warns, or fails with *ErrTooLarge when strict
+	SkipBadImages    bool         // Leave out images that are almost entirely black, blown out or undecodable (see AnalyzeImage)
 }
 
+// Default PNG pile border matches Info.fcpxml: solid black Simple Border
+var DefaultPngPileBorderColor = [4]float64{0, 0, 0, 1}
+
+// Simple Border color parameter key (from Info.fcpxml). Only the color is set;
+// the border width stays at the effect's default.
+const simpleBorderColorKey = "9999/987171795/987171799/3/987171806/2"
+
 // GeneratePngPile creates a PNG pile effect similar to Info.fcpxml with base video and sliding PNGs
 func GeneratePngPile(duration float64, totalImages int, inputDir string, verbose bool) (*FCPXML, error) {
 	config := &PngPileConfig{
@@ -396,8 +417,17 @@ func GeneratePngPile(duration float64, totalImages int, inputDir string, verbose
 	return GeneratePngPileWithConfig(config, verbose)
 }
 
-// GeneratePngPileWithConfig creates a PNG pile effect with full configuration options
+// GeneratePngPileWithConfig creates a PNG pile effect with full configuration options.
+// Generation is all-or-nothing: if any image can't be added the transaction is rolled back,
+// releasing its reserved IDs, and an error is returned instead of a pile with missing images.
 func GeneratePngPileWithConfig(config *PngPileConfig, verbose bool) (*FCPXML, error) {
+	if err := config.Pace.Validate(); err != nil {
+		return nil, err
+	}
+	if config.AttributionsPath != "" && config.UseExisting {
+		return nil, fmt.Errorf("image credits need downloaded images; existing images carry no attributions")
+	}
+
 	if verbose {
 		fmt.Printf("Generating PNG pile with %.1fs duration, %d images\n", config.Duration, config.TotalImages)
 	}
@@ -454,6 +484,15 @@ func GeneratePngPileWithConfig(config *PngPileConfig, verbose bool) (*FCPXML, er
 
 	// Calculate how many video clips needed to cover full duration
 	numClips := int(math.Ceil(config.Duration / videoClipDuration))
+
+	// Refuse (or warn about) absurd piles before thousands of clips are built in memory
+	estimatedElements := numClips + config.TotalImages
+	if config.NumberOverlay {
+		estimatedElements += config.TotalImages
+	}
+	if err := config.Limits.Check(estimatedElements, config.Duration); err != nil {
+		return nil, err
+	}
 	if verbose {
 		fmt.Printf("Creating %d video clips of %.2fs each to cover %.1fs total\n", numClips, videoClipDuration, config.Duration)
 	}
@@ -495,6 +534,7 @@ func GeneratePngPileWithConfig(config *PngPileConfig, verbose bool) (*FCPXML, er
 
 	// Get or download PNG files
 	var pngFiles []string
+	var attributions []ImageAttribution
 	if config.UseExisting {
 		// Use existing files from directory
 		pngFiles, err = getPngFiles(config.OutputDir)
@@ -506,10 +546,21 @@ func GeneratePngPileWithConfig(config *PngPileConfig, verbose bool) (*FCPXML, er
 		}
 	} else {
 		// Download themed images from Pixabay
-		pngFiles, err = downloadThemedImagesForPile(config, verbose)
+		attributions, err = downloadThemedImagesForPile(config, verbose)
 		if err != nil {
 			return nil, fmt.Errorf("failed to download themed images: %v", err)
 		}
+		for _, attribution := range attributions {
+			pngFiles = append(pngFiles, attribution.FilePath)
+		}
+		if len(pngFiles) < config.TotalImages {
+			fmt.Fprintf(os.Stderr, "Warning: only %d of %d images downloaded; the pile will use %d\n", len(pngFiles), config.TotalImages, len(pngFiles))
+		}
+	}
+
+	// Drop black/blown-out frames and broken downloads before picking the images to use
+	if config.SkipBadImages {
+		pngFiles = FilterBadImages(pngFiles)
 	}
 
 	if len(pngFiles) == 0 {
@@ -525,20 +576,61 @@ func GeneratePngPileWithConfig(config *PngPileConfig, verbose bool) (*FCPXML, er
 		fmt.Printf("Using %d images for PNG pile\n", len(pngFiles))
 	}
 
-	// Create border effect like Info.fcpxml
-	effectIDs := tx.ReserveIDs(1)
-	borderEffectID := effectIDs[0]
-	_, err = tx.CreateEffect(borderEffectID, "Simple Border", ".../Effects.localized/Stylize.localized/Simple Border.localized/Simple Border.moef")
-	if err != nil {
-		return nil, fmt.Errorf("failed to create border effect: %v", err)
+	// Credit exactly the downloads that made it into the pile, before optimizing renames them
+	if config.AttributionsPath != "" {
+		if err := WriteAttributionCredits(config.AttributionsPath, attributionsFor(attributions, pngFiles)); err != nil {
+			return nil, err
+		}
+		if verbose {
+			fmt.Printf("Wrote image credits to %s\n", config.AttributionsPath)
+		}
+	}
+
+	if config.OptimizeImages {
+		maxEdge := config.MaxEdge
+		if maxEdge <= 0 {
+			maxEdge = DefaultMaxImageEdge
+		}
+		optimized, err := OptimizeImages(pngFiles, maxEdge)
+		if err != nil {
+			return nil, fmt.Errorf("failed to optimize images: %v", err)
+		}
+		pngFiles = optimized.Paths
+		fmt.Printf("Optimized %d images to max %dpx edge, saved %d bytes\n", optimized.Resized, maxEdge, optimized.BytesSaved)
+	}
+
+	// Create border effect like Info.fcpxml (skipped entirely with NoBorder)
+	var borderFilters []FilterVideo
+	if !config.NoBorder {
+		borderColor := DefaultPngPileBorderColor
+		if config.BorderColor != nil {
+			borderColor = *config.BorderColor
+		}
+		effectIDs := tx.ReserveIDs(1)
+		borderEffectID := effectIDs[0]
+		_, err = tx.CreateEffect(borderEffectID, "Simple Border", ".../Effects.localized/Stylize.localized/Simple Border.localized/Simple Border.moef")
+		if err != nil {
+			return nil, fmt.Errorf("failed to create border effect: %v", err)
+		}
+		borderFilters = createSimpleBorderFilters(borderEffectID, borderColor)
 	}
 
-	// Calculate timing progression (starts slow, speeds up)
-	imageTimings := calculateProgessiveTiming(len(pngFiles), config.Duration)
+	// Calculate timing progression (spacing follows config.Pace)
+	imageTimings := calculateProgessiveTiming(len(pngFiles), config.Duration, config.Pace)
+
+	// Numbered piles share one Text effect for every number title
+	var numberEffectID string
+	if config.NumberOverlay {
+		numberEffectID = tx.ReserveIDs(1)[0]
+		if _, err := tx.CreateEffect(numberEffectID, "Text", ".../Titles.localized/Basic Text.localized/Text.localized/Text.moti"); err != nil {
+			return nil, fmt.Errorf("failed to create number text effect: %v", err)
+		}
+	}
 
 	// Add PNG images to the FIRST video clip only (like Info.fcpxml - only first clip has images)
 	if len(videoClips) > 0 {
 		firstClip := &videoClips[0] // Get reference to first clip
+		var numbers []NumberSection
 		
 		for i, pngFile := range pngFiles {
 			timing := imageTimings[i]
@@ -547,14 +639,21 @@ func GeneratePngPileWithConfig(config *PngPileConfig, verbose bool) (*FCPXML, er
 				fmt.Printf("Adding PNG %d/%d: %s at %.2fs, lane %d\n", i+1, len(pngFiles), filepath.Base(pngFile), timing.startTime, i+1)
 			}
 
-			err = addSlidingPngImageToAssetClip(firstClip, tx, pngFile, timing, i, borderEffectID, verbose, createdAssets, createdFormats)
+			err = addPileImage(firstClip, tx, pngFile, timing, i, borderFilters, verbose, createdAssets, createdFormats)
 			if err != nil {
-				if verbose {
-					fmt.Printf("Warning: Failed to add PNG %s: %v\n", pngFile, err)
-				}
-				continue
+				// Abort instead of committing a pile with holes; the deferred Rollback releases the reserved IDs
+				return nil, fmt.Errorf("failed to add PNG %s: %v", pngFile, err)
+			}
+
+			if config.NumberOverlay {
+				added := firstClip.Videos[len(firstClip.Videos)-1]
+				numbers = append(numbers, NumberSection{Number: len(numbers) + 1, VideoID: added.Ref, Offset: added.Offset})
 			}
 		}
+
+		if config.NumberOverlay {
+			addPngPileNumberTitles(firstClip, numberEffectID, TemplateData{Numbers: numbers})
+		}
 	}
 
 	// Get spine reference and add ALL video clips back-to-back (repeat 6s video for full duration)
@@ -575,7 +674,7 @@ func GeneratePngPileWithConfig(config *PngPileConfig, verbose bool) (*FCPXML, er
 	fcpxml.Library.Events[0].Projects[0].Sequences[0].Duration = ConvertSecondsToFCPDuration(config.Duration)
 
 	// 🚨 CRITICAL: VALIDATE COMPLIANCE (per CLAUDE.md)
-	violations := ValidateClaudeCompliance(fcpxml)
+	violations, _ := SplitValidationWarnings(ValidateClaudeCompliance(fcpxml))
 	if len(violations) > 0 {
 		return nil, fmt.Errorf("ERROR: validation failed with %d violations:\n%s", len(violations), strings.Join(violations, "\n"))
 	}
@@ -593,22 +692,75 @@ type ImageTiming struct {
 	duration  float64
 }
 
-// calculateProgessiveTiming calculates start times with FAST initial pace that gets even faster
-func calculateProgessiveTiming(numImages int, totalDuration float64) []ImageTiming {
+// PNG pile pace curves for PaceConfig.Curve
+const (
+	PaceAccelerate = "accelerate" // Gaps between images shrink (default)
+	PaceDecelerate = "decelerate" // Images arrive in quick succession, then the gaps grow
+	PaceLinear     = "linear"     // Evenly spaced start times
+	PaceEase       = "ease"       // Slow at both ends, fastest in the middle
+)
+
+// DefaultPaceBase is the decay base for accelerate/decelerate curves
+const DefaultPaceBase = 0.4
+
+// minLastImageSeconds is the least screen time the final image of the pile gets
+const minLastImageSeconds = 1.0
+
+// PaceConfig controls how the gaps between PNG pile images change over time.
+// Base (0-1, exclusive) sets how steep the accelerate/decelerate curves are; smaller is steeper.
+type PaceConfig struct {
+	Curve string
+	Base  float64
+}
+
+// Validate checks the curve name and base, treating zero values as the defaults
+func (p PaceConfig) Validate() error {
+	switch p.Curve {
+	case "", PaceAccelerate, PaceDecelerate, PaceLinear, PaceEase:
+	default:
+		return fmt.Errorf("unknown pace curve '%s' (use %s, %s, %s or %s)", p.Curve, PaceAccelerate, PaceDecelerate, PaceLinear, PaceEase)
+	}
+	if p.Base < 0 || p.Base >= 1 {
+		return fmt.Errorf("pace base must be between 0 and 1, got %g", p.Base)
+	}
+	return nil
+}
+
+// stepWeight returns the relative gap after image i of numImages for the configured curve
+func (p PaceConfig) stepWeight(i, numImages int) float64 {
+	base := p.Base
+	if base == 0 {
+		base = DefaultPaceBase
+	}
+
+	switch p.Curve {
+	case PaceDecelerate:
+		return math.Pow(base, float64(numImages-1-i)/8.0)
+	case PaceLinear:
+		return 1
+	case PaceEase:
+		// Large gaps at the ends, small in the middle
+		return 1 - 0.8*math.Sin(math.Pi*(float64(i)+0.5)/float64(numImages))
+	default:
+		// More aggressive acceleration curve - starts fast, gets insane
+		return math.Pow(base, float64(i)/8.0)
+	}
+}
+
+// calculateProgessiveTiming calculates start times whose spacing follows the pace curve
+// (by default a FAST initial pace that gets even faster)
+func calculateProgessiveTiming(numImages int, totalDuration float64, pace PaceConfig) []ImageTiming {
 	timings := make([]ImageTiming, numImages)
 	
-	// 🚨 FAST FROM START: Begin with rapid-fire PNGs, then accelerate to insanity!
-	// Much faster initial pace - PNGs flying in like machine gun fire
 	totalWeight := 0.0
 	for i := 0; i < numImages; i++ {
-		// More aggressive acceleration curve - starts fast, gets insane
-		weight := math.Pow(0.4, float64(i)/8.0) // Faster decay = quicker acceleration
-		totalWeight += weight
+		totalWeight += pace.stepWeight(i, numImages)
 	}
 	
+	// Latest start that still leaves the final image on screen long enough
+	latestStart := math.Max(0, totalDuration-minLastImageSeconds)
+
 	currentTime := 0.0
-	// Each image persists from its start time until the end of the video (pile up effect)
-	
 	for i := 0; i < numImages; i++ {
 		// Each image lasts from its start time until the end of the video (pile up effect)
 		remainingDuration := totalDuration - currentTime
@@ -617,23 +769,70 @@ func calculateProgessiveTiming(numImages int, totalDuration float64) []ImageTimi
 			duration:  remainingDuration,
 		}
 		
-		// Calculate time until next image (starts fast, gets MUCH faster)
-		weight := math.Pow(0.4, float64(i)/8.0)
-		// Use much smaller portion of total duration for faster initial pace
-		timeStep := (totalDuration * 0.5) * (weight / totalWeight) // Reduced from 0.8 to 0.5
+		// Use half of the total duration for the arrivals so the finished pile stays on screen
+		timeStep := (totalDuration * 0.5) * (pace.stepWeight(i, numImages) / totalWeight)
 		currentTime += timeStep
 		
 		// Don't go past the end
-		if currentTime > totalDuration-0.5 { // Leave only 0.5 seconds for final images
-			currentTime = totalDuration - 0.5
+		if currentTime > latestStart {
+			currentTime = latestStart
 		}
 	}
 	
 	return timings
 }
 
+// createSimpleBorderFilters builds a Simple Border filter (e.g. the border applied to every PNG in the pile)
+func createSimpleBorderFilters(borderEffectID string, color [4]float64) []FilterVideo {
+	return []FilterVideo{
+		{
+			Ref:  borderEffectID,
+			Name: "Simple Border",
+			Params: []Param{
+				{
+					Name:  "Color",
+					Key:   simpleBorderColorKey,
+					Value: formatRGBA(color),
+				},
+			},
+		},
+	}
+}
+
+// formatRGBA formats an RGBA color as the space-separated string FCP params expect (e.g. "0 0 0 1")
+func formatRGBA(color [4]float64) string {
+	parts := make([]string, len(color))
+	for i, c := range color {
+		parts[i] = strconv.FormatFloat(c, 'f', -1, 64)
+	}
+	return strings.Join(parts, " ")
+}
+
+// ParseRGBA parses a space-separated "r g b a" color string (each 0.0-1.0) such as "1 1 1 1"
+func ParseRGBA(value string) ([4]float64, error) {
+	var color [4]float64
+	fields := strings.Fields(value)
+	if len(fields) != 4 {
+		return color, fmt.Errorf("color must have 4 components (r g b a), got %d", len(fields))
+	}
+	for i, field := range fields {
+		c, err := strconv.ParseFloat(field, 64)
+		if err != nil {
+			return color, fmt.Errorf("invalid color component '%s': %v", field, err)
+		}
+		if c < 0 || c > 1 {
+			return color, fmt.Errorf("color component %s out of range 0.0-1.0", field)
+		}
+		color[i] = c
+	}
+	return color, nil
+}
+
+// addPileImage nests one PNG in the pile's base clip; a variable so tests can inject failures
+var addPileImage = addSlidingPngImageToAssetClip
+
 // addSlidingPngImageToAssetClip adds a PNG as nested Video within AssetClip with lane assignment (like Info.fcpxml)
-func addSlidingPngImageToAssetClip(baseClip *AssetClip, tx *ResourceTransaction, pngPath string, timing ImageTiming, index int, borderEffectID string, verbose bool, createdAssets, createdFormats map[string]string) error {
+func addSlidingPngImageToAssetClip(baseClip *AssetClip, tx *ResourceTransaction, pngPath string, timing ImageTiming, index int, borderFilters []FilterVideo, verbose bool, createdAssets, createdFormats map[string]string) error {
 	// Create image asset if not exists
 	var assetID, formatID string
 	var err error
@@ -651,7 +850,9 @@ func addSlidingPngImageToAssetClip(baseClip *AssetClip, tx *ResourceTransaction,
 			return fmt.Errorf("failed to create PNG asset: %v", err)
 		}
 
-		_, err = tx.CreateFormat(formatID, "FFVideoFormatRateUndefined", "800", "600", "1-13-1")
+		// The pile sequence is 1920x1080, used when the PNG header can't be read
+		width, height := imageFormatSize(pngPath, "1920", "1080")
+		_, err = tx.CreateFormat(formatID, "FFVideoFormatRateUndefined", width, height, "1-13-1")
 		if err != nil {
 			return fmt.Errorf("failed to create PNG format: %v", err)
 		}
@@ -672,19 +873,7 @@ func addSlidingPngImageToAssetClip(baseClip *AssetClip, tx *ResourceTransaction,
 		Name:     fmt.Sprintf("PNG_%d_%s", index+1, strings.TrimSuffix(filepath.Base(pngPath), filepath.Ext(pngPath))),
 		Start:    "3600s", // Match Info.fcpxml start time
 		AdjustTransform: slideAnimation,
-		FilterVideos: []FilterVideo{
-			{
-				Ref:  borderEffectID,
-				Name: "Simple Border",
-				Params: []Param{
-					{
-						Name:  "Color",
-						Key:   "9999/987171795/987171799/3/987171806/2",
-						Value: "0 0 0 1", // Black border like Info.fcpxml
-					},
-				},
-			},
-		},
+		FilterVideos:    borderFilters, // nil when the border is disabled
 	}
 
 	// Add PNG Video as nested element within the main AssetClip (like Info.fcpxml)
@@ -693,6 +882,65 @@ func addSlidingPngImageToAssetClip(baseClip *AssetClip, tx *ResourceTransaction,
 	return nil
 }
 
+// pngPileNumberPosition is the upper-left corner of the vertical frame, clear of the PNG's center
+const pngPileNumberPosition = "-700 1350"
+
+// addPngPileNumberTitles nests a large number title in each numbered PNG video of the pile.
+// data.Numbers pairs each number with the asset (VideoID) and offset of the PNG it labels;
+// nesting the title inside the PNG keeps it on that PNG's lane for exactly as long as the PNG.
+func addPngPileNumberTitles(baseClip *AssetClip, textEffectID string, data TemplateData) {
+	for _, number := range data.Numbers {
+		for i := range baseClip.Videos {
+			video := &baseClip.Videos[i]
+			if video.Ref != number.VideoID || video.Offset != number.Offset {
+				continue
+			}
+
+			label := strconv.Itoa(number.Number)
+			textStyleID := GenerateTextStyleID(label, "png_pile_number_"+video.Name)
+			video.NestedTitles = append(video.NestedTitles, Title{
+				Ref:      textEffectID,
+				Lane:     "1",
+				Offset:   video.Start, // Nested offsets are in the parent's local time
+				Name:     label + " - Number",
+				Start:    "86486400/24000s",
+				Duration: video.Duration,
+				Params: []Param{
+					{
+						Name:  "Position",
+						Key:   "9999/10003/13260/3296672360/1/100/101",
+						Value: pngPileNumberPosition,
+					},
+				},
+				Text: &TitleText{
+					TextStyles: []TextStyleRef{
+						{
+							Ref:  textStyleID,
+							Text: label,
+						},
+					},
+				},
+				TextStyleDefs: []TextStyleDef{
+					{
+						ID: textStyleID,
+						TextStyle: TextStyle{
+							Font:         "Helvetica Neue",
+							FontSize:     "300",
+							FontFace:     "Bold",
+							FontColor:    "1 1 1 1",
+							Bold:         "1",
+							Alignment:    "center",
+							ShadowColor:  DefaultTextShadowColor,
+							ShadowOffset: DefaultTextShadowOffset,
+						},
+					},
+				},
+			})
+			break
+		}
+	}
+}
+
 // addSlidingPngImage adds a PNG with sliding animation and black border (legacy function, keeping for compatibility)
 func addSlidingPngImage(spine *Spine, tx *ResourceTransaction, pngPath string, timing ImageTiming, index int, borderEffectID string, verbose bool, createdAssets, createdFormats map[string]string) error {
 	// Create image asset if not exists
@@ -712,7 +960,9 @@ func addSlidingPngImage(spine *Spine, tx *ResourceTransaction, pngPath string, t
 			return fmt.Errorf("failed to create PNG asset: %v", err)
 		}
 
-		_, err = tx.CreateFormat(formatID, "FFVideoFormatRateUndefined", "800", "600", "1-13-1")
+		// The pile sequence is 1920x1080, used when the PNG header can't be read
+		width, height := imageFormatSize(pngPath, "1920", "1080")
+		_, err = tx.CreateFormat(formatID, "FFVideoFormatRateUndefined", width, height, "1-13-1")
 		if err != nil {
 			return fmt.Errorf("failed to create PNG format: %v", err)
 		}
@@ -880,21 +1130,23 @@ func createSlidingAnimationWithRotation(startTime, duration float64, index int)
 	}
 }
 
+// slideDirections are the start/end positions of the edge slides; the first four are the
+// cardinal edges that SlideDirection picks from
+var slideDirections = []struct{ startX, endX, startY, endY string }{
+	{"62.5", "0", "0", "0"},     // Right to center (like Info.fcpxml)
+	{"-62.5", "0", "0", "0"},    // Left to center (like Info.fcpxml) 
+	{"0", "0", "45", "0"},       // Top to center
+	{"0", "0", "-45", "0"},      // Bottom to center
+	{"44.2", "0", "31.2", "0"},  // Top-right diagonal
+	{"-44.2", "0", "31.2", "0"}, // Top-left diagonal
+	{"44.2", "0", "-31.2", "0"}, // Bottom-right diagonal
+	{"-44.2", "0", "-31.2", "0"}, // Bottom-left diagonal
+}
+
 // createSlidingAnimation creates position animation from various directions (legacy function)
 func createSlidingAnimation(startTime, duration float64, index int) *AdjustTransform {
 	// Determine slide direction based on index
-	directions := []struct{ startX, endX, startY, endY string }{
-		{"62.5", "0", "0", "0"},     // Right to center (like Info.fcpxml)
-		{"-62.5", "0", "0", "0"},    // Left to center (like Info.fcpxml) 
-		{"0", "0", "45", "0"},       // Top to center
-		{"0", "0", "-45", "0"},      // Bottom to center
-		{"44.2", "0", "31.2", "0"},  // Top-right diagonal
-		{"-44.2", "0", "31.2", "0"}, // Top-left diagonal
-		{"44.2", "0", "-31.2", "0"}, // Bottom-right diagonal
-		{"-44.2", "0", "-31.2", "0"}, // Bottom-left diagonal
-	}
-	
-	direction := directions[index%len(directions)]
+	direction := slideDirections[index%len(slideDirections)]
 	
 	return &AdjustTransform{
 		Params: []Param{
@@ -968,8 +1220,12 @@ func getPngFiles(dir string) ([]string, error) {
 	return pngFiles, nil
 }
 
-// downloadThemedImagesForPile downloads themed images for PNG pile effect
-func downloadThemedImagesForPile(config *PngPileConfig, verbose bool) ([]string, error) {
+// downloadPileThemeImages fetches images for one theme word; tests replace it to avoid the network
+var downloadPileThemeImages = DownloadImagesFromPixabay
+
+// downloadThemedImagesForPile downloads themed images for PNG pile effect, returning each
+// image's attribution (its FilePath is the downloaded file)
+func downloadThemedImagesForPile(config *PngPileConfig, verbose bool) ([]ImageAttribution, error) {
 	if verbose {
 		fmt.Printf("Downloading %d themed images to %s\n", config.TotalImages, config.OutputDir)
 	}
@@ -1020,7 +1276,7 @@ func downloadThemedImagesForPile(config *PngPileConfig, verbose bool) ([]string,
 	}
 
 	// Download images for each theme
-	var allFiles []string
+	var allAttributions []ImageAttribution
 	imagesPerTheme := 1 // One image per theme word
 	
 	for i, theme := range themes[:config.TotalImages] {
@@ -1029,7 +1285,7 @@ func downloadThemedImagesForPile(config *PngPileConfig, verbose bool) ([]string,
 		}
 		
 		// Use existing Pixabay download function
-		attributions, err := DownloadImagesFromPixabay(theme, imagesPerTheme, config.OutputDir, config.PixabayAPIKey)
+		attributions, err := downloadPileThemeImages(theme, imagesPerTheme, config.OutputDir, config.PixabayAPIKey)
 		if err != nil {
 			if verbose {
 				fmt.Printf("Warning: Failed to download images for theme '%s': %v\n", theme, err)
@@ -1037,20 +1293,17 @@ func downloadThemedImagesForPile(config *PngPileConfig, verbose bool) ([]string,
 			continue
 		}
 		
-		// Extract file paths
-		for _, attr := range attributions {
-			allFiles = append(allFiles, attr.FilePath)
-		}
+		allAttributions = append(allAttributions, attributions...)
 		
 		// Stop if we have enough images
-		if len(allFiles) >= config.TotalImages {
+		if len(allAttributions) >= config.TotalImages {
 			break
 		}
 	}
 
 	if verbose {
-		fmt.Printf("Successfully downloaded %d themed images\n", len(allFiles))
+		fmt.Printf("Successfully downloaded %d themed images\n", len(allAttributions))
 	}
 
-	return allFiles, nil
+	return allAttributions, nil
 }
@@ -0,0 +1,301 @@
+package fcp
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// DefaultMarkdownSecondsPerBlock is how long each Markdown block stays on screen as a title card
+const DefaultMarkdownSecondsPerBlock = 4.0
+
+// Markdown title font sizes: headings shrink by level, body text and list lines are smaller still
+const (
+	markdownHeading1FontSize  = "320"
+	markdownHeading2FontSize  = "240"
+	markdownHeading3FontSize  = "180"
+	markdownParagraphFontSize = "120"
+	markdownListFontSize      = "110"
+	markdownListLineStep      = 180 // Pixels between staggered list lines
+)
+
+// Markdown block kinds
+const (
+	markdownHeading   = "heading"
+	markdownParagraph = "paragraph"
+	markdownList      = "list"
+)
+
+var (
+	markdownHeadingPattern  = regexp.MustCompile(`^(#{1,6})\s+(.*?)\s*#*$`)
+	markdownListItemPattern = regexp.MustCompile(`^(?:[-*+]|\d+[.)])\s+(.*)$`)
+)
+
+// markdownBlock is one title card: a heading, a paragraph, or a list whose items are its lines
+type markdownBlock struct {
+	kind  string
+	level int // Heading level, 1-6
+	lines []string
+}
+
+// parseMarkdownBlocks reads the Markdown subset md-titles understands. Blank lines separate
+// blocks; within a block every heading line is its own block, consecutive list items form a
+// list block and any other lines are joined into a paragraph.
+func parseMarkdownBlocks(mdPath string) ([]markdownBlock, error) {
+	file, err := os.Open(mdPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open markdown file: %v", err)
+	}
+	defer file.Close()
+
+	var blocks []markdownBlock
+	var current *markdownBlock
+	flush := func() {
+		if current != nil {
+			blocks = append(blocks, *current)
+			current = nil
+		}
+	}
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "":
+			flush()
+		case markdownHeadingPattern.MatchString(line):
+			flush()
+			match := markdownHeadingPattern.FindStringSubmatch(line)
+			blocks = append(blocks, markdownBlock{kind: markdownHeading, level: len(match[1]), lines: []string{match[2]}})
+		case markdownListItemPattern.MatchString(line):
+			if current == nil || current.kind != markdownList {
+				flush()
+				current = &markdownBlock{kind: markdownList}
+			}
+			current.lines = append(current.lines, markdownListItemPattern.FindStringSubmatch(line)[1])
+		default:
+			if current == nil || current.kind != markdownParagraph {
+				flush()
+				current = &markdownBlock{kind: markdownParagraph}
+			}
+			current.lines = append(current.lines, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read markdown file: %v", err)
+	}
+	flush()
+
+	if len(blocks) == 0 {
+		return nil, fmt.Errorf("no text found in %s", mdPath)
+	}
+	return blocks, nil
+}
+
+// GenerateTitlesFromMarkdown turns a Markdown script into back-to-back title cards, one per block,
+// each perBlockSeconds long: headings become large centered titles, lists reveal their items one
+// line at a time, and paragraphs become plain centered text. **bold** and __bold__ runs are bold.
+//
+// 🚨 CLAUDE.md Rules Applied Here:
+// - Heading and paragraph cards are spine titles (no lane), like credit cards
+// - List cards are spine gaps holding one title per item on lanes, laid out by StaggerConfig
+// - One shared Text effect created through the ResourceRegistry/Transaction pattern
+// - Frame-aligned offsets → ConvertSecondsToFCPDuration() function
+func GenerateTitlesFromMarkdown(mdPath string, perBlockSeconds float64) (*FCPXML, error) {
+	if perBlockSeconds <= 0 {
+		return nil, fmt.Errorf("seconds per block must be positive, got %g", perBlockSeconds)
+	}
+
+	blocks, err := parseMarkdownBlocks(mdPath)
+	if err != nil {
+		return nil, err
+	}
+
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create base FCPXML: %v", err)
+	}
+	sequence, err := firstSequence(fcpxml)
+	if err != nil {
+		return nil, err
+	}
+
+	registry := NewResourceRegistry(fcpxml)
+	tx := NewTransaction(registry)
+	defer tx.Rollback()
+
+	textEffectID := tx.ReserveIDs(1)[0]
+	if _, err := tx.CreateEffect(textEffectID, "Text", ".../Titles.localized/Basic Text.localized/Text.localized/Text.moti"); err != nil {
+		return nil, fmt.Errorf("failed to create text effect: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	width, height := 1920.0, 1080.0
+	if w, h, err := sequenceFrameSize(fcpxml, sequence); err == nil {
+		width, height = w, h
+	}
+
+	blockDuration := ConvertSecondsToFCPDuration(perBlockSeconds)
+	blockFrames := parseFCPDuration(blockDuration)
+
+	for i, block := range blocks {
+		offset := formatFrameAlignedTime(i * blockFrames)
+		baseName := fmt.Sprintf("md_block_%d", i)
+
+		if block.kind == markdownList {
+			gap := Gap{Name: "List", Offset: offset, Duration: blockDuration}
+			gap.Titles = markdownListTitles(textEffectID, block.lines, blockFrames, width, height, baseName)
+			sequence.Spine.Gaps = append(sequence.Spine.Gaps, gap)
+			continue
+		}
+
+		fontSize := markdownParagraphFontSize
+		if block.kind == markdownHeading {
+			fontSize = markdownHeadingFontSize(block.level)
+		}
+		title := createMarkdownTitle(textEffectID, strings.Join(block.lines, "\n"), fontSize, block.kind == markdownHeading, baseName)
+		title.Offset = offset
+		title.Duration = blockDuration
+		sequence.Spine.Titles = append(sequence.Spine.Titles, title)
+	}
+
+	sequence.Duration = calculateTimelineDuration(sequence)
+	return fcpxml, nil
+}
+
+// markdownHeadingFontSize maps a heading level to its font size; levels past 3 share the smallest
+func markdownHeadingFontSize(level int) string {
+	switch level {
+	case 1:
+		return markdownHeading1FontSize
+	case 2:
+		return markdownHeading2FontSize
+	}
+	return markdownHeading3FontSize
+}
+
+// markdownListTitles stacks one title per list item, centered as a block (or fitted to the
+// title-safe area when long). Each item appears a step after the previous one and stays
+// until the card ends.
+func markdownListTitles(textEffectID string, items []string, cardFrames int, width, height float64, baseName string) []Title {
+	stagger := StaggerConfig{PixelStep: intPtr(markdownListLineStep), AutoFit: true}.fitToFrame(len(items), width, height)
+	if stagger.rows == 0 {
+		// A single column fits the safe area at its (possibly tightened) step, so center it
+		stagger.originY = (len(items) - 1) * stagger.step() / 2
+	}
+
+	// Reveal every item within the first half of the card so the full list is readable
+	stepFrames := cardFrames / 1001 / 2 / len(items) * 1001
+
+	titles := make([]Title, 0, len(items))
+	for i, item := range items {
+		title := createMarkdownTitle(textEffectID, item, markdownListFontSize, false, fmt.Sprintf("%s_item_%d", baseName, i))
+		title.Lane = fmt.Sprintf("%d", stagger.lane(i, len(items)))
+		title.Offset = formatFrameAlignedTime(i * stepFrames)
+		title.Duration = formatFrameAlignedTime(cardFrames - i*stepFrames)
+		title.Params = append([]Param{{
+			Name:  "Position",
+			Key:   "9999/10003/13260/3296672360/1/100/101",
+			Value: stagger.position(i),
+		}}, title.Params...)
+		titles = append(titles, title)
+	}
+	return titles
+}
+
+// createMarkdownTitle builds a centered title for text, splitting **bold** runs into a bold
+// text style. bold makes the whole title bold (headings).
+func createMarkdownTitle(textEffectID, text, fontSize string, bold bool, baseName string) Title {
+	regularStyleID := GenerateTextStyleID(text, baseName+"_regular")
+	boldStyleID := GenerateTextStyleID(text, baseName+"_bold")
+
+	var runs []TextStyleRef
+	usesRegular, usesBold := false, false
+	for _, run := range splitMarkdownBold(text) {
+		if bold || run.bold {
+			runs = append(runs, TextStyleRef{Ref: boldStyleID, Text: run.text})
+			usesBold = true
+		} else {
+			runs = append(runs, TextStyleRef{Ref: regularStyleID, Text: run.text})
+			usesRegular = true
+		}
+	}
+
+	shadow := TextStyleOptions{ShadowColor: DefaultTextShadowColor}
+	var defs []TextStyleDef
+	if usesRegular {
+		style := TextStyle{Font: "Helvetica Neue", FontSize: fontSize, FontFace: "Regular", FontColor: "1 1 1 1", Alignment: "center"}
+		shadow.applyTo(&style)
+		defs = append(defs, TextStyleDef{ID: regularStyleID, TextStyle: style})
+	}
+	if usesBold {
+		style := TextStyle{Font: "Helvetica Neue", FontSize: fontSize, FontFace: "Bold", FontColor: "1 1 1 1", Bold: "1", Alignment: "center"}
+		shadow.applyTo(&style)
+		defs = append(defs, TextStyleDef{ID: boldStyleID, TextStyle: style})
+	}
+
+	return Title{
+		Ref:   textEffectID,
+		Name:  stripMarkdownBold(text) + " - Markdown",
+		Start: "86486400/24000s",
+		Params: []Param{
+			{
+				Name:  "Alignment",
+				Key:   "9999/10003/13260/3296672360/2/354/3296667315/401",
+				Value: "1 (Center)",
+			},
+		},
+		Text:          &TitleText{TextStyles: runs},
+		TextStyleDefs: defs,
+	}
+}
+
+// markdownRun is a stretch of text that is either all bold or all regular
+type markdownRun struct {
+	text string
+	bold bool
+}
+
+// splitMarkdownBold splits text on ** and __ markers; an unmatched marker is kept as plain text
+func splitMarkdownBold(text string) []markdownRun {
+	var runs []markdownRun
+	for text != "" {
+		start, marker := -1, ""
+		for _, m := range []string{"**", "__"} {
+			if i := strings.Index(text, m); i >= 0 && (start < 0 || i < start) {
+				start, marker = i, m
+			}
+		}
+		if start < 0 {
+			break
+		}
+		end := strings.Index(text[start+2:], marker)
+		if end < 0 {
+			break
+		}
+		if start > 0 {
+			runs = append(runs, markdownRun{text: text[:start]})
+		}
+		if end > 0 {
+			runs = append(runs, markdownRun{text: text[start+2 : start+2+end], bold: true})
+		}
+		text = text[start+2+end+2:]
+	}
+	if text != "" {
+		runs = append(runs, markdownRun{text: text})
+	}
+	return runs
+}
+
+// stripMarkdownBold drops the bold markers, for clip names
+func stripMarkdownBold(text string) string {
+	var plain strings.Builder
+	for _, run := range splitMarkdownBold(text) {
+		plain.WriteString(run.text)
+	}
+	return plain.String()
+}
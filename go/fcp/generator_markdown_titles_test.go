@@ -0,0 +1,92 @@
+package fcp
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestGenerateTitlesFromMarkdown tests that a heading followed by two list items becomes a large
+// heading card and then a card revealing the two items as staggered lines
+func TestGenerateTitlesFromMarkdown(t *testing.T) {
+	dir := t.TempDir()
+	mdPath := filepath.Join(dir, "script.md")
+	script := "# Why **Go**\n- Fast builds\n- Simple tooling\n"
+	if err := os.WriteFile(mdPath, []byte(script), 0644); err != nil {
+		t.Fatalf("Failed to write markdown: %v", err)
+	}
+
+	fcpxml, err := GenerateTitlesFromMarkdown(mdPath, 4)
+	if err != nil {
+		t.Fatalf("GenerateTitlesFromMarkdown failed: %v", err)
+	}
+	sequence := &fcpxml.Library.Events[0].Projects[0].Sequences[0]
+
+	if len(sequence.Spine.Titles) != 1 {
+		t.Fatalf("Expected 1 heading card on the spine, got %d", len(sequence.Spine.Titles))
+	}
+	heading := sequence.Spine.Titles[0]
+	if heading.Offset != "0s" {
+		t.Errorf("Heading card should start the timeline, got offset %s", heading.Offset)
+	}
+	var headingText strings.Builder
+	for _, run := range heading.Text.TextStyles {
+		headingText.WriteString(run.Text)
+	}
+	if headingText.String() != "Why Go" {
+		t.Errorf("Expected heading text 'Why Go' without bold markers, got %q", headingText.String())
+	}
+	for _, def := range heading.TextStyleDefs {
+		if def.TextStyle.FontSize != markdownHeading1FontSize || def.TextStyle.Bold != "1" {
+			t.Errorf("Heading should be bold at size %s, got size %s bold %q", markdownHeading1FontSize, def.TextStyle.FontSize, def.TextStyle.Bold)
+		}
+	}
+
+	if len(sequence.Spine.Gaps) != 1 {
+		t.Fatalf("Expected 1 list card, got %d", len(sequence.Spine.Gaps))
+	}
+	list := sequence.Spine.Gaps[0]
+	if list.Offset != heading.Duration {
+		t.Errorf("List card should follow the heading at %s, got %s", heading.Duration, list.Offset)
+	}
+	if len(list.Titles) != 2 {
+		t.Fatalf("Expected 2 list lines, got %d", len(list.Titles))
+	}
+	first, second := list.Titles[0], list.Titles[1]
+	if first.Text.TextStyles[0].Text != "Fast builds" || second.Text.TextStyles[0].Text != "Simple tooling" {
+		t.Errorf("Unexpected list lines %q, %q", first.Text.TextStyles[0].Text, second.Text.TextStyles[0].Text)
+	}
+	if parseFCPDuration(second.Offset) <= parseFCPDuration(first.Offset) {
+		t.Errorf("Second line should appear after the first, got offsets %s and %s", first.Offset, second.Offset)
+	}
+	if addDurations(second.Offset, second.Duration) != list.Duration {
+		t.Errorf("List lines should stay until the card ends")
+	}
+	if first.Params[0].Value != "0 90" || second.Params[0].Value != "0 -90" {
+		t.Errorf("Expected lines centered around the middle at 0 90 and 0 -90, got %s and %s", first.Params[0].Value, second.Params[0].Value)
+	}
+	if first.TextStyleDefs[0].TextStyle.FontSize != markdownListFontSize {
+		t.Errorf("List lines should use size %s, got %s", markdownListFontSize, first.TextStyleDefs[0].TextStyle.FontSize)
+	}
+
+	if sequence.Duration != addDurations(heading.Duration, list.Duration) {
+		t.Errorf("Sequence duration %s should cover both cards", sequence.Duration)
+	}
+}
+
+// TestSplitMarkdownBold tests bold runs and that unmatched markers stay literal
+func TestSplitMarkdownBold(t *testing.T) {
+	runs := splitMarkdownBold("Ship **fast** and __often__ 2**3")
+	var got []string
+	for _, run := range runs {
+		if run.bold {
+			got = append(got, "["+run.text+"]")
+		} else {
+			got = append(got, run.text)
+		}
+	}
+	if want := "Ship |[fast]| and |[often]| 2**3"; strings.Join(got, "|") != want {
+		t.Errorf("Expected %q, got %q", want, strings.Join(got, "|"))
+	}
+}
@@ -0,0 +1,159 @@
+package fcp
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"strconv"
+)
+
+// Montage defaults: two-second highlights, hard cuts, reproducible random in-points
+const (
+	DefaultMontageSegmentSeconds = 2.0
+	DefaultMontageSeed           = 1
+)
+
+// probeVideoDuration reports a source's length in seconds; ffprobe's container duration works
+// for video files as well as audio (a variable so tests can skip ffprobe)
+var probeVideoDuration = probeAudioDurationWithFFprobe
+
+// MontageOptions tweaks GenerateMontageWithOptions; the zero value takes the head of each source with hard cuts
+type MontageOptions struct {
+	CrossfadeSeconds float64 // Cross-dissolve between segments; 0 for hard cuts
+	RandomIn         bool    // Pick a random in-point per source instead of its first frame
+	Seed             int64   // Same seed → same in-points
+	RecordParams     bool    // Write the montage parameters to the project note (see RecordGenerationParams)
+}
+
+// GenerateMontage cuts each video down to its first segmentSeconds and lays them back-to-back
+func GenerateMontage(videoPaths []string, segmentSeconds float64) (*FCPXML, error) {
+	return GenerateMontageWithOptions(videoPaths, segmentSeconds, MontageOptions{Seed: DefaultMontageSeed})
+}
+
+// GenerateMontageWithOptions trims every source to a segmentSeconds highlight, optionally from a
+// random in-point, and plays them in order with optional cross-dissolves.
+//
+// 🚨 CLAUDE.md Rules Applied Here:
+// - Sources go through AddVideo → asset-clips on the spine; the trim is the clip's start/duration
+// - Each source is probed so the segment (and its in-point) stays inside the media
+// - A dissolve nests the next segment's head on lane 1 of the outgoing clip with an opacity ramp
+// - Every dissolve shortens the timeline by its length: the next spine clip starts after that head
+// - Segment, dissolve and in-points are whole frames → ConvertSecondsToFCPDuration() function
+func GenerateMontageWithOptions(videoPaths []string, segmentSeconds float64, options MontageOptions) (*FCPXML, error) {
+	if len(videoPaths) == 0 {
+		return nil, fmt.Errorf("montage needs at least one video")
+	}
+	if segmentSeconds <= 0 {
+		return nil, fmt.Errorf("segment length must be positive, got %g", segmentSeconds)
+	}
+
+	segment := parseFCPDuration(ConvertSecondsToFCPDuration(segmentSeconds))
+	crossfade := 0
+	if options.CrossfadeSeconds > 0 {
+		crossfade = parseFCPDuration(ConvertSecondsToFCPDuration(options.CrossfadeSeconds))
+		if crossfade >= segment {
+			return nil, fmt.Errorf("crossfade of %gs must be shorter than the %gs segment", options.CrossfadeSeconds, segmentSeconds)
+		}
+	}
+
+	// Probe every source before building anything so a short clip fails the whole montage
+	inPoints := make([]int, len(videoPaths))
+	sourceLengths := make([]int, len(videoPaths))
+	random := rand.New(rand.NewSource(options.Seed))
+	for i, videoPath := range videoPaths {
+		seconds, err := probeVideoDuration(videoPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to probe %s: %v", videoPath, err)
+		}
+		sourceLengths[i] = int(math.Floor(seconds*24000.0/1001.0)) * 1001
+		if sourceLengths[i] < segment {
+			return nil, fmt.Errorf("%s is %.2fs, shorter than the %gs segment", videoPath, seconds, segmentSeconds)
+		}
+		if options.RandomIn {
+			inPoints[i] = random.Intn((sourceLengths[i]-segment)/1001+1) * 1001
+		}
+	}
+
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create base FCPXML: %v", err)
+	}
+	sequence, err := firstSequence(fcpxml)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, videoPath := range videoPaths {
+		if err := AddVideo(fcpxml, videoPath); err != nil {
+			return nil, fmt.Errorf("failed to add %s: %v", videoPath, err)
+		}
+		clip := &sequence.Spine.AssetClips[len(sequence.Spine.AssetClips)-1]
+		setMontageAssetDuration(fcpxml, clip.Ref, sourceLengths[i])
+
+		// After a dissolve the spine clip picks up where the connected head left off
+		in, length := inPoints[i], segment
+		if i > 0 && crossfade > 0 {
+			in, length = in+crossfade, length-crossfade
+		}
+		clip.Start = formatFrameAlignedTime(in)
+		clip.Duration = formatFrameAlignedTime(length)
+		sequence.Duration = calculateTimelineDuration(sequence)
+	}
+
+	if crossfade > 0 {
+		for i := 1; i < len(sequence.Spine.AssetClips); i++ {
+			addMontageDissolve(&sequence.Spine.AssetClips[i-1], sequence.Spine.AssetClips[i], inPoints[i], crossfade)
+		}
+	}
+
+	if options.RecordParams {
+		RecordGenerationParams(fcpxml, "montage", map[string]string{
+			"segment":   strconv.FormatFloat(segmentSeconds, 'f', -1, 64),
+			"crossfade": strconv.FormatFloat(options.CrossfadeSeconds, 'f', -1, 64),
+			"random-in": strconv.FormatBool(options.RandomIn),
+			"seed":      strconv.FormatInt(options.Seed, 10),
+			"videos":    strconv.Itoa(len(videoPaths)),
+		})
+	}
+	return fcpxml, nil
+}
+
+// setMontageAssetDuration records the probed source length on the asset so trims past
+// AddVideo's 10s placeholder duration stay inside it
+func setMontageAssetDuration(fcpxml *FCPXML, assetID string, length int) {
+	for i := range fcpxml.Resources.Assets {
+		if fcpxml.Resources.Assets[i].ID == assetID {
+			fcpxml.Resources.Assets[i].Duration = formatFrameAlignedTime(length)
+			return
+		}
+	}
+}
+
+// addMontageDissolve connects the incoming clip's first crossfade frames on top of the end of
+// outgoing, fading its opacity from 0 to 1 so the cut becomes a dissolve
+func addMontageDissolve(outgoing *AssetClip, incoming AssetClip, incomingIn, crossfade int) {
+	outgoingEnd := parseFCPDuration(outgoing.Start) + parseFCPDuration(outgoing.Duration)
+
+	head := AssetClip{
+		Ref:         incoming.Ref,
+		Lane:        "1",
+		Offset:      formatFrameAlignedTime(outgoingEnd - crossfade),
+		Name:        incoming.Name,
+		Start:       formatFrameAlignedTime(incomingIn),
+		Duration:    formatFrameAlignedTime(crossfade),
+		Format:      incoming.Format,
+		TCFormat:    incoming.TCFormat,
+		ConformRate: incoming.ConformRate,
+		AudioRole:   incoming.AudioRole,
+		AdjustBlend: &AdjustBlend{
+			Params: []Param{{
+				Name: "amount",
+				KeyframeAnimation: &KeyframeAnimation{Keyframes: []Keyframe{
+					{Time: formatFrameAlignedTime(incomingIn), Value: "0"},
+					{Time: formatFrameAlignedTime(incomingIn + crossfade), Value: "1"},
+				}},
+			}},
+		},
+	}
+	outgoing.NestedAssetClips = append(outgoing.NestedAssetClips, head)
+}
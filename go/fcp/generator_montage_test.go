@@ -0,0 +1,129 @@
+package fcp
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// setupMontageSources writes count fake videos and stubs probing so each reports 10 seconds
+func setupMontageSources(t *testing.T, count int) []string {
+	t.Helper()
+
+	originalProbe := probeVideoDuration
+	t.Cleanup(func() { probeVideoDuration = originalProbe })
+	probeVideoDuration = func(string) (float64, error) { return 10.0, nil }
+
+	dir := t.TempDir()
+	var paths []string
+	for i := 0; i < count; i++ {
+		path := filepath.Join(dir, "clip"+string(rune('a'+i))+".mp4")
+		if err := os.WriteFile(path, []byte("fake video"), 0644); err != nil {
+			t.Fatalf("Failed to create test video: %v", err)
+		}
+		paths = append(paths, path)
+	}
+	return paths
+}
+
+// TestGenerateMontage tests that three 10s clips at 2s each make a 6s timeline
+func TestGenerateMontage(t *testing.T) {
+	paths := setupMontageSources(t, 3)
+
+	fcpxml, err := GenerateMontage(paths, 2.0)
+	if err != nil {
+		t.Fatalf("GenerateMontage failed: %v", err)
+	}
+
+	sequence := fcpxml.Library.Events[0].Projects[0].Sequences[0]
+	segment := parseFCPDuration(ConvertSecondsToFCPDuration(2.0))
+	if got := parseFCPDuration(sequence.Duration); got != 3*segment {
+		t.Errorf("Expected a %s timeline, got %s", formatFrameAlignedTime(3*segment), sequence.Duration)
+	}
+	if len(sequence.Spine.AssetClips) != 3 {
+		t.Fatalf("Expected 3 spine clips, got %d", len(sequence.Spine.AssetClips))
+	}
+	for i, clip := range sequence.Spine.AssetClips {
+		if parseFCPDuration(clip.Start) != 0 || parseFCPDuration(clip.Duration) != segment {
+			t.Errorf("Clip %d should be the first 2s of its source, got start %s duration %s", i, clip.Start, clip.Duration)
+		}
+		if parseFCPDuration(clip.Offset) != i*segment {
+			t.Errorf("Clip %d should start at %s, got %s", i, formatFrameAlignedTime(i*segment), clip.Offset)
+		}
+	}
+	if asset := fcpxml.Resources.Assets[0]; parseFCPDuration(asset.Duration) != 239*1001 {
+		t.Errorf("Expected the asset to carry the probed 10s length, got %s", asset.Duration)
+	}
+}
+
+// TestGenerateMontageCrossfade tests that each dissolve shortens the timeline and fades the next clip in
+func TestGenerateMontageCrossfade(t *testing.T) {
+	paths := setupMontageSources(t, 3)
+
+	fcpxml, err := GenerateMontageWithOptions(paths, 2.0, MontageOptions{CrossfadeSeconds: 0.5, RandomIn: true, Seed: 7})
+	if err != nil {
+		t.Fatalf("GenerateMontageWithOptions failed: %v", err)
+	}
+
+	sequence := fcpxml.Library.Events[0].Projects[0].Sequences[0]
+	segment := parseFCPDuration(ConvertSecondsToFCPDuration(2.0))
+	crossfade := parseFCPDuration(ConvertSecondsToFCPDuration(0.5))
+	if got := parseFCPDuration(sequence.Duration); got != 3*segment-2*crossfade {
+		t.Errorf("Expected a %s timeline, got %s", formatFrameAlignedTime(3*segment-2*crossfade), sequence.Duration)
+	}
+
+	clips := sequence.Spine.AssetClips
+	for i := 0; i < 2; i++ {
+		if len(clips[i].NestedAssetClips) != 1 {
+			t.Fatalf("Clip %d should carry the next clip's head, got %d nested clips", i, len(clips[i].NestedAssetClips))
+		}
+		head := clips[i].NestedAssetClips[0]
+		if head.Ref != clips[i+1].Ref || head.Lane != "1" || parseFCPDuration(head.Duration) != crossfade {
+			t.Errorf("Dissolve %d has the wrong clip, lane or length: %+v", i, head)
+		}
+		// The spine clip continues exactly where the dissolving head ends
+		if parseFCPDuration(head.Start)+crossfade != parseFCPDuration(clips[i+1].Start) {
+			t.Errorf("Clip %d starts at %s, expected the frame after its dissolve head", i+1, clips[i+1].Start)
+		}
+		if parseFCPDuration(clips[i+1].Start)+parseFCPDuration(clips[i+1].Duration) > 239*1001 {
+			t.Errorf("Clip %d runs past the end of its source", i+1)
+		}
+		if head.AdjustBlend == nil || len(head.AdjustBlend.Params) != 1 {
+			t.Fatalf("Dissolve %d should ramp opacity", i)
+		}
+	}
+	if len(clips[2].NestedAssetClips) != 0 {
+		t.Errorf("The last clip should have no outgoing dissolve")
+	}
+
+	output := filepath.Join(t.TempDir(), "montage.fcpxml")
+	if err := WriteToFile(fcpxml, output); err != nil {
+		t.Fatalf("WriteToFile failed: %v", err)
+	}
+	data, _ := os.ReadFile(output)
+	if !strings.Contains(string(data), "<adjust-blend>") {
+		t.Errorf("Expected adjust-blend opacity ramps in the output")
+	}
+
+	// The same seed picks the same in-points
+	again, err := GenerateMontageWithOptions(paths, 2.0, MontageOptions{CrossfadeSeconds: 0.5, RandomIn: true, Seed: 7})
+	if err != nil {
+		t.Fatalf("GenerateMontageWithOptions failed: %v", err)
+	}
+	for i, clip := range again.Library.Events[0].Projects[0].Sequences[0].Spine.AssetClips {
+		if clip.Start != clips[i].Start {
+			t.Errorf("Seed 7 should repeat in-point %s for clip %d, got %s", clips[i].Start, i, clip.Start)
+		}
+	}
+}
+
+// TestGenerateMontageShortSource tests that a source shorter than the segment is rejected
+func TestGenerateMontageShortSource(t *testing.T) {
+	paths := setupMontageSources(t, 1)
+	probeVideoDuration = func(string) (float64, error) { return 1.0, nil }
+
+	if _, err := GenerateMontage(paths, 2.0); err == nil {
+		t.Errorf("Expected an error for a 1s source with a 2s segment")
+	}
+}
@@ -0,0 +1,267 @@
+package fcp
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// DefaultSpecClipSeconds is the on-screen time for a spec clip that doesn't set a duration
+const DefaultSpecClipSeconds = 5.0
+
+// aspectPresets maps a platform aspect ratio to its sequence frame size at 23.976fps
+var aspectPresets = map[string][2]int{
+	"16:9": {1920, 1080},
+	"9:16": {1080, 1920},
+	"1:1":  {1080, 1080},
+	"4:5":  {1080, 1350},
+}
+
+// ProjectSpec describes timeline content independent of frame size, so one spec can
+// be laid out for several delivery aspects
+type ProjectSpec struct {
+	Name  string     `json:"name"`
+	Clips []ClipSpec `json:"clips"`
+}
+
+// ClipSpec is one image or video of a ProjectSpec, played back to back in order
+type ClipSpec struct {
+	Path     string  `json:"path"`
+	Duration float64 `json:"duration,omitempty"` // Seconds; DefaultSpecClipSeconds when unset
+	Fit      string  `json:"fit,omitempty"`      // "fill" (default, crop to cover the frame) or "fit" (letterbox)
+}
+
+// ReadProjectSpec loads a JSON project spec. Relative clip paths resolve against the spec's directory.
+func ReadProjectSpec(path string) (*ProjectSpec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read spec: %v", err)
+	}
+
+	var spec ProjectSpec
+	if err := json.Unmarshal(data, &spec); err != nil {
+		return nil, fmt.Errorf("failed to parse spec %s: %v", path, err)
+	}
+
+	for i := range spec.Clips {
+		if spec.Clips[i].Path != "" && !filepath.IsAbs(spec.Clips[i].Path) {
+			spec.Clips[i].Path = filepath.Join(filepath.Dir(path), spec.Clips[i].Path)
+		}
+	}
+
+	return &spec, nil
+}
+
+// ParseAspects splits a comma separated aspect list ("16:9,9:16") and checks each against the presets
+func ParseAspects(value string) ([]string, error) {
+	var aspects []string
+	for _, aspect := range strings.Split(value, ",") {
+		aspect = strings.TrimSpace(aspect)
+		if aspect == "" {
+			continue
+		}
+		if _, ok := aspectPresets[aspect]; !ok {
+			return nil, fmt.Errorf("unknown aspect '%s' (must be one of: 16:9, 9:16, 1:1, 4:5)", aspect)
+		}
+		if containsString(aspects, aspect) {
+			return nil, fmt.Errorf("aspect '%s' listed more than once", aspect)
+		}
+		aspects = append(aspects, aspect)
+	}
+
+	if len(aspects) == 0 {
+		return nil, fmt.Errorf("no aspects given")
+	}
+	return aspects, nil
+}
+
+// conformScale returns the extra scale on top of FCP's default "fit" conform that realizes
+// the requested mode: 1 for fit, and the ratio that covers the frame for fill
+func conformScale(sourceWidth, sourceHeight, frameWidth, frameHeight float64, mode string) float64 {
+	if mode == "fit" || sourceWidth <= 0 || sourceHeight <= 0 {
+		return 1
+	}
+
+	widthRatio := frameWidth / sourceWidth
+	heightRatio := frameHeight / sourceHeight
+	if widthRatio > heightRatio {
+		return widthRatio / heightRatio
+	}
+	return heightRatio / widthRatio
+}
+
+// GenerateMultiAspect lays the same spec out once per aspect (e.g. 16:9 and 9:16 deliverables).
+//
+// 🚨 CLAUDE.md Rules Applied Here:
+// - The DTD allows one sequence per project, so each aspect gets its own project in the shared event
+// - Assets and their formats are created once through the Transaction and referenced by ID from every sequence
+// - Images become Video elements, videos AssetClip elements; fill/fit is an adjust-transform scale per aspect
+func GenerateMultiAspect(spec ProjectSpec, aspects []string) (*FCPXML, error) {
+	if len(spec.Clips) == 0 {
+		return nil, fmt.Errorf("spec has no clips")
+	}
+	if len(aspects) == 0 {
+		return nil, fmt.Errorf("no aspects given")
+	}
+	for _, aspect := range aspects {
+		if _, ok := aspectPresets[aspect]; !ok {
+			return nil, fmt.Errorf("unknown aspect '%s'", aspect)
+		}
+	}
+	for _, clip := range spec.Clips {
+		if clip.Fit != "" && clip.Fit != "fit" && clip.Fit != "fill" {
+			return nil, fmt.Errorf("invalid fit '%s' for %s (must be 'fit' or 'fill')", clip.Fit, clip.Path)
+		}
+	}
+
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create base FCPXML: %v", err)
+	}
+
+	// Every sequence format comes from the aspect presets below
+	fcpxml.Resources.Formats = nil
+	template := fcpxml.Library.Events[0].Projects[0]
+	projectName := spec.Name
+	if projectName == "" {
+		projectName = template.Name
+	}
+
+	registry := NewResourceRegistry(fcpxml)
+	tx := NewTransaction(registry)
+	defer tx.Rollback()
+
+	sequenceFormats := make(map[string]string)
+	for _, aspect := range aspects {
+		size := aspectPresets[aspect]
+		formatID := tx.ReserveIDs(1)[0]
+		if _, err := tx.CreateFormatWithFrameDuration(formatID, "1001/24000s", strconv.Itoa(size[0]), strconv.Itoa(size[1]), "1-1-1 (Rec. 709)"); err != nil {
+			return nil, fmt.Errorf("failed to create %s sequence format: %v", aspect, err)
+		}
+		sequenceFormats[aspect] = formatID
+	}
+
+	type sharedClip struct {
+		spec          ClipSpec
+		assetID       string
+		formatID      string
+		isImage       bool
+		duration      string
+		width, height float64
+	}
+
+	clips := make([]sharedClip, len(spec.Clips))
+	for i, clipSpec := range spec.Clips {
+		absPath, err := filepath.Abs(clipSpec.Path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get absolute path: %v", err)
+		}
+		if _, err := os.Stat(absPath); err != nil {
+			return nil, fmt.Errorf("clip file does not exist: %s", absPath)
+		}
+
+		seconds := clipSpec.Duration
+		if seconds <= 0 {
+			seconds = DefaultSpecClipSeconds
+		}
+
+		ids := tx.ReserveIDs(2)
+		clip := sharedClip{
+			spec:     clipSpec,
+			assetID:  ids[0],
+			formatID: ids[1],
+			isImage:  isImageFile(absPath),
+			duration: ConvertSecondsToFCPDuration(seconds),
+		}
+		name := strings.TrimSuffix(filepath.Base(absPath), filepath.Ext(absPath))
+
+		if clip.isImage {
+			width, height, err := imageDimensions(absPath)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read %s: %v", absPath, err)
+			}
+			clip.width, clip.height = float64(width), float64(height)
+			if _, err := tx.CreateFormat(clip.formatID, "FFVideoFormatRateUndefined", strconv.Itoa(width), strconv.Itoa(height), "1-13-1"); err != nil {
+				return nil, fmt.Errorf("failed to create image format for %s: %v", name, err)
+			}
+			if _, err := tx.CreateAsset(clip.assetID, absPath, name, clip.duration, clip.formatID); err != nil {
+				return nil, fmt.Errorf("failed to create image asset for %s: %v", name, err)
+			}
+		} else if err := tx.CreateVideoAssetWithDetection(clip.assetID, absPath, name, clip.duration, clip.formatID); err != nil {
+			return nil, fmt.Errorf("failed to create video asset for %s: %v", name, err)
+		}
+
+		clips[i] = clip
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	// Video dimensions are only known once detection has produced the format
+	for i := range clips {
+		if clips[i].isImage {
+			continue
+		}
+		for _, format := range fcpxml.Resources.Formats {
+			if format.ID == clips[i].formatID {
+				clips[i].width = parseFormatDimension(format.Width)
+				clips[i].height = parseFormatDimension(format.Height)
+			}
+		}
+	}
+
+	var projects []Project
+	for _, aspect := range aspects {
+		size := aspectPresets[aspect]
+		sequence := template.Sequences[0]
+		sequence.Format = sequenceFormats[aspect]
+		sequence.Spine = Spine{}
+
+		offset := "0s"
+		for _, clip := range clips {
+			var transform *AdjustTransform
+			if scale := conformScale(clip.width, clip.height, float64(size[0]), float64(size[1]), clip.spec.Fit); scale != 1 {
+				transform = &AdjustTransform{Scale: fmt.Sprintf("%.4f %.4f", scale, scale)}
+			}
+
+			name := strings.TrimSuffix(filepath.Base(clip.spec.Path), filepath.Ext(clip.spec.Path))
+			if clip.isImage {
+				sequence.Spine.Videos = append(sequence.Spine.Videos, Video{
+					Ref:             clip.assetID,
+					Offset:          offset,
+					Name:            name,
+					Start:           "86399313/24000s",
+					Duration:        clip.duration,
+					AdjustTransform: transform,
+				})
+			} else {
+				sequence.Spine.AssetClips = append(sequence.Spine.AssetClips, AssetClip{
+					Ref:             clip.assetID,
+					Offset:          offset,
+					Name:            name,
+					Duration:        clip.duration,
+					Format:          clip.formatID,
+					TCFormat:        "NDF",
+					AudioRole:       "dialogue",
+					AdjustTransform: transform,
+				})
+			}
+			offset = addDurations(offset, clip.duration)
+		}
+		sequence.Duration = offset
+
+		projects = append(projects, Project{
+			Name:      projectName + " " + aspect,
+			UID:       generateRandomUID(),
+			ModDate:   template.ModDate,
+			Sequences: []Sequence{sequence},
+		})
+	}
+	fcpxml.Library.Events[0].Projects = projects
+
+	return fcpxml, nil
+}
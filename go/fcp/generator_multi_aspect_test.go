@@ -0,0 +1,80 @@
+package fcp
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestGenerateMultiAspectSharesAssets validates that each aspect gets its own sequence over the same assets
+func TestGenerateMultiAspectSharesAssets(t *testing.T) {
+	dir := t.TempDir()
+	writeTestPNG(t, filepath.Join(dir, "wide.png"), 320, 180)
+	writeTestPNG(t, filepath.Join(dir, "tall.png"), 180, 320)
+
+	specPath := filepath.Join(dir, "spec.json")
+	specJSON := `{"name": "Promo", "clips": [{"path": "wide.png", "duration": 3}, {"path": "tall.png", "fit": "fit"}]}`
+	if err := os.WriteFile(specPath, []byte(specJSON), 0644); err != nil {
+		t.Fatalf("Failed to write spec: %v", err)
+	}
+
+	spec, err := ReadProjectSpec(specPath)
+	if err != nil {
+		t.Fatalf("ReadProjectSpec failed: %v", err)
+	}
+
+	fcpxml, err := GenerateMultiAspect(*spec, []string{"16:9", "9:16"})
+	if err != nil {
+		t.Fatalf("GenerateMultiAspect failed: %v", err)
+	}
+
+	projects := fcpxml.Library.Events[0].Projects
+	if len(projects) != 2 {
+		t.Fatalf("Expected one project per aspect, got %d", len(projects))
+	}
+	if projects[0].Name != "Promo 16:9" || projects[1].Name != "Promo 9:16" {
+		t.Errorf("Unexpected project names: %s, %s", projects[0].Name, projects[1].Name)
+	}
+	if len(fcpxml.Resources.Assets) != 2 {
+		t.Errorf("Expected assets to be created once, got %d", len(fcpxml.Resources.Assets))
+	}
+
+	wide := projects[0].Sequences[0]
+	tall := projects[1].Sequences[0]
+	if wide.Format == tall.Format {
+		t.Errorf("Expected each aspect to have its own sequence format")
+	}
+	if len(wide.Spine.Videos) != 2 || len(tall.Spine.Videos) != 2 {
+		t.Fatalf("Expected both sequences to hold both images")
+	}
+	for i := range wide.Spine.Videos {
+		if wide.Spine.Videos[i].Ref != tall.Spine.Videos[i].Ref {
+			t.Errorf("Clip %d references %s in 16:9 but %s in 9:16", i, wide.Spine.Videos[i].Ref, tall.Spine.Videos[i].Ref)
+		}
+	}
+	if wide.Duration != tall.Duration || wide.Duration != addDurations(ConvertSecondsToFCPDuration(3), ConvertSecondsToFCPDuration(DefaultSpecClipSeconds)) {
+		t.Errorf("Unexpected sequence durations: %s and %s", wide.Duration, tall.Duration)
+	}
+
+	// The wide image fills 16:9 as is but must be scaled to cover 9:16; "fit" clips are never scaled
+	if wide.Spine.Videos[0].AdjustTransform != nil {
+		t.Errorf("Expected no scale for a 16:9 image in a 16:9 sequence")
+	}
+	if transform := tall.Spine.Videos[0].AdjustTransform; transform == nil || transform.Scale != "3.1605 3.1605" {
+		t.Errorf("Expected fill scale 3.1605 for a 16:9 image in 9:16, got %+v", transform)
+	}
+	if tall.Spine.Videos[1].AdjustTransform != nil || wide.Spine.Videos[1].AdjustTransform != nil {
+		t.Errorf("Expected fit clips to keep FCP's default conform")
+	}
+
+	if err := WriteToFile(fcpxml, filepath.Join(dir, "variants.fcpxml")); err != nil {
+		t.Errorf("Expected multi-aspect output to pass validation on write: %v", err)
+	}
+
+	if _, err := GenerateMultiAspect(*spec, []string{"3:2"}); err == nil {
+		t.Errorf("Expected error for unknown aspect")
+	}
+	if _, err := ParseAspects("16:9,16:9"); err == nil {
+		t.Errorf("Expected error for duplicate aspect")
+	}
+}
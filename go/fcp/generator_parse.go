@@ -2,6 +2,7 @@ package fcp
 
 import (
 	"fmt"
+	"math/big"
 
 	"os"
 
@@ -42,12 +43,94 @@ func calculateTimelineDuration(sequence *Sequence) string {
 		}
 	}
 
+	for _, refClip := range sequence.Spine.RefClips {
+		refClipEndTime := parseOffsetAndDuration(refClip.Offset, refClip.Duration)
+		if refClipEndTime > maxEndTime {
+			maxEndTime = refClipEndTime
+		}
+	}
+
 	if maxEndTime == 0 {
 		return "0s"
 	}
 	return fmt.Sprintf("%d/24000s", maxEndTime)
 }
 
+// RecalculateSequenceDuration raises every sequence's duration to the exact end of its last spine
+// element (transitions included), fixing durations left too short by manual edits or concatenation.
+// A duration that already covers the spine is left as written: FCP exports carry sample-accurate
+// durations that frame-snapped arithmetic would corrupt, and trailing space is not an error.
+func RecalculateSequenceDuration(fcpxml *FCPXML) {
+	for e := range fcpxml.Library.Events {
+		for p := range fcpxml.Library.Events[e].Projects {
+			for s := range fcpxml.Library.Events[e].Projects[p].Sequences {
+				sequence := &fcpxml.Library.Events[e].Projects[p].Sequences[s]
+				end, ok := exactSpineEnd(sequence)
+				if !ok {
+					continue
+				}
+				if current, err := parseFCPTimeRat(sequence.Duration); err == nil && current.Cmp(end) >= 0 {
+					continue
+				}
+				sequence.Duration = formatInTimebase(end, SequenceFrameRate(fcpxml, sequence))
+			}
+		}
+	}
+}
+
+// formatInTimebase writes an exact time in rate's timebase (e.g. 120120/24000s) when it lands on a
+// whole tick, and in lowest terms otherwise
+func formatInTimebase(value *big.Rat, rate FrameRate) string {
+	ticks := new(big.Rat).Mul(value, big.NewRat(int64(rate.Timebase), 1))
+	if !ticks.IsInt() {
+		return formatFCPTimeRat(value)
+	}
+	return rate.formatTicks(int(ticks.Num().Int64()))
+}
+
+// exactSpineEnd returns where the last spine element ends, summed exactly in each element's own
+// timebase. ok is false when any offset or duration doesn't parse, so nothing is guessed.
+func exactSpineEnd(sequence *Sequence) (*big.Rat, bool) {
+	end := new(big.Rat)
+	ok := true
+	extend := func(offset, duration string) {
+		start, err := parseFCPTimeRat(offset)
+		if err != nil {
+			ok = false
+			return
+		}
+		length, err := parseFCPTimeRat(duration)
+		if err != nil {
+			ok = false
+			return
+		}
+		if elementEnd := start.Add(start, length); elementEnd.Cmp(end) > 0 {
+			end = elementEnd
+		}
+	}
+
+	spine := &sequence.Spine
+	for _, clip := range spine.AssetClips {
+		extend(clip.Offset, clip.Duration)
+	}
+	for _, video := range spine.Videos {
+		extend(video.Offset, video.Duration)
+	}
+	for _, title := range spine.Titles {
+		extend(title.Offset, title.Duration)
+	}
+	for _, gap := range spine.Gaps {
+		extend(gap.Offset, gap.Duration)
+	}
+	for _, refClip := range spine.RefClips {
+		extend(refClip.Offset, refClip.Duration)
+	}
+	for _, transition := range spine.Transitions {
+		extend(transition.Offset, transition.Duration)
+	}
+	return end, ok
+}
+
 // parseOffsetAndDuration parses FCP time format and returns end time in 1001/24000s units
 func parseOffsetAndDuration(offset, duration string) int {
 	offsetFrames := parseFCPDuration(offset)
@@ -83,6 +166,60 @@ func parseFCPDuration(duration string) int {
 	return 0
 }
 
+// ParseFCPTime parses an FCP time ("N/Ds", "Ns" or "0s") into its exact numerator and
+// denominator (seconds = numerator/denominator). It is the one parser for rational times;
+// callers that need seconds, ticks or sums build on it instead of re-splitting strings.
+func ParseFCPTime(value string) (int64, int64, error) {
+	if !strings.HasSuffix(value, "s") {
+		return 0, 0, fmt.Errorf("invalid FCP time '%s': missing 's' suffix", value)
+	}
+
+	parts := strings.Split(strings.TrimSuffix(value, "s"), "/")
+	numerator, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil || len(parts) > 2 {
+		return 0, 0, fmt.Errorf("invalid FCP time '%s'", value)
+	}
+	if len(parts) == 1 {
+		return numerator, 1, nil
+	}
+
+	denominator, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil || denominator <= 0 {
+		return 0, 0, fmt.Errorf("invalid FCP time '%s'", value)
+	}
+	return numerator, denominator, nil
+}
+
+// parseFCPTimeRat parses an FCP time as an exact fraction of seconds (see ParseFCPTime)
+func parseFCPTimeRat(value string) (*big.Rat, error) {
+	numerator, denominator, err := ParseFCPTime(value)
+	if err != nil {
+		return nil, err
+	}
+	return big.NewRat(numerator, denominator), nil
+}
+
+// formatFCPTimeRat formats an exact time as "0s", "Ns" or "N/Ds" in lowest terms
+func formatFCPTimeRat(value *big.Rat) string {
+	if value.Sign() == 0 {
+		return "0s"
+	}
+	if value.IsInt() {
+		return value.Num().String() + "s"
+	}
+	return value.Num().String() + "/" + value.Denom().String() + "s"
+}
+
+// fcpDurationToSeconds converts an FCP time ("1001/24000s", "5s" or "0s") to seconds.
+// Unlike parseFCPDuration it keeps the exact rational value instead of snapping to frames.
+func fcpDurationToSeconds(duration string) float64 {
+	numerator, denominator, err := ParseFCPTime(duration)
+	if err != nil {
+		return 0
+	}
+	return float64(numerator) / float64(denominator)
+}
+
 // addDurations adds two FCP duration strings and returns the result
 func addDurations(duration1, duration2 string) string {
 	frames1 := parseFCPDuration(duration1)
@@ -102,20 +239,16 @@ func createKenBurnsAnimation(offsetDuration string, totalDurationSeconds float64
 
 // createKenBurnsAnimationWithFormat creates Ken Burns effect animation with format-aware scaling
 func createKenBurnsAnimationWithFormat(offsetDuration string, totalDurationSeconds float64, format string) *AdjustTransform {
-	return createKenBurnsAnimationWithFormatIndex(offsetDuration, totalDurationSeconds, format, 0)
+	return createKenBurnsAnimationWithFormatIndex(offsetDuration, totalDurationSeconds, format, 0, imageStartForTimebase(DefaultFrameRate), DefaultFrameRate)
 }
 
-// createKenBurnsAnimationWithFormatIndex creates Ken Burns effect animation with format-aware scaling and alternating zoom direction
-func createKenBurnsAnimationWithFormatIndex(offsetDuration string, totalDurationSeconds float64, format string, imageIndex int) *AdjustTransform {
-
-	videoStartFrames := 86399313
+// createKenBurnsAnimationWithFormatIndex creates Ken Burns effect animation with format-aware scaling and alternating zoom direction.
+// Keyframes are in the image's local time, so they begin at videoStart and use the sequence timebase rate.
+func createKenBurnsAnimationWithFormatIndex(offsetDuration string, totalDurationSeconds float64, format string, imageIndex int, videoStart string, rate FrameRate) *AdjustTransform {
 
 	// Ken Burns effect duration should be longer than slide (3 seconds for subtle effect)
-	kenBurnsDuration := ConvertSecondsToFCPDuration(3.0)
-	kenBurnsFrames := parseFCPDuration(kenBurnsDuration)
-
-	startTime := fmt.Sprintf("%d/24000s", videoStartFrames)
-	endTime := fmt.Sprintf("%d/24000s", videoStartFrames+kenBurnsFrames)
+	startTime := videoStart
+	endTime := rate.addSeconds(videoStart, 3.0)
 
 	// Adjust scale values based on format and alternate zoom direction based on image index
 	var startScale, endScale string
@@ -148,6 +281,13 @@ func createKenBurnsAnimationWithFormatIndex(offsetDuration string, totalDuration
 		}
 	}
 
+	return kenBurnsTransform(startTime, endTime, "0 0", "-20 -15", startScale, endScale)
+}
+
+// kenBurnsTransform is the keyframed zoom and pan every Ken Burns move is written as: position and
+// scale run from their start to end values between startTime and endTime, with anchor and rotation
+// held at 0
+func kenBurnsTransform(startTime, endTime, startPosition, endPosition, startScale, endScale string) *AdjustTransform {
 	return &AdjustTransform{
 		Params: []Param{
 			{
@@ -173,11 +313,11 @@ func createKenBurnsAnimationWithFormatIndex(offsetDuration string, totalDuration
 					Keyframes: []Keyframe{
 						{
 							Time:  startTime,
-							Value: "0 0",
+							Value: startPosition,
 						},
 						{
 							Time:  endTime,
-							Value: "-20 -15",
+							Value: endPosition,
 						},
 					},
 				},
@@ -223,11 +363,11 @@ func createKenBurnsAnimationWithFormatIndex(offsetDuration string, totalDuration
 // createEnhancedKenBurnsWithFormat creates both adjust-crop and adjust-transform for full-frame image filling
 // Based on the pattern from Info.fcpxml where images are scaled high and use pan-rect for Ken Burns effect
 func createEnhancedKenBurnsWithFormat(offsetDuration string, totalDurationSeconds float64, format string) (*AdjustCrop, *AdjustTransform) {
-	return createEnhancedKenBurnsWithFormatIndex(offsetDuration, totalDurationSeconds, format, 0)
+	return createEnhancedKenBurnsWithFormatIndex(offsetDuration, totalDurationSeconds, format, 0, imageStartForTimebase(DefaultFrameRate), DefaultFrameRate)
 }
 
 // createEnhancedKenBurnsWithFormatIndex creates both adjust-crop and adjust-transform with alternating zoom direction
-func createEnhancedKenBurnsWithFormatIndex(offsetDuration string, totalDurationSeconds float64, format string, imageIndex int) (*AdjustCrop, *AdjustTransform) {
+func createEnhancedKenBurnsWithFormatIndex(offsetDuration string, totalDurationSeconds float64, format string, imageIndex int, videoStart string, rate FrameRate) (*AdjustCrop, *AdjustTransform) {
 	var adjustCrop *AdjustCrop
 	var adjustTransform *AdjustTransform
 	
@@ -283,7 +423,7 @@ func createEnhancedKenBurnsWithFormatIndex(offsetDuration string, totalDurationS
 		}
 	} else {
 		// For horizontal format, use standard Ken Burns animation with alternating direction
-		adjustTransform = createKenBurnsAnimationWithFormatIndex(offsetDuration, totalDurationSeconds, format, imageIndex)
+		adjustTransform = createKenBurnsAnimationWithFormatIndex(offsetDuration, totalDurationSeconds, format, imageIndex, videoStart, rate)
 	}
 	
 	return adjustCrop, adjustTransform
@@ -297,11 +437,24 @@ func createEnhancedKenBurnsWithFormatIndex(offsetDuration string, totalDurationS
 // - Atomic ID reservation prevents race conditions and ID collisions
 // - Uses frame-aligned durations → ConvertSecondsToFCPDuration() function
 // - Unique text-style-def IDs → generateUID() function for deterministic UIDs
-// - Each text element appears 1 second later with 300px Y offset progression
+// - Each text element appears later with a 300px Y offset progression (see StaggerConfig)
+// - StaggerConfig.AutoFit keeps long files inside the title-safe area of the sequence frame
 //
 // ❌ NEVER: fmt.Sprintf("<title ref='%s'...") - CRITICAL VIOLATION!
 // ✅ ALWAYS: Use ResourceRegistry/Transaction pattern for proper resource management
 func AddTextFromFile(fcpxml *FCPXML, textFilePath string, offsetSeconds float64, durationSeconds float64) error {
+	return AddTextFromFileStyled(fcpxml, textFilePath, offsetSeconds, durationSeconds, TextStyleOptions{}, StaggerConfig{})
+}
+
+// AddTextFromFileStyled is AddTextFromFile with drop shadow/outline options applied to every text style
+// and a configurable stagger (axis, pixel spacing, time spacing) between lines
+func AddTextFromFileStyled(fcpxml *FCPXML, textFilePath string, offsetSeconds float64, durationSeconds float64, styleOptions TextStyleOptions, stagger StaggerConfig) error {
+	if err := styleOptions.Validate(); err != nil {
+		return err
+	}
+	if err := stagger.Validate(); err != nil {
+		return err
+	}
 
 	data, err := os.ReadFile(textFilePath)
 	if err != nil {
@@ -398,6 +551,14 @@ func AddTextFromFile(fcpxml *FCPXML, textFilePath string, offsetSeconds float64,
 			return fmt.Errorf("no video or asset-clip element found in spine to add text overlays to")
 		}
 
+		if stagger.AutoFit {
+			width, height, err := sequenceFrameSize(fcpxml, sequence)
+			if err != nil {
+				return fmt.Errorf("cannot autofit text: %v", err)
+			}
+			stagger = stagger.fitToFrame(len(textLines), width, height)
+		}
+
 		textDuration := ConvertSecondsToFCPDuration(durationSeconds)
 
 		for i, textLine := range textLines {
@@ -415,17 +576,15 @@ func AddTextFromFile(fcpxml *FCPXML, textFilePath string, offsetSeconds float64,
 				clipStartFrames = parseFCPDuration(targetVideo.Start)
 			}
 
-			staggerSeconds := durationSeconds * 0.5
+			staggerSeconds := stagger.timeStep(durationSeconds)
 			staggerDuration := ConvertSecondsToFCPDuration(staggerSeconds)
 			staggerFramesPer := parseFCPDuration(staggerDuration)
 			staggerFrames := i * staggerFramesPer
 			elementOffsetFrames := clipStartFrames + staggerFrames
 			elementOffset := fmt.Sprintf("%d/24000s", elementOffsetFrames)
 
-			yOffset := i * -300
-			positionValue := fmt.Sprintf("0 %d", yOffset)
-
-			laneNumber := len(textLines) - i
+			positionValue := stagger.position(i)
+			laneNumber := stagger.lane(i, len(textLines))
 
 			title := Title{
 				Ref:      textEffectID,
@@ -533,8 +692,10 @@ func AddTextFromFile(fcpxml *FCPXML, textFilePath string, offsetSeconds float64,
 					},
 				},
 			}
+			styleOptions.applyTo(&title.TextStyleDefs[0].TextStyle)
 
-			if i > 0 {
+			// The first line sits at the default center unless AutoFit moved it
+			if i > 0 || positionValue != "0 0" {
 				positionParam := Param{
 					Name:  "Position",
 					Key:   "9999/10003/13260/3296672360/1/100/101",
@@ -573,6 +734,15 @@ func AddTextFromFile(fcpxml *FCPXML, textFilePath string, offsetSeconds float64,
 // ❌ NEVER: fmt.Sprintf("<title ref='%s'...") - CRITICAL VIOLATION!
 // ✅ ALWAYS: Use ResourceRegistry/Transaction pattern for proper resource management
 func AddSingleText(fcpxml *FCPXML, text string, offsetSeconds float64, durationSeconds float64) error {
+	return AddSingleTextStyled(fcpxml, text, offsetSeconds, durationSeconds, TextStyleOptions{})
+}
+
+// AddSingleTextStyled is AddSingleText with drop shadow/outline options for legibility over busy backgrounds.
+// styleOptions.Position places the title at a named screen position such as "bottom-center".
+func AddSingleTextStyled(fcpxml *FCPXML, text string, offsetSeconds float64, durationSeconds float64, styleOptions TextStyleOptions) error {
+	if err := styleOptions.Validate(); err != nil {
+		return err
+	}
 
 	registry := NewResourceRegistry(fcpxml)
 
@@ -714,6 +884,27 @@ func AddSingleText(fcpxml *FCPXML, text string, offsetSeconds float64, durationS
 			},
 		},
 	}
+	styleOptions.applyTo(&title.TextStyleDefs[0].TextStyle)
+
+	// Named positions are computed from the frame size instead of the fixed default
+	if styleOptions.Position != "" || styleOptions.PositionOffset != "" {
+		width, height := 1920.0, 1080.0
+		if sequence, err := firstSequence(fcpxml); err == nil {
+			if w, h, err := sequenceFrameSize(fcpxml, sequence); err == nil {
+				width, height = w, h
+			}
+		}
+		position, err := TitlePositionValue(styleOptions.positionName(), styleOptions.PositionOffset, width, height)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+		for i := range title.Params {
+			if title.Params[i].Name == "Position" {
+				title.Params[i].Value = position
+			}
+		}
+	}
 
 	err = tx.Commit()
 	if err != nil {
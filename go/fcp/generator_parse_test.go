@@ -0,0 +1,57 @@
+package fcp
+
+import (
+	"math/big"
+	"testing"
+)
+
+// TestParseFCPTime tests exact parsing of every FCP time notation and rejection of malformed ones
+func TestParseFCPTime(t *testing.T) {
+	valid := map[string][2]int64{
+		"0s":           {0, 1},
+		"9s":           {9, 1},
+		"1001/24000s":  {1001, 24000},
+		"22285/44100s": {22285, 44100},
+		"-3s":          {-3, 1},
+	}
+	for input, want := range valid {
+		numerator, denominator, err := ParseFCPTime(input)
+		if err != nil {
+			t.Errorf("ParseFCPTime(%q) failed: %v", input, err)
+			continue
+		}
+		if numerator != want[0] || denominator != want[1] {
+			t.Errorf("ParseFCPTime(%q) = %d/%d, want %d/%d", input, numerator, denominator, want[0], want[1])
+		}
+	}
+
+	for _, input := range []string{"", "5", "1/0s", "1/2/3s", "abc s", "1001/-24000s"} {
+		if _, _, err := ParseFCPTime(input); err == nil {
+			t.Errorf("Expected error for %q", input)
+		}
+	}
+}
+
+// TestFormatFCPTimeRat tests that exact sums across timebases format in lowest terms
+func TestFormatFCPTimeRat(t *testing.T) {
+	a, err := parseFCPTimeRat("9s")
+	if err != nil {
+		t.Fatalf("parseFCPTimeRat failed: %v", err)
+	}
+	b, err := parseFCPTimeRat("1001/24000s")
+	if err != nil {
+		t.Fatalf("parseFCPTimeRat failed: %v", err)
+	}
+
+	cases := map[string]*big.Rat{
+		"0s":            new(big.Rat),
+		"9s":            a,
+		"1001/24000s":   b,
+		"217001/24000s": new(big.Rat).Add(a, b),
+	}
+	for want, value := range cases {
+		if got := formatFCPTimeRat(value); got != want {
+			t.Errorf("formatFCPTimeRat = %s, want %s", got, want)
+		}
+	}
+}
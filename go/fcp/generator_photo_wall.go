@@ -0,0 +1,166 @@
+package fcp
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// DefaultPhotoWallHoldSeconds is how long the finished wall stays on screen after the last photo pops in
+const DefaultPhotoWallHoldSeconds = 3.0
+
+// photoWallPop is the pop-in: each photo grows from nothing past its size and settles back
+const (
+	photoWallPopOvershootSeconds = 0.2
+	photoWallPopSettleSeconds    = 0.3
+	photoWallPopOvershoot        = 1.08
+)
+
+// calculateWallTiming gives image i a start i*interval seconds in; like the PNG pile, every image
+// then lasts until the end, which is hold seconds after the last one appears
+func calculateWallTiming(numImages int, intervalSeconds, holdSeconds float64) []ImageTiming {
+	total := float64(numImages-1)*intervalSeconds + holdSeconds
+	timings := make([]ImageTiming, numImages)
+	for i := range timings {
+		start := float64(i) * intervalSeconds
+		timings[i] = ImageTiming{startTime: start, duration: total - start}
+	}
+	return timings
+}
+
+// GeneratePhotoWall builds a wall of photos on a rows x cols grid: one photo pops in every
+// appearIntervalSeconds, filling the grid left-to-right, top-to-bottom, and every photo stays
+// in its cell until the end, DefaultPhotoWallHoldSeconds after the last one arrives.
+//
+// 🚨 CLAUDE.md Rules Applied Here:
+// - A black Vivid generator is the spine element; photos nest on lanes 1..N as <video> elements
+// - Cells come from the contact sheet grid, so position and scale are adjust-transform units
+// - Pop keyframes start at the image's start in the sequence timebase and are scale-only, curve="linear"
+// - Offsets/durations are frame-aligned, and each photo ends exactly where the wall does
+func GeneratePhotoWall(imagePaths []string, rows, cols int, appearIntervalSeconds float64) (*FCPXML, error) {
+	if len(imagePaths) == 0 {
+		return nil, fmt.Errorf("no images given for the photo wall")
+	}
+	if rows < 1 || cols < 1 {
+		return nil, fmt.Errorf("photo wall needs at least 1 row and 1 column, got %dx%d", rows, cols)
+	}
+	if len(imagePaths) > rows*cols {
+		return nil, fmt.Errorf("%d images don't fit a %dx%d wall (%d cells)", len(imagePaths), rows, cols, rows*cols)
+	}
+	if appearIntervalSeconds < 0 {
+		return nil, fmt.Errorf("appear interval must not be negative, got %g", appearIntervalSeconds)
+	}
+
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create base FCPXML: %v", err)
+	}
+	sequence, err := firstSequence(fcpxml)
+	if err != nil {
+		return nil, err
+	}
+
+	aspect := 16.0 / 9.0
+	if width, height, err := sequenceFrameSize(fcpxml, sequence); err == nil {
+		aspect = width / height
+	}
+	cells := gridCells(rows, cols, len(imagePaths), aspect)
+	timings := calculateWallTiming(len(imagePaths), appearIntervalSeconds, DefaultPhotoWallHoldSeconds)
+	imageStart, rate := sequenceImageStart(fcpxml, sequence, 0)
+
+	wallDuration := ConvertSecondsToFCPDuration(timings[0].duration)
+
+	registry := NewResourceRegistry(fcpxml)
+	tx := NewTransaction(registry)
+	defer tx.Rollback()
+
+	backgroundID := findEffectIDByUID(fcpxml, ".../Generators.localized/Solids.localized/Vivid.localized/Vivid.motn")
+	if backgroundID == "" {
+		backgroundID = tx.ReserveIDs(1)[0]
+		if _, err := tx.CreateEffect(backgroundID, "Vivid", ".../Generators.localized/Solids.localized/Vivid.localized/Vivid.motn"); err != nil {
+			return nil, fmt.Errorf("failed to create background generator: %v", err)
+		}
+	}
+
+	background := Video{
+		Ref:      backgroundID,
+		Offset:   "0s",
+		Name:     "Photo Wall",
+		Start:    "0s",
+		Duration: wallDuration,
+		Params: []Param{
+			{Name: "Fill Color", Value: "0 0 0 1"},
+		},
+	}
+
+	for i, imagePath := range imagePaths {
+		absPath, err := filepath.Abs(imagePath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get absolute path: %v", err)
+		}
+		name := strings.TrimSuffix(filepath.Base(imagePath), filepath.Ext(imagePath))
+
+		width, height := "1280", "720"
+		if w, h, err := imageDimensions(absPath); err == nil {
+			width, height = strconv.Itoa(w), strconv.Itoa(h)
+		}
+
+		ids := tx.ReserveIDs(2)
+		if _, err := tx.CreateFormat(ids[1], "FFVideoFormatRateUndefined", width, height, "1-13-1"); err != nil {
+			return nil, fmt.Errorf("failed to create image format for %s: %v", name, err)
+		}
+		if _, err := tx.CreateAsset(ids[0], absPath, name, "0s", ids[1]); err != nil {
+			return nil, fmt.Errorf("failed to create image asset for %s: %v", name, err)
+		}
+
+		// Every photo ends with the wall, whatever rounding its offset got
+		offset := ConvertSecondsToFCPDuration(timings[i].startTime)
+		duration := formatFrameAlignedTime(parseFCPDuration(wallDuration) - parseFCPDuration(offset))
+
+		cell := cells[i]
+		background.NestedVideos = append(background.NestedVideos, Video{
+			Ref:      ids[0],
+			Lane:     strconv.Itoa(i + 1),
+			Offset:   offset,
+			Name:     name,
+			Start:    imageStart,
+			Duration: duration,
+			AdjustTransform: &AdjustTransform{
+				Position: fmt.Sprintf("%.4f %.4f", cell.X, cell.Y),
+				Params: []Param{
+					{
+						Name:              "scale",
+						KeyframeAnimation: photoWallPopKeyframes(imageStart, rate, cell.Scale),
+					},
+				},
+			},
+		})
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	sequence.Spine.Videos = append(sequence.Spine.Videos, background)
+	sequence.Duration = wallDuration
+
+	violations, _ := SplitValidationWarnings(ValidateClaudeCompliance(fcpxml))
+	if len(violations) > 0 {
+		return nil, fmt.Errorf("ERROR: validation failed with %d violations:\n%s", len(violations), strings.Join(violations, "\n"))
+	}
+
+	return fcpxml, nil
+}
+
+// photoWallPopKeyframes scales a photo from 0 to a little past scale and back to scale
+func photoWallPopKeyframes(imageStart string, rate FrameRate, scale float64) *KeyframeAnimation {
+	value := func(s float64) string { return fmt.Sprintf("%.4f %.4f", s, s) }
+	return &KeyframeAnimation{
+		Keyframes: []Keyframe{
+			{Time: imageStart, Value: value(0), Curve: "linear"},
+			{Time: rate.addSeconds(imageStart, photoWallPopOvershootSeconds), Value: value(scale * photoWallPopOvershoot), Curve: "linear"},
+			{Time: rate.addSeconds(imageStart, photoWallPopSettleSeconds), Value: value(scale), Curve: "linear"},
+		},
+	}
+}
@@ -0,0 +1,65 @@
+package fcp
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+)
+
+// TestGeneratePhotoWall tests that a 2x2 wall with a 1s interval pops images in at 0,1,2,3s and keeps them all
+func TestGeneratePhotoWall(t *testing.T) {
+	dir := t.TempDir()
+	var paths []string
+	for i := 0; i < 4; i++ {
+		path := filepath.Join(dir, fmt.Sprintf("photo_%d.png", i))
+		writeTestPNG(t, path, 64, 48)
+		paths = append(paths, path)
+	}
+
+	fcpxml, err := GeneratePhotoWall(paths, 2, 2, 1.0)
+	if err != nil {
+		t.Fatalf("GeneratePhotoWall failed: %v", err)
+	}
+
+	sequence := fcpxml.Library.Events[0].Projects[0].Sequences[0]
+	if len(sequence.Spine.Videos) != 1 {
+		t.Fatalf("Expected one background video on the spine, got %d", len(sequence.Spine.Videos))
+	}
+	wall := sequence.Spine.Videos[0]
+	photos := wall.NestedVideos
+	if len(photos) != 4 {
+		t.Fatalf("Expected 4 photos on the wall, got %d", len(photos))
+	}
+
+	wallEnd := parseFCPDuration(wall.Duration)
+	if sequence.Duration != wall.Duration || wallEnd != parseFCPDuration(ConvertSecondsToFCPDuration(3+DefaultPhotoWallHoldSeconds)) {
+		t.Errorf("Expected the wall to last %gs, got %s (sequence %s)", 3+DefaultPhotoWallHoldSeconds, wall.Duration, sequence.Duration)
+	}
+
+	positions := make(map[string]bool)
+	for i, photo := range photos {
+		if want := ConvertSecondsToFCPDuration(float64(i)); photo.Offset != want {
+			t.Errorf("Photo %d: expected to appear at %s, got %s", i, want, photo.Offset)
+		}
+		// Visible to the end: every photo runs until the wall ends
+		if end := parseFCPDuration(photo.Offset) + parseFCPDuration(photo.Duration); end != wallEnd {
+			t.Errorf("Photo %d: expected to end with the wall at %d, ends at %d", i, wallEnd, end)
+		}
+
+		keyframes := photo.AdjustTransform.Params[0].KeyframeAnimation.Keyframes
+		if keyframes[0].Value != "0.0000 0.0000" || keyframes[0].Time != photo.Start {
+			t.Errorf("Photo %d: expected the pop to start from scale 0 at its start, got %+v", i, keyframes[0])
+		}
+		if last := keyframes[len(keyframes)-1].Value; last != "0.4500 0.4500" {
+			t.Errorf("Photo %d: expected to settle at the cell scale 0.4500, got %s", i, last)
+		}
+		positions[photo.AdjustTransform.Position] = true
+	}
+	if len(positions) != 4 {
+		t.Errorf("Expected 4 distinct cells, got %v", positions)
+	}
+
+	if _, err := GeneratePhotoWall(append(paths, paths[0]), 2, 2, 1.0); err == nil {
+		t.Errorf("Expected an error for more images than cells")
+	}
+}
@@ -0,0 +1,422 @@
+package fcp
+
+import (
+	"errors"
+	"fmt"
+	"image/color"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// setupPngPileDir creates a temp working dir with the base video and a few PNGs for GeneratePngPileWithConfig
+func setupPngPileDir(t *testing.T) string {
+	t.Helper()
+
+	tempDir := t.TempDir()
+	originalDir, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("Failed to get working directory: %v", err)
+	}
+	if err := os.Chdir(tempDir); err != nil {
+		t.Fatalf("Failed to change to temp directory: %v", err)
+	}
+	t.Cleanup(func() { os.Chdir(originalDir) })
+
+	// Base video track is referenced relative to the working directory
+	if err := os.WriteFile("164240-830460859.mp4", []byte("fake mp4 data"), 0644); err != nil {
+		t.Fatalf("Failed to create base video: %v", err)
+	}
+
+	pngDir := filepath.Join(tempDir, "pngs")
+	if err := os.MkdirAll(pngDir, 0755); err != nil {
+		t.Fatalf("Failed to create png dir: %v", err)
+	}
+	for i := 0; i < 3; i++ {
+		pngPath := filepath.Join(pngDir, fmt.Sprintf("image_%d.png", i))
+		if err := os.WriteFile(pngPath, []byte("fake png data"), 0644); err != nil {
+			t.Fatalf("Failed to create test PNG: %v", err)
+		}
+	}
+
+	return pngDir
+}
+
+// TestPngPileBorderColor tests that a configured border color reaches the Simple Border param
+func TestPngPileBorderColor(t *testing.T) {
+	pngDir := setupPngPileDir(t)
+
+	config := &PngPileConfig{
+		Duration:    10,
+		TotalImages: 3,
+		OutputDir:   pngDir,
+		UseExisting: true,
+		BorderColor: &[4]float64{1, 1, 1, 1},
+	}
+
+	fcpxml, err := GeneratePngPileWithConfig(config, false)
+	if err != nil {
+		t.Fatalf("GeneratePngPileWithConfig failed: %v", err)
+	}
+
+	images := fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine.AssetClips[0].Videos
+	if len(images) != 3 {
+		t.Fatalf("Expected 3 PNG videos, got %d", len(images))
+	}
+
+	for i, image := range images {
+		if len(image.FilterVideos) != 1 {
+			t.Fatalf("Image %d: expected 1 border filter, got %d", i, len(image.FilterVideos))
+		}
+
+		params := make(map[string]string)
+		for _, param := range image.FilterVideos[0].Params {
+			params[param.Key] = param.Value
+		}
+		if params[simpleBorderColorKey] != "1 1 1 1" {
+			t.Errorf("Image %d: expected border color '1 1 1 1', got '%s'", i, params[simpleBorderColorKey])
+		}
+		if len(params) != 1 {
+			t.Errorf("Image %d: expected only the color param, got %v", i, params)
+		}
+	}
+}
+
+// TestPngPileDefaultBorder tests that a zero-value border config keeps the black Simple Border
+func TestPngPileDefaultBorder(t *testing.T) {
+	pngDir := setupPngPileDir(t)
+
+	config := &PngPileConfig{
+		Duration:    10,
+		TotalImages: 3,
+		OutputDir:   pngDir,
+		UseExisting: true,
+	}
+
+	fcpxml, err := GeneratePngPileWithConfig(config, false)
+	if err != nil {
+		t.Fatalf("GeneratePngPileWithConfig failed: %v", err)
+	}
+
+	for i, image := range fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine.AssetClips[0].Videos {
+		if len(image.FilterVideos) != 1 {
+			t.Fatalf("Image %d: expected 1 border filter, got %d", i, len(image.FilterVideos))
+		}
+		if got := image.FilterVideos[0].Params[0].Value; got != "0 0 0 1" {
+			t.Errorf("Image %d: expected default black border, got '%s'", i, got)
+		}
+	}
+}
+
+// TestPngPileNoBorder tests that NoBorder omits the border filter and effect
+func TestPngPileNoBorder(t *testing.T) {
+	pngDir := setupPngPileDir(t)
+
+	config := &PngPileConfig{
+		Duration:    10,
+		TotalImages: 3,
+		OutputDir:   pngDir,
+		UseExisting: true,
+		NoBorder:    true,
+	}
+
+	fcpxml, err := GeneratePngPileWithConfig(config, false)
+	if err != nil {
+		t.Fatalf("GeneratePngPileWithConfig failed: %v", err)
+	}
+
+	for i, image := range fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine.AssetClips[0].Videos {
+		if len(image.FilterVideos) != 0 {
+			t.Errorf("Image %d: expected no border filter, got %d", i, len(image.FilterVideos))
+		}
+	}
+
+	for _, effect := range fcpxml.Resources.Effects {
+		if effect.Name == "Simple Border" {
+			t.Errorf("Expected no Simple Border effect with NoBorder")
+		}
+	}
+}
+
+// TestPngPileNumberOverlay tests that a pile of five images gets number titles 1 through 5 on the respective lanes
+func TestPngPileNumberOverlay(t *testing.T) {
+	pngDir := setupPngPileDir(t)
+	for i := 3; i < 5; i++ {
+		pngPath := filepath.Join(pngDir, fmt.Sprintf("image_%d.png", i))
+		if err := os.WriteFile(pngPath, []byte("fake png data"), 0644); err != nil {
+			t.Fatalf("Failed to create test PNG: %v", err)
+		}
+	}
+
+	config := &PngPileConfig{
+		Duration:      10,
+		TotalImages:   5,
+		OutputDir:     pngDir,
+		UseExisting:   true,
+		NumberOverlay: true,
+	}
+
+	fcpxml, err := GeneratePngPileWithConfig(config, false)
+	if err != nil {
+		t.Fatalf("GeneratePngPileWithConfig failed: %v", err)
+	}
+
+	images := fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine.AssetClips[0].Videos
+	if len(images) != 5 {
+		t.Fatalf("Expected 5 PNG videos, got %d", len(images))
+	}
+	for i, image := range images {
+		if image.Lane != fmt.Sprintf("%d", i+1) {
+			t.Errorf("Image %d: expected lane %d, got %s", i, i+1, image.Lane)
+		}
+		if len(image.NestedTitles) != 1 {
+			t.Fatalf("Image %d: expected 1 number title, got %d", i, len(image.NestedTitles))
+		}
+		title := image.NestedTitles[0]
+		if got := title.Text.TextStyles[0].Text; got != fmt.Sprintf("%d", i+1) {
+			t.Errorf("Image %d: expected number %d, got '%s'", i, i+1, got)
+		}
+		if title.Duration != image.Duration || title.Offset != image.Start {
+			t.Errorf("Image %d: number title should span the PNG (offset %s, duration %s), got offset %s, duration %s",
+				i, image.Start, image.Duration, title.Offset, title.Duration)
+		}
+	}
+
+	// Numbered PNGs carry both a title and the border filter, whose order the DTD fixes
+	outputPath := filepath.Join(t.TempDir(), "numbered.fcpxml")
+	if err := WriteToFile(fcpxml, outputPath); err != nil {
+		t.Fatalf("WriteToFile failed: %v", err)
+	}
+	requireDTDValid(t, outputPath)
+}
+
+// TestPngPileTooLarge tests that a 10-hour pile fails with ErrTooLarge when strict and only warns otherwise
+func TestPngPileTooLarge(t *testing.T) {
+	pngDir := setupPngPileDir(t)
+
+	config := &PngPileConfig{
+		Duration:    10 * 60 * 60,
+		TotalImages: 3,
+		OutputDir:   pngDir,
+		UseExisting: true,
+		Limits:      RenderLimits{Strict: true},
+	}
+
+	_, err := GeneratePngPileWithConfig(config, false)
+	var tooLarge *ErrTooLarge
+	if !errors.As(err, &tooLarge) {
+		t.Fatalf("Expected *ErrTooLarge for a 10-hour pile, got %v", err)
+	}
+	if tooLarge.Seconds != config.Duration || tooLarge.MaxSeconds != DefaultMaxTimelineSeconds {
+		t.Errorf("Unexpected ErrTooLarge details: %+v", tooLarge)
+	}
+
+	if err := (RenderLimits{}).Check(20000, config.Duration); err != nil {
+		t.Errorf("Non-strict limits should only warn, got %v", err)
+	}
+	if err := (RenderLimits{MaxElements: 10, Strict: true}).Check(11, 1); err == nil {
+		t.Errorf("Expected a custom element limit of 10 to reject 11 elements")
+	}
+}
+
+// TestParseRGBA tests border color parsing
+func TestParseRGBA(t *testing.T) {
+	color, err := ParseRGBA("1 0.5 0 1")
+	if err != nil {
+		t.Fatalf("ParseRGBA failed: %v", err)
+	}
+	if color != [4]float64{1, 0.5, 0, 1} {
+		t.Errorf("Unexpected color: %v", color)
+	}
+	if formatRGBA(color) != "1 0.5 0 1" {
+		t.Errorf("Expected round trip '1 0.5 0 1', got '%s'", formatRGBA(color))
+	}
+
+	for _, invalid := range []string{"", "1 1 1", "1 1 1 2", "a b c d"} {
+		if _, err := ParseRGBA(invalid); err == nil {
+			t.Errorf("Expected error for color '%s'", invalid)
+		}
+	}
+}
+
+// TestProgressiveTimingPaceCurves tests that the pace curve controls the gaps between image starts
+func TestProgressiveTimingPaceCurves(t *testing.T) {
+	const numImages, duration = 10, 30.0
+
+	gaps := func(timings []ImageTiming) []float64 {
+		var result []float64
+		for i := 1; i < len(timings); i++ {
+			result = append(result, timings[i].startTime-timings[i-1].startTime)
+		}
+		return result
+	}
+
+	linear := gaps(calculateProgessiveTiming(numImages, duration, PaceConfig{Curve: PaceLinear}))
+	for i, gap := range linear {
+		if math.Abs(gap-linear[0]) > 1e-9 {
+			t.Errorf("Linear gap %d: expected %.4f, got %.4f", i, linear[0], gap)
+		}
+	}
+
+	decelerate := gaps(calculateProgessiveTiming(numImages, duration, PaceConfig{Curve: PaceDecelerate}))
+	for i := 1; i < len(decelerate); i++ {
+		if decelerate[i] <= decelerate[i-1] {
+			t.Errorf("Decelerate gap %d (%.4f) should be larger than gap %d (%.4f)", i, decelerate[i], i-1, decelerate[i-1])
+		}
+	}
+
+	accelerate := gaps(calculateProgessiveTiming(numImages, duration, PaceConfig{}))
+	if accelerate[0] <= accelerate[len(accelerate)-1] {
+		t.Errorf("Default pace should accelerate, got first gap %.4f and last gap %.4f", accelerate[0], accelerate[len(accelerate)-1])
+	}
+
+	// Even a very short pile leaves the last image on screen for a second
+	short := calculateProgessiveTiming(numImages, 1.5, PaceConfig{Curve: PaceLinear})
+	if last := short[len(short)-1]; last.duration < minLastImageSeconds {
+		t.Errorf("Expected last image to last at least %.1fs, got %.4f", minLastImageSeconds, last.duration)
+	}
+
+	if err := (PaceConfig{Curve: "bouncy"}).Validate(); err == nil {
+		t.Error("Expected unknown pace curve to be rejected")
+	}
+}
+
+// TestPngPileWritesCredits tests that AttributionsPath credits the downloaded images the pile
+// actually uses: a black download dropped by SkipBadImages is left out
+func TestPngPileWritesCredits(t *testing.T) {
+	setupPngPileDir(t)
+	tempDir := t.TempDir()
+
+	original := downloadPileThemeImages
+	t.Cleanup(func() { downloadPileThemeImages = original })
+	downloadPileThemeImages = func(word string, count int, outputDir string, apiKey string) ([]ImageAttribution, error) {
+		if err := os.MkdirAll(outputDir, 0755); err != nil {
+			return nil, err
+		}
+		path := filepath.Join(outputDir, word+".png")
+		if word == "forest" {
+			writeSolidPNG(t, path, 64, 48, color.NRGBA{A: 255})
+		} else {
+			writeTestPNG(t, path, 64, 48)
+		}
+		id := len(word) * 100
+		return []ImageAttribution{{
+			FilePath:  path,
+			Source:    "pixabay",
+			Author:    "Photographer_" + word,
+			UserID:    id + 1,
+			PixabayID: id,
+		}}, nil
+	}
+
+	creditsPath := filepath.Join(tempDir, "CREDITS.txt")
+	config := &PngPileConfig{
+		Duration:         10,
+		TotalImages:      2,
+		OutputDir:        filepath.Join(tempDir, "images"),
+		AttributionsPath: creditsPath,
+		SkipBadImages:    true,
+	}
+	if _, err := GeneratePngPileWithConfig(config, false); err != nil {
+		t.Fatalf("GeneratePngPileWithConfig failed: %v", err)
+	}
+
+	data, err := os.ReadFile(creditsPath)
+	if err != nil {
+		t.Fatalf("Expected credits file: %v", err)
+	}
+	credits := string(data)
+	// The first two themes are "forest" (black, skipped) and "mountain"
+	for _, expected := range []string{"File: mountain.png", "Photographer: Photographer_mountain", "Source: https://pixabay.com/photos/id-800/"} {
+		if !strings.Contains(credits, expected) {
+			t.Errorf("Credits file missing %q:\n%s", expected, credits)
+		}
+	}
+	if strings.Contains(credits, "forest") {
+		t.Errorf("Expected the skipped forest image to be left out of the credits:\n%s", credits)
+	}
+
+	config.UseExisting = true
+	if _, err := GeneratePngPileWithConfig(config, false); err == nil {
+		t.Error("Expected an error for credits without downloaded images")
+	}
+}
+
+// TestPngPileRollbackOnFailure injects a failure on the second image and checks that the pile
+// aborts without leaking its media copy or leaving IDs reserved in the registry
+func TestPngPileRollbackOnFailure(t *testing.T) {
+	pngDir := setupPngPileDir(t)
+
+	originalAdd := addPileImage
+	defer func() { addPileImage = originalAdd }()
+
+	var pileTx *ResourceTransaction
+	var copyPath string
+	addPileImage = func(baseClip *AssetClip, tx *ResourceTransaction, pngPath string, timing ImageTiming, index int, borderFilters []FilterVideo, verbose bool, createdAssets, createdFormats map[string]string) error {
+		pileTx = tx
+		if index == 0 {
+			return originalAdd(baseClip, tx, pngPath, timing, index, borderFilters, verbose, createdAssets, createdFormats)
+		}
+		var err error
+		if copyPath, err = tx.createUniqueMediaCopy(pngPath, "pile_test"); err != nil {
+			t.Fatalf("createUniqueMediaCopy failed: %v", err)
+		}
+		return errors.New("injected failure")
+	}
+
+	config := &PngPileConfig{
+		Duration:    10,
+		TotalImages: 3,
+		OutputDir:   pngDir,
+		UseExisting: true,
+	}
+	fcpxml, err := GeneratePngPileWithConfig(config, false)
+	if err == nil || !strings.Contains(err.Error(), "injected failure") {
+		t.Fatalf("Expected the injected failure to abort the pile, got fcpxml=%v err=%v", fcpxml != nil, err)
+	}
+	if fcpxml != nil {
+		t.Errorf("Expected no FCPXML from a failed pile")
+	}
+
+	if copyPath == "" {
+		t.Fatalf("Injected step never made a media copy")
+	}
+	if _, err := os.Stat(copyPath); !os.IsNotExist(err) {
+		t.Errorf("Media copy %s leaked after rollback", copyPath)
+		os.Remove(copyPath)
+	}
+
+	registry := pileTx.registry
+	for id, used := range registry.usedIDs {
+		if _, registered := registry.resources[id]; used && !registered {
+			t.Errorf("ID %s is still reserved after rollback", id)
+		}
+	}
+	if len(registry.assets) != 0 || len(registry.effects) != 0 {
+		t.Errorf("Expected no assets or effects from the rolled-back pile, got %d and %d", len(registry.assets), len(registry.effects))
+	}
+
+	// A committed transaction keeps its IDs and copies when the deferred Rollback runs
+	tx := NewTransaction(registry)
+	ids := tx.ReserveIDs(1)
+	committedCopy, err := tx.createUniqueMediaCopy(filepath.Join(pngDir, "image_0.png"), "pile_test")
+	if err != nil {
+		t.Fatalf("createUniqueMediaCopy failed: %v", err)
+	}
+	defer os.Remove(committedCopy)
+	if _, err := tx.CreateEffect(ids[0], "Text", ".../Titles.localized/Basic Text.localized/Text.localized/Text.moti"); err != nil {
+		t.Fatalf("CreateEffect failed: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatalf("Commit failed: %v", err)
+	}
+	tx.Rollback()
+	if !registry.usedIDs[ids[0]] {
+		t.Errorf("Rollback after Commit released committed ID %s", ids[0])
+	}
+	if _, err := os.Stat(committedCopy); err != nil {
+		t.Errorf("Rollback after Commit deleted the committed media copy: %v", err)
+	}
+}
@@ -0,0 +1,186 @@
+package fcp
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// Reel defaults: three-second highlights with half-second dissolves and a two-second music fade
+const (
+	DefaultReelSegmentSeconds   = 3.0
+	DefaultReelCrossfadeSeconds = 0.5
+	DefaultReelFadeOutSeconds   = 2.0
+)
+
+// ReelOptions configures GenerateReel. LUTPath picks the grade (empty leaves the footage
+// ungraded); FadeOutSeconds 0 ends the music without a fade.
+type ReelOptions struct {
+	SegmentSeconds   float64
+	CrossfadeSeconds float64
+	FadeOutSeconds   float64
+	LUTPath          string // .cube file applied through Custom LUT
+	RandomIn         bool   // Random in-point per segment, so looped sources show different moments
+	Seed             int64
+	RecordParams     bool // Write the reel parameters to the project note (see RecordGenerationParams)
+}
+
+// DefaultReelOptions returns the options `cutlass reel` starts from
+func DefaultReelOptions() ReelOptions {
+	return ReelOptions{
+		SegmentSeconds:   DefaultReelSegmentSeconds,
+		CrossfadeSeconds: DefaultReelCrossfadeSeconds,
+		FadeOutSeconds:   DefaultReelFadeOutSeconds,
+		RandomIn:         true,
+		Seed:             DefaultMontageSeed,
+	}
+}
+
+// GenerateReel cuts a folder's worth of videos into a graded montage that runs exactly as long
+// as the music under it: the sources are looped until the montage covers the song, the last
+// segment is trimmed to end with it, and the music fades out over its final seconds.
+//
+// 🚨 CLAUDE.md Rules Applied Here:
+// - Segments and dissolves come from GenerateMontageWithOptions; the grade from ApplyLUT
+// - Music is an audio asset created through the Transaction with its probed duration
+// - Music connects under the first spine clip at lane -1 from the clip's start, i.e. timeline 0
+// - AddAudio isn't used: it turns the first asset-clip into a video and drops its dissolve and grade
+// - Fade keyframes are in the music clip's local time; every time is frame-aligned
+func GenerateReel(videoPaths []string, musicPath string, options ReelOptions) (*FCPXML, error) {
+	if len(videoPaths) == 0 {
+		return nil, fmt.Errorf("reel needs at least one video")
+	}
+	for _, videoPath := range videoPaths {
+		if _, err := os.Stat(videoPath); err != nil {
+			return nil, fmt.Errorf("video file does not exist: %s", videoPath)
+		}
+	}
+	if options.FadeOutSeconds < 0 {
+		return nil, fmt.Errorf("fade-out must not be negative, got %g", options.FadeOutSeconds)
+	}
+
+	if !isAudioFile(musicPath) {
+		return nil, fmt.Errorf("file is not a supported audio format (WAV, MP3, M4A, AAC, FLAC): %s", musicPath)
+	}
+	absMusic, err := filepath.Abs(musicPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get absolute path: %v", err)
+	}
+	if _, err := os.Stat(absMusic); err != nil {
+		return nil, fmt.Errorf("music file does not exist: %s", absMusic)
+	}
+	musicSeconds, err := probeAudioDuration(absMusic)
+	if err != nil {
+		return nil, fmt.Errorf("failed to probe music duration: %v", err)
+	}
+	music := parseFCPDuration(ConvertSecondsToFCPDuration(musicSeconds))
+	fade := parseFCPDuration(ConvertSecondsToFCPDuration(options.FadeOutSeconds))
+	if music <= 0 {
+		return nil, fmt.Errorf("music %s has no duration", musicPath)
+	}
+	if fade > music {
+		return nil, fmt.Errorf("fade-out of %gs is longer than the %.2fs music", options.FadeOutSeconds, musicSeconds)
+	}
+
+	// Each segment after the first adds segment-crossfade, so loop the sources until the song is covered
+	segment := parseFCPDuration(ConvertSecondsToFCPDuration(options.SegmentSeconds))
+	crossfade := 0
+	if options.CrossfadeSeconds > 0 {
+		crossfade = parseFCPDuration(ConvertSecondsToFCPDuration(options.CrossfadeSeconds))
+	}
+	if segment <= crossfade {
+		return nil, fmt.Errorf("segment of %gs must be longer than the %gs crossfade", options.SegmentSeconds, options.CrossfadeSeconds)
+	}
+	count := 1
+	if music > segment {
+		step := segment - crossfade
+		count += (music - segment + step - 1) / step
+	}
+	segments := make([]string, count)
+	for i := range segments {
+		segments[i] = videoPaths[i%len(videoPaths)]
+	}
+
+	fcpxml, err := GenerateMontageWithOptions(segments, options.SegmentSeconds, MontageOptions{
+		CrossfadeSeconds: options.CrossfadeSeconds,
+		RandomIn:         options.RandomIn,
+		Seed:             options.Seed,
+	})
+	if err != nil {
+		return nil, err
+	}
+	sequence, err := firstSequence(fcpxml)
+	if err != nil {
+		return nil, err
+	}
+
+	// The last segment overshoots by less than one step; trim it to end on the music
+	last := &sequence.Spine.AssetClips[len(sequence.Spine.AssetClips)-1]
+	overshoot := parseFCPDuration(calculateTimelineDuration(sequence)) - music
+	last.Duration = formatFrameAlignedTime(parseFCPDuration(last.Duration) - overshoot)
+	sequence.Duration = formatFrameAlignedTime(music)
+
+	if options.LUTPath != "" {
+		if err := ApplyLUT(fcpxml, options.LUTPath); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := addReelMusic(fcpxml, sequence, absMusic, music, fade); err != nil {
+		return nil, err
+	}
+
+	// The note names the reel; the montage underneath was built without RecordParams
+	if !options.RecordParams {
+		return fcpxml, nil
+	}
+	params := map[string]string{
+		"segment":   strconv.FormatFloat(options.SegmentSeconds, 'f', -1, 64),
+		"crossfade": strconv.FormatFloat(options.CrossfadeSeconds, 'f', -1, 64),
+		"fade-out":  strconv.FormatFloat(options.FadeOutSeconds, 'f', -1, 64),
+		"random-in": strconv.FormatBool(options.RandomIn),
+		"seed":      strconv.FormatInt(options.Seed, 10),
+		"music":     filepath.Base(musicPath),
+		"videos":    strconv.Itoa(len(videoPaths)),
+	}
+	if options.LUTPath != "" {
+		params["lut"] = filepath.Base(options.LUTPath)
+	}
+	RecordGenerationParams(fcpxml, "reel", params)
+	return fcpxml, nil
+}
+
+// addReelMusic connects the music under the first spine clip and fades its last fade units out
+func addReelMusic(fcpxml *FCPXML, sequence *Sequence, absMusic string, music, fade int) error {
+	registry := NewResourceRegistry(fcpxml)
+	tx := NewTransaction(registry)
+	defer tx.Rollback()
+
+	assetID := tx.ReserveIDs(1)[0]
+	musicName := strings.TrimSuffix(filepath.Base(absMusic), filepath.Ext(absMusic))
+	asset, err := tx.CreateAsset(assetID, absMusic, musicName, formatFrameAlignedTime(music), "")
+	if err != nil {
+		return fmt.Errorf("failed to create music asset: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	first := &sequence.Spine.AssetClips[0]
+	track := AssetClip{
+		Ref:       asset.ID,
+		Lane:      "-1",
+		Offset:    formatFrameAlignedTime(parseFCPDuration(first.Start)),
+		Name:      musicName,
+		Duration:  formatFrameAlignedTime(music),
+		TCFormat:  "NDF",
+		AudioRole: "music",
+	}
+	if fade > 0 {
+		addVolumeRamp(&track, music-fade, music, crossfadeFullVolume, crossfadeSilentVolume)
+	}
+	first.NestedAssetClips = append(first.NestedAssetClips, track)
+	return nil
+}
@@ -0,0 +1,115 @@
+package fcp
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestGenerateReel tests that two 10s sources loop into a graded montage exactly as long as a 10s song
+func TestGenerateReel(t *testing.T) {
+	paths := setupMontageSources(t, 2)
+	originalProbe := probeAudioDuration
+	probeAudioDuration = func(string) (float64, error) { return 10, nil }
+	defer func() { probeAudioDuration = originalProbe }()
+
+	musicPath := filepath.Join(t.TempDir(), "song.mp3")
+	if err := os.WriteFile(musicPath, []byte("fake audio"), 0644); err != nil {
+		t.Fatalf("Failed to create test music: %v", err)
+	}
+
+	lutPath := filepath.Join(t.TempDir(), "look.cube")
+	if err := os.WriteFile(lutPath, []byte("LUT_3D_SIZE 2\n"), 0644); err != nil {
+		t.Fatalf("Failed to create test LUT: %v", err)
+	}
+
+	options := DefaultReelOptions()
+	options.LUTPath = lutPath
+	fcpxml, err := GenerateReel(paths, musicPath, options)
+	if err != nil {
+		t.Fatalf("GenerateReel failed: %v", err)
+	}
+
+	// 3s segments overlapping by 0.5s: 72 + 3*60 frames covers the 240 frame song, the last one trimmed
+	sequence := fcpxml.Library.Events[0].Projects[0].Sequences[0]
+	music := parseFCPDuration(ConvertSecondsToFCPDuration(10))
+	clips := sequence.Spine.AssetClips
+	if len(clips) != 4 {
+		t.Fatalf("Expected 4 video segments, got %d", len(clips))
+	}
+	if got := parseFCPDuration(sequence.Duration); got != music {
+		t.Errorf("Expected the sequence to match the %s music, got %s", formatFrameAlignedTime(music), sequence.Duration)
+	}
+	if got := parseFCPDuration(calculateTimelineDuration(&sequence)); got != music {
+		t.Errorf("Expected the segments to end with the music, got %s", formatFrameAlignedTime(got))
+	}
+	for i, clip := range clips {
+		if len(clip.FilterVideos) != 1 || clip.FilterVideos[0].Name != "Custom LUT" {
+			t.Errorf("Segment %d: expected the Custom LUT grade, got %+v", i, clip.FilterVideos)
+		}
+	}
+
+	var track *AssetClip
+	for i, nested := range clips[0].NestedAssetClips {
+		if nested.AudioRole == "music" {
+			track = &clips[0].NestedAssetClips[i]
+		}
+	}
+	if track == nil {
+		t.Fatalf("Expected a music track under the first segment, got %+v", clips[0].NestedAssetClips)
+	}
+	if track.Lane != "-1" || track.Offset != formatFrameAlignedTime(parseFCPDuration(clips[0].Start)) || parseFCPDuration(track.Duration) != music {
+		t.Errorf("Expected music on lane -1 from the timeline start for the full song, got %+v", *track)
+	}
+	keyframes := track.AdjustVolume.Params[0].KeyframeAnimation.Keyframes
+	fade := parseFCPDuration(ConvertSecondsToFCPDuration(DefaultReelFadeOutSeconds))
+	if len(keyframes) != 2 || parseFCPDuration(keyframes[0].Time) != music-fade || parseFCPDuration(keyframes[1].Time) != music || keyframes[1].Value != crossfadeSilentVolume {
+		t.Errorf("Expected a %s fade out ending with the music, got %+v", formatFrameAlignedTime(fade), keyframes)
+	}
+
+	if err := WriteToFile(fcpxml, filepath.Join(t.TempDir(), "reel.fcpxml")); err != nil {
+		t.Errorf("Reel failed validation on write: %v", err)
+	}
+
+	if _, err := GenerateReel(paths, filepath.Join(t.TempDir(), "missing.mp3"), options); err == nil {
+		t.Error("Expected error for missing music")
+	}
+	if _, err := GenerateReel([]string{filepath.Join(t.TempDir(), "missing.mp4")}, musicPath, options); err == nil {
+		t.Error("Expected error for missing video")
+	}
+}
+
+// TestReelRecordParams tests that --record-params names the reel, not the montage it is built from
+func TestReelRecordParams(t *testing.T) {
+	paths := setupMontageSources(t, 2)
+	originalProbe := probeAudioDuration
+	probeAudioDuration = func(string) (float64, error) { return 10, nil }
+	defer func() { probeAudioDuration = originalProbe }()
+
+	musicPath := filepath.Join(t.TempDir(), "song.mp3")
+	if err := os.WriteFile(musicPath, []byte("fake audio"), 0644); err != nil {
+		t.Fatalf("Failed to create test music: %v", err)
+	}
+
+	options := DefaultReelOptions()
+	fcpxml, err := GenerateReel(paths, musicPath, options)
+	if err != nil {
+		t.Fatalf("GenerateReel failed: %v", err)
+	}
+	if note := fcpxml.Library.Events[0].Projects[0].Sequences[0].Note; note != "" {
+		t.Errorf("Expected no note without RecordParams, got '%s'", note)
+	}
+
+	options.RecordParams = true
+	fcpxml, err = GenerateReel(paths, musicPath, options)
+	if err != nil {
+		t.Fatalf("GenerateReel failed: %v", err)
+	}
+	note := fcpxml.Library.Events[0].Projects[0].Sequences[0].Note
+	for _, want := range []string{"cutlass reel ", "music=song.mp3", "seed=", "fade-out=2"} {
+		if !strings.Contains(note, want) {
+			t.Errorf("Expected note to contain '%s', got '%s'", want, note)
+		}
+	}
+}
@@ -0,0 +1,296 @@
+package fcp
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// safeAreaGuidePrefix names every guide element so RemoveSafeAreaGuides can strip them later
+const safeAreaGuidePrefix = "Safe Area Guide"
+
+// SafeAreaGuide describes one framing guide rectangle
+type SafeAreaGuide struct {
+	Name  string     // Label shown in the FCP timeline
+	Scale float64    // Fraction of the frame covered by the rectangle
+	Color [4]float64 // RGBA outline color
+}
+
+// titleSafeScale is the fraction of the frame inside which text is guaranteed to be visible
+const titleSafeScale = 0.8
+
+// SafeAreaGuides are the standard broadcast safe areas: action safe (90%) and title safe (80%)
+var SafeAreaGuides = []SafeAreaGuide{
+	{Name: "Action Safe", Scale: 0.9, Color: [4]float64{1, 1, 0, 1}},
+	{Name: "Title Safe", Scale: titleSafeScale, Color: [4]float64{0, 1, 1, 1}},
+}
+
+// shapesGeneratorUID is the Shapes generator, verified in reference/plus_sign.fcpxml
+const shapesGeneratorUID = ".../Generators.localized/Elements.localized/Shapes.localized/Shapes.motn"
+
+// safeAreaLineThickness is the width of each outline edge as a fraction of the frame height
+const safeAreaLineThickness = 0.004
+
+// AddSafeAreaGuides overlays action-safe and title-safe rectangle outlines over the timeline
+// as a compositing aid. Guides are not meant for final output - strip them with RemoveSafeAreaGuides.
+// durationSeconds <= 0 covers the whole sequence.
+//
+// 🚨 CLAUDE.md Rules Applied Here:
+// - Only verified UIDs and param keys: each outline is four square-cornered Shapes rectangles (as in plus_sign.fcpxml)
+// - Guides connect to every spine video/asset-clip they overlap, trimmed to that clip, so nothing outlasts its parent
+// - Edge positions come from the sequence format's real aspect, not an assumed 16:9
+// - Spine elements themselves never get lanes
+// - Calling it again replaces existing guides instead of stacking duplicates
+func AddSafeAreaGuides(fcpxml *FCPXML, durationSeconds float64) error {
+	sequence, err := firstSequence(fcpxml)
+	if err != nil {
+		return fmt.Errorf("no sequence found to add safe area guides to")
+	}
+
+	RemoveSafeAreaGuides(fcpxml)
+
+	duration := ConvertSecondsToFCPDuration(durationSeconds)
+	if durationSeconds <= 0 {
+		duration = sequence.Duration
+	}
+	if parseFCPDuration(duration) <= 0 {
+		return fmt.Errorf("safe area guides need a duration (sequence is empty)")
+	}
+	spanEnd, err := parseFCPTimeRat(duration)
+	if err != nil {
+		return fmt.Errorf("invalid guide duration %s: %v", duration, err)
+	}
+
+	width, height, err := sequenceFrameSize(fcpxml, sequence)
+	if err != nil {
+		return err
+	}
+
+	rate := SequenceFrameRate(fcpxml, sequence)
+
+	if len(sequence.Spine.Videos) == 0 && len(sequence.Spine.AssetClips) == 0 {
+		return fmt.Errorf("no video or asset-clip in spine to attach safe area guides to")
+	}
+
+	registry := NewResourceRegistry(fcpxml)
+	tx := NewTransaction(registry)
+	defer tx.Rollback()
+
+	shapesID := findEffectIDByUID(fcpxml, shapesGeneratorUID)
+	if shapesID == "" {
+		shapesID = tx.ReserveIDs(1)[0]
+		if _, err := tx.CreateEffect(shapesID, "Shapes", shapesGeneratorUID); err != nil {
+			return fmt.Errorf("failed to create Shapes generator: %v", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	attached := 0
+	for i := range sequence.Spine.Videos {
+		video := &sequence.Spine.Videos[i]
+		guides, err := safeAreaGuidesFor(shapesID, width/height, rate, video.Offset, video.Start, video.Duration, spanEnd,
+			highestNestedLane(video.NestedVideos, video.NestedAssetClips, video.NestedTitles))
+		if err != nil {
+			return fmt.Errorf("video '%s': %v", video.Name, err)
+		}
+		video.NestedVideos = append(video.NestedVideos, guides...)
+		attached += len(guides)
+	}
+	for i := range sequence.Spine.AssetClips {
+		clip := &sequence.Spine.AssetClips[i]
+		guides, err := safeAreaGuidesFor(shapesID, width/height, rate, clip.Offset, clip.Start, clip.Duration, spanEnd,
+			highestNestedLane(clip.Videos, clip.NestedAssetClips, clip.Titles))
+		if err != nil {
+			return fmt.Errorf("asset-clip '%s': %v", clip.Name, err)
+		}
+		clip.Videos = append(clip.Videos, guides...)
+		attached += len(guides)
+	}
+	if attached == 0 {
+		return fmt.Errorf("no video or asset-clip overlaps the first %s of the timeline", duration)
+	}
+
+	return nil
+}
+
+// safeAreaGuidesFor builds the guide edges connected to one spine element, covering the part of
+// it that falls before spanEnd (nil when the element starts after the span)
+func safeAreaGuidesFor(shapesID string, aspect float64, rate FrameRate, offset, start, duration string, spanEnd *big.Rat, highestLane int) ([]Video, error) {
+	parentOffset, err := parseFCPTimeRat(offset)
+	if err != nil {
+		return nil, fmt.Errorf("invalid offset %s: %v", offset, err)
+	}
+	parentDuration, err := parseFCPTimeRat(duration)
+	if err != nil {
+		return nil, fmt.Errorf("invalid duration %s: %v", duration, err)
+	}
+	if parentOffset.Cmp(spanEnd) >= 0 {
+		return nil, nil
+	}
+
+	guideDuration := new(big.Rat).Sub(spanEnd, parentOffset)
+	if guideDuration.Cmp(parentDuration) > 0 {
+		guideDuration = parentDuration
+	}
+	if guideDuration.Sign() <= 0 {
+		return nil, nil
+	}
+
+	ticks, _ := new(big.Rat).Mul(guideDuration, big.NewRat(int64(rate.Timebase), 1)).Float64()
+	guideDurationValue := rate.formatTicks(int(math.Round(ticks)))
+
+	guideOffset := start
+	if guideOffset == "" {
+		guideOffset = "0s"
+	}
+
+	// adjust-transform units are percent of frame height from frame center; an unscaled
+	// Shapes rectangle fills the frame like Vivid does
+	type edge struct {
+		name            string
+		scale, position string
+	}
+	var guides []Video
+	lane := highestLane
+	for _, guide := range SafeAreaGuides {
+		halfHeight := 50 * guide.Scale
+		halfWidth := 50 * aspect * guide.Scale
+		horizontal := fmt.Sprintf("%.4f %.4f", guide.Scale, safeAreaLineThickness)
+		vertical := fmt.Sprintf("%.4f %.4f", safeAreaLineThickness/aspect, guide.Scale)
+		edges := []edge{
+			{"Top", horizontal, fmt.Sprintf("0 %.4f", halfHeight)},
+			{"Bottom", horizontal, fmt.Sprintf("0 %.4f", -halfHeight)},
+			{"Left", vertical, fmt.Sprintf("%.4f 0", -halfWidth)},
+			{"Right", vertical, fmt.Sprintf("%.4f 0", halfWidth)},
+		}
+		// Shapes' Fill Color is RGB only
+		fillColor := strings.Join(strings.Fields(formatRGBA(guide.Color))[:3], " ")
+
+		for _, e := range edges {
+			lane++
+			guides = append(guides, Video{
+				Ref:      shapesID,
+				Lane:     fmt.Sprintf("%d", lane),
+				Offset:   guideOffset,
+				Name:     fmt.Sprintf("%s - %s (%s%%) %s", safeAreaGuidePrefix, guide.Name, strconv.FormatFloat(guide.Scale*100, 'f', -1, 64), e.name),
+				Start:    "0s",
+				Duration: guideDurationValue,
+				Params: []Param{
+					{Name: "Shape", Key: "9999/988461322/100/988461395/2/100", Value: "4 (Rectangle)"},
+					{Name: "Fill Color", Key: "9999/988455508/988455699/2/353/113/111", Value: fillColor},
+					{Name: "Outline", Key: "9999/988461322/100/988464485/2/100", Value: "0"},
+					{Name: "Corners", Key: "9999/988461322/100/988469428/2/100", Value: "1 (Square)"},
+				},
+				AdjustTransform: &AdjustTransform{
+					Position: e.position,
+					Scale:    e.scale,
+				},
+			})
+		}
+	}
+	return guides, nil
+}
+
+// RemoveSafeAreaGuides strips every guide added by AddSafeAreaGuides from the spine
+func RemoveSafeAreaGuides(fcpxml *FCPXML) {
+	for e := range fcpxml.Library.Events {
+		for p := range fcpxml.Library.Events[e].Projects {
+			for s := range fcpxml.Library.Events[e].Projects[p].Sequences {
+				spine := &fcpxml.Library.Events[e].Projects[p].Sequences[s].Spine
+				for i := range spine.Videos {
+					spine.Videos[i].NestedVideos = withoutSafeAreaGuides(spine.Videos[i].NestedVideos)
+				}
+				for i := range spine.AssetClips {
+					spine.AssetClips[i].Videos = withoutSafeAreaGuides(spine.AssetClips[i].Videos)
+				}
+			}
+		}
+	}
+}
+
+// withoutSafeAreaGuides filters guide elements out of a nested video list
+func withoutSafeAreaGuides(videos []Video) []Video {
+	var kept []Video
+	for _, video := range videos {
+		if !strings.HasPrefix(video.Name, safeAreaGuidePrefix) {
+			kept = append(kept, video)
+		}
+	}
+	return kept
+}
+
+// timelineStartParent returns the spine video or asset-clip that starts the timeline, which
+// timeline-wide overlays connect to so they begin at 0s (both nil when the spine has neither)
+func timelineStartParent(sequence *Sequence) (*Video, *AssetClip) {
+	var parentVideo *Video
+	var parentClip *AssetClip
+	for i := range sequence.Spine.Videos {
+		if parentVideo == nil || parseFCPDuration(sequence.Spine.Videos[i].Offset) < parseFCPDuration(parentVideo.Offset) {
+			parentVideo = &sequence.Spine.Videos[i]
+		}
+	}
+	for i := range sequence.Spine.AssetClips {
+		clip := &sequence.Spine.AssetClips[i]
+		if parentVideo != nil && parseFCPDuration(parentVideo.Offset) <= parseFCPDuration(clip.Offset) {
+			continue
+		}
+		if parentClip == nil || parseFCPDuration(clip.Offset) < parseFCPDuration(parentClip.Offset) {
+			parentClip = clip
+			parentVideo = nil
+		}
+	}
+	return parentVideo, parentClip
+}
+
+// connectedParentLayout returns the parent's local start time (where nested offsets begin)
+// and the highest lane already used by its connected clips
+func connectedParentLayout(parentVideo *Video, parentClip *AssetClip) (string, int) {
+	var parentStart string
+	var highestLane int
+	if parentVideo != nil {
+		parentStart = parentVideo.Start
+		highestLane = highestNestedLane(parentVideo.NestedVideos, parentVideo.NestedAssetClips, parentVideo.NestedTitles)
+	} else {
+		parentStart = parentClip.Start
+		highestLane = highestNestedLane(parentClip.Videos, parentClip.NestedAssetClips, parentClip.Titles)
+	}
+	if parentStart == "" {
+		parentStart = "0s"
+	}
+	return parentStart, highestLane
+}
+
+// highestNestedLane returns the highest lane used by nested connected clips (0 when none)
+func highestNestedLane(videos []Video, clips []AssetClip, titles []Title) int {
+	highest := 0
+	check := func(lane string) {
+		if n, err := strconv.Atoi(lane); err == nil && n > highest {
+			highest = n
+		}
+	}
+	for _, video := range videos {
+		check(video.Lane)
+	}
+	for _, clip := range clips {
+		check(clip.Lane)
+	}
+	for _, title := range titles {
+		check(title.Lane)
+	}
+	return highest
+}
+
+// findEffectIDByUID returns the ID of an existing effect resource with the given UID, or ""
+func findEffectIDByUID(fcpxml *FCPXML, uid string) string {
+	for _, effect := range fcpxml.Resources.Effects {
+		if effect.UID == uid {
+			return effect.ID
+		}
+	}
+	return ""
+}
@@ -0,0 +1,156 @@
+package fcp
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestAddSafeAreaGuides tests that two outline guides are added at action/title safe scales and validate
+func TestAddSafeAreaGuides(t *testing.T) {
+	tempDir := t.TempDir()
+	imagePath := filepath.Join(tempDir, "frame.png")
+	if err := os.WriteFile(imagePath, []byte("fake png data"), 0644); err != nil {
+		t.Fatalf("Failed to create test image: %v", err)
+	}
+
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("Failed to generate empty FCPXML: %v", err)
+	}
+	if err := AddImage(fcpxml, imagePath, 10.0); err != nil {
+		t.Fatalf("Failed to add image: %v", err)
+	}
+
+	if err := AddSafeAreaGuides(fcpxml, 0); err != nil {
+		t.Fatalf("AddSafeAreaGuides failed: %v", err)
+	}
+	// Adding again must replace rather than duplicate
+	if err := AddSafeAreaGuides(fcpxml, 0); err != nil {
+		t.Fatalf("Second AddSafeAreaGuides failed: %v", err)
+	}
+
+	sequence := fcpxml.Library.Events[0].Projects[0].Sequences[0]
+	guides := sequence.Spine.Videos[0].NestedVideos
+	if len(guides) != 8 {
+		t.Fatalf("Expected 8 guide edges (4 per outline), got %d", len(guides))
+	}
+
+	// GenerateEmpty is 1920x1080, so the action safe top edge sits at 45% of the frame height
+	// and its right edge at 45% of the frame width (80 units)
+	expected := map[int][2]string{
+		0: {"0.9000 0.0040", "0 45.0000"},
+		3: {"0.0023 0.9000", "80.0000 0"},
+		4: {"0.8000 0.0040", "0 40.0000"},
+	}
+	lanes := map[string]bool{}
+	for i, guide := range guides {
+		if !strings.HasPrefix(guide.Name, safeAreaGuidePrefix) {
+			t.Errorf("Guide %d: unexpected name %s", i, guide.Name)
+		}
+		if guide.Params[0].Value != "4 (Rectangle)" {
+			t.Errorf("Guide %d: expected a Shapes rectangle, got %s", i, guide.Params[0].Value)
+		}
+		if want, ok := expected[i]; ok && (guide.AdjustTransform == nil || guide.AdjustTransform.Scale != want[0] || guide.AdjustTransform.Position != want[1]) {
+			t.Errorf("Guide %d: expected scale %s at %s, got %+v", i, want[0], want[1], guide.AdjustTransform)
+		}
+		if guide.Lane == "" || lanes[guide.Lane] {
+			t.Errorf("Guide %d: expected its own lane, got %q", i, guide.Lane)
+		}
+		lanes[guide.Lane] = true
+		if guide.Duration != sequence.Duration {
+			t.Errorf("Guide %d: expected to span timeline %s, got %s", i, sequence.Duration, guide.Duration)
+		}
+	}
+
+	violations := ValidateClaudeCompliance(fcpxml)
+	if len(violations) > 0 {
+		t.Errorf("Unexpected violations: %v", violations)
+	}
+	if err := WriteToFile(fcpxml, filepath.Join(tempDir, "guides.fcpxml")); err != nil {
+		t.Errorf("Safe area guides failed validation: %v", err)
+	}
+
+	RemoveSafeAreaGuides(fcpxml)
+	if len(fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine.Videos[0].NestedVideos) != 0 {
+		t.Errorf("Expected RemoveSafeAreaGuides to strip all guides")
+	}
+}
+
+// TestAddSafeAreaGuidesScopedToClips tests that guides connect to every clip they overlap and
+// never outlast the clip they are nested in
+func TestAddSafeAreaGuidesScopedToClips(t *testing.T) {
+	tempDir := t.TempDir()
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("Failed to generate empty FCPXML: %v", err)
+	}
+	for i, seconds := range []float64{4, 6} {
+		imagePath := filepath.Join(tempDir, fmt.Sprintf("frame%d.png", i))
+		if err := os.WriteFile(imagePath, []byte("fake png data"), 0644); err != nil {
+			t.Fatalf("Failed to create test image: %v", err)
+		}
+		if err := AddImage(fcpxml, imagePath, seconds); err != nil {
+			t.Fatalf("Failed to add image: %v", err)
+		}
+	}
+
+	// 7s of guides covers all of the first image and 3s of the second
+	if err := AddSafeAreaGuides(fcpxml, 7); err != nil {
+		t.Fatalf("AddSafeAreaGuides failed: %v", err)
+	}
+
+	videos := fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine.Videos
+	for i, want := range []string{ConvertSecondsToFCPDuration(4), ConvertSecondsToFCPDuration(3)} {
+		guides := videos[i].NestedVideos
+		if len(guides) != 8 {
+			t.Fatalf("Clip %d: expected 8 guide edges, got %d", i, len(guides))
+		}
+		for _, guide := range guides {
+			if guide.Offset != videos[i].Start && !(videos[i].Start == "" && guide.Offset == "0s") {
+				t.Errorf("Clip %d: guide should start with the clip, got offset %s", i, guide.Offset)
+			}
+			if guide.Duration != want {
+				t.Errorf("Clip %d: expected guide duration %s, got %s", i, want, guide.Duration)
+			}
+		}
+	}
+
+	for _, warning := range nestedSpanViolations(&fcpxml.Library.Events[0].Projects[0].Sequences[0]) {
+		t.Errorf("Unexpected nested span warning: %s", warning)
+	}
+	if err := WriteToFile(fcpxml, filepath.Join(tempDir, "guides.fcpxml")); err != nil {
+		t.Errorf("Scoped safe area guides failed validation: %v", err)
+	}
+}
+
+// TestVideoReferenceMustBeGenerator tests that a <video> may reference a generator effect but
+// not a filter effect, which only belongs in filter-video
+func TestVideoReferenceMustBeGenerator(t *testing.T) {
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("Failed to generate empty FCPXML: %v", err)
+	}
+	fcpxml.Resources.Effects = append(fcpxml.Resources.Effects,
+		Effect{ID: "r2", Name: "Shapes", UID: shapesGeneratorUID},
+		Effect{ID: "r3", Name: "Simple Border", UID: ".../Effects.localized/Stylize.localized/Simple Border.localized/Simple Border.moef"},
+	)
+
+	registry := NewReferenceRegistry()
+	for i := range fcpxml.Resources.Effects {
+		if err := registry.RegisterEffect(&fcpxml.Resources.Effects[i]); err != nil {
+			t.Fatalf("Failed to register effect: %v", err)
+		}
+	}
+	if err := registry.validateVideoReference("r2"); err != nil {
+		t.Errorf("Expected generator reference to validate, got %v", err)
+	}
+	if err := registry.validateVideoReference("r3"); err == nil {
+		t.Errorf("Expected filter effect to be rejected as a video source")
+	}
+	if err := registry.validateVideoReference("r9"); err == nil {
+		t.Errorf("Expected dangling reference to be rejected")
+	}
+}
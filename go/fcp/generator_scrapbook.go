@@ -0,0 +1,126 @@
+package fcp
+
+import (
+	"fmt"
+	"math/rand"
+	"strconv"
+)
+
+// Scrapbook defaults. Offsets are adjust-transform units (percent of frame height, origin at
+// frame center), small enough that a photo at DefaultScrapbookScale stays mostly on screen.
+const (
+	DefaultScrapbookSeed        = 1
+	DefaultScrapbookMaxRotation = 8.0
+	DefaultScrapbookMaxOffsetX  = 25.0
+	DefaultScrapbookMaxOffsetY  = 15.0
+	DefaultScrapbookScale       = 0.6
+	DefaultScrapbookScaleJitter = 0.05
+)
+
+// ScrapbookConfig controls how photos are scattered by GenerateScrapbookWithConfig
+type ScrapbookConfig struct {
+	ImagePaths      []string
+	PerImageSeconds float64 // Time between drops; every photo stays until the end
+	Seed            int64   // Same seed → same layout
+	MaxRotation     float64 // Degrees either side of upright
+	MaxOffsetX      float64 // Horizontal offset bound from frame center
+	MaxOffsetY      float64 // Vertical offset bound from frame center
+	Scale           float64 // Base photo scale
+	ScaleJitter     float64 // Random scale change either side of Scale
+}
+
+// scrapbookPlacement is the random transform picked for one photo
+type scrapbookPlacement struct {
+	X, Y     float64
+	Rotation float64
+	Scale    float64
+}
+
+// GenerateScrapbook scatters imagePaths on screen one after another with the default bounds
+func GenerateScrapbook(imagePaths []string, perImageSeconds float64) (*FCPXML, error) {
+	return GenerateScrapbookWithConfig(ScrapbookConfig{
+		ImagePaths:      imagePaths,
+		PerImageSeconds: perImageSeconds,
+		Seed:            DefaultScrapbookSeed,
+		MaxRotation:     DefaultScrapbookMaxRotation,
+		MaxOffsetX:      DefaultScrapbookMaxOffsetX,
+		MaxOffsetY:      DefaultScrapbookMaxOffsetY,
+		Scale:           DefaultScrapbookScale,
+		ScaleJitter:     DefaultScrapbookScaleJitter,
+	})
+}
+
+// GenerateScrapbookWithConfig drops each photo at a small random angle, offset and scale, every
+// PerImageSeconds, and keeps earlier photos visible underneath so they pile up like a scrapbook.
+//
+// 🚨 CLAUDE.md Rules Applied Here:
+// - Images go through AddImage → Video elements (never asset-clips)
+// - The first photo is the spine element; later photos nest on lanes 1, 2, ... so each lands on top
+// - Nested offsets are in the first photo's local time (its start + drop time)
+// - Randomness comes from a local rand.Rand seeded with config.Seed, so output is reproducible
+func GenerateScrapbookWithConfig(config ScrapbookConfig) (*FCPXML, error) {
+	if len(config.ImagePaths) == 0 {
+		return nil, fmt.Errorf("scrapbook needs at least one image")
+	}
+	if config.PerImageSeconds <= 0 {
+		return nil, fmt.Errorf("seconds per image must be positive, got %g", config.PerImageSeconds)
+	}
+
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create base FCPXML: %v", err)
+	}
+
+	random := rand.New(rand.NewSource(config.Seed))
+	totalSeconds := config.PerImageSeconds * float64(len(config.ImagePaths))
+	sequence := &fcpxml.Library.Events[0].Projects[0].Sequences[0]
+
+	for i, imagePath := range config.ImagePaths {
+		// Each photo stays from its drop until the end of the scrapbook
+		remaining := totalSeconds - config.PerImageSeconds*float64(i)
+		if err := AddImage(fcpxml, imagePath, remaining); err != nil {
+			return nil, fmt.Errorf("failed to add image %s: %v", imagePath, err)
+		}
+
+		added := sequence.Spine.Videos[len(sequence.Spine.Videos)-1]
+		added.AdjustTransform = randomScrapbookPlacement(random, config).transform()
+
+		if i == 0 {
+			sequence.Spine.Videos[0] = added
+			continue
+		}
+
+		// Move the photo from the end of the spine onto the next lane of the first photo
+		sequence.Spine.Videos = sequence.Spine.Videos[:len(sequence.Spine.Videos)-1]
+		base := &sequence.Spine.Videos[0]
+		added.Lane = strconv.Itoa(i)
+		added.Offset = addDurations(base.Start, ConvertSecondsToFCPDuration(config.PerImageSeconds*float64(i)))
+		base.NestedVideos = append(base.NestedVideos, added)
+	}
+
+	sequence.Duration = calculateTimelineDuration(sequence)
+	return fcpxml, nil
+}
+
+// randomScrapbookPlacement picks a transform within the configured bounds
+func randomScrapbookPlacement(random *rand.Rand, config ScrapbookConfig) scrapbookPlacement {
+	spread := func(limit float64) float64 {
+		return (random.Float64()*2 - 1) * limit
+	}
+
+	return scrapbookPlacement{
+		X:        spread(config.MaxOffsetX),
+		Y:        spread(config.MaxOffsetY),
+		Rotation: spread(config.MaxRotation),
+		Scale:    config.Scale + spread(config.ScaleJitter),
+	}
+}
+
+// transform converts the placement into a static adjust-transform
+func (p scrapbookPlacement) transform() *AdjustTransform {
+	return &AdjustTransform{
+		Position: fmt.Sprintf("%.4f %.4f", p.X, p.Y),
+		Scale:    fmt.Sprintf("%.4f %.4f", p.Scale, p.Scale),
+		Rotation: fmt.Sprintf("%.4f", p.Rotation),
+	}
+}
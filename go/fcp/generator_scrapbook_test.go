@@ -0,0 +1,82 @@
+package fcp
+
+import (
+	"fmt"
+	"math"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// TestScrapbookTransformsWithinBounds tests that every photo's random transform respects the config
+func TestScrapbookTransformsWithinBounds(t *testing.T) {
+	tempDir := t.TempDir()
+	var imagePaths []string
+	for i := 0; i < 12; i++ {
+		imagePath := filepath.Join(tempDir, fmt.Sprintf("photo_%d.png", i))
+		writeTestPNG(t, imagePath, 64, 48)
+		imagePaths = append(imagePaths, imagePath)
+	}
+
+	config := ScrapbookConfig{
+		ImagePaths:      imagePaths,
+		PerImageSeconds: 1.5,
+		Seed:            42,
+		MaxRotation:     DefaultScrapbookMaxRotation,
+		MaxOffsetX:      20,
+		MaxOffsetY:      10,
+		Scale:           DefaultScrapbookScale,
+		ScaleJitter:     DefaultScrapbookScaleJitter,
+	}
+	fcpxml, err := GenerateScrapbookWithConfig(config)
+	if err != nil {
+		t.Fatalf("GenerateScrapbookWithConfig failed: %v", err)
+	}
+
+	spine := fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine
+	if len(spine.Videos) != 1 {
+		t.Fatalf("Expected 1 spine video, got %d", len(spine.Videos))
+	}
+	base := spine.Videos[0]
+	if len(base.NestedVideos) != len(imagePaths)-1 {
+		t.Fatalf("Expected %d nested photos, got %d", len(imagePaths)-1, len(base.NestedVideos))
+	}
+
+	photos := append([]Video{base}, base.NestedVideos...)
+	for i, photo := range photos {
+		transform := photo.AdjustTransform
+		if transform == nil {
+			t.Fatalf("Photo %d has no transform", i)
+		}
+
+		rotation, err := strconv.ParseFloat(transform.Rotation, 64)
+		if err != nil || math.Abs(rotation) > config.MaxRotation {
+			t.Errorf("Photo %d: rotation %s outside ±%g°", i, transform.Rotation, config.MaxRotation)
+		}
+
+		position := strings.Fields(transform.Position)
+		x, errX := strconv.ParseFloat(position[0], 64)
+		y, errY := strconv.ParseFloat(position[1], 64)
+		if errX != nil || errY != nil || math.Abs(x) > config.MaxOffsetX || math.Abs(y) > config.MaxOffsetY {
+			t.Errorf("Photo %d: position %s outside ±%g/±%g", i, transform.Position, config.MaxOffsetX, config.MaxOffsetY)
+		}
+
+		if i > 0 && photo.Lane != strconv.Itoa(i) {
+			t.Errorf("Photo %d: expected lane %d, got %s", i, i, photo.Lane)
+		}
+	}
+
+	// The same seed reproduces the same layout
+	again, err := GenerateScrapbookWithConfig(config)
+	if err != nil {
+		t.Fatalf("Second GenerateScrapbookWithConfig failed: %v", err)
+	}
+	if again.Library.Events[0].Projects[0].Sequences[0].Spine.Videos[0].AdjustTransform.Position != base.AdjustTransform.Position {
+		t.Error("Expected the same seed to produce the same placement")
+	}
+
+	if violations := ValidateClaudeCompliance(fcpxml); len(violations) > 0 {
+		t.Errorf("Scrapbook failed validation: %v", violations)
+	}
+}
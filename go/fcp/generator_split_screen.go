@@ -0,0 +1,174 @@
+package fcp
+
+import (
+	"fmt"
+	"math"
+	"path/filepath"
+	"strings"
+)
+
+// Split-screen layouts for GenerateSplitScreen
+const (
+	SplitScreen2UpHorizontal = "2-up-horizontal" // Side by side, left and right
+	SplitScreen2UpVertical   = "2-up-vertical"   // Stacked, top and bottom
+	SplitScreen3Up           = "3-up"            // Three columns
+)
+
+// splitScreenMutedVolume is FCP's lowest volume setting, used to silence sources other than --audio-from
+const splitScreenMutedVolume = "-96dB"
+
+// splitScreenCells returns the cells of layout in adjust-transform units for a frame of the given
+// aspect ratio. Every cell keeps the frame's aspect after scaling, so the clips never overlap.
+func splitScreenCells(layout string, aspect float64) ([]GridCell, error) {
+	frameWidth := 100.0 * aspect
+
+	var cols, rows int
+	switch layout {
+	case SplitScreen2UpHorizontal:
+		cols, rows = 2, 1
+	case SplitScreen2UpVertical:
+		cols, rows = 1, 2
+	case SplitScreen3Up:
+		cols, rows = 3, 1
+	default:
+		return nil, fmt.Errorf("unknown split-screen layout '%s' (use %s, %s or %s)", layout, SplitScreen2UpHorizontal, SplitScreen2UpVertical, SplitScreen3Up)
+	}
+
+	cellWidth := frameWidth / float64(cols)
+	cellHeight := 100.0 / float64(rows)
+	scale := math.Min(1.0/float64(cols), 1.0/float64(rows))
+
+	cells := make([]GridCell, 0, cols*rows)
+	for row := 0; row < rows; row++ {
+		for col := 0; col < cols; col++ {
+			cells = append(cells, GridCell{
+				X:      -frameWidth/2 + cellWidth*(float64(col)+0.5),
+				Y:      50.0 - cellHeight*(float64(row)+0.5),
+				Width:  cellWidth,
+				Height: cellHeight,
+				Scale:  scale,
+			})
+		}
+	}
+	return cells, nil
+}
+
+// GenerateSplitScreen plays two or three videos at once, tiled by layout, with all their audio
+func GenerateSplitScreen(paths []string, layout string) (*FCPXML, error) {
+	return GenerateSplitScreenWithAudio(paths, layout, 0)
+}
+
+// GenerateSplitScreenWithAudio is GenerateSplitScreen keeping only the audio of source audioFrom
+// (1-based); 0 keeps the audio of every source.
+//
+// 🚨 CLAUDE.md Rules Applied Here:
+// - A black Vivid generator is the spine element; sources nest on lanes 1..N as asset-clips
+// - Each source is probed and the split screen lasts as long as the shortest one (frame-aligned)
+// - Position/scale come from splitScreenCells, sharing GridCell units with the contact sheet
+// - Assets are created through the Transaction with CreateVideoAssetWithDetection
+func GenerateSplitScreenWithAudio(paths []string, layout string, audioFrom int) (*FCPXML, error) {
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create base FCPXML: %v", err)
+	}
+	sequence, err := firstSequence(fcpxml)
+	if err != nil {
+		return nil, err
+	}
+
+	aspect := 16.0 / 9.0
+	if width, height, err := sequenceFrameSize(fcpxml, sequence); err == nil {
+		aspect = width / height
+	}
+	cells, err := splitScreenCells(layout, aspect)
+	if err != nil {
+		return nil, err
+	}
+	if len(paths) != len(cells) {
+		return nil, fmt.Errorf("layout %s needs %d videos, got %d", layout, len(cells), len(paths))
+	}
+	if audioFrom < 0 || audioFrom > len(paths) {
+		return nil, fmt.Errorf("audio source %d out of range (1-%d, or 0 for all)", audioFrom, len(paths))
+	}
+
+	// The split screen ends with its shortest source
+	lengths := make([]int, len(paths))
+	shortest := 0
+	for i, path := range paths {
+		seconds, err := probeVideoDuration(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to probe %s: %v", path, err)
+		}
+		lengths[i] = int(math.Floor(seconds*24000.0/1001.0)) * 1001
+		if lengths[i] <= 0 {
+			return nil, fmt.Errorf("%s has no duration", path)
+		}
+		if i == 0 || lengths[i] < shortest {
+			shortest = lengths[i]
+		}
+	}
+	duration := formatFrameAlignedTime(shortest)
+
+	registry := NewResourceRegistry(fcpxml)
+	tx := NewTransaction(registry)
+	defer tx.Rollback()
+
+	backgroundID := findEffectIDByUID(fcpxml, ".../Generators.localized/Solids.localized/Vivid.localized/Vivid.motn")
+	if backgroundID == "" {
+		backgroundID = tx.ReserveIDs(1)[0]
+		if _, err := tx.CreateEffect(backgroundID, "Vivid", ".../Generators.localized/Solids.localized/Vivid.localized/Vivid.motn"); err != nil {
+			return nil, fmt.Errorf("failed to create background generator: %v", err)
+		}
+	}
+
+	background := Video{
+		Ref:      backgroundID,
+		Offset:   "0s",
+		Name:     "Split Screen",
+		Start:    "0s",
+		Duration: duration,
+		Params: []Param{
+			{Name: "Fill Color", Value: "0 0 0 1"},
+		},
+	}
+
+	for i, path := range paths {
+		absPath, err := filepath.Abs(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get absolute path: %v", err)
+		}
+		ids := tx.ReserveIDs(2)
+		name := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+		if err := tx.CreateVideoAssetWithDetection(ids[0], absPath, name, formatFrameAlignedTime(lengths[i]), ids[1]); err != nil {
+			return nil, fmt.Errorf("failed to create video asset for %s: %v", name, err)
+		}
+
+		cell := cells[i]
+		clip := AssetClip{
+			Ref:       ids[0],
+			Lane:      fmt.Sprintf("%d", i+1),
+			Offset:    "0s",
+			Name:      name,
+			Duration:  duration,
+			Format:    ids[1],
+			TCFormat:  "NDF",
+			AudioRole: "dialogue",
+			AdjustTransform: &AdjustTransform{
+				Position: fmt.Sprintf("%.4f %.4f", cell.X, cell.Y),
+				Scale:    fmt.Sprintf("%.4f %.4f", cell.Scale, cell.Scale),
+			},
+		}
+		if audioFrom != 0 && audioFrom != i+1 {
+			clip.AdjustVolume = &AdjustVolume{Amount: splitScreenMutedVolume}
+		}
+		background.NestedAssetClips = append(background.NestedAssetClips, clip)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	sequence.Spine.Videos = append(sequence.Spine.Videos, background)
+	sequence.Duration = duration
+	return fcpxml, nil
+}
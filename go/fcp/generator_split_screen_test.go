@@ -0,0 +1,87 @@
+package fcp
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+)
+
+// TestGenerateSplitScreen2UpHorizontal tests that two sources are scaled to half width, left and right
+func TestGenerateSplitScreen2UpHorizontal(t *testing.T) {
+	paths := setupMontageSources(t, 2)
+
+	fcpxml, err := GenerateSplitScreen(paths, SplitScreen2UpHorizontal)
+	if err != nil {
+		t.Fatalf("GenerateSplitScreen failed: %v", err)
+	}
+
+	sequence := fcpxml.Library.Events[0].Projects[0].Sequences[0]
+	if len(sequence.Spine.Videos) != 1 {
+		t.Fatalf("Expected one background video on the spine, got %d", len(sequence.Spine.Videos))
+	}
+	clips := sequence.Spine.Videos[0].NestedAssetClips
+	if len(clips) != 2 {
+		t.Fatalf("Expected 2 nested clips, got %d", len(clips))
+	}
+
+	// 16:9 frame is 177.78 units wide, so the half-width cells are centered at ±44.44
+	halfWidth := 100.0 * 16.0 / 9.0 / 4
+	expected := []string{fmt.Sprintf("%.4f 0.0000", -halfWidth), fmt.Sprintf("%.4f 0.0000", halfWidth)}
+	for i, clip := range clips {
+		if clip.AdjustTransform == nil {
+			t.Fatalf("Clip %d has no transform", i)
+		}
+		if clip.AdjustTransform.Scale != "0.5000 0.5000" {
+			t.Errorf("Clip %d: expected half scale, got %s", i, clip.AdjustTransform.Scale)
+		}
+		if clip.AdjustTransform.Position != expected[i] {
+			t.Errorf("Clip %d: expected position %s, got %s", i, expected[i], clip.AdjustTransform.Position)
+		}
+		if clip.Lane != fmt.Sprintf("%d", i+1) || clip.AdjustVolume != nil {
+			t.Errorf("Clip %d: expected lane %d with its audio kept, got lane %s", i, i+1, clip.Lane)
+		}
+	}
+
+	if err := WriteToFile(fcpxml, filepath.Join(t.TempDir(), "split.fcpxml")); err != nil {
+		t.Errorf("WriteToFile failed: %v", err)
+	}
+}
+
+// TestGenerateSplitScreenAudioFrom tests that only the chosen source keeps its audio
+func TestGenerateSplitScreenAudioFrom(t *testing.T) {
+	paths := setupMontageSources(t, 3)
+
+	fcpxml, err := GenerateSplitScreenWithAudio(paths, SplitScreen3Up, 2)
+	if err != nil {
+		t.Fatalf("GenerateSplitScreenWithAudio failed: %v", err)
+	}
+
+	clips := fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine.Videos[0].NestedAssetClips
+	for i, clip := range clips {
+		muted := clip.AdjustVolume != nil && clip.AdjustVolume.Amount == splitScreenMutedVolume
+		if muted != (i != 1) {
+			t.Errorf("Clip %d: muted=%v, expected only clip 2 audible", i+1, muted)
+		}
+	}
+
+	if _, err := GenerateSplitScreenWithAudio(paths, SplitScreen3Up, 4); err == nil {
+		t.Errorf("Expected an error for audio source 4 of 3")
+	}
+	if _, err := GenerateSplitScreen(paths, SplitScreen2UpVertical); err == nil {
+		t.Errorf("Expected an error for three videos in a 2-up layout")
+	}
+	if _, err := GenerateSplitScreen(paths[:2], "4-up"); err == nil {
+		t.Errorf("Expected an error for an unknown layout")
+	}
+}
+
+// TestSplitScreenCells tests that the stacked layout tiles the frame top and bottom
+func TestSplitScreenCells(t *testing.T) {
+	cells, err := splitScreenCells(SplitScreen2UpVertical, 16.0/9.0)
+	if err != nil {
+		t.Fatalf("splitScreenCells failed: %v", err)
+	}
+	if len(cells) != 2 || cells[0].Y != 25 || cells[1].Y != -25 || cells[0].X != 0 || cells[0].Scale != 0.5 {
+		t.Errorf("Unexpected 2-up-vertical cells: %+v", cells)
+	}
+}
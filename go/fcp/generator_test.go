@@ -8,7 +8,12 @@
 package fcp
 
 import (
+	"bytes"
+	"encoding/xml"
+	"io"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -41,6 +46,39 @@ func TestGenerateEmpty(t *testing.T) {
 	}
 }
 
+// TestGenerateEmptyWithAudio tests sequence audio layout and sample rate options
+func TestGenerateEmptyWithAudio(t *testing.T) {
+	fcpxml, err := GenerateEmptyWithAudio("", "horizontal", "mono", "44.1k")
+	if err != nil {
+		t.Fatalf("GenerateEmptyWithAudio failed: %v", err)
+	}
+
+	sequence := fcpxml.Library.Events[0].Projects[0].Sequences[0]
+	if sequence.AudioLayout != "mono" {
+		t.Errorf("Expected audio layout 'mono', got '%s'", sequence.AudioLayout)
+	}
+	if sequence.AudioRate != "44.1k" {
+		t.Errorf("Expected audio rate '44.1k', got '%s'", sequence.AudioRate)
+	}
+
+	// Defaults stay stereo/48k
+	fcpxml, err = GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("GenerateEmpty failed: %v", err)
+	}
+	sequence = fcpxml.Library.Events[0].Projects[0].Sequences[0]
+	if sequence.AudioLayout != "stereo" || sequence.AudioRate != "48k" {
+		t.Errorf("Expected default stereo/48k, got %s/%s", sequence.AudioLayout, sequence.AudioRate)
+	}
+
+	if _, err := GenerateEmptyWithAudio("", "horizontal", "stereo", "22k"); err == nil {
+		t.Errorf("Expected error for invalid audio rate")
+	}
+	if _, err := GenerateEmptyWithAudio("", "horizontal", "quad", "48k"); err == nil {
+		t.Errorf("Expected error for invalid audio layout")
+	}
+}
+
 var emptyxml = `<?xml version="1.0" encoding="UTF-8"?>
 <!DOCTYPE fcpxml>
 
@@ -580,6 +618,74 @@ func TestAppendPng(t *testing.T) {
 	}
 }
 
+// TestAddGapBetweenImages tests that a gap shifts the following image by the gap length
+func TestAddGapBetweenImages(t *testing.T) {
+	tempDir := t.TempDir()
+	firstImage := filepath.Join(tempDir, "first.png")
+	secondImage := filepath.Join(tempDir, "second.png")
+	for _, path := range []string{firstImage, secondImage} {
+		if err := os.WriteFile(path, []byte("fake png data"), 0644); err != nil {
+			t.Fatalf("Failed to create test image: %v", err)
+		}
+	}
+
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("GenerateEmpty failed: %v", err)
+	}
+
+	if err := AddImage(fcpxml, firstImage, 3.0); err != nil {
+		t.Fatalf("First AddImage failed: %v", err)
+	}
+	if err := AddGap(fcpxml, 2.0); err != nil {
+		t.Fatalf("AddGap failed: %v", err)
+	}
+	if err := AddImage(fcpxml, secondImage, 3.0); err != nil {
+		t.Fatalf("Second AddImage failed: %v", err)
+	}
+
+	sequence := fcpxml.Library.Events[0].Projects[0].Sequences[0]
+	if len(sequence.Spine.Gaps) != 1 {
+		t.Fatalf("Expected 1 gap in spine, got %d", len(sequence.Spine.Gaps))
+	}
+
+	gap := sequence.Spine.Gaps[0]
+	firstEnd := parseOffsetAndDuration(sequence.Spine.Videos[0].Offset, sequence.Spine.Videos[0].Duration)
+	if parseFCPDuration(gap.Offset) != firstEnd {
+		t.Errorf("Expected gap to start at end of first image (%d), got %s", firstEnd, gap.Offset)
+	}
+	if gap.Duration != ConvertSecondsToFCPDuration(2.0) {
+		t.Errorf("Expected frame-aligned gap duration %s, got %s", ConvertSecondsToFCPDuration(2.0), gap.Duration)
+	}
+
+	expectedOffset := firstEnd + parseFCPDuration(gap.Duration)
+	if parseFCPDuration(sequence.Spine.Videos[1].Offset) != expectedOffset {
+		t.Errorf("Expected second image at %d/24000s, got %s", expectedOffset, sequence.Spine.Videos[1].Offset)
+	}
+	if parseFCPDuration(sequence.Duration) != expectedOffset+parseFCPDuration(sequence.Spine.Videos[1].Duration) {
+		t.Errorf("Sequence duration %s does not include the gap", sequence.Duration)
+	}
+
+	// The gap must be written between the two images
+	data, err := xml.Marshal(sequence.Spine)
+	if err != nil {
+		t.Fatalf("Failed to marshal spine: %v", err)
+	}
+	output := string(data)
+	gapIndex := strings.Index(output, "<gap")
+	if gapIndex < strings.Index(output, `name="first"`) || gapIndex > strings.Index(output, `name="second"`) {
+		t.Errorf("Expected gap between the two images in spine output: %s", output)
+	}
+
+	if err := WriteToFile(fcpxml, filepath.Join(tempDir, "gap.fcpxml")); err != nil {
+		t.Errorf("Timeline with gap failed validation: %v", err)
+	}
+
+	if err := AddGap(fcpxml, 0); err == nil {
+		t.Errorf("Expected error for zero-length gap")
+	}
+}
+
 func TestAppendMovToPng(t *testing.T) {
 	testFile := "test_append_mov_to_png.fcpxml"
 
@@ -950,3 +1056,189 @@ func TestFrameBoundaryAlignment(t *testing.T) {
 	})
 }
 
+
+// TestWriteToMatchesWriteToFile tests that streaming output is byte-for-byte identical to the file output
+func TestWriteToMatchesWriteToFile(t *testing.T) {
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("GenerateEmpty failed: %v", err)
+	}
+	tempDir := t.TempDir()
+	imagePath := filepath.Join(tempDir, "still.png")
+	writeTestPNG(t, imagePath, 32, 18)
+	if err := AddImage(fcpxml, imagePath, 5); err != nil {
+		t.Fatalf("AddImage failed: %v", err)
+	}
+
+	filename := filepath.Join(tempDir, "out.fcpxml")
+	if err := WriteToFile(fcpxml, filename); err != nil {
+		t.Fatalf("WriteToFile failed: %v", err)
+	}
+	fileBytes, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatalf("Failed to read output: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := WriteTo(fcpxml, &buf); err != nil {
+		t.Fatalf("WriteTo failed: %v", err)
+	}
+	if !bytes.Equal(buf.Bytes(), fileBytes) {
+		t.Error("WriteTo output differs from WriteToFile output")
+	}
+	if !bytes.HasPrefix(buf.Bytes(), []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<!DOCTYPE fcpxml>")) {
+		t.Error("Expected XML declaration and DOCTYPE at the start of the output")
+	}
+
+	// "-" routes WriteToFile to os.Stdout
+	reader, writer, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("Failed to create pipe: %v", err)
+	}
+	piped := make(chan []byte)
+	go func() {
+		data, _ := io.ReadAll(reader)
+		piped <- data
+	}()
+	original := os.Stdout
+	os.Stdout = writer
+	writeErr := WriteToFile(fcpxml, StdoutFilename)
+	os.Stdout = original
+	writer.Close()
+	if writeErr != nil {
+		t.Fatalf("WriteToFile to stdout failed: %v", writeErr)
+	}
+	if !bytes.Equal(<-piped, fileBytes) {
+		t.Error("Stdout output differs from file output")
+	}
+}
+
+// TestWriteToFileCorrectsStaleSequenceDuration tests that a too-short sequence duration is raised
+// to the end of the spine on write while a longer one is left as written
+func TestWriteToFileCorrectsStaleSequenceDuration(t *testing.T) {
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("GenerateEmpty failed: %v", err)
+	}
+
+	tempDir := t.TempDir()
+	for name, seconds := range map[string]float64{"first.png": 2, "second.png": 3} {
+		imagePath := filepath.Join(tempDir, name)
+		writeTestPNG(t, imagePath, 32, 18)
+		if err := AddImage(fcpxml, imagePath, seconds); err != nil {
+			t.Fatalf("AddImage failed: %v", err)
+		}
+	}
+
+	sequence := &fcpxml.Library.Events[0].Projects[0].Sequences[0]
+	sequence.Duration = ConvertSecondsToFCPDuration(1) // Stale value from a manual edit
+
+	filename := filepath.Join(tempDir, "fixed.fcpxml")
+	if err := WriteToFile(fcpxml, filename); err != nil {
+		t.Fatalf("WriteToFile failed: %v", err)
+	}
+
+	expected := addDurations(ConvertSecondsToFCPDuration(2), ConvertSecondsToFCPDuration(3))
+	if sequence.Duration != expected {
+		t.Errorf("Expected sequence duration %s, got %s", expected, sequence.Duration)
+	}
+
+	written, err := ReadFromFile(filename)
+	if err != nil {
+		t.Fatalf("ReadFromFile failed: %v", err)
+	}
+	if got := written.Library.Events[0].Projects[0].Sequences[0].Duration; got != sequence.Duration {
+		t.Errorf("Expected written duration %s, got %s", sequence.Duration, got)
+	}
+
+	// Trailing space after the last clip is not stale and must survive
+	sequence.Duration = ConvertSecondsToFCPDuration(60)
+	RecalculateSequenceDuration(fcpxml)
+	if sequence.Duration != ConvertSecondsToFCPDuration(60) {
+		t.Errorf("Expected longer duration to be kept, got %s", sequence.Duration)
+	}
+}
+
+// TestRecalculateSequenceDurationKeepsSamples tests that FCP exports, whose durations are exact in
+// their own timebases, come through recalculation untouched
+func TestRecalculateSequenceDurationKeepsSamples(t *testing.T) {
+	for _, name := range []string{"three_words.fcpxml", "add_slide_text_to_existing.fcpxml", "imec.fcpxml", "pip.fcpxml"} {
+		fcpxml, err := ReadFromFile(filepath.Join("..", "..", "samples", name))
+		if err != nil {
+			t.Fatalf("Failed to read %s: %v", name, err)
+		}
+		before := fcpxml.Library.Events[0].Projects[0].Sequences[0].Duration
+		RecalculateSequenceDuration(fcpxml)
+		if after := fcpxml.Library.Events[0].Projects[0].Sequences[0].Duration; after != before {
+			t.Errorf("%s: duration changed from %s to %s", name, before, after)
+		}
+	}
+}
+
+// TestRecalculateSequenceDurationIncludesTransitions tests that a transition ending past the last
+// clip extends the sequence
+func TestRecalculateSequenceDurationIncludesTransitions(t *testing.T) {
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("GenerateEmpty failed: %v", err)
+	}
+	sequence := &fcpxml.Library.Events[0].Projects[0].Sequences[0]
+	sequence.Spine.Gaps = []Gap{{Name: "Gap", Offset: "0s", Duration: "2s"}}
+	sequence.Spine.Transitions = []Transition{{Name: "Cross Dissolve", Offset: "2s", Duration: "1001/1000s"}}
+	sequence.Duration = "2s"
+
+	RecalculateSequenceDuration(fcpxml)
+	if sequence.Duration != "72024/24000s" {
+		t.Errorf("Expected duration 72024/24000s, got %s", sequence.Duration)
+	}
+}
+
+// TestWriteToFileSafeGuardsExistingFiles tests the overwrite refusal and the .bak backup
+func TestWriteToFileSafeGuardsExistingFiles(t *testing.T) {
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("GenerateEmpty failed: %v", err)
+	}
+
+	tempDir := t.TempDir()
+	filename := filepath.Join(tempDir, "edited.fcpxml")
+	handEdited := []byte("<!-- hand edited -->")
+	if err := os.WriteFile(filename, handEdited, 0644); err != nil {
+		t.Fatalf("Failed to create existing file: %v", err)
+	}
+
+	if err := WriteToFileSafe(fcpxml, filename, WriteOptions{}); err == nil {
+		t.Fatal("Expected an error when overwriting without --force")
+	}
+	if data, _ := os.ReadFile(filename); !bytes.Equal(data, handEdited) {
+		t.Error("Refused write must leave the existing file untouched")
+	}
+
+	if err := WriteToFileSafe(fcpxml, filename, WriteOptions{Backup: true}); err != nil {
+		t.Fatalf("WriteToFileSafe with backup failed: %v", err)
+	}
+	backups, err := filepath.Glob(filename + ".*.bak")
+	if err != nil || len(backups) != 1 {
+		t.Fatalf("Expected one .bak file, got %v (%v)", backups, err)
+	}
+	if data, _ := os.ReadFile(backups[0]); !bytes.Equal(data, handEdited) {
+		t.Error("Backup should hold the previous file contents")
+	}
+	if data, _ := os.ReadFile(filename); !bytes.Contains(data, []byte("<fcpxml")) {
+		t.Error("Expected the new FCPXML to be written after the backup")
+	}
+
+	// Back-to-back backups within the same second must not replace each other
+	for i := 0; i < 3; i++ {
+		if err := WriteToFileSafe(fcpxml, filename, WriteOptions{Backup: true}); err != nil {
+			t.Fatalf("Repeated backup write failed: %v", err)
+		}
+	}
+	if backups, _ := filepath.Glob(filename + ".*.bak"); len(backups) != 4 {
+		t.Errorf("Expected 4 distinct backups, got %v", backups)
+	}
+
+	if err := WriteToFileSafe(fcpxml, filename, WriteOptions{Force: true}); err != nil {
+		t.Errorf("WriteToFileSafe with force failed: %v", err)
+	}
+}
@@ -1074,4 +1074,229 @@ func getPositionValue(title Title) string {
 		}
 	}
 	return ""
-}
\ No newline at end of file
+}
+// TestAddSingleTextStyledOutline tests that outline and shadow options become text-style attributes
+func TestAddSingleTextStyledOutline(t *testing.T) {
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("Failed to create empty FCPXML: %v", err)
+	}
+
+	options := TextStyleOptions{
+		ShadowColor:  DefaultTextShadowColor,
+		OutlineColor: "1 0 0 1",
+		OutlineWidth: 3,
+	}
+	if err := AddSingleTextStyled(fcpxml, "Readable", 0, 5, options); err != nil {
+		t.Fatalf("AddSingleTextStyled failed: %v", err)
+	}
+
+	data, err := xml.Marshal(fcpxml)
+	if err != nil {
+		t.Fatalf("Failed to marshal FCPXML: %v", err)
+	}
+	output := string(data)
+
+	for _, expected := range []string{`strokeColor="1 0 0 1"`, `strokeWidth="3"`, `shadowColor="0 0 0 0.75"`, `shadowOffset="5 315"`} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("Expected %s in marshaled text style", expected)
+		}
+	}
+
+	// Without options no stroke or shadow attributes are emitted
+	plain, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("Failed to create empty FCPXML: %v", err)
+	}
+	if err := AddSingleText(plain, "Plain", 0, 5); err != nil {
+		t.Fatalf("AddSingleText failed: %v", err)
+	}
+	data, _ = xml.Marshal(plain)
+	if strings.Contains(string(data), "strokeColor") || strings.Contains(string(data), "shadowColor") {
+		t.Errorf("Expected no stroke or shadow attributes without options")
+	}
+}
+
+// TestTextStyleOptionsValidate tests color range and width validation
+func TestTextStyleOptionsValidate(t *testing.T) {
+	invalid := []TextStyleOptions{
+		{OutlineColor: "1 0 0 2", OutlineWidth: 1},
+		{OutlineWidth: -1},
+		{ShadowColor: "0 0 0"},
+		{ShadowColor: DefaultTextShadowColor, ShadowOffset: "-5 315"},
+		{ShadowColor: DefaultTextShadowColor, ShadowBlur: -1},
+	}
+	for i, options := range invalid {
+		if err := options.Validate(); err == nil {
+			t.Errorf("Expected validation error for case %d: %+v", i, options)
+		}
+	}
+
+	if err := (TextStyleOptions{}).Validate(); err != nil {
+		t.Errorf("Expected empty options to be valid, got %v", err)
+	}
+}
+
+// TestAddTextFromFileHorizontalStagger tests that a horizontal stagger moves lines along X instead of Y
+func TestAddTextFromFileHorizontalStagger(t *testing.T) {
+	tempDir := t.TempDir()
+
+	imagePath := filepath.Join(tempDir, "background.png")
+	writeTestPNG(t, imagePath, 64, 36)
+	testTextFile := filepath.Join(tempDir, "words.txt")
+	if err := os.WriteFile(testTextFile, []byte("One\nTwo\nThree"), 0644); err != nil {
+		t.Fatalf("Failed to create test text file: %v", err)
+	}
+
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("Failed to create empty FCPXML: %v", err)
+	}
+	if err := AddImage(fcpxml, imagePath, 20.0); err != nil {
+		t.Fatalf("AddImage failed: %v", err)
+	}
+
+	stagger := StaggerConfig{Axis: StaggerAxisHorizontal, PixelStep: intPtr(400), TimeStepSeconds: floatPtr(1.0)}
+	if err := AddTextFromFileStyled(fcpxml, testTextFile, 0, 10.0, TextStyleOptions{}, stagger); err != nil {
+		t.Fatalf("AddTextFromFileStyled failed: %v", err)
+	}
+
+	video := &fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine.Videos[0]
+	if len(video.NestedTitles) != 3 {
+		t.Fatalf("Expected 3 nested titles, got %d", len(video.NestedTitles))
+	}
+
+	videoStart := parseFCPDuration(video.Start)
+	oneSecond := parseFCPDuration(ConvertSecondsToFCPDuration(1.0))
+	for i, title := range video.NestedTitles {
+		if i > 0 {
+			expected := fmt.Sprintf("%d 0", i*400)
+			if position := getPositionValue(title); position != expected {
+				t.Errorf("Expected Position '%s' at index %d, got '%s'", expected, i, position)
+			}
+		}
+
+		// Later lines are layered above earlier ones
+		if expectedLane := fmt.Sprintf("%d", i+1); title.Lane != expectedLane {
+			t.Errorf("Expected lane %s at index %d, got %s", expectedLane, i, title.Lane)
+		}
+
+		if offset := parseFCPDuration(title.Offset); offset != videoStart+i*oneSecond {
+			t.Errorf("Expected offset %d at index %d, got %d", videoStart+i*oneSecond, i, offset)
+		}
+	}
+
+	if err := AddTextFromFileStyled(fcpxml, testTextFile, 0, 10.0, TextStyleOptions{}, StaggerConfig{Axis: "diagonal"}); err == nil {
+		t.Error("Expected error for unknown stagger axis")
+	}
+}
+
+// TestAddTextFromFileZeroStagger tests that explicit zero steps stack every line on one spot at one time
+func TestAddTextFromFileZeroStagger(t *testing.T) {
+	tempDir := t.TempDir()
+
+	imagePath := filepath.Join(tempDir, "background.png")
+	writeTestPNG(t, imagePath, 64, 36)
+	testTextFile := filepath.Join(tempDir, "words.txt")
+	if err := os.WriteFile(testTextFile, []byte("One\nTwo\nThree"), 0644); err != nil {
+		t.Fatalf("Failed to create test text file: %v", err)
+	}
+
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("Failed to create empty FCPXML: %v", err)
+	}
+	if err := AddImage(fcpxml, imagePath, 20.0); err != nil {
+		t.Fatalf("AddImage failed: %v", err)
+	}
+
+	stagger := StaggerConfig{PixelStep: intPtr(0), TimeStepSeconds: floatPtr(0)}
+	if err := AddTextFromFileStyled(fcpxml, testTextFile, 0, 10.0, TextStyleOptions{}, stagger); err != nil {
+		t.Fatalf("AddTextFromFileStyled failed: %v", err)
+	}
+
+	titles := fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine.Videos[0].NestedTitles
+	if len(titles) != 3 {
+		t.Fatalf("Expected 3 nested titles, got %d", len(titles))
+	}
+	for i, title := range titles {
+		if position := getPositionValue(title); i > 0 && position != "0 0" {
+			t.Errorf("Expected Position '0 0' at index %d, got '%s'", i, position)
+		}
+		if title.Offset != titles[0].Offset {
+			t.Errorf("Expected every line at offset %s, got %s at index %d", titles[0].Offset, title.Offset, i)
+		}
+	}
+}
+
+// TestAddTextFromFileAutoFit tests that 12 staggered lines in a 1080-tall sequence stay inside the title-safe area
+func TestAddTextFromFileAutoFit(t *testing.T) {
+	tempDir := t.TempDir()
+
+	imagePath := filepath.Join(tempDir, "background.png")
+	writeTestPNG(t, imagePath, 64, 36)
+	var lines []string
+	for i := 1; i <= 12; i++ {
+		lines = append(lines, fmt.Sprintf("Line %d", i))
+	}
+	testTextFile := filepath.Join(tempDir, "long.txt")
+	if err := os.WriteFile(testTextFile, []byte(strings.Join(lines, "\n")), 0644); err != nil {
+		t.Fatalf("Failed to create test text file: %v", err)
+	}
+
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("Failed to create empty FCPXML: %v", err)
+	}
+	if err := AddImage(fcpxml, imagePath, 20.0); err != nil {
+		t.Fatalf("AddImage failed: %v", err)
+	}
+	sequence := &fcpxml.Library.Events[0].Projects[0].Sequences[0]
+	for i := range fcpxml.Resources.Formats {
+		if fcpxml.Resources.Formats[i].ID == sequence.Format {
+			fcpxml.Resources.Formats[i].Width = "1920"
+			fcpxml.Resources.Formats[i].Height = "1080"
+		}
+	}
+	width, height, err := sequenceFrameSize(fcpxml, sequence)
+	if err != nil || height != 1080 {
+		t.Fatalf("Expected a 1080-tall sequence, got %vx%v (%v)", width, height, err)
+	}
+
+	if err := AddTextFromFileStyled(fcpxml, testTextFile, 0, 10.0, TextStyleOptions{}, StaggerConfig{AutoFit: true}); err != nil {
+		t.Fatalf("AddTextFromFileStyled failed: %v", err)
+	}
+
+	titles := sequence.Spine.Videos[0].NestedTitles
+	if len(titles) != 12 {
+		t.Fatalf("Expected 12 nested titles, got %d", len(titles))
+	}
+	safeHalfHeight := height * titleSafeScale / 2
+	previousY := safeHalfHeight + 1
+	for i, title := range titles {
+		var x, y float64
+		if _, err := fmt.Sscanf(getPositionValue(title), "%g %g", &x, &y); err != nil {
+			t.Fatalf("Line %d: unreadable position '%s'", i, getPositionValue(title))
+		}
+		if y > safeHalfHeight || y < -safeHalfHeight {
+			t.Errorf("Line %d: Y %g is outside the title-safe range ±%g", i, y, safeHalfHeight)
+		}
+		if y >= previousY {
+			t.Errorf("Line %d: Y %g should be below the previous line (%g)", i, y, previousY)
+		}
+		previousY = y
+	}
+
+	// Too many lines for one column wrap into more columns, still inside the safe area
+	wrapped := StaggerConfig{AutoFit: true}.fitToFrame(40, 1920, 1080)
+	for i := 0; i < 40; i++ {
+		var x, y float64
+		fmt.Sscanf(wrapped.position(i), "%g %g", &x, &y)
+		if y > safeHalfHeight || y < -safeHalfHeight || x < -1920*titleSafeScale/2 || x > 1920*titleSafeScale/2 {
+			t.Errorf("Wrapped line %d at '%s' is outside the title-safe area", i, wrapped.position(i))
+		}
+	}
+	if wrapped.rows == 0 {
+		t.Errorf("Expected 40 lines to wrap into columns")
+	}
+}
@@ -0,0 +1,132 @@
+package fcp
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// DefaultTimelapseFPS is the playback rate of a timelapse's frames
+const DefaultTimelapseFPS = 24.0
+
+// sequenceFramesPerSecond is the 23.976fps rate of the sequence GenerateEmpty creates
+const sequenceFramesPerSecond = 24000.0 / 1001
+
+// GenerateTimelapse plays every image in dir back-to-back at fps frames per second, in natural
+// filename order (IMG_2 before IMG_10), so a numbered photo sequence plays like video.
+//
+// 🚨 CLAUDE.md Rules Applied Here:
+// - Images go through AddImage → Video elements with no Ken Burns move
+// - Each image's length is the difference of cumulative frame-aligned times, so rounding never drifts
+// - A frame that would round to zero sequence frames (fps above 23.976) is dropped with a warning
+func GenerateTimelapse(dir string, fps float64) (*FCPXML, error) {
+	if fps <= 0 {
+		return nil, fmt.Errorf("timelapse fps must be positive, got %.3f", fps)
+	}
+
+	paths, err := getPngFiles(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list images in %s: %v", dir, err)
+	}
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("no images found in %s", dir)
+	}
+	sort.SliceStable(paths, func(i, j int) bool {
+		return naturalLess(filepath.Base(paths[i]), filepath.Base(paths[j]))
+	})
+
+	warnInconsistentDimensions(paths)
+
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create base FCPXML: %v", err)
+	}
+
+	dropped := 0
+	for i, path := range paths {
+		startFrame := math.Round(float64(i) * sequenceFramesPerSecond / fps)
+		endFrame := math.Round(float64(i+1) * sequenceFramesPerSecond / fps)
+		if endFrame <= startFrame {
+			dropped++
+			continue
+		}
+		if err := AddImage(fcpxml, path, (endFrame-startFrame)/sequenceFramesPerSecond); err != nil {
+			return nil, fmt.Errorf("failed to add frame %s: %v", path, err)
+		}
+	}
+	if dropped > 0 {
+		fmt.Fprintf(os.Stderr, "Warning: dropped %d of %d frames; %.3f fps is faster than the 23.976 fps sequence\n", dropped, len(paths), fps)
+	}
+
+	return fcpxml, nil
+}
+
+// warnInconsistentDimensions prints a warning when the images aren't all the same size, since
+// mixed sizes jump around in a timelapse. Images whose size can't be read are ignored.
+func warnInconsistentDimensions(paths []string) {
+	sizes := make(map[string]int)
+	var first string
+	for _, path := range paths {
+		width, height, err := imageDimensions(path)
+		if err != nil {
+			continue
+		}
+		size := fmt.Sprintf("%dx%d", width, height)
+		if first == "" {
+			first = size
+		}
+		sizes[size]++
+	}
+	if len(sizes) <= 1 {
+		return
+	}
+
+	var others []string
+	for size, count := range sizes {
+		if size != first {
+			others = append(others, fmt.Sprintf("%d at %s", count, size))
+		}
+	}
+	sort.Strings(others)
+	fmt.Fprintf(os.Stderr, "Warning: timelapse frames have inconsistent dimensions (%d at %s, %s)\n", sizes[first], first, strings.Join(others, ", "))
+}
+
+// naturalLess orders names with embedded numbers by their numeric value, so "IMG_2" < "IMG_10"
+func naturalLess(a, b string) bool {
+	ra, rb := []rune(a), []rune(b)
+	i, j := 0, 0
+	for i < len(ra) && j < len(rb) {
+		if unicode.IsDigit(ra[i]) && unicode.IsDigit(rb[j]) {
+			startA, startB := i, j
+			for i < len(ra) && unicode.IsDigit(ra[i]) {
+				i++
+			}
+			for j < len(rb) && unicode.IsDigit(rb[j]) {
+				j++
+			}
+			numA := strings.TrimLeft(string(ra[startA:i]), "0")
+			numB := strings.TrimLeft(string(rb[startB:j]), "0")
+			if len(numA) != len(numB) {
+				return len(numA) < len(numB)
+			}
+			if numA != numB {
+				return numA < numB
+			}
+			continue
+		}
+		if ra[i] != rb[j] {
+			return ra[i] < rb[j]
+		}
+		i++
+		j++
+	}
+	if len(ra)-i != len(rb)-j {
+		return len(ra)-i < len(rb)-j
+	}
+	// Equal apart from zero padding: fall back to plain order for a stable result
+	return a < b
+}
@@ -0,0 +1,61 @@
+package fcp
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+)
+
+func TestGenerateTimelapse(t *testing.T) {
+	dir := t.TempDir()
+	for i := 1; i <= 48; i++ {
+		writeTestPNG(t, filepath.Join(dir, fmt.Sprintf("IMG_%d.png", i)), 16, 9)
+	}
+
+	fcpxml, err := GenerateTimelapse(dir, 24)
+	if err != nil {
+		t.Fatalf("GenerateTimelapse failed: %v", err)
+	}
+
+	seq := fcpxml.Library.Events[0].Projects[0].Sequences[0]
+	if seq.Duration != ConvertSecondsToFCPDuration(2) {
+		t.Errorf("Expected 2 second timeline %s, got %s", ConvertSecondsToFCPDuration(2), seq.Duration)
+	}
+
+	videos := seq.Spine.Videos
+	if len(videos) != 48 {
+		t.Fatalf("Expected 48 frames on the spine, got %d", len(videos))
+	}
+
+	expectedOffset := 0
+	for i, video := range videos {
+		if want := fmt.Sprintf("IMG_%d", i+1); video.Name != want {
+			t.Errorf("Frame %d: expected %s, got %s", i, want, video.Name)
+		}
+		if got := parseFCPDuration(video.Offset); got != expectedOffset {
+			t.Errorf("Frame %d: expected offset %d, got %d", i, expectedOffset, got)
+		}
+		expectedOffset += parseFCPDuration(video.Duration)
+	}
+	if expectedOffset != parseFCPDuration(seq.Duration) {
+		t.Errorf("Frames end at %d but sequence lasts %d", expectedOffset, parseFCPDuration(seq.Duration))
+	}
+}
+
+func TestNaturalLess(t *testing.T) {
+	cases := []struct {
+		a, b string
+		want bool
+	}{
+		{"IMG_2.png", "IMG_10.png", true},
+		{"IMG_10.png", "IMG_2.png", false},
+		{"IMG_002.png", "IMG_10.png", true},
+		{"a.png", "b.png", true},
+		{"IMG_9", "IMG_9a", true},
+	}
+	for _, c := range cases {
+		if got := naturalLess(c.a, c.b); got != c.want {
+			t.Errorf("naturalLess(%q, %q) = %v, want %v", c.a, c.b, got, c.want)
+		}
+	}
+}
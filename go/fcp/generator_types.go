@@ -1,10 +1,12 @@
 package fcp
 
 import (
+	"bytes"
 	"crypto/md5"
 	"encoding/hex"
 	"fmt"
 
+	"io"
 	"math"
 
 	"os"
@@ -12,6 +14,7 @@ import (
 	"path/filepath"
 
 	"strings"
+	"time"
 )
 
 type TemplateVideo struct {
@@ -146,6 +149,25 @@ func GenerateEmpty(filename string) (*FCPXML, error) {
 
 // GenerateEmptyWithFormat creates an empty FCPXML file structure with specified format
 func GenerateEmptyWithFormat(filename string, format string) (*FCPXML, error) {
+	return GenerateEmptyWithAudio(filename, format, "stereo", "48k")
+}
+
+// sequenceAudioLayouts and sequenceAudioRates are the sequence audio settings accepted by the FCPXML DTD
+var (
+	sequenceAudioLayouts = []string{"mono", "stereo", "surround"}
+	sequenceAudioRates   = []string{"32k", "44.1k", "48k", "88.2k", "96k", "176.4k", "192k"}
+)
+
+// GenerateEmptyWithAudio creates an empty FCPXML file structure with specified format and sequence audio settings.
+// Audio assets added later should match the sequence layout/rate (e.g. mono 44.1k voice-over projects).
+func GenerateEmptyWithAudio(filename string, format string, audioLayout string, audioRate string) (*FCPXML, error) {
+	if !containsString(sequenceAudioLayouts, audioLayout) {
+		return nil, fmt.Errorf("invalid audio layout '%s' (must be one of: %s)", audioLayout, strings.Join(sequenceAudioLayouts, ", "))
+	}
+	if !containsString(sequenceAudioRates, audioRate) {
+		return nil, fmt.Errorf("invalid audio rate '%s' (must be one of: %s)", audioRate, strings.Join(sequenceAudioRates, ", "))
+	}
+
 	var formatConfig Format
 	
 	switch format {
@@ -193,8 +215,8 @@ func GenerateEmptyWithFormat(filename string, format string) (*FCPXML, error) {
 									Duration:    "0s",
 									TCStart:     "0s",
 									TCFormat:    "NDF",
-									AudioLayout: "stereo",
-									AudioRate:   "48k",
+									AudioLayout: audioLayout,
+									AudioRate:   audioRate,
 									Spine: Spine{
 										AssetClips: []AssetClip{},
 									},
@@ -255,13 +277,121 @@ func GenerateEmptyWithFormat(filename string, format string) (*FCPXML, error) {
 	return fcpxml, nil
 }
 
-// WriteToFile marshals the FCPXML struct to a file.
+// StdoutFilename is the output filename that makes WriteToFile write to os.Stdout
+const StdoutFilename = "-"
+
+// RecalculateDurationOnWrite makes WriteTo/WriteToFile raise sequence durations that end before
+// the spine does (see RecalculateSequenceDuration). A too-short duration causes FCP's
+// "Invalid edit with no respective media" error.
+var RecalculateDurationOnWrite = true
+
+// WriteToFile marshals the FCPXML struct to a file, or to os.Stdout when filename is "-".
+// A filename ending in .fcpxmld is written as a bundle directory (see WriteBundle).
 //
 // 🚨 CLAUDE.md Rule: NO XML STRING TEMPLATES → USE xml.MarshalIndent() function
 // - After writing, VALIDATE with: xmllint --dtdvalid FCPXMLv1_13.dtd filename
 // - Before commits, CHECK with: ValidateClaudeCompliance() function
 // WriteToFile writes FCPXML to file using the new validation-first architecture
 func WriteToFile(fcpxml *FCPXML, filename string) error {
+	if filename == StdoutFilename {
+		return WriteTo(fcpxml, os.Stdout)
+	}
+	if IsBundlePath(filename) {
+		return WriteBundle(fcpxml, filename)
+	}
+
+	// Marshal before creating the file so a validation failure leaves no partial output
+	var buf bytes.Buffer
+	if err := WriteTo(fcpxml, &buf); err != nil {
+		return err
+	}
+
+	err := os.WriteFile(filename, buf.Bytes(), 0644)
+	if err != nil {
+		return fmt.Errorf("failed to write file: %v", err)
+	}
+
+	return nil
+}
+
+// WriteOptions controls what WriteToFileSafe does when the output file already exists
+type WriteOptions struct {
+	Force  bool // Overwrite the existing file
+	Backup bool // Copy the existing file to a timestamped .bak (never reusing a name) before overwriting
+}
+
+// WriteToFileSafe is WriteToFile with an overwrite guard: an existing file is refused unless
+// opts.Force or opts.Backup is set. Library callers that want a plain overwrite keep using WriteToFile.
+func WriteToFileSafe(fcpxml *FCPXML, filename string, opts WriteOptions) error {
+	if err := PrepareOverwrite(filename, opts); err != nil {
+		return err
+	}
+	return WriteToFile(fcpxml, filename)
+}
+
+// PrepareOverwrite applies the WriteOptions guard to filename before something writes it:
+// nothing to do for new files or stdout, an error without Force/Backup, or a backup copy with Backup.
+func PrepareOverwrite(filename string, opts WriteOptions) error {
+	if filename == StdoutFilename {
+		return nil
+	}
+	// A bundle is overwritten by replacing its Info.fcpxml, so that is the file to guard
+	if IsBundlePath(filename) {
+		filename = bundleInfoPath(filename)
+	}
+
+	existing, err := os.ReadFile(filename)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read existing file: %v", err)
+	}
+
+	if opts.Backup {
+		return writeBackup(filename, existing)
+	}
+
+	if !opts.Force {
+		return fmt.Errorf("%s already exists (use --force to overwrite or --backup to keep a copy)", filename)
+	}
+
+	return nil
+}
+
+// writeBackup copies data to filename.<timestamp>.bak. Two writes in the same millisecond (e.g.
+// a batch) get -1, -2, ... suffixes: the file is created exclusively, so no backup is replaced.
+func writeBackup(filename string, data []byte) error {
+	stamp := fmt.Sprintf("%s.%s", filename, time.Now().Format("20060102-150405.000"))
+	for n := 0; ; n++ {
+		backupPath := stamp + ".bak"
+		if n > 0 {
+			backupPath = fmt.Sprintf("%s-%d.bak", stamp, n)
+		}
+		file, err := os.OpenFile(backupPath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+		if os.IsExist(err) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to write backup: %v", err)
+		}
+		_, err = file.Write(data)
+		if closeErr := file.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return fmt.Errorf("failed to write backup: %v", err)
+		}
+		return nil
+	}
+}
+
+// WriteTo validates and marshals the FCPXML (XML declaration, DOCTYPE and body) to w
+func WriteTo(fcpxml *FCPXML, w io.Writer) error {
+	if RecalculateDurationOnWrite {
+		RecalculateSequenceDuration(fcpxml)
+	}
+
 	// Use the validation-first marshaling from Step 17
 	output, err := fcpxml.ValidateAndMarshal()
 	if err != nil {
@@ -273,11 +403,11 @@ func WriteToFile(fcpxml *FCPXML, filename string) error {
 
 `
 
-	fullXML := xmlHeader + string(output)
-
-	err = os.WriteFile(filename, []byte(fullXML), 0644)
-	if err != nil {
-		return fmt.Errorf("failed to write file: %v", err)
+	if _, err := io.WriteString(w, xmlHeader); err != nil {
+		return fmt.Errorf("failed to write XML header: %v", err)
+	}
+	if _, err := w.Write(output); err != nil {
+		return fmt.Errorf("failed to write FCPXML: %v", err)
 	}
 
 	return nil
@@ -140,6 +140,8 @@ func createUniqueMediaCopy(originalPath, prefix string) (string, error) {
 
 	_, err = io.Copy(destFile, sourceFile)
 	if err != nil {
+		destFile.Close()
+		os.Remove(uniquePath)
 		return originalPath, fmt.Errorf("failed to copy file contents: %v", err)
 	}
 
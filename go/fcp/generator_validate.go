@@ -11,12 +11,58 @@ import (
 	"strings"
 )
 
+// fictionalEffectUIDs are effect UIDs that don't exist in FCP; importing them crashes or fails
+var fictionalEffectUIDs = map[string]bool{
+	"FFParticleSystem": true,
+	"FFReplicator":     true,
+	"FFGravity":        true,
+	"FFWind":           true,
+	"FFEmitter":        true,
+	"FFAttractor":      true,
+	"FFMotion":         true,
+	"FFTransform":      true,
+	"FFColorize":       true,
+	"FFTurbulence":     true,
+	"FFWave":           true,
+	"FFSpiral":         true,
+	"FFAnimatedText":   true,
+	"FFDistortion":     true,
+}
+
+// ValidateEffectUID rejects the fictionalEffectUIDs, for callers outside this package that create
+// their own effect resources
+func ValidateEffectUID(uid string) error {
+	if fictionalEffectUIDs[uid] {
+		return fmt.Errorf("effect UID '%s' is not a built-in FCP effect", uid)
+	}
+	return nil
+}
+
+// ValidationWarningPrefix starts ValidateClaudeCompliance entries that flag a likely mistake
+// without making the FCPXML invalid, e.g. a connected clip hanging off the end of its parent
+const ValidationWarningPrefix = "Warning: "
+
+// SplitValidationWarnings separates ValidateClaudeCompliance entries into violations, which must
+// stop a write, and warnings, which are only reported
+func SplitValidationWarnings(entries []string) (violations, warnings []string) {
+	for _, entry := range entries {
+		if strings.HasPrefix(entry, ValidationWarningPrefix) {
+			warnings = append(warnings, entry)
+		} else {
+			violations = append(violations, entry)
+		}
+	}
+	return violations, warnings
+}
+
 // ValidateClaudeCompliance performs automated checks for CLAUDE.md rule compliance.
 //
 // 🚨 CLAUDE.md Validation - Run this before any commit!
 // This function helps catch violations of critical rules in CLAUDE.md
+// Entries starting with ValidationWarningPrefix are warnings (see SplitValidationWarnings).
 func ValidateClaudeCompliance(fcpxml *FCPXML) []string {
-	var violations []string
+	// Out-of-order spine slices still marshal in time order, so they only warrant a warning
+	violations := unsortedSpineWarnings(fcpxml)
 
 	idMap := make(map[string]bool)
 
@@ -118,32 +164,28 @@ func ValidateClaudeCompliance(fcpxml *FCPXML) []string {
 		}
 	}
 
-	fictionalEffectUIDs := map[string]bool{
-		"FFParticleSystem": true,
-		"FFReplicator":     true,
-		"FFGravity":        true,
-		"FFWind":           true,
-		"FFEmitter":        true,
-		"FFAttractor":      true,
-		"FFMotion":         true,
-		"FFTransform":      true,
-		"FFColorize":       true,
-		"FFTurbulence":     true,
-		"FFWave":           true,
-		"FFSpiral":         true,
-		"FFAnimatedText":   true,
-		"FFDistortion":     true,
-	}
-
 	for _, effect := range fcpxml.Resources.Effects {
 		if fictionalEffectUIDs[effect.UID] {
 			violations = append(violations, fmt.Sprintf("Fictional effect UID '%s' detected in effect '%s' - use built-in adjust-* elements instead", effect.UID, effect.Name))
 		}
 	}
 
-	validateKeyframes := func(keyframes []Keyframe, location string) {
+	validateKeyframes := func(paramName string, keyframes []Keyframe, location string) {
+		paramType := ParseKeyframeParameterType(paramName)
 		for i, keyframe := range keyframes {
 
+			// FCP rejects or ignores interpolation attributes on these params (see initializeDefaultRules)
+			switch paramType {
+			case KeyframeParameterPosition:
+				if keyframe.Interp != "" || keyframe.Curve != "" {
+					violations = append(violations, fmt.Sprintf("Position keyframe with interp/curve attribute at %s[%d] - position keyframes must have NO attributes", location, i))
+				}
+			case KeyframeParameterScale, KeyframeParameterRotation, KeyframeParameterAnchor:
+				if keyframe.Interp != "" {
+					violations = append(violations, fmt.Sprintf("Interp attribute on %s keyframe at %s[%d] - only curve is allowed", paramName, location, i))
+				}
+			}
+
 			if keyframe.Interp != "" {
 				validInterps := map[string]bool{"linear": true, "ease": true, "easeIn": true, "easeOut": true, "easeInOut": true}
 				if !validInterps[keyframe.Interp] {
@@ -169,7 +211,7 @@ func ValidateClaudeCompliance(fcpxml *FCPXML) []string {
 					if clip.AdjustTransform != nil {
 						for _, param := range clip.AdjustTransform.Params {
 							if param.KeyframeAnimation != nil {
-								validateKeyframes(param.KeyframeAnimation.Keyframes, fmt.Sprintf("AssetClip '%s' AdjustTransform param '%s'", clip.Name, param.Name))
+								validateKeyframes(param.Name, param.KeyframeAnimation.Keyframes, fmt.Sprintf("AssetClip '%s' AdjustTransform param '%s'", clip.Name, param.Name))
 							}
 						}
 					}
@@ -177,7 +219,7 @@ func ValidateClaudeCompliance(fcpxml *FCPXML) []string {
 					for _, filter := range clip.FilterVideos {
 						for _, param := range filter.Params {
 							if param.KeyframeAnimation != nil {
-								validateKeyframes(param.KeyframeAnimation.Keyframes, fmt.Sprintf("AssetClip '%s' FilterVideo '%s' param '%s'", clip.Name, filter.Name, param.Name))
+								validateKeyframes(param.Name, param.KeyframeAnimation.Keyframes, fmt.Sprintf("AssetClip '%s' FilterVideo '%s' param '%s'", clip.Name, filter.Name, param.Name))
 							}
 						}
 					}
@@ -187,7 +229,7 @@ func ValidateClaudeCompliance(fcpxml *FCPXML) []string {
 					if video.AdjustTransform != nil {
 						for _, param := range video.AdjustTransform.Params {
 							if param.KeyframeAnimation != nil {
-								validateKeyframes(param.KeyframeAnimation.Keyframes, fmt.Sprintf("Video '%s' AdjustTransform param '%s'", video.Name, param.Name))
+								validateKeyframes(param.Name, param.KeyframeAnimation.Keyframes, fmt.Sprintf("Video '%s' AdjustTransform param '%s'", video.Name, param.Name))
 							}
 						}
 					}
@@ -196,7 +238,7 @@ func ValidateClaudeCompliance(fcpxml *FCPXML) []string {
 				for _, title := range sequence.Spine.Titles {
 					for _, param := range title.Params {
 						if param.KeyframeAnimation != nil {
-							validateKeyframes(param.KeyframeAnimation.Keyframes, fmt.Sprintf("Title '%s' param '%s'", title.Name, param.Name))
+							validateKeyframes(param.Name, param.KeyframeAnimation.Keyframes, fmt.Sprintf("Title '%s' param '%s'", title.Name, param.Name))
 						}
 					}
 				}
@@ -214,8 +256,10 @@ func ValidateClaudeCompliance(fcpxml *FCPXML) []string {
 	for _, effect := range fcpxml.Resources.Effects {
 		resourceIDs[effect.ID] = true
 	}
+	mediaIDs := make(map[string]bool)
 	for _, media := range fcpxml.Resources.Media {
 		resourceIDs[media.ID] = true
+		mediaIDs[media.ID] = true
 	}
 
 	checkRef := func(ref, elementType string) {
@@ -243,6 +287,42 @@ func ValidateClaudeCompliance(fcpxml *FCPXML) []string {
 				for _, title := range sequence.Spine.Titles {
 					checkRef(title.Ref, fmt.Sprintf("Title '%s'", title.Name))
 				}
+
+				for _, transition := range sequence.Spine.Transitions {
+					for _, filter := range transition.FilterVideos {
+						checkRef(filter.Ref, fmt.Sprintf("FilterVideo '%s' in Transition '%s'", filter.Name, transition.Name))
+					}
+				}
+
+				for _, refClip := range sequence.Spine.RefClips {
+					if !mediaIDs[refClip.Ref] {
+						violations = append(violations, fmt.Sprintf("RefClip '%s' references '%s', which is not a media resource - ref-clips must point at a compound clip <media>", refClip.Name, refClip.Ref))
+					}
+				}
+			}
+		}
+	}
+
+	// Connected titles/videos must stay inside their parent clip's span (hand-computed offsets drift)
+	for _, event := range fcpxml.Library.Events {
+		for _, project := range event.Projects {
+			for i := range project.Sequences {
+				violations = append(violations, nestedSpanViolations(&project.Sequences[i])...)
+			}
+		}
+	}
+
+	// 🚨 CRITICAL: A sequence format must point at a defined format (FixSequenceFormat repairs this)
+	formatIDs := make(map[string]bool)
+	for _, format := range fcpxml.Resources.Formats {
+		formatIDs[format.ID] = true
+	}
+	for _, event := range fcpxml.Library.Events {
+		for _, project := range event.Projects {
+			for i, sequence := range project.Sequences {
+				if sequence.Format != "" && !formatIDs[sequence.Format] {
+					violations = append(violations, fmt.Sprintf("Missing sequence format: sequence[%d] in project '%s' references undefined format '%s' - FCP cannot open a sequence without a format", i, project.Name, sequence.Format))
+				}
 			}
 		}
 	}
@@ -392,16 +472,38 @@ func AddImageWithSlideAndFormat(fcpxml *FCPXML, imagePath string, durationSecond
 }
 
 func AddImageWithSlideAndFormatIndex(fcpxml *FCPXML, imagePath string, durationSeconds float64, withSlide bool, format string, imageIndex int) error {
+	return addImageWithOptions(fcpxml, imagePath, durationSeconds, format, imageIndex, ImageOptions{Slide: withSlide})
+}
+
+// AddImageWithOptions is AddImage with per-call settings such as auto-orientation (see ImageOptions)
+func AddImageWithOptions(fcpxml *FCPXML, imagePath string, durationSeconds float64, opts ImageOptions) error {
+	return addImageWithOptions(fcpxml, imagePath, durationSeconds, "horizontal", 0, opts)
+}
+
+func addImageWithOptions(fcpxml *FCPXML, imagePath string, durationSeconds float64, format string, imageIndex int, opts ImageOptions) error {
+
+	if err := ValidateImageElementMode(opts.Element); err != nil {
+		return err
+	}
 
 	if !isImageFile(imagePath) {
 		return fmt.Errorf("file is not a supported image format (PNG, JPG, JPEG): %s", imagePath)
 	}
 
+	// Phone photos stored sideways (EXIF Orientation) are swapped for an upright copy
+	if opts.AutoOrient {
+		uprightPath, err := orientImageFile(imagePath)
+		if err != nil {
+			return fmt.Errorf("failed to auto-rotate image: %v", err)
+		}
+		imagePath = uprightPath
+	}
+
 	registry := NewResourceRegistry(fcpxml)
 
 	if asset, exists := registry.GetOrCreateAsset(imagePath); exists {
 
-		return addImageAssetClipToSpineWithFormatIndex(fcpxml, asset, durationSeconds, withSlide, format, imageIndex)
+		return addImageAssetClipToSpineWithFormatIndex(fcpxml, asset, durationSeconds, format, imageIndex, opts)
 	}
 
 	tx := NewTransaction(registry)
@@ -425,7 +527,7 @@ func AddImageWithSlideAndFormatIndex(fcpxml *FCPXML, imagePath string, durationS
 
 	frameDuration := ConvertSecondsToFCPDuration(durationSeconds)
 
-	// Set format dimensions based on format type
+	// Undecodable images fall back to the sequence size, or the format type's frame if there is none
 	var width, height string
 	switch format {
 	case "vertical":
@@ -435,6 +537,12 @@ func AddImageWithSlideAndFormatIndex(fcpxml *FCPXML, imagePath string, durationS
 	default:
 		width, height = "1280", "720"
 	}
+	if sequence, err := firstSequence(fcpxml); err == nil {
+		if frameWidth, frameHeight, err := sequenceFrameSize(fcpxml, sequence); err == nil {
+			width, height = strconv.Itoa(int(frameWidth)), strconv.Itoa(int(frameHeight))
+		}
+	}
+	width, height = imageFormatSize(absPath, width, height)
 
 	_, err = tx.CreateFormat(formatID, "FFVideoFormatRateUndefined", width, height, "1-13-1")
 	if err != nil {
@@ -453,7 +561,7 @@ func AddImageWithSlideAndFormatIndex(fcpxml *FCPXML, imagePath string, durationS
 		return fmt.Errorf("failed to commit transaction: %v", err)
 	}
 
-	return addImageAssetClipToSpineWithFormatIndex(fcpxml, asset, durationSeconds, withSlide, format, imageIndex)
+	return addImageAssetClipToSpineWithFormatIndex(fcpxml, asset, durationSeconds, format, imageIndex, opts)
 }
 
 // addImageAssetClipToSpine adds an image Video element to the sequence spine
@@ -475,11 +583,11 @@ func addImageAssetClipToSpineWithSlide(fcpxml *FCPXML, asset *Asset, durationSec
 
 // addImageAssetClipToSpineWithFormat adds an image Video element to the sequence spine with format-aware scaling
 func addImageAssetClipToSpineWithFormat(fcpxml *FCPXML, asset *Asset, durationSeconds float64, withSlide bool, format string) error {
-	return addImageAssetClipToSpineWithFormatIndex(fcpxml, asset, durationSeconds, withSlide, format, 0)
+	return addImageAssetClipToSpineWithFormatIndex(fcpxml, asset, durationSeconds, format, 0, ImageOptions{Slide: withSlide})
 }
 
 // addImageAssetClipToSpineWithFormatIndex adds an image Video element to the sequence spine with format-aware scaling and alternating Ken Burns direction
-func addImageAssetClipToSpineWithFormatIndex(fcpxml *FCPXML, asset *Asset, durationSeconds float64, withSlide bool, format string, imageIndex int) error {
+func addImageAssetClipToSpineWithFormatIndex(fcpxml *FCPXML, asset *Asset, durationSeconds float64, format string, imageIndex int, opts ImageOptions) error {
 
 	if len(fcpxml.Library.Events) > 0 && len(fcpxml.Library.Events[0].Projects) > 0 && len(fcpxml.Library.Events[0].Projects[0].Sequences) > 0 {
 		sequence := &fcpxml.Library.Events[0].Projects[0].Sequences[0]
@@ -488,22 +596,30 @@ func addImageAssetClipToSpineWithFormatIndex(fcpxml *FCPXML, asset *Asset, durat
 
 		clipDuration := ConvertSecondsToFCPDuration(durationSeconds)
 
+		// Stills start an hour into their local time, on the sequence's own frame grid
+		imageStart, rate := sequenceImageStart(fcpxml, sequence, opts.StartSeconds)
+
 		video := Video{
 			Ref:      asset.ID,
 			Offset:   currentTimelineDuration,
 			Name:     asset.Name,
-			Start:    "86399313/24000s",
+			Start:    imageStart,
 			Duration: clipDuration,
 		}
 
-		if withSlide {
+		if opts.Slide {
 			// Use enhanced Ken Burns with both crop and transform for vertical format
 			if format == "vertical" {
-				adjustCrop, adjustTransform := createEnhancedKenBurnsWithFormatIndex(currentTimelineDuration, durationSeconds, format, imageIndex)
+				adjustCrop, adjustTransform := createEnhancedKenBurnsWithFormatIndex(currentTimelineDuration, durationSeconds, format, imageIndex, imageStart, rate)
 				video.AdjustCrop = adjustCrop
 				video.AdjustTransform = adjustTransform
 			} else {
-				video.AdjustTransform = createKenBurnsAnimationWithFormatIndex(currentTimelineDuration, durationSeconds, format, imageIndex)
+				video.AdjustTransform = createKenBurnsAnimationWithFormatIndex(currentTimelineDuration, durationSeconds, format, imageIndex, imageStart, rate)
+				// The stock zoom/pan assumes a landscape image; pull it in where this image's real
+				// aspect would reveal the frame edge (images whose size can't be read are left as is)
+				if fit, err := imageKenBurnsFit(fcpxml, sequence, asset.ID); err == nil {
+					clampKenBurnsTransform(video.AdjustTransform, fit)
+				}
 			}
 		} else {
 			// Add zoom scaling for vertical format to fill frame with no empty space
@@ -514,7 +630,12 @@ func addImageAssetClipToSpineWithFormatIndex(fcpxml *FCPXML, asset *Asset, durat
 			}
 		}
 
-		sequence.Spine.Videos = append(sequence.Spine.Videos, video)
+		// Opt-in asset-clip mode (see ImageElementAssetClip for the crash risk)
+		if opts.Element == ImageElementAssetClip {
+			sequence.Spine.AssetClips = append(sequence.Spine.AssetClips, imageAssetClip(video))
+		} else {
+			sequence.Spine.Videos = append(sequence.Spine.Videos, video)
+		}
 
 		newTimelineDuration := addDurations(currentTimelineDuration, clipDuration)
 		sequence.Duration = newTimelineDuration
@@ -529,7 +650,11 @@ func addImageAssetClipToSpineWithFormatIndex(fcpxml *FCPXML, asset *Asset, durat
 // - Reads FCPXML file and unmarshals into struct representation
 // - Maintains all existing resources and timeline structure
 // - Use this before AddVideo/AddImage to preserve existing content
+// - An .fcpxmld bundle is read from its Info.fcpxml
 func ReadFromFile(filename string) (*FCPXML, error) {
+	if IsBundlePath(filename) {
+		filename = bundleInfoPath(filename)
+	}
 
 	data, err := os.ReadFile(filename)
 	if err != nil {
@@ -0,0 +1,69 @@
+package fcp
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// SourceURL returns the page crediting the image: the Pixabay photo page for Pixabay downloads,
+// or the Lorem Picsum site for placeholder images
+func (a ImageAttribution) SourceURL() string {
+	if a.Source == "pixabay" && a.PixabayID != 0 {
+		return fmt.Sprintf("https://pixabay.com/photos/id-%d/", a.PixabayID)
+	}
+	return "https://picsum.photos/"
+}
+
+// photographerCredit formats the author with their Pixabay profile, matching the story attributions
+func (a ImageAttribution) photographerCredit() string {
+	if a.Author == "" {
+		return "Unknown"
+	}
+	if a.UserID != 0 {
+		return fmt.Sprintf("%s (https://pixabay.com/users/%s-%d/)", a.Author, strings.ToLower(a.Author), a.UserID)
+	}
+	return a.Author
+}
+
+// attributionsFor keeps the attributions of the files in used, in used's order
+func attributionsFor(attributions []ImageAttribution, used []string) []ImageAttribution {
+	byPath := make(map[string]ImageAttribution, len(attributions))
+	for _, attribution := range attributions {
+		byPath[attribution.FilePath] = attribution
+	}
+	var kept []ImageAttribution
+	for _, path := range used {
+		if attribution, ok := byPath[path]; ok {
+			kept = append(kept, attribution)
+		}
+	}
+	return kept
+}
+
+// WriteAttributionCredits writes a plain-text credits file with one entry per downloaded image
+// (file, photographer and source URL) to satisfy the image sites' attribution requirements
+func WriteAttributionCredits(creditsPath string, attributions []ImageAttribution) error {
+	var builder strings.Builder
+	builder.WriteString("Image credits\n")
+	builder.WriteString("=============\n")
+
+	for _, attribution := range attributions {
+		builder.WriteString("\n")
+		builder.WriteString(fmt.Sprintf("File: %s\n", filepath.Base(attribution.FilePath)))
+		builder.WriteString(fmt.Sprintf("Photographer: %s\n", attribution.photographerCredit()))
+		builder.WriteString(fmt.Sprintf("Source: %s\n", attribution.SourceURL()))
+	}
+
+	if dir := filepath.Dir(creditsPath); dir != "." {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return fmt.Errorf("failed to create credits directory: %v", err)
+		}
+	}
+	if err := os.WriteFile(creditsPath, []byte(builder.String()), 0644); err != nil {
+		return fmt.Errorf("failed to write credits file: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package fcp
+
+import "math/rand"
+
+// imageBag deals images shuffle-bag style: every image once per round in random order, then a
+// reshuffle. A round never starts with the image that ended the previous one, so no image is
+// drawn twice in a row while the bag holds at least two distinct paths.
+type imageBag struct {
+	paths []string
+	order []int
+	next  int
+	rng   *rand.Rand
+}
+
+// newImageBag creates a bag over the distinct paths. A nil rng draws its seed from the global
+// source, so generators seeded with rand.Seed stay reproducible.
+func newImageBag(paths []string, rng *rand.Rand) *imageBag {
+	if rng == nil {
+		rng = rand.New(rand.NewSource(rand.Int63()))
+	}
+	seen := map[string]bool{}
+	var distinct []string
+	for _, path := range paths {
+		if !seen[path] {
+			seen[path] = true
+			distinct = append(distinct, path)
+		}
+	}
+	return &imageBag{paths: distinct, rng: rng}
+}
+
+// Next returns the next image, or "" when the bag is empty
+func (b *imageBag) Next() string {
+	if len(b.paths) == 0 {
+		return ""
+	}
+	if b.next >= len(b.order) {
+		b.reshuffle()
+	}
+	path := b.paths[b.order[b.next]]
+	b.next++
+	return path
+}
+
+// reshuffle starts a new round, moving the previous round's last image off the front
+func (b *imageBag) reshuffle() {
+	last := -1
+	if len(b.order) > 0 {
+		last = b.order[len(b.order)-1]
+	}
+	b.order = b.rng.Perm(len(b.paths))
+	b.next = 0
+	if len(b.order) > 1 && b.order[0] == last {
+		swap := 1 + b.rng.Intn(len(b.order)-1)
+		b.order[0], b.order[swap] = b.order[swap], b.order[0]
+	}
+}
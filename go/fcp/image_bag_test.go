@@ -0,0 +1,47 @@
+package fcp
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// TestImageBag tests that draws never repeat back to back and every image comes up each round
+func TestImageBag(t *testing.T) {
+	paths := []string{"a.png", "b.png", "c.png"}
+	bag := newImageBag(paths, rand.New(rand.NewSource(42)))
+
+	var draws []string
+	for i := 0; i < 100; i++ {
+		draws = append(draws, bag.Next())
+	}
+	for i := 1; i < len(draws); i++ {
+		if draws[i] == draws[i-1] {
+			t.Fatalf("Draw %d repeats %s", i, draws[i])
+		}
+	}
+	for round := 0; round+3 <= len(draws); round += 3 {
+		seen := map[string]bool{}
+		for _, path := range draws[round : round+3] {
+			seen[path] = true
+		}
+		if len(seen) != 3 {
+			t.Errorf("Round starting at draw %d is not a full shuffle: %v", round, draws[round:round+3])
+		}
+	}
+
+	// The same seed deals the same sequence
+	again := newImageBag(paths, rand.New(rand.NewSource(42)))
+	for i, want := range draws {
+		if got := again.Next(); got != want {
+			t.Fatalf("Draw %d: expected %s with the same seed, got %s", i, want, got)
+		}
+	}
+
+	if got := newImageBag(nil, nil).Next(); got != "" {
+		t.Errorf("Expected empty bag to return \"\", got %s", got)
+	}
+	single := newImageBag([]string{"a.png", "a.png"}, nil)
+	if single.Next() != "a.png" || single.Next() != "a.png" {
+		t.Error("Expected a single-image bag to keep returning its image")
+	}
+}
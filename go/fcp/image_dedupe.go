@@ -0,0 +1,73 @@
+package fcp
+
+import (
+	"fmt"
+	"os"
+)
+
+// DuplicateImageWindow is how many of the preceding images a repeat is looked for in; a copy
+// further back than this reads as a deliberate callback rather than an accident
+const DuplicateImageWindow = 3
+
+// DuplicateImage is an image whose bytes match one shown shortly before it in the slideshow
+type DuplicateImage struct {
+	Index    int    // Position of the repeat in the image list
+	Path     string // The repeat
+	Original string // The earlier, identical image
+	Distance int    // How many images earlier the original is (1 is back to back)
+}
+
+// FindDuplicateImages returns every image that is byte-identical to one of the DuplicateImageWindow
+// images before it. With skip, a repeat is treated as removed, so distances count only the images
+// that remain. This is about what the viewer sees twice, not shared assets: copies under different
+// names are caught. Files that can't be read are never reported.
+func FindDuplicateImages(paths []string, skip bool) []DuplicateImage {
+	var duplicates []DuplicateImage
+	var shown []int // indices of the images that stay in the slideshow
+	sums := make([]string, len(paths))
+	for i, path := range paths {
+		if sum, err := fileMD5(path); err == nil {
+			sums[i] = sum
+		}
+
+		duplicate := false
+		for back := 1; back <= DuplicateImageWindow && back <= len(shown); back++ {
+			earlier := shown[len(shown)-back]
+			if sums[i] != "" && sums[earlier] == sums[i] {
+				duplicates = append(duplicates, DuplicateImage{Index: i, Path: path, Original: paths[earlier], Distance: back})
+				duplicate = true
+				break
+			}
+		}
+		if !duplicate || !skip {
+			shown = append(shown, i)
+		}
+	}
+	return duplicates
+}
+
+// DedupeImages warns about each nearby repeat (see FindDuplicateImages) and, when skip is set,
+// leaves the repeats out; the remaining images keep their order
+func DedupeImages(paths []string, skip bool) []string {
+	duplicates := FindDuplicateImages(paths, skip)
+	dropped := map[int]bool{}
+	for _, duplicate := range duplicates {
+		if skip {
+			fmt.Fprintf(os.Stderr, "Warning: skipping %s: identical to %s, %d image(s) earlier\n", duplicate.Path, duplicate.Original, duplicate.Distance)
+			dropped[duplicate.Index] = true
+		} else {
+			fmt.Fprintf(os.Stderr, "Warning: %s is identical to %s, %d image(s) earlier (--dedupe skips it)\n", duplicate.Path, duplicate.Original, duplicate.Distance)
+		}
+	}
+	if len(dropped) == 0 {
+		return paths
+	}
+
+	kept := make([]string, 0, len(paths)-len(dropped))
+	for i, path := range paths {
+		if !dropped[i] {
+			kept = append(kept, path)
+		}
+	}
+	return kept
+}
@@ -0,0 +1,42 @@
+package fcp
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestDedupeImages tests that a byte-identical copy right after its original is reported, and dropped with skip
+func TestDedupeImages(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to create test image: %v", err)
+		}
+		return path
+	}
+	beach := write("beach.png", "beach pixels")
+	copied := write("beach copy.png", "beach pixels")
+	forest := write("forest.png", "forest pixels")
+	paths := []string{beach, copied, forest}
+
+	duplicates := FindDuplicateImages(paths, false)
+	if len(duplicates) != 1 || duplicates[0].Path != copied || duplicates[0].Original != beach || duplicates[0].Distance != 1 {
+		t.Fatalf("Expected the copy reported as a back-to-back repeat of beach.png, got %+v", duplicates)
+	}
+
+	if kept := DedupeImages(paths, false); len(kept) != 3 {
+		t.Errorf("Expected warnings only without skip, got %v", kept)
+	}
+	kept := DedupeImages(paths, true)
+	if len(kept) != 2 || kept[0] != beach || kept[1] != forest {
+		t.Errorf("Expected the copy dropped and distinct images kept, got %v", kept)
+	}
+
+	// A copy further back than the window is left alone
+	spaced := []string{beach, forest, write("lake.png", "lake"), write("city.png", "city"), copied}
+	if duplicates := FindDuplicateImages(spaced, false); len(duplicates) != 0 {
+		t.Errorf("Expected no repeat %d images apart, got %+v", len(spaced)-1, duplicates)
+	}
+}
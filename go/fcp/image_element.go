@@ -0,0 +1,63 @@
+package fcp
+
+import "fmt"
+
+// Spine element types for images added by AddImageWithOptions (see ImageOptions.Element)
+//
+// 🚨 CRASH RISK: FCP has crashed importing stills as asset-clips (addAssetClip:toObject:parentFormatID),
+// which is why images are <video> elements everywhere else (samples/png.fcpxml). Only use
+// ImageElementAssetClip when a workflow needs it, and check the import in FCP. Features that animate
+// the image afterwards (fx-static-image effects, Ken Burns edits) look for video elements.
+const (
+	ImageElementVideo     = "video"
+	ImageElementAssetClip = "asset-clip"
+)
+
+// ValidateImageElementMode rejects anything but "video" and "asset-clip"; "" means video
+func ValidateImageElementMode(mode string) error {
+	switch mode {
+	case "", ImageElementVideo, ImageElementAssetClip:
+		return nil
+	default:
+		return fmt.Errorf("unknown image element mode '%s' (use %s or %s)", mode, ImageElementVideo, ImageElementAssetClip)
+	}
+}
+
+// imageAssetClip turns the Video AddImage built for a still into the equivalent asset-clip.
+// Nothing audio-related is set: the clip gets no audioRole, and image assets carry no audio
+// properties, so FCP has no audio to look for.
+func imageAssetClip(video Video) AssetClip {
+	return AssetClip{
+		Ref:             video.Ref,
+		Offset:          video.Offset,
+		Name:            video.Name,
+		Start:           video.Start,
+		Duration:        video.Duration,
+		AdjustCrop:      video.AdjustCrop,
+		AdjustTransform: video.AdjustTransform,
+	}
+}
+
+// addedImage is the spine element of a still: its asset, local start and transform
+type addedImage struct {
+	ref       string
+	start     string
+	transform **AdjustTransform
+}
+
+// lastAddedImage returns the image AddImageWithOptions just appended: the last spine video, or
+// the last asset-clip when opts asked for one
+func lastAddedImage(sequence *Sequence, opts ImageOptions) (addedImage, error) {
+	if opts.Element == ImageElementAssetClip {
+		if len(sequence.Spine.AssetClips) == 0 {
+			return addedImage{}, fmt.Errorf("image was not added to the timeline")
+		}
+		clip := &sequence.Spine.AssetClips[len(sequence.Spine.AssetClips)-1]
+		return addedImage{clip.Ref, clip.Start, &clip.AdjustTransform}, nil
+	}
+	if len(sequence.Spine.Videos) == 0 {
+		return addedImage{}, fmt.Errorf("image was not added to the timeline")
+	}
+	video := &sequence.Spine.Videos[len(sequence.Spine.Videos)-1]
+	return addedImage{video.Ref, video.Start, &video.AdjustTransform}, nil
+}
@@ -0,0 +1,82 @@
+package fcp
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+// TestImageElementMode validates that images land as <video> by default and as <asset-clip>
+// in asset-clip mode, without audio properties either way
+func TestImageElementMode(t *testing.T) {
+	imagePath := filepath.Join(t.TempDir(), "still.png")
+	writeTestPNG(t, imagePath, 64, 36)
+
+	for _, mode := range []string{ImageElementVideo, ImageElementAssetClip} {
+		fcpxml, err := GenerateEmpty("")
+		if err != nil {
+			t.Fatalf("GenerateEmpty failed: %v", err)
+		}
+		if err := AddImageWithOptions(fcpxml, imagePath, 3, ImageOptions{Element: mode}); err != nil {
+			t.Fatalf("AddImage in %s mode failed: %v", mode, err)
+		}
+
+		spine := fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine
+		switch mode {
+		case ImageElementVideo:
+			if len(spine.Videos) != 1 || len(spine.AssetClips) != 0 {
+				t.Errorf("Expected 1 video and no asset-clips in video mode, got %d and %d", len(spine.Videos), len(spine.AssetClips))
+			}
+		case ImageElementAssetClip:
+			if len(spine.AssetClips) != 1 || len(spine.Videos) != 0 {
+				t.Fatalf("Expected 1 asset-clip and no videos in asset-clip mode, got %d and %d", len(spine.AssetClips), len(spine.Videos))
+			}
+			clip := spine.AssetClips[0]
+			if clip.AudioRole != "" || clip.AdjustVolume != nil {
+				t.Errorf("Image asset-clip must not carry audio settings, got role '%s'", clip.AudioRole)
+			}
+			if clip.Duration != ConvertSecondsToFCPDuration(3) || clip.Offset != "0s" {
+				t.Errorf("Unexpected asset-clip timing: offset %s duration %s", clip.Offset, clip.Duration)
+			}
+		}
+
+		asset := fcpxml.Resources.Assets[0]
+		if asset.HasAudio != "" || asset.AudioSources != "" || asset.AudioChannels != "" {
+			t.Errorf("%s mode: image asset must have no audio properties, got %+v", mode, asset)
+		}
+	}
+
+	fcpxml, _ := GenerateEmpty("")
+	if err := AddImageWithOptions(fcpxml, imagePath, 3, ImageOptions{Element: "clip"}); err == nil {
+		t.Errorf("Expected an error for an unknown image element mode")
+	}
+}
+
+// TestImageElementAssetClipPlacement validates that slides and static placement land on the
+// asset-clip when the image is written as one
+func TestImageElementAssetClipPlacement(t *testing.T) {
+	imagePath := filepath.Join(t.TempDir(), "still.png")
+	writeTestPNG(t, imagePath, 64, 36)
+	opts := ImageOptions{Element: ImageElementAssetClip}
+
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("GenerateEmpty failed: %v", err)
+	}
+	if err := AddImageWithStaticTransform(fcpxml, imagePath, 3, "10 -20", "2", opts); err != nil {
+		t.Fatalf("AddImageWithStaticTransform failed: %v", err)
+	}
+	if err := AddImageSlideFrom(fcpxml, imagePath, 3, SlideFromLeft, 0, opts); err != nil {
+		t.Fatalf("AddImageSlideFrom failed: %v", err)
+	}
+
+	clips := fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine.AssetClips
+	if len(clips) != 2 {
+		t.Fatalf("Expected 2 asset-clips, got %d", len(clips))
+	}
+	if transform := clips[0].AdjustTransform; transform == nil || transform.Scale != "2 2" || transform.Position != "10 -20" {
+		t.Errorf("Expected the static placement on the first asset-clip, got %+v", transform)
+	}
+	if transform := clips[1].AdjustTransform; transform == nil || len(transform.Params) != 1 || transform.Params[0].Name != "position" {
+		t.Errorf("Expected the slide's position keyframes on the second asset-clip, got %+v", transform)
+	}
+}
@@ -0,0 +1,228 @@
+package fcp
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// DefaultMaxImageEdge is the longest edge (in pixels) images are downscaled to by OptimizeImages
+const DefaultMaxImageEdge = 2048
+
+// optimizedImageDir holds resized copies next to the originals so later runs reuse them
+const optimizedImageDir = ".cutlass_optimized"
+
+// ImageOptimizeResult reports what OptimizeImages did
+type ImageOptimizeResult struct {
+	Paths      []string // Same order as the input; resized images point at their cached copy
+	Resized    int      // Number of images replaced by a smaller copy
+	BytesSaved int64    // Total source bytes minus total cached copy bytes for resized images
+}
+
+// OptimizeImages downscales every image whose longest edge exceeds maxEdge into a cached copy
+// (<dir>/.cutlass_optimized/<name>_<maxEdge>.<ext>) and returns the paths to reference instead.
+// Aspect ratio and file format are preserved; images already within maxEdge are returned unchanged.
+// Resizing uses sips (macOS) or ffmpeg when installed, otherwise a built-in area-average scaler.
+func OptimizeImages(imagePaths []string, maxEdge int) (*ImageOptimizeResult, error) {
+	if maxEdge <= 0 {
+		return nil, fmt.Errorf("max edge must be greater than 0, got %d", maxEdge)
+	}
+
+	result := &ImageOptimizeResult{}
+	for _, imagePath := range imagePaths {
+		optimized, saved, err := optimizeImage(imagePath, maxEdge)
+		if err != nil {
+			return nil, fmt.Errorf("failed to optimize %s: %v", imagePath, err)
+		}
+		if optimized != imagePath {
+			result.Resized++
+			result.BytesSaved += saved
+		}
+		result.Paths = append(result.Paths, optimized)
+	}
+
+	return result, nil
+}
+
+// optimizeImage returns the path to use for one image and the bytes saved by resizing it
+func optimizeImage(imagePath string, maxEdge int) (string, int64, error) {
+	source, err := os.Stat(imagePath)
+	if err != nil {
+		return "", 0, fmt.Errorf("image file does not exist: %s", imagePath)
+	}
+
+	width, height, err := imageDimensions(imagePath)
+	if err != nil {
+		return "", 0, err
+	}
+	if width <= maxEdge && height <= maxEdge {
+		return imagePath, 0, nil
+	}
+
+	newWidth, newHeight := fitWithinEdge(width, height, maxEdge)
+
+	ext := filepath.Ext(imagePath)
+	name := strings.TrimSuffix(filepath.Base(imagePath), ext)
+	cacheDir := filepath.Join(filepath.Dir(imagePath), optimizedImageDir)
+	cachedPath := filepath.Join(cacheDir, fmt.Sprintf("%s_%d%s", name, maxEdge, ext))
+
+	// Reuse a cached copy unless the source changed after it was made
+	cached, err := os.Stat(cachedPath)
+	if err != nil || cached.ModTime().Before(source.ModTime()) {
+		if err := os.MkdirAll(cacheDir, 0755); err != nil {
+			return "", 0, fmt.Errorf("failed to create cache directory: %v", err)
+		}
+		if err := resizeImageFile(imagePath, cachedPath, newWidth, newHeight); err != nil {
+			return "", 0, err
+		}
+		if cached, err = os.Stat(cachedPath); err != nil {
+			return "", 0, fmt.Errorf("resized copy missing: %v", err)
+		}
+	}
+
+	return cachedPath, source.Size() - cached.Size(), nil
+}
+
+// imageDimensions reads width and height from the image header without decoding pixels
+func imageDimensions(imagePath string) (int, int, error) {
+	file, err := os.Open(imagePath)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to open image: %v", err)
+	}
+	defer file.Close()
+
+	config, _, err := image.DecodeConfig(file)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to read image dimensions: %v", err)
+	}
+	return config.Width, config.Height, nil
+}
+
+// imageFormatSize returns the pixel size to record in an image's format resource.
+// The real size is read from the image header so FCP's fit/fill/crop scaling matches
+// the file; images Go cannot decode (e.g. HEIC) fall back to the given frame size.
+func imageFormatSize(imagePath, fallbackWidth, fallbackHeight string) (string, string) {
+	width, height, err := imageDimensions(imagePath)
+	if err != nil || width <= 0 || height <= 0 {
+		return fallbackWidth, fallbackHeight
+	}
+	return strconv.Itoa(width), strconv.Itoa(height)
+}
+
+// fitWithinEdge scales width/height so the longest edge equals maxEdge, preserving aspect ratio
+func fitWithinEdge(width, height, maxEdge int) (int, int) {
+	if width >= height {
+		newHeight := int(float64(height)*float64(maxEdge)/float64(width) + 0.5)
+		if newHeight < 1 {
+			newHeight = 1
+		}
+		return maxEdge, newHeight
+	}
+	newWidth := int(float64(width)*float64(maxEdge)/float64(height) + 0.5)
+	if newWidth < 1 {
+		newWidth = 1
+	}
+	return newWidth, maxEdge
+}
+
+// resizeImageFile writes a width x height copy of src to dst using the best available tool
+func resizeImageFile(src, dst string, width, height int) error {
+	if _, err := exec.LookPath("sips"); err == nil {
+		cmd := exec.Command("sips", "-z", strconv.Itoa(height), strconv.Itoa(width), src, "--out", dst)
+		if output, err := cmd.CombinedOutput(); err != nil {
+			return fmt.Errorf("sips failed: %v\nOutput: %s", err, string(output))
+		}
+		return nil
+	}
+
+	if _, err := exec.LookPath("ffmpeg"); err == nil {
+		cmd := exec.Command("ffmpeg", "-v", "quiet", "-y", "-i", src, "-vf", fmt.Sprintf("scale=%d:%d", width, height), dst)
+		if output, err := cmd.CombinedOutput(); err != nil {
+			return fmt.Errorf("ffmpeg failed: %v\nOutput: %s", err, string(output))
+		}
+		return nil
+	}
+
+	return resizeImageFileNative(src, dst, width, height)
+}
+
+// resizeImageFileNative downscales PNG/JPEG files with an area-average filter
+func resizeImageFileNative(src, dst string, width, height int) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return fmt.Errorf("failed to open image: %v", err)
+	}
+	img, format, err := image.Decode(in)
+	in.Close()
+	if err != nil {
+		return fmt.Errorf("failed to decode image: %v", err)
+	}
+
+	resized := downscaleImage(img, width, height)
+
+	out, err := os.Create(dst)
+	if err != nil {
+		return fmt.Errorf("failed to create resized image: %v", err)
+	}
+	defer out.Close()
+
+	switch format {
+	case "png":
+		err = png.Encode(out, resized)
+	case "jpeg":
+		err = jpeg.Encode(out, resized, &jpeg.Options{Quality: 90})
+	default:
+		err = fmt.Errorf("unsupported image format '%s'", format)
+	}
+	if err != nil {
+		os.Remove(dst)
+		return fmt.Errorf("failed to encode resized image: %v", err)
+	}
+
+	return nil
+}
+
+// downscaleImage averages each block of source pixels that maps onto a destination pixel
+func downscaleImage(img image.Image, width, height int) *image.NRGBA {
+	bounds := img.Bounds()
+	dst := image.NewNRGBA(image.Rect(0, 0, width, height))
+	scaleX := float64(bounds.Dx()) / float64(width)
+	scaleY := float64(bounds.Dy()) / float64(height)
+
+	for y := 0; y < height; y++ {
+		y0 := bounds.Min.Y + int(float64(y)*scaleY)
+		y1 := bounds.Min.Y + int(float64(y+1)*scaleY)
+		if y1 <= y0 {
+			y1 = y0 + 1
+		}
+		for x := 0; x < width; x++ {
+			x0 := bounds.Min.X + int(float64(x)*scaleX)
+			x1 := bounds.Min.X + int(float64(x+1)*scaleX)
+			if x1 <= x0 {
+				x1 = x0 + 1
+			}
+
+			var r, g, b, a, count uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					c := color.NRGBAModel.Convert(img.At(sx, sy)).(color.NRGBA)
+					r += uint64(c.R)
+					g += uint64(c.G)
+					b += uint64(c.B)
+					a += uint64(c.A)
+					count++
+				}
+			}
+			dst.SetNRGBA(x, y, color.NRGBA{R: uint8(r / count), G: uint8(g / count), B: uint8(b / count), A: uint8(a / count)})
+		}
+	}
+
+	return dst
+}
@@ -0,0 +1,185 @@
+package fcp
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeTestPNG writes a solid PNG of the given size
+func writeTestPNG(t *testing.T, path string, width, height int) {
+	t.Helper()
+
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.SetNRGBA(x, y, color.NRGBA{R: uint8(x), G: uint8(y), B: 128, A: 255})
+		}
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		t.Fatalf("Failed to create test PNG: %v", err)
+	}
+	defer file.Close()
+	if err := png.Encode(file, img); err != nil {
+		t.Fatalf("Failed to encode test PNG: %v", err)
+	}
+}
+
+// TestOptimizeImages tests that oversized images get a cached downscaled copy and small ones are untouched
+func TestOptimizeImages(t *testing.T) {
+	tempDir := t.TempDir()
+	largePath := filepath.Join(tempDir, "large.png")
+	smallPath := filepath.Join(tempDir, "small.png")
+	writeTestPNG(t, largePath, 4000, 200)
+	writeTestPNG(t, smallPath, 640, 480)
+
+	result, err := OptimizeImages([]string{largePath, smallPath}, DefaultMaxImageEdge)
+	if err != nil {
+		t.Fatalf("OptimizeImages failed: %v", err)
+	}
+
+	if result.Resized != 1 {
+		t.Errorf("Expected 1 resized image, got %d", result.Resized)
+	}
+	if result.Paths[1] != smallPath {
+		t.Errorf("Expected small image to be referenced directly, got %s", result.Paths[1])
+	}
+
+	cachedPath := result.Paths[0]
+	if cachedPath == largePath || !strings.Contains(cachedPath, optimizedImageDir) {
+		t.Fatalf("Expected large image to point at a cached copy, got %s", cachedPath)
+	}
+	if filepath.Ext(cachedPath) != ".png" {
+		t.Errorf("Expected cached copy to keep the PNG format, got %s", cachedPath)
+	}
+
+	width, height, err := imageDimensions(cachedPath)
+	if err != nil {
+		t.Fatalf("Failed to read cached copy: %v", err)
+	}
+	if width > DefaultMaxImageEdge || height > DefaultMaxImageEdge {
+		t.Errorf("Expected cached copy within %dpx, got %dx%d", DefaultMaxImageEdge, width, height)
+	}
+	if width != 2048 || height != 102 {
+		t.Errorf("Expected aspect ratio preserved at 2048x102, got %dx%d", width, height)
+	}
+	if result.BytesSaved <= 0 {
+		t.Errorf("Expected bytes saved to be positive, got %d", result.BytesSaved)
+	}
+
+	// A second run reuses the cached copy
+	again, err := OptimizeImages([]string{largePath}, DefaultMaxImageEdge)
+	if err != nil {
+		t.Fatalf("Second OptimizeImages failed: %v", err)
+	}
+	if again.Paths[0] != cachedPath {
+		t.Errorf("Expected cached copy %s to be reused, got %s", cachedPath, again.Paths[0])
+	}
+}
+
+// TestPngPileOptimizeImages tests that the pile asset references the downscaled copy
+func TestPngPileOptimizeImages(t *testing.T) {
+	pngDir := setupPngPileDir(t)
+	for i := 0; i < 3; i++ {
+		writeTestPNG(t, filepath.Join(pngDir, fmt.Sprintf("image_%d.png", i)), 320, 240)
+	}
+	largePath := filepath.Join(pngDir, "image_0.png")
+	writeTestPNG(t, largePath, 4000, 200)
+
+	config := &PngPileConfig{
+		Duration:       10,
+		TotalImages:    3,
+		OutputDir:      pngDir,
+		UseExisting:    true,
+		OptimizeImages: true,
+	}
+
+	fcpxml, err := GeneratePngPileWithConfig(config, false)
+	if err != nil {
+		t.Fatalf("GeneratePngPileWithConfig failed: %v", err)
+	}
+
+	foundCached := false
+	for _, asset := range fcpxml.Resources.Assets {
+		if strings.HasSuffix(asset.MediaRep.Src, "/image_0.png") {
+			t.Errorf("Expected oversized image to be replaced by its cached copy, got %s", asset.MediaRep.Src)
+		}
+		if strings.Contains(asset.MediaRep.Src, optimizedImageDir) {
+			foundCached = true
+		}
+	}
+	if !foundCached {
+		t.Errorf("Expected an asset pointing at the optimized copy")
+	}
+}
+
+// TestAddImageFormatSize tests that image formats record the image's real pixel size
+func TestAddImageFormatSize(t *testing.T) {
+	tempDir := t.TempDir()
+	photoPath := filepath.Join(tempDir, "landscape.jpg")
+	file, err := os.Create(photoPath)
+	if err != nil {
+		t.Fatalf("Failed to create test JPEG: %v", err)
+	}
+	if err := jpeg.Encode(file, image.NewGray(image.Rect(0, 0, 3000, 2000)), nil); err != nil {
+		t.Fatalf("Failed to encode test JPEG: %v", err)
+	}
+	file.Close()
+
+	// A .png that isn't really an image falls back to the sequence size
+	brokenPath := filepath.Join(tempDir, "broken.png")
+	if err := os.WriteFile(brokenPath, []byte("not a png"), 0644); err != nil {
+		t.Fatalf("Failed to create broken PNG: %v", err)
+	}
+
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("GenerateEmpty failed: %v", err)
+	}
+	if err := AddImage(fcpxml, photoPath, 5); err != nil {
+		t.Fatalf("AddImage failed: %v", err)
+	}
+	if err := AddImage(fcpxml, brokenPath, 5); err != nil {
+		t.Fatalf("AddImage failed for undecodable image: %v", err)
+	}
+
+	sequence := fcpxml.Library.Events[0].Projects[0].Sequences[0]
+	frameWidth, frameHeight, err := sequenceFrameSize(fcpxml, &sequence)
+	if err != nil {
+		t.Fatalf("sequenceFrameSize failed: %v", err)
+	}
+	expected := map[string][2]string{
+		photoPath:  {"3000", "2000"},
+		brokenPath: {fmt.Sprint(int(frameWidth)), fmt.Sprint(int(frameHeight))},
+	}
+	for _, asset := range fcpxml.Resources.Assets {
+		size, ok := expected[mediaSourcePath(asset.MediaRep.Src)]
+		if !ok {
+			continue
+		}
+		var format *Format
+		for i := range fcpxml.Resources.Formats {
+			if fcpxml.Resources.Formats[i].ID == asset.Format {
+				format = &fcpxml.Resources.Formats[i]
+			}
+		}
+		if format == nil {
+			t.Fatalf("Asset %s has no format", asset.Name)
+		}
+		if format.Width != size[0] || format.Height != size[1] {
+			t.Errorf("Asset %s: expected format %sx%s, got %sx%s", asset.Name, size[0], size[1], format.Width, format.Height)
+		}
+		delete(expected, mediaSourcePath(asset.MediaRep.Src))
+	}
+	if len(expected) != 0 {
+		t.Errorf("Expected image assets were not created: %v", expected)
+	}
+}
@@ -0,0 +1,18 @@
+package fcp
+
+// ImageOptions are the per-call settings of AddImageWithOptions. The zero value adds the image
+// the way AddImage does.
+type ImageOptions struct {
+	// Slide adds the stock Ken Burns move (see AddImageWithSlide)
+	Slide bool
+	// AutoOrient references an upright copy of photos whose EXIF Orientation says they are stored
+	// rotated or mirrored. The copy is written to .cutlass_oriented beside the photo, so it is off
+	// unless asked for; the CLI turns it on unless --no-auto-rotate is given.
+	AutoOrient bool
+	// StartSeconds is the image's local start time; 0 keeps the standard one hour start in the
+	// sequence's timebase
+	StartSeconds float64
+	// Element is the spine element written for the still: "" or ImageElementVideo for <video>,
+	// or ImageElementAssetClip, which has crashed FCP on import (see ImageElementAssetClip)
+	Element string
+}
@@ -0,0 +1,113 @@
+package fcp
+
+import (
+	"fmt"
+	"image"
+	"image/draw"
+	"image/jpeg"
+	"image/png"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// orientedImageDir holds upright copies next to the originals so later runs reuse them
+const orientedImageDir = ".cutlass_oriented"
+
+// orientImageFile returns the path AddImage should use for imagePath: the file itself when it
+// is already upright (or has no EXIF data), otherwise a cached copy with the orientation applied
+// to the pixels. The copy keeps the original file name so the asset name and UID don't change.
+func orientImageFile(imagePath string) (string, error) {
+	orientation, err := ReadEXIFOrientation(imagePath)
+	if err != nil || orientation == 1 {
+		return imagePath, nil // Unreadable EXIF is left to the normal missing/invalid file checks
+	}
+
+	source, err := os.Stat(imagePath)
+	if err != nil {
+		return "", fmt.Errorf("image file does not exist: %s", imagePath)
+	}
+	cacheDir := filepath.Join(filepath.Dir(imagePath), orientedImageDir)
+	uprightPath := filepath.Join(cacheDir, filepath.Base(imagePath))
+
+	// Reuse an upright copy unless the source changed after it was made
+	if cached, err := os.Stat(uprightPath); err == nil && !cached.ModTime().Before(source.ModTime()) {
+		return uprightPath, nil
+	}
+
+	in, err := os.Open(imagePath)
+	if err != nil {
+		return "", fmt.Errorf("failed to open image: %v", err)
+	}
+	defer in.Close()
+	img, _, err := image.Decode(in)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode image: %v", err)
+	}
+
+	if err := os.MkdirAll(cacheDir, 0755); err != nil {
+		return "", fmt.Errorf("failed to create cache directory: %v", err)
+	}
+	out, err := os.Create(uprightPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to create upright copy: %v", err)
+	}
+	defer out.Close()
+
+	// Re-encoding drops the EXIF block, so viewers can't apply the orientation a second time
+	upright := applyEXIFOrientation(img, orientation)
+	switch strings.ToLower(filepath.Ext(imagePath)) {
+	case ".png":
+		err = png.Encode(out, upright)
+	default:
+		err = jpeg.Encode(out, upright, &jpeg.Options{Quality: 95})
+	}
+	if err != nil {
+		os.Remove(uprightPath)
+		return "", fmt.Errorf("failed to encode upright copy: %v", err)
+	}
+
+	return uprightPath, nil
+}
+
+// applyEXIFOrientation returns img turned the way an EXIF Orientation value (1-8) describes:
+// 2/4 mirror, 3 rotates 180°, 6/8 rotate 90° clockwise/counter-clockwise, 5/7 mirror across a diagonal.
+func applyEXIFOrientation(img image.Image, orientation int) image.Image {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if orientation <= 1 || orientation > 8 {
+		return img
+	}
+
+	outWidth, outHeight := width, height
+	if orientation >= 5 {
+		outWidth, outHeight = height, width
+	}
+	out := image.NewNRGBA(image.Rect(0, 0, outWidth, outHeight))
+	src := image.NewNRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(src, src.Bounds(), img, bounds.Min, draw.Src)
+
+	for y := 0; y < outHeight; y++ {
+		for x := 0; x < outWidth; x++ {
+			var sx, sy int
+			switch orientation {
+			case 2:
+				sx, sy = width-1-x, y
+			case 3:
+				sx, sy = width-1-x, height-1-y
+			case 4:
+				sx, sy = x, height-1-y
+			case 5:
+				sx, sy = y, x
+			case 6:
+				sx, sy = y, height-1-x
+			case 7:
+				sx, sy = width-1-y, height-1-x
+			case 8:
+				sx, sy = width-1-y, x
+			}
+			out.SetNRGBA(x, y, src.NRGBAAt(sx, sy))
+		}
+	}
+	return out
+}
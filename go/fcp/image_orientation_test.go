@@ -0,0 +1,145 @@
+package fcp
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// writeOrientedPNG writes a 4x2 PNG with a red top-left pixel and an eXIf chunk holding orientation
+func writeOrientedPNG(t *testing.T, path string, orientation uint16) {
+	t.Helper()
+
+	img := image.NewNRGBA(image.Rect(0, 0, 4, 2))
+	for y := 0; y < 2; y++ {
+		for x := 0; x < 4; x++ {
+			img.SetNRGBA(x, y, color.NRGBA{0, 0, 255, 255})
+		}
+	}
+	img.SetNRGBA(0, 0, color.NRGBA{255, 0, 0, 255})
+
+	var encoded bytes.Buffer
+	if err := png.Encode(&encoded, img); err != nil {
+		t.Fatalf("Failed to encode PNG: %v", err)
+	}
+
+	// Little-endian TIFF with a single IFD0 entry: Orientation (SHORT)
+	var tiff bytes.Buffer
+	le := binary.LittleEndian
+	tiff.WriteString("II")
+	binary.Write(&tiff, le, uint16(42))
+	binary.Write(&tiff, le, uint32(8))
+	binary.Write(&tiff, le, uint16(1))
+	binary.Write(&tiff, le, [2]uint16{exifTagOrientation, 3})
+	binary.Write(&tiff, le, uint32(1))
+	binary.Write(&tiff, le, [2]uint16{orientation, 0})
+	binary.Write(&tiff, le, uint32(0))
+
+	// eXIf goes right after the IHDR chunk (8-byte signature + 25-byte IHDR)
+	var chunk bytes.Buffer
+	binary.Write(&chunk, binary.BigEndian, uint32(tiff.Len()))
+	chunk.WriteString("eXIf")
+	chunk.Write(tiff.Bytes())
+	binary.Write(&chunk, binary.BigEndian, crc32.ChecksumIEEE(chunk.Bytes()[4:]))
+
+	data := encoded.Bytes()
+	var out bytes.Buffer
+	out.Write(data[:33])
+	out.Write(chunk.Bytes())
+	out.Write(data[33:])
+
+	if err := os.WriteFile(path, out.Bytes(), 0644); err != nil {
+		t.Fatalf("Failed to write PNG: %v", err)
+	}
+}
+
+// TestAddImageAutoOrient tests that a photo tagged orientation 6 (90° CW) is imported upright
+func TestAddImageAutoOrient(t *testing.T) {
+	imagePath := filepath.Join(t.TempDir(), "phone.png")
+	writeOrientedPNG(t, imagePath, 6)
+
+	if orientation, err := ReadEXIFOrientation(imagePath); err != nil || orientation != 6 {
+		t.Fatalf("Expected orientation 6, got %d (%v)", orientation, err)
+	}
+
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("GenerateEmpty failed: %v", err)
+	}
+	if err := AddImageWithOptions(fcpxml, imagePath, 3, ImageOptions{AutoOrient: true}); err != nil {
+		t.Fatalf("AddImageWithOptions failed: %v", err)
+	}
+
+	uprightPath := mediaSourcePath(fcpxml.Resources.Assets[0].MediaRep.Src)
+	if filepath.Base(filepath.Dir(uprightPath)) != orientedImageDir || filepath.Base(uprightPath) != "phone.png" {
+		t.Fatalf("Expected the asset to reference the upright copy, got %s", uprightPath)
+	}
+	if fcpxml.Resources.Assets[0].Name != "phone" {
+		t.Errorf("Upright copy should keep the asset name 'phone', got '%s'", fcpxml.Resources.Assets[0].Name)
+	}
+
+	file, err := os.Open(uprightPath)
+	if err != nil {
+		t.Fatalf("Failed to open upright copy: %v", err)
+	}
+	defer file.Close()
+	upright, err := png.Decode(file)
+	if err != nil {
+		t.Fatalf("Failed to decode upright copy: %v", err)
+	}
+
+	// Rotating 90° clockwise turns the 4x2 image into 2x4 with the red corner at the top right
+	if upright.Bounds().Dx() != 2 || upright.Bounds().Dy() != 4 {
+		t.Fatalf("Expected a 2x4 upright image, got %dx%d", upright.Bounds().Dx(), upright.Bounds().Dy())
+	}
+	if r, g, b, _ := upright.At(1, 0).RGBA(); r>>8 != 255 || g != 0 || b != 0 {
+		t.Errorf("Expected the red pixel at the top right after rotation")
+	}
+	if orientation, _ := ReadEXIFOrientation(uprightPath); orientation != 1 {
+		t.Errorf("Upright copy should carry no rotation, got orientation %d", orientation)
+	}
+}
+
+// TestAddImageAutoOrientDisabled tests that AddImage, without AutoOrient, references the original
+// file and writes no upright copy
+func TestAddImageAutoOrientDisabled(t *testing.T) {
+	imagePath := filepath.Join(t.TempDir(), "phone.png")
+	writeOrientedPNG(t, imagePath, 6)
+
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("GenerateEmpty failed: %v", err)
+	}
+	if err := AddImage(fcpxml, imagePath, 3); err != nil {
+		t.Fatalf("AddImage failed: %v", err)
+	}
+	if src := mediaSourcePath(fcpxml.Resources.Assets[0].MediaRep.Src); src != imagePath {
+		t.Errorf("Expected the original file %s, got %s", imagePath, src)
+	}
+	if _, err := os.Stat(filepath.Join(filepath.Dir(imagePath), orientedImageDir)); !os.IsNotExist(err) {
+		t.Errorf("Expected no %s directory without AutoOrient, got %v", orientedImageDir, err)
+	}
+}
+
+// TestApplyEXIFOrientation tests where the top-left stored pixel lands for all eight orientations
+func TestApplyEXIFOrientation(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 4, 2))
+	img.SetNRGBA(0, 0, color.NRGBA{255, 0, 0, 255})
+
+	expected := map[int]image.Point{
+		1: {0, 0}, 2: {3, 0}, 3: {3, 1}, 4: {0, 1},
+		5: {0, 0}, 6: {1, 0}, 7: {1, 3}, 8: {0, 3},
+	}
+	for orientation, corner := range expected {
+		out := applyEXIFOrientation(img, orientation)
+		if r, _, _, _ := out.At(corner.X, corner.Y).RGBA(); r>>8 != 255 {
+			t.Errorf("Orientation %d: expected the marked pixel at %v", orientation, corner)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package fcp
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// SlideDirection is the frame edge an image slides in from on its way to center
+type SlideDirection string
+
+const (
+	SlideFromLeft  SlideDirection = "left"
+	SlideFromRight SlideDirection = "right"
+	SlideFromUp    SlideDirection = "up"
+	SlideFromDown  SlideDirection = "down"
+)
+
+// slideDirectionIndex maps each edge to its row in slideDirections
+var slideDirectionIndex = map[SlideDirection]int{
+	SlideFromRight: 0,
+	SlideFromLeft:  1,
+	SlideFromUp:    2,
+	SlideFromDown:  3,
+}
+
+// slideInSeconds is how long the slide to center takes
+const slideInSeconds = 1.0
+
+// AddImageSlideFrom adds an image that slides in from the given edge to center over one second.
+// distance overrides how far off center it starts (in FCP position units); 0 uses the stock
+// slide distance. An empty direction keeps AddImageWithSlide's default Ken Burns move. opts
+// applies as in AddImageWithOptions; its Slide is ignored.
+//
+// 🚨 CLAUDE.md Rules Applied Here:
+// - Position keyframes carry no curve and key off the image's start on its own frame grid
+// - The slide is frame-aligned and shortened to the image when the image is under a second
+func AddImageSlideFrom(fcpxml *FCPXML, imagePath string, durationSeconds float64, from SlideDirection, distance float64, opts ImageOptions) error {
+	if from == "" {
+		opts.Slide = true
+		return AddImageWithOptions(fcpxml, imagePath, durationSeconds, opts)
+	}
+	index, ok := slideDirectionIndex[from]
+	if !ok {
+		return fmt.Errorf("invalid slide direction '%s' (use left, right, up or down)", from)
+	}
+	if distance < 0 {
+		return fmt.Errorf("slide distance must not be negative, got %g", distance)
+	}
+
+	opts.Slide = false
+	if err := AddImageWithOptions(fcpxml, imagePath, durationSeconds, opts); err != nil {
+		return err
+	}
+	sequence, err := firstSequence(fcpxml)
+	if err != nil {
+		return err
+	}
+
+	image, err := lastAddedImage(sequence, opts)
+	if err != nil {
+		return err
+	}
+
+	startX, startY := slideStartPosition(index, distance)
+	rate := SequenceFrameRate(fcpxml, sequence)
+	*image.transform = &AdjustTransform{
+		Params: []Param{
+			{
+				Name: "position",
+				KeyframeAnimation: &KeyframeAnimation{
+					Keyframes: []Keyframe{
+						{Time: image.start, Value: startX + " " + startY},
+						{Time: rate.addSeconds(image.start, math.Min(slideInSeconds, durationSeconds)), Value: "0 0"},
+					},
+				},
+			},
+		},
+	}
+	return nil
+}
+
+// slideStartPosition is the off-center start of slideDirections[index], rescaled to distance when set
+func slideStartPosition(index int, distance float64) (string, string) {
+	direction := slideDirections[index]
+	if distance == 0 {
+		return direction.startX, direction.startY
+	}
+	x, _ := strconv.ParseFloat(direction.startX, 64)
+	y, _ := strconv.ParseFloat(direction.startY, 64)
+	scale := distance / math.Hypot(x, y)
+	return strconv.FormatFloat(x*scale, 'f', -1, 64), strconv.FormatFloat(y*scale, 'f', -1, 64)
+}
@@ -0,0 +1,57 @@
+package fcp
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestAddImageSlideFrom tests that an edge slide keys position from off-center to center over one second
+func TestAddImageSlideFrom(t *testing.T) {
+	imagePath := filepath.Join(t.TempDir(), "photo.png")
+	writeTestPNG(t, imagePath, 64, 36)
+
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("GenerateEmpty failed: %v", err)
+	}
+	if err := AddImageSlideFrom(fcpxml, imagePath, 5, SlideFromRight, 0, ImageOptions{}); err != nil {
+		t.Fatalf("AddImageSlideFrom failed: %v", err)
+	}
+
+	video := fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine.Videos[0]
+	if video.AdjustTransform == nil || len(video.AdjustTransform.Params) != 1 {
+		t.Fatalf("Expected a single position param, got %+v", video.AdjustTransform)
+	}
+	param := video.AdjustTransform.Params[0]
+	keyframes := param.KeyframeAnimation.Keyframes
+	if param.Name != "position" || len(keyframes) != 2 {
+		t.Fatalf("Expected two position keyframes, got %+v", param)
+	}
+
+	startX := strings.Fields(keyframes[0].Value)[0]
+	endX := strings.Fields(keyframes[1].Value)[0]
+	if strings.HasPrefix(startX, "-") || startX == "0" || endX != "0" {
+		t.Errorf("Expected start X positive and end X zero, got %s and %s", startX, endX)
+	}
+	if keyframes[0].Time != video.Start {
+		t.Errorf("Expected slide to start at the image start %s, got %s", video.Start, keyframes[0].Time)
+	}
+	slideUnits := timeUnits(keyframes[1].Time) - timeUnits(keyframes[0].Time)
+	if slideUnits != 24024 || keyframes[0].Curve != "" || keyframes[1].Curve != "" {
+		t.Errorf("Expected a curveless 24-frame slide, got %d units (%+v)", slideUnits, keyframes)
+	}
+
+	// A custom distance rescales the stock start position
+	if err := AddImageSlideFrom(fcpxml, imagePath, 5, SlideFromUp, 80, ImageOptions{}); err != nil {
+		t.Fatalf("AddImageSlideFrom failed: %v", err)
+	}
+	up := fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine.Videos[1]
+	if got := up.AdjustTransform.Params[0].KeyframeAnimation.Keyframes[0].Value; got != "0 80" {
+		t.Errorf("Expected slide from up to start at '0 80', got '%s'", got)
+	}
+
+	if err := AddImageSlideFrom(fcpxml, imagePath, 5, SlideDirection("sideways"), 0, ImageOptions{}); err == nil {
+		t.Error("Expected error for unknown slide direction")
+	}
+}
@@ -0,0 +1,127 @@
+package fcp
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// FrameRate is a sequence timebase as written in its format's frameDuration:
+// "1001/24000s" is {FrameDuration: 1001, Timebase: 24000}, "100/3000s" (30fps) is {100, 3000}
+type FrameRate struct {
+	FrameDuration int // Ticks per frame
+	Timebase      int // Ticks per second
+}
+
+// DefaultFrameRate is the 23.976fps timebase GenerateEmpty sequences use
+var DefaultFrameRate = FrameRate{FrameDuration: 1001, Timebase: 24000}
+
+// defaultImageStartSeconds is where still images start in their local time. FCP gives stills
+// a one hour start, which in the 24000 timebase is the familiar "86399313/24000s".
+const defaultImageStartSeconds = 3600.0
+
+// ParseFrameRate reads a format frameDuration such as "1001/24000s", "100/3000s" or "1/25s"
+func ParseFrameRate(frameDuration string) (FrameRate, error) {
+	if !strings.HasSuffix(frameDuration, "s") {
+		return FrameRate{}, fmt.Errorf("invalid frame duration '%s': missing 's' suffix", frameDuration)
+	}
+	parts := strings.Split(strings.TrimSuffix(frameDuration, "s"), "/")
+	if len(parts) != 2 {
+		return FrameRate{}, fmt.Errorf("invalid frame duration '%s': expected ticks/timebase", frameDuration)
+	}
+	ticks, err1 := strconv.Atoi(parts[0])
+	timebase, err2 := strconv.Atoi(parts[1])
+	if err1 != nil || err2 != nil || ticks <= 0 || timebase <= 0 {
+		return FrameRate{}, fmt.Errorf("invalid frame duration '%s'", frameDuration)
+	}
+	return FrameRate{FrameDuration: ticks, Timebase: timebase}, nil
+}
+
+// SequenceFrameRate returns the timebase of the sequence's format, or DefaultFrameRate when the
+// format is missing or has no usable frameDuration
+func SequenceFrameRate(fcpxml *FCPXML, sequence *Sequence) FrameRate {
+	for _, format := range fcpxml.Resources.Formats {
+		if format.ID == sequence.Format {
+			if rate, err := ParseFrameRate(format.FrameDuration); err == nil {
+				return rate
+			}
+		}
+	}
+	return DefaultFrameRate
+}
+
+// imageStartForTimebase is the standard one hour image start, floored to a whole frame of fr
+func imageStartForTimebase(fr FrameRate) string {
+	return imageStartAt(fr, defaultImageStartSeconds)
+}
+
+// titleStartForTimebase is the local start FCP gives titles: one hour of timecode, i.e. 3600
+// seconds of frames at the nominal rate, which is "86486400/24000s" at 23.976fps
+func titleStartForTimebase(fr FrameRate) string {
+	nominalFPS := int(math.Round(float64(fr.Timebase) / float64(fr.FrameDuration)))
+	return fr.formatTicks(3600 * nominalFPS * fr.FrameDuration)
+}
+
+// imageStartAt is seconds floored to a whole frame of fr, written in fr's timebase
+func imageStartAt(fr FrameRate, seconds float64) string {
+	frames := int(math.Floor(seconds * float64(fr.Timebase) / float64(fr.FrameDuration)))
+	return fr.formatTicks(frames * fr.FrameDuration)
+}
+
+// sequenceImageStart is the start a still gets in sequence: startSeconds when set, otherwise the
+// standard start in the sequence's own timebase
+func sequenceImageStart(fcpxml *FCPXML, sequence *Sequence, startSeconds float64) (string, FrameRate) {
+	rate := SequenceFrameRate(fcpxml, sequence)
+	if startSeconds > 0 {
+		return imageStartAt(rate, startSeconds), rate
+	}
+	return imageStartForTimebase(rate), rate
+}
+
+// AddSeconds returns the FCP time base plus seconds rounded to whole frames of fr. Keyframes that
+// key off a clip's start use it so they stay on the same frame grid as the start. The result
+// keeps base's timebase when the frames land on whole ticks there ("3003/30000s" steps stay in
+// 30000) and is otherwise written in fr's timebase; a base that is on neither grid is an error.
+func (fr FrameRate) AddSeconds(base string, seconds float64) (string, error) {
+	numerator, timebase, err := ParseFCPTime(base)
+	if err != nil {
+		return "", err
+	}
+
+	frames := int64(math.Round(seconds * float64(fr.Timebase) / float64(fr.FrameDuration)))
+	if frames == 0 {
+		return base, nil
+	}
+
+	offsetTicks := frames * int64(fr.FrameDuration) * timebase
+	if offsetTicks%int64(fr.Timebase) == 0 {
+		return fmt.Sprintf("%d/%ds", numerator+offsetTicks/int64(fr.Timebase), timebase), nil
+	}
+	baseTicks := numerator * int64(fr.Timebase)
+	if baseTicks%timebase != 0 {
+		return "", fmt.Errorf("time %s is not on the %d/%ds frame grid", base, fr.FrameDuration, fr.Timebase)
+	}
+	return fmt.Sprintf("%d/%ds", baseTicks/timebase+frames*int64(fr.FrameDuration), fr.Timebase), nil
+}
+
+// addSeconds is AddSeconds for starts this package wrote in fr's own timebase, which can't fail
+func (fr FrameRate) addSeconds(base string, seconds float64) string {
+	t, _ := fr.AddSeconds(base, seconds)
+	return t
+}
+
+// frameTime is seconds rounded to the nearest frame of fr, as an exact time
+func (fr FrameRate) frameTime(seconds float64) *big.Rat {
+	frames := int64(math.Round(seconds * float64(fr.Timebase) / float64(fr.FrameDuration)))
+	return big.NewRat(frames*int64(fr.FrameDuration), int64(fr.Timebase))
+}
+
+// formatTicks writes a tick count as an FCP time in fr's timebase
+func (fr FrameRate) formatTicks(ticks int) string {
+	if ticks == 0 {
+		return "0s"
+	}
+	return fmt.Sprintf("%d/%ds", ticks, fr.Timebase)
+}
@@ -0,0 +1,138 @@
+package fcp
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+// TestImageStartForTimebase tests the one hour image and title starts in common timebases
+func TestImageStartForTimebase(t *testing.T) {
+	tests := map[string]string{
+		"1001/24000s": "86399313/24000s",
+		"100/3000s":   "10800000/3000s",
+		"1001/30000s": "107999892/30000s",
+		"1/25s":       "90000/25s",
+	}
+	for frameDuration, expected := range tests {
+		rate, err := ParseFrameRate(frameDuration)
+		if err != nil {
+			t.Fatalf("ParseFrameRate(%q) failed: %v", frameDuration, err)
+		}
+		if start := imageStartForTimebase(rate); start != expected {
+			t.Errorf("Image start for %s: expected %s, got %s", frameDuration, expected, start)
+		}
+	}
+
+	titleStarts := map[string]string{
+		"1001/24000s": "86486400/24000s",
+		"100/3000s":   "10800000/3000s",
+		"1001/30000s": "108108000/30000s",
+		"1/25s":       "90000/25s",
+	}
+	for frameDuration, expected := range titleStarts {
+		rate, _ := ParseFrameRate(frameDuration)
+		if start := titleStartForTimebase(rate); start != expected {
+			t.Errorf("Title start for %s: expected %s, got %s", frameDuration, expected, start)
+		}
+	}
+
+	for _, invalid := range []string{"", "1001/24000", "24000s", "0/3000s"} {
+		if _, err := ParseFrameRate(invalid); err == nil {
+			t.Errorf("Expected an error for frame duration %q", invalid)
+		}
+	}
+}
+
+// TestAddImageStartIn30fpsSequence tests that images in a 30fps sequence start on its frame grid
+func TestAddImageStartIn30fpsSequence(t *testing.T) {
+	imagePath := filepath.Join(t.TempDir(), "still.png")
+	writeTestPNG(t, imagePath, 64, 64)
+
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("GenerateEmpty failed: %v", err)
+	}
+	sequence := &fcpxml.Library.Events[0].Projects[0].Sequences[0]
+	for i := range fcpxml.Resources.Formats {
+		if fcpxml.Resources.Formats[i].ID == sequence.Format {
+			fcpxml.Resources.Formats[i].FrameDuration = "100/3000s"
+		}
+	}
+
+	if err := AddImageWithSlide(fcpxml, imagePath, 5.0, true); err != nil {
+		t.Fatalf("AddImageWithSlide failed: %v", err)
+	}
+
+	video := sequence.Spine.Videos[0]
+	if video.Start != "10800000/3000s" {
+		t.Errorf("Expected image start 10800000/3000s in the 30fps timebase, got %s", video.Start)
+	}
+
+	// Ken Burns keyframes key off the start and stay in the same timebase (3s = 90 frames later)
+	for _, param := range video.AdjustTransform.Params {
+		keyframes := param.KeyframeAnimation.Keyframes
+		if keyframes[0].Time != video.Start || keyframes[len(keyframes)-1].Time != "10809000/3000s" {
+			t.Errorf("Param %s keyframes should run from %s to 10809000/3000s, got %s to %s",
+				param.Name, video.Start, keyframes[0].Time, keyframes[len(keyframes)-1].Time)
+		}
+	}
+}
+
+// TestAddImageStartOverride tests that ImageOptions.StartSeconds replaces the default start
+func TestAddImageStartOverride(t *testing.T) {
+	imagePath := filepath.Join(t.TempDir(), "still.png")
+	writeTestPNG(t, imagePath, 64, 64)
+
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("GenerateEmpty failed: %v", err)
+	}
+	if err := AddImageWithOptions(fcpxml, imagePath, 5.0, ImageOptions{StartSeconds: 10}); err != nil {
+		t.Fatalf("AddImageWithOptions failed: %v", err)
+	}
+
+	// 10s floored to whole 23.976fps frames: 239 frames
+	if start := fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine.Videos[0].Start; start != "239239/24000s" {
+		t.Errorf("Expected overridden start 239239/24000s, got %s", start)
+	}
+}
+
+// TestFrameRateAddSeconds validates that keyframe times stay frame-aligned in any timebase
+func TestFrameRateAddSeconds(t *testing.T) {
+	cases := []struct {
+		rate   FrameRate
+		base   string
+		offset float64
+		want   string
+	}{
+		{DefaultFrameRate, "86399313/24000s", 0, "86399313/24000s"},
+		{DefaultFrameRate, "86399313/24000s", 1.0, "86423337/24000s"},
+		{DefaultFrameRate, "0s", 1.0, "24024/24000s"},
+		{DefaultFrameRate, "3600s", 0.5, "86412012/24000s"},
+		{DefaultFrameRate, "1801800/30000s", 1.0, "1831830/30000s"},
+		{DefaultFrameRate, "100/25s", 1.0, "120024/24000s"},
+		{DefaultFrameRate, "86399313/24000s", 0.01, "86399313/24000s"},
+		{FrameRate{FrameDuration: 100, Timebase: 3000}, "10800000/3000s", 1.0, "10803000/3000s"},
+		{FrameRate{FrameDuration: 1, Timebase: 25}, "3600s", 0.4, "90010/25s"},
+	}
+
+	for _, c := range cases {
+		got, err := c.rate.AddSeconds(c.base, c.offset)
+		if err != nil {
+			t.Errorf("%v.AddSeconds(%q, %v) returned error: %v", c.rate, c.base, c.offset, err)
+			continue
+		}
+		if got != c.want {
+			t.Errorf("%v.AddSeconds(%q, %v) = %q, want %q", c.rate, c.base, c.offset, got, c.want)
+		}
+	}
+
+	for _, bad := range []string{"", "garbage", "12/0s", "1/2/3s", "100"} {
+		if _, err := DefaultFrameRate.AddSeconds(bad, 1.0); err == nil {
+			t.Errorf("Expected error for unparseable base time %q", bad)
+		}
+	}
+	if _, err := DefaultFrameRate.AddSeconds("1/7s", 1.0); err == nil {
+		t.Error("Expected error for a base off the frame grid")
+	}
+}
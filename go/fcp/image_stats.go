@@ -0,0 +1,93 @@
+package fcp
+
+import (
+	"fmt"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"math"
+	"os"
+)
+
+// Mean luma (0-255) beyond which an image is treated as a failed capture or broken download
+const (
+	blackImageMaxMeanLuma       = 8.0
+	blownOutImageMinMeanLuma    = 247.0
+	imageStatsMaxSamplesPerSide = 1024 // Large images are sampled on a grid of at most this many pixels per axis
+)
+
+// ImageStats is the brightness of an image as Rec. 709 luma, 0 (black) to 255 (white)
+type ImageStats struct {
+	MeanLuma float64
+	MinLuma  float64
+	MaxLuma  float64
+}
+
+// Problem describes why the image is likely unusable, or "" when it looks fine: almost
+// entirely black (lens cap, failed render) or blown out (overexposed, blank download)
+func (s ImageStats) Problem() string {
+	switch {
+	case s.MeanLuma <= blackImageMaxMeanLuma:
+		return fmt.Sprintf("almost entirely black (mean luma %.1f)", s.MeanLuma)
+	case s.MeanLuma >= blownOutImageMinMeanLuma:
+		return fmt.Sprintf("blown out (mean luma %.1f)", s.MeanLuma)
+	}
+	return ""
+}
+
+// AnalyzeImage decodes the PNG, JPEG or GIF at path and measures its luma. Images bigger than
+// 1024 pixels on a side are sampled on an even grid, which is plenty for the mean.
+func AnalyzeImage(path string) (ImageStats, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return ImageStats{}, fmt.Errorf("failed to open image: %v", err)
+	}
+	defer file.Close()
+
+	img, _, err := image.Decode(file)
+	if err != nil {
+		return ImageStats{}, fmt.Errorf("failed to decode image: %v", err)
+	}
+
+	bounds := img.Bounds()
+	if bounds.Empty() {
+		return ImageStats{}, fmt.Errorf("image has no pixels")
+	}
+	stepX := int(math.Max(1, math.Ceil(float64(bounds.Dx())/imageStatsMaxSamplesPerSide)))
+	stepY := int(math.Max(1, math.Ceil(float64(bounds.Dy())/imageStatsMaxSamplesPerSide)))
+
+	stats := ImageStats{MinLuma: 255}
+	total, samples := 0.0, 0
+	for y := bounds.Min.Y; y < bounds.Max.Y; y += stepY {
+		for x := bounds.Min.X; x < bounds.Max.X; x += stepX {
+			r, g, b, _ := img.At(x, y).RGBA()
+			luma := (0.2126*float64(r) + 0.7152*float64(g) + 0.0722*float64(b)) / 257
+			total += luma
+			samples++
+			stats.MinLuma = math.Min(stats.MinLuma, luma)
+			stats.MaxLuma = math.Max(stats.MaxLuma, luma)
+		}
+	}
+	stats.MeanLuma = total / float64(samples)
+	return stats, nil
+}
+
+// FilterBadImages drops images that are almost entirely black, blown out or can't be decoded,
+// printing a warning for each, and returns the rest in their original order
+func FilterBadImages(paths []string) []string {
+	kept := make([]string, 0, len(paths))
+	for _, path := range paths {
+		stats, err := AnalyzeImage(path)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: skipping %s: %v\n", path, err)
+			continue
+		}
+		if problem := stats.Problem(); problem != "" {
+			fmt.Fprintf(os.Stderr, "Warning: skipping %s: %s\n", path, problem)
+			continue
+		}
+		kept = append(kept, path)
+	}
+	return kept
+}
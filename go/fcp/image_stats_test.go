@@ -0,0 +1,98 @@
+package fcp
+
+import (
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeSolidPNG writes a width x height PNG filled with c
+func writeSolidPNG(t *testing.T, path string, width, height int, c color.NRGBA) {
+	t.Helper()
+
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.SetNRGBA(x, y, c)
+		}
+	}
+	file, err := os.Create(path)
+	if err != nil {
+		t.Fatalf("Failed to create test PNG: %v", err)
+	}
+	defer file.Close()
+	if err := png.Encode(file, img); err != nil {
+		t.Fatalf("Failed to encode test PNG: %v", err)
+	}
+}
+
+// TestAnalyzeImage tests that a solid-black image is flagged and a normal image passes
+func TestAnalyzeImage(t *testing.T) {
+	dir := t.TempDir()
+	blackPath := filepath.Join(dir, "black.png")
+	whitePath := filepath.Join(dir, "white.png")
+	normalPath := filepath.Join(dir, "normal.png")
+	writeSolidPNG(t, blackPath, 64, 48, color.NRGBA{A: 255})
+	writeSolidPNG(t, whitePath, 64, 48, color.NRGBA{R: 255, G: 255, B: 255, A: 255})
+	writeTestPNG(t, normalPath, 200, 150)
+
+	black, err := AnalyzeImage(blackPath)
+	if err != nil {
+		t.Fatalf("AnalyzeImage failed: %v", err)
+	}
+	if black.MeanLuma != 0 || black.MaxLuma != 0 || black.Problem() == "" {
+		t.Errorf("Expected a solid black image to be flagged, got %+v (%q)", black, black.Problem())
+	}
+
+	white, err := AnalyzeImage(whitePath)
+	if err != nil {
+		t.Fatalf("AnalyzeImage failed: %v", err)
+	}
+	if white.MinLuma < 254.9 || white.Problem() == "" {
+		t.Errorf("Expected a solid white image to be flagged as blown out, got %+v (%q)", white, white.Problem())
+	}
+
+	normal, err := AnalyzeImage(normalPath)
+	if err != nil {
+		t.Fatalf("AnalyzeImage failed: %v", err)
+	}
+	if problem := normal.Problem(); problem != "" {
+		t.Errorf("Expected a normal image to pass, got %q for %+v", problem, normal)
+	}
+	if !(normal.MinLuma < normal.MeanLuma && normal.MeanLuma < normal.MaxLuma) {
+		t.Errorf("Expected min < mean < max for a gradient, got %+v", normal)
+	}
+}
+
+// TestPngPileSkipBadImages tests that SkipBadImages drops black and undecodable images from the pile
+func TestPngPileSkipBadImages(t *testing.T) {
+	pngDir := setupPngPileDir(t)
+	// image_0 stays the fake (undecodable) PNG from setup
+	writeSolidPNG(t, filepath.Join(pngDir, "image_1.png"), 32, 32, color.NRGBA{A: 255})
+	writeTestPNG(t, filepath.Join(pngDir, "image_2.png"), 32, 32)
+
+	config := &PngPileConfig{
+		Duration:      10,
+		TotalImages:   3,
+		OutputDir:     pngDir,
+		UseExisting:   true,
+		SkipBadImages: true,
+	}
+	fcpxml, err := GeneratePngPileWithConfig(config, false)
+	if err != nil {
+		t.Fatalf("GeneratePngPileWithConfig failed: %v", err)
+	}
+
+	images := fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine.AssetClips[0].Videos
+	if len(images) != 1 || !strings.HasSuffix(images[0].Name, "image_2") {
+		names := []string{}
+		for _, image := range images {
+			names = append(names, image.Name)
+		}
+		t.Errorf("Expected only image_2 to survive, got %v", names)
+	}
+}
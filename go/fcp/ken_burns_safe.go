@@ -0,0 +1,217 @@
+package fcp
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// KenBurnsRect is the part of an image the frame should show, in fractions of the image
+// (0-1, origin top-left). A rect whose aspect differs from the frame is shown cropped to the
+// frame's aspect around its center.
+type KenBurnsRect struct {
+	X      float64
+	Y      float64
+	Width  float64
+	Height float64
+}
+
+// FullImageRect shows the whole image (as much of it as covers the frame)
+var FullImageRect = KenBurnsRect{X: 0, Y: 0, Width: 1, Height: 1}
+
+// ParseKenBurnsRect parses "x y width height" fractions, e.g. "0.25 0.25 0.5 0.5"
+func ParseKenBurnsRect(value string) (KenBurnsRect, error) {
+	fields := strings.Fields(value)
+	if len(fields) != 4 {
+		return KenBurnsRect{}, fmt.Errorf("invalid rect '%s': expected 'x y width height'", value)
+	}
+	var numbers [4]float64
+	for i, field := range fields {
+		number, err := strconv.ParseFloat(field, 64)
+		if err != nil {
+			return KenBurnsRect{}, fmt.Errorf("invalid rect '%s': %v", value, err)
+		}
+		numbers[i] = number
+	}
+	if numbers[2] <= 0 || numbers[3] <= 0 {
+		return KenBurnsRect{}, fmt.Errorf("invalid rect '%s': width and height must be positive", value)
+	}
+	return KenBurnsRect{X: numbers[0], Y: numbers[1], Width: numbers[2], Height: numbers[3]}, nil
+}
+
+// kenBurnsFit is an image as FCP places it before adjust-transform: fitted inside the frame.
+// All sizes are adjust-transform units (percent of frame height).
+type kenBurnsFit struct {
+	frameWidth, frameHeight float64
+	imageWidth, imageHeight float64
+}
+
+func newKenBurnsFit(imageAspect, frameAspect float64) kenBurnsFit {
+	fit := kenBurnsFit{frameWidth: 100 * frameAspect, frameHeight: 100}
+	if imageAspect > frameAspect {
+		fit.imageWidth, fit.imageHeight = fit.frameWidth, fit.frameWidth/imageAspect
+	} else {
+		fit.imageWidth, fit.imageHeight = 100*imageAspect, 100
+	}
+	return fit
+}
+
+// minScale is the smallest scale at which the image covers the whole frame
+func (f kenBurnsFit) minScale() float64 {
+	return math.Max(f.frameWidth/f.imageWidth, f.frameHeight/f.imageHeight)
+}
+
+// clamp raises scale to minScale and pulls the position in until no image edge is inside the
+// frame. The bounds are linear in scale, so a linear move between two clamped keyframes stays
+// covered the whole way. changed reports whether anything had to move.
+func (f kenBurnsFit) clamp(scale, x, y float64) (float64, float64, float64, bool) {
+	changed := false
+	if minimum := f.minScale(); scale < minimum-1e-9 {
+		scale, changed = minimum, true
+	}
+	scale = roundUp4(scale)
+
+	maxX := (f.imageWidth*scale - f.frameWidth) / 2
+	maxY := (f.imageHeight*scale - f.frameHeight) / 2
+	clampedX := math.Max(-maxX, math.Min(maxX, x))
+	clampedY := math.Max(-maxY, math.Min(maxY, y))
+	if math.Abs(clampedX-x) > 1e-9 || math.Abs(clampedY-y) > 1e-9 {
+		changed = true
+	}
+	return scale, truncate4(clampedX), truncate4(clampedY), changed
+}
+
+// rectTransform is the scale and position that center rect in the frame at the size it asks for
+func (f kenBurnsFit) rectTransform(rect KenBurnsRect) (float64, float64, float64) {
+	scale := math.Max(f.frameWidth/(f.imageWidth*rect.Width), f.frameHeight/(f.imageHeight*rect.Height))
+	centerX := rect.X + rect.Width/2
+	centerY := rect.Y + rect.Height/2
+	// Moving the image left brings its right side to the frame center; +Y is up in FCP
+	return scale, -(centerX - 0.5) * f.imageWidth * scale, (centerY - 0.5) * f.imageHeight * scale
+}
+
+// roundUp4 and truncate4 keep the 4-decimal values written to FCPXML on the covered side
+func roundUp4(value float64) float64 { return math.Ceil(value*10000-1e-6) / 10000 }
+
+func truncate4(value float64) float64 {
+	if truncated := math.Trunc(value*10000) / 10000; truncated != 0 {
+		return truncated
+	}
+	return 0 // No "-0.0000" positions
+}
+
+// AddImageKenBurns adds an image that moves from showing start to showing end over its whole
+// duration. The image's real pixel size decides how far it can zoom out and pan: a rect that
+// would reveal the frame edge is clamped, and a warning saying so is returned. opts applies as
+// in AddImageWithOptions; its Slide is ignored.
+//
+// 🚨 CLAUDE.md Rules Applied Here:
+// - Same keyframes as the stock AddImageWithSlide move (kenBurnsTransform), clamped by the same kenBurnsFit
+// - Keyframes run from the image's start to start + duration (image local time, sequence timebase)
+// - Pixel dimensions are read from the image file; an undecodable image's format is only the frame size
+func AddImageKenBurns(fcpxml *FCPXML, imagePath string, durationSeconds float64, start, end KenBurnsRect, opts ImageOptions) ([]string, error) {
+	opts.Slide = false
+	if err := AddImageWithOptions(fcpxml, imagePath, durationSeconds, opts); err != nil {
+		return nil, err
+	}
+	sequence, err := firstSequence(fcpxml)
+	if err != nil {
+		return nil, err
+	}
+
+	image, err := lastAddedImage(sequence, opts)
+	if err != nil {
+		return nil, err
+	}
+	fit, err := imageKenBurnsFit(fcpxml, sequence, image.ref)
+	if err != nil {
+		return nil, err
+	}
+
+	rate := SequenceFrameRate(fcpxml, sequence)
+	transform, warnings := createKenBurnsRectAnimation(fit, start, end, image.start, rate.addSeconds(image.start, durationSeconds))
+	*image.transform = transform
+	return warnings, nil
+}
+
+// createKenBurnsRectAnimation is the Ken Burns move from showing start at startTime to showing
+// end at endTime, with each rect clamped to fit. It returns a warning for each rect that moved.
+func createKenBurnsRectAnimation(fit kenBurnsFit, start, end KenBurnsRect, startTime, endTime string) (*AdjustTransform, []string) {
+	startScale, startX, startY, startClamped := fit.clamp(fit.rectTransform(start))
+	endScale, endX, endY, endClamped := fit.clamp(fit.rectTransform(end))
+
+	var warnings []string
+	if startClamped {
+		warnings = append(warnings, fmt.Sprintf("Ken Burns start rect would reveal the frame edge; clamped to scale %.4f", startScale))
+	}
+	if endClamped {
+		warnings = append(warnings, fmt.Sprintf("Ken Burns end rect would reveal the frame edge; clamped to scale %.4f", endScale))
+	}
+
+	return kenBurnsTransform(startTime, endTime,
+		fmt.Sprintf("%.4f %.4f", startX, startY), fmt.Sprintf("%.4f %.4f", endX, endY),
+		fmt.Sprintf("%.4f %.4f", startScale, startScale), fmt.Sprintf("%.4f %.4f", endScale, endScale)), warnings
+}
+
+// imageKenBurnsFit reads the pixel size of the image asset assetID and fits it to the sequence frame
+func imageKenBurnsFit(fcpxml *FCPXML, sequence *Sequence, assetID string) (kenBurnsFit, error) {
+	var asset *Asset
+	for i := range fcpxml.Resources.Assets {
+		if fcpxml.Resources.Assets[i].ID == assetID {
+			asset = &fcpxml.Resources.Assets[i]
+		}
+	}
+	if asset == nil {
+		return kenBurnsFit{}, fmt.Errorf("video '%s' is not an image asset", assetID)
+	}
+	width, height, err := imageDimensions(mediaSourcePath(asset.MediaRep.Src))
+	if err != nil {
+		return kenBurnsFit{}, fmt.Errorf("failed to read image size of '%s': %v", asset.Name, err)
+	}
+
+	frameAspect := 16.0 / 9.0
+	if frameWidth, frameHeight, err := sequenceFrameSize(fcpxml, sequence); err == nil {
+		frameAspect = frameWidth / frameHeight
+	}
+	return newKenBurnsFit(float64(width)/float64(height), frameAspect), nil
+}
+
+// clampKenBurnsTransform applies fit.clamp to every scale/position keyframe pair of a Ken Burns
+// transform, reporting whether any keyframe moved. Keyframes are paired by index.
+func clampKenBurnsTransform(transform *AdjustTransform, fit kenBurnsFit) bool {
+	var position, scale *Param
+	for i := range transform.Params {
+		switch transform.Params[i].Name {
+		case "position":
+			position = &transform.Params[i]
+		case "scale":
+			scale = &transform.Params[i]
+		}
+	}
+	if position == nil || scale == nil || position.KeyframeAnimation == nil || scale.KeyframeAnimation == nil {
+		return false
+	}
+	positions, scales := position.KeyframeAnimation.Keyframes, scale.KeyframeAnimation.Keyframes
+	if len(positions) != len(scales) {
+		return false
+	}
+
+	changed := false
+	for i := range positions {
+		var x, y, scaleX, scaleY float64
+		if _, err := fmt.Sscanf(positions[i].Value, "%g %g", &x, &y); err != nil {
+			continue
+		}
+		if _, err := fmt.Sscanf(scales[i].Value, "%g %g", &scaleX, &scaleY); err != nil {
+			continue
+		}
+		s, cx, cy, moved := fit.clamp(math.Min(scaleX, scaleY), x, y)
+		if moved {
+			positions[i].Value = strconv.FormatFloat(cx, 'f', -1, 64) + " " + strconv.FormatFloat(cy, 'f', -1, 64)
+			scales[i].Value = strconv.FormatFloat(math.Max(s, scaleX), 'f', -1, 64) + " " + strconv.FormatFloat(math.Max(s, scaleY), 'f', -1, 64)
+			changed = true
+		}
+	}
+	return changed
+}
@@ -0,0 +1,139 @@
+package fcp
+
+import (
+	"fmt"
+	"math"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// assertKenBurnsCovers checks that every scale/position keyframe keeps an image of imageAspect
+// covering a 16:9 frame
+func assertKenBurnsCovers(t *testing.T, transform *AdjustTransform, imageAspect float64) {
+	t.Helper()
+
+	frameWidth := 100 * 16.0 / 9.0
+	imageWidth, imageHeight := 100*imageAspect, 100.0
+	if imageAspect > 16.0/9.0 {
+		imageWidth, imageHeight = frameWidth, frameWidth/imageAspect
+	}
+
+	var positions, scales []Keyframe
+	for _, param := range transform.Params {
+		switch param.Name {
+		case "position":
+			positions = param.KeyframeAnimation.Keyframes
+		case "scale":
+			scales = param.KeyframeAnimation.Keyframes
+		}
+	}
+	if len(positions) == 0 || len(positions) != len(scales) {
+		t.Fatalf("Expected matching position and scale keyframes, got %d and %d", len(positions), len(scales))
+	}
+
+	for i := range positions {
+		var x, y, scale, scaleY float64
+		fmt.Sscanf(positions[i].Value, "%g %g", &x, &y)
+		fmt.Sscanf(scales[i].Value, "%g %g", &scale, &scaleY)
+		slackX := (imageWidth*scale - frameWidth) / 2
+		slackY := (imageHeight*scale - 100) / 2
+		if slackX < -1e-9 || slackY < -1e-9 || math.Abs(x) > slackX+1e-9 || math.Abs(y) > slackY+1e-9 {
+			t.Errorf("Keyframe %d (scale %s, position %s) reveals the frame edge", i, scales[i].Value, positions[i].Value)
+		}
+	}
+}
+
+// kenBurnsKeyframes returns the keyframes of the transform param called name
+func kenBurnsKeyframes(t *testing.T, transform *AdjustTransform, name string) []Keyframe {
+	t.Helper()
+	for _, param := range transform.Params {
+		if param.Name == name && param.KeyframeAnimation != nil {
+			return param.KeyframeAnimation.Keyframes
+		}
+	}
+	t.Fatalf("Expected %s keyframes in %+v", name, transform.Params)
+	return nil
+}
+
+// TestAddImageKenBurnsClamped tests that an end rect hanging off the image is clamped so no edge shows
+func TestAddImageKenBurnsClamped(t *testing.T) {
+	imagePath := filepath.Join(t.TempDir(), "photo.png")
+	writeTestPNG(t, imagePath, 400, 300)
+
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("GenerateEmpty failed: %v", err)
+	}
+
+	// The end rect's center is past the bottom-right corner of the image
+	end := KenBurnsRect{X: 0.8, Y: 0.8, Width: 0.5, Height: 0.5}
+	warnings, err := AddImageKenBurns(fcpxml, imagePath, 5.0, FullImageRect, end, ImageOptions{})
+	if err != nil {
+		t.Fatalf("AddImageKenBurns failed: %v", err)
+	}
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "end rect") {
+		t.Errorf("Expected a warning for the clamped end rect only, got %q", warnings)
+	}
+
+	video := fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine.Videos[0]
+	assertKenBurnsCovers(t, video.AdjustTransform, 4.0/3.0)
+
+	// A 4:3 image must be scaled up by 16:9 / 4:3 before it fills the frame
+	scales := kenBurnsKeyframes(t, video.AdjustTransform, "scale")
+	if scales[0].Value != "1.3334 1.3334" {
+		t.Errorf("Expected the full-image start to be raised to the 1.3334 cover scale, got %s", scales[0].Value)
+	}
+	if scales[0].Time != video.Start || scales[1].Time != DefaultFrameRate.addSeconds(video.Start, 5.0) {
+		t.Errorf("Keyframes should span the clip, got %s to %s", scales[0].Time, scales[1].Time)
+	}
+}
+
+// TestAddImageKenBurnsInBounds tests that a rect the image can show is used as requested, on an
+// asset-clip image as well as a video
+func TestAddImageKenBurnsInBounds(t *testing.T) {
+	imagePath := filepath.Join(t.TempDir(), "photo.png")
+	writeTestPNG(t, imagePath, 400, 300)
+
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("GenerateEmpty failed: %v", err)
+	}
+
+	center, _ := ParseKenBurnsRect("0.25 0.25 0.5 0.5")
+	warnings, err := AddImageKenBurns(fcpxml, imagePath, 5.0, center, center, ImageOptions{Element: ImageElementAssetClip})
+	if err != nil {
+		t.Fatalf("AddImageKenBurns failed: %v", err)
+	}
+	if len(warnings) != 0 {
+		t.Errorf("Expected no warnings for an in-bounds rect, got %q", warnings)
+	}
+
+	transform := fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine.AssetClips[0].AdjustTransform
+	if position := kenBurnsKeyframes(t, transform, "position")[1].Value; position != "0.0000 0.0000" {
+		t.Errorf("Expected the centered rect at position 0 0, got %s", position)
+	}
+	if scale := kenBurnsKeyframes(t, transform, "scale")[1].Value; scale != "2.6667 2.6667" {
+		t.Errorf("Expected the half-size rect at scale 2.6667, got %s", scale)
+	}
+
+	if _, err := ParseKenBurnsRect("0.1 0.1 0 0.5"); err == nil {
+		t.Errorf("Expected an error for a zero-width rect")
+	}
+}
+
+// TestAddImageSlideClampedForPortrait tests that the stock slide zoom never reveals edges of a tall photo
+func TestAddImageSlideClampedForPortrait(t *testing.T) {
+	imagePath := filepath.Join(t.TempDir(), "portrait.png")
+	writeTestPNG(t, imagePath, 300, 600)
+
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("GenerateEmpty failed: %v", err)
+	}
+	if err := AddImageWithSlide(fcpxml, imagePath, 5.0, true); err != nil {
+		t.Fatalf("AddImageWithSlide failed: %v", err)
+	}
+
+	assertKenBurnsCovers(t, fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine.Videos[0].AdjustTransform, 0.5)
+}
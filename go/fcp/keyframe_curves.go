@@ -0,0 +1,58 @@
+package fcp
+
+import "reflect"
+
+// Keyframe curve values accepted on scale, rotation and anchor keyframes
+const (
+	CurveLinear = "linear"
+	CurveSmooth = "smooth"
+)
+
+// curveParams are the adjust-transform params whose keyframes may carry a curve attribute;
+// position keyframes must have none (see keyframe_validation.go)
+var curveParams = map[string]bool{"scale": true, "rotation": true, "anchor": true}
+
+// SmoothKeyframeCurves switches every linear scale, rotation and anchor keyframe in the document
+// to a smooth (bezier) curve and returns how many keyframes changed.
+//
+// 🚨 CLAUDE.md Rules Applied Here:
+// - Position keyframes are never touched, so they stay free of interp/curve attributes
+// - Only keyframes that say "linear" change; ones without a curve already use FCP's smooth default
+// - Generators keep writing linear curves, so this runs as a pass after generation like SimplifyAnimations
+func SmoothKeyframeCurves(fcpxml *FCPXML) int {
+	changed := 0
+	paramType := reflect.TypeOf(Param{})
+
+	var visit func(value reflect.Value)
+	visit = func(value reflect.Value) {
+		switch value.Kind() {
+		case reflect.Ptr:
+			if !value.IsNil() {
+				visit(value.Elem())
+			}
+		case reflect.Struct:
+			if value.Type() == paramType && value.CanAddr() && value.Addr().CanInterface() {
+				param := value.Addr().Interface().(*Param)
+				if curveParams[param.Name] && param.KeyframeAnimation != nil {
+					for i := range param.KeyframeAnimation.Keyframes {
+						keyframe := &param.KeyframeAnimation.Keyframes[i]
+						if keyframe.Curve == CurveLinear {
+							keyframe.Curve = CurveSmooth
+							changed++
+						}
+					}
+				}
+			}
+			for i := 0; i < value.NumField(); i++ {
+				visit(value.Field(i))
+			}
+		case reflect.Slice:
+			for i := 0; i < value.Len(); i++ {
+				visit(value.Index(i))
+			}
+		}
+	}
+	visit(reflect.ValueOf(fcpxml))
+
+	return changed
+}
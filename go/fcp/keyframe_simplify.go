@@ -0,0 +1,139 @@
+package fcp
+
+import (
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// SimplifyKeyframes removes keyframes that lie within tolerance of the straight line between the
+// keyframes kept around them (Ramer–Douglas–Peucker over time). The distance is measured in the
+// parameter's own units: for "x y" positions the distance in pixels, for scale in scale units.
+// The first and last keyframes are always kept, as are the attributes of every kept keyframe.
+//
+// 🚨 CLAUDE.md Rules Applied Here:
+// - Animations whose values don't all parse as numbers with the same component count are left alone
+// - Tolerance 0 only drops keyframes that are exactly on the line
+// - Removed keyframes are measured against linear interpolation, the curve every generator writes
+func SimplifyKeyframes(anim *KeyframeAnimation, tolerance float64) {
+	if anim == nil || len(anim.Keyframes) < 3 || tolerance < 0 {
+		return
+	}
+
+	points := make([]keyframePoint, len(anim.Keyframes))
+	for i, keyframe := range anim.Keyframes {
+		values, ok := parseKeyframeValues(keyframe.Value)
+		if !ok || (i > 0 && len(values) != len(points[0].values)) {
+			return
+		}
+		points[i] = keyframePoint{seconds: fcpDurationToSeconds(keyframe.Time), values: values}
+	}
+
+	keep := make([]bool, len(points))
+	keep[0], keep[len(points)-1] = true, true
+	simplifyRange(points, 0, len(points)-1, tolerance, keep)
+
+	kept := make([]Keyframe, 0, len(points))
+	for i, keyframe := range anim.Keyframes {
+		if keep[i] {
+			kept = append(kept, keyframe)
+		}
+	}
+	anim.Keyframes = kept
+}
+
+// SimplifyAnimations runs SimplifyKeyframes over every keyframe animation in the document and
+// returns how many keyframes were removed
+func SimplifyAnimations(fcpxml *FCPXML, tolerance float64) int {
+	removed := 0
+	animationType := reflect.TypeOf(&KeyframeAnimation{})
+
+	var visit func(value reflect.Value)
+	visit = func(value reflect.Value) {
+		switch value.Kind() {
+		case reflect.Ptr:
+			if value.IsNil() {
+				return
+			}
+			if value.Type() == animationType && value.CanInterface() {
+				anim := value.Interface().(*KeyframeAnimation)
+				before := len(anim.Keyframes)
+				SimplifyKeyframes(anim, tolerance)
+				removed += before - len(anim.Keyframes)
+				return
+			}
+			visit(value.Elem())
+		case reflect.Struct:
+			for i := 0; i < value.NumField(); i++ {
+				visit(value.Field(i))
+			}
+		case reflect.Slice:
+			for i := 0; i < value.Len(); i++ {
+				visit(value.Index(i))
+			}
+		}
+	}
+	visit(reflect.ValueOf(fcpxml))
+
+	return removed
+}
+
+// keyframePoint is a keyframe as a point in time with one or more numeric components
+type keyframePoint struct {
+	seconds float64
+	values  []float64
+}
+
+// simplifyRange marks the keyframes between first and last that must be kept to stay within tolerance
+func simplifyRange(points []keyframePoint, first, last int, tolerance float64, keep []bool) {
+	if last-first < 2 {
+		return
+	}
+
+	farthest, distance := -1, -1.0
+	for i := first + 1; i < last; i++ {
+		if d := deviationFromLine(points[first], points[last], points[i]); d > distance {
+			farthest, distance = i, d
+		}
+	}
+	if distance <= tolerance {
+		return
+	}
+
+	keep[farthest] = true
+	simplifyRange(points, first, farthest, tolerance, keep)
+	simplifyRange(points, farthest, last, tolerance, keep)
+}
+
+// deviationFromLine is how far point is from the value linear interpolation between start and end
+// gives at point's time
+func deviationFromLine(start, end, point keyframePoint) float64 {
+	t := 0.0
+	if span := end.seconds - start.seconds; span > 0 {
+		t = (point.seconds - start.seconds) / span
+	}
+	sum := 0.0
+	for i := range point.values {
+		expected := start.values[i] + (end.values[i]-start.values[i])*t
+		sum += (point.values[i] - expected) * (point.values[i] - expected)
+	}
+	return math.Sqrt(sum)
+}
+
+// parseKeyframeValues reads a keyframe value such as "1.5" or "-80 40"
+func parseKeyframeValues(value string) ([]float64, bool) {
+	fields := strings.Fields(value)
+	if len(fields) == 0 {
+		return nil, false
+	}
+	values := make([]float64, len(fields))
+	for i, field := range fields {
+		number, err := strconv.ParseFloat(field, 64)
+		if err != nil {
+			return nil, false
+		}
+		values[i] = number
+	}
+	return values, true
+}
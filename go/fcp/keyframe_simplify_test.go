@@ -0,0 +1,126 @@
+package fcp
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+// TestSimplifyKeyframesLinearRamp tests that a dense linear ramp collapses to its two endpoints
+func TestSimplifyKeyframesLinearRamp(t *testing.T) {
+	anim := &KeyframeAnimation{}
+	for i := 0; i <= 60; i++ {
+		anim.Keyframes = append(anim.Keyframes, Keyframe{
+			Time:  fmt.Sprintf("%d/24000s", i*1001),
+			Value: fmt.Sprintf("%.4f %.4f", float64(i)*2, float64(i)*-1.5),
+		})
+	}
+	first, last := anim.Keyframes[0], anim.Keyframes[60]
+
+	SimplifyKeyframes(anim, 0.01)
+
+	if len(anim.Keyframes) != 2 {
+		t.Fatalf("Expected the ramp to collapse to 2 keyframes, got %d", len(anim.Keyframes))
+	}
+	if anim.Keyframes[0] != first || anim.Keyframes[1] != last {
+		t.Errorf("Expected the first and last keyframes to be kept, got %+v", anim.Keyframes)
+	}
+}
+
+// TestSimplifyKeyframesCurvedPath tests that a curved path keeps its shape within tolerance
+func TestSimplifyKeyframesCurvedPath(t *testing.T) {
+	const tolerance = 1.0
+	const radius = 100.0
+
+	circle := func(seconds float64) (float64, float64) {
+		angle := seconds / 10 * 2 * math.Pi
+		return radius * math.Cos(angle), radius * math.Sin(angle)
+	}
+
+	anim := &KeyframeAnimation{}
+	for i := 0; i <= 100; i++ {
+		x, y := circle(float64(i) / 10)
+		anim.Keyframes = append(anim.Keyframes, Keyframe{
+			Time:  fmt.Sprintf("%d/10s", i),
+			Value: fmt.Sprintf("%.4f %.4f", x, y),
+		})
+	}
+
+	SimplifyKeyframes(anim, tolerance)
+
+	if len(anim.Keyframes) >= 101 || len(anim.Keyframes) < 8 {
+		t.Fatalf("Expected a circle to keep a reduced but non-trivial set of keyframes, got %d", len(anim.Keyframes))
+	}
+
+	// Every original sample must be within tolerance of the simplified path
+	for i := 0; i <= 100; i++ {
+		seconds := float64(i) / 10
+		x, y := circle(seconds)
+		for k := 1; k < len(anim.Keyframes); k++ {
+			startTime := fcpDurationToSeconds(anim.Keyframes[k-1].Time)
+			endTime := fcpDurationToSeconds(anim.Keyframes[k].Time)
+			if seconds < startTime || seconds > endTime {
+				continue
+			}
+			start, _ := parseKeyframeValues(anim.Keyframes[k-1].Value)
+			end, _ := parseKeyframeValues(anim.Keyframes[k].Value)
+			p := (seconds - startTime) / (endTime - startTime)
+			dx := start[0] + (end[0]-start[0])*p - x
+			dy := start[1] + (end[1]-start[1])*p - y
+			if math.Hypot(dx, dy) > tolerance+0.001 {
+				t.Errorf("Sample at %.1fs is %.3f away from the simplified path", seconds, math.Hypot(dx, dy))
+			}
+			break
+		}
+	}
+}
+
+// TestSimplifyKeyframesLeavesNonNumeric tests that values that aren't numbers are not touched
+func TestSimplifyKeyframesLeavesNonNumeric(t *testing.T) {
+	anim := &KeyframeAnimation{Keyframes: []Keyframe{
+		{Time: "0s", Value: "0"},
+		{Time: "1s", Value: "abc"},
+		{Time: "2s", Value: "2"},
+	}}
+	SimplifyKeyframes(anim, 1)
+	if len(anim.Keyframes) != 3 {
+		t.Errorf("Expected non-numeric animation to be left alone, got %d keyframes", len(anim.Keyframes))
+	}
+}
+
+// TestSimplifyAnimations tests that every animation in the document is simplified
+func TestSimplifyAnimations(t *testing.T) {
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("GenerateEmpty failed: %v", err)
+	}
+	ramp := func() *KeyframeAnimation {
+		return &KeyframeAnimation{Keyframes: []Keyframe{
+			{Time: "0s", Value: "1 1", Curve: "linear"},
+			{Time: "1s", Value: "1.5 1.5", Curve: "linear"},
+			{Time: "2s", Value: "2 2", Curve: "linear"},
+		}}
+	}
+	sequence := &fcpxml.Library.Events[0].Projects[0].Sequences[0]
+	sequence.Spine.Videos = append(sequence.Spine.Videos, Video{
+		Name: "Ramp",
+		AdjustTransform: &AdjustTransform{Params: []Param{
+			{Name: "scale", KeyframeAnimation: ramp()},
+		}},
+		NestedAssetClips: []AssetClip{{
+			Name:            "Nested",
+			AdjustTransform: &AdjustTransform{Params: []Param{{Name: "scale", KeyframeAnimation: ramp()}}},
+		}},
+	})
+
+	if removed := SimplifyAnimations(fcpxml, 0.001); removed != 2 {
+		t.Errorf("Expected 2 keyframes removed, got %d", removed)
+	}
+	video := sequence.Spine.Videos[0]
+	if n := len(video.AdjustTransform.Params[0].KeyframeAnimation.Keyframes); n != 2 {
+		t.Errorf("Expected spine animation to keep 2 keyframes, got %d", n)
+	}
+	if n := len(video.NestedAssetClips[0].AdjustTransform.Params[0].KeyframeAnimation.Keyframes); n != 2 {
+		t.Errorf("Expected nested animation to keep 2 keyframes, got %d", n)
+	}
+}
@@ -1,6 +1,7 @@
 package fcp
 
 import (
+	"strings"
 	"testing"
 )
 
@@ -665,4 +666,56 @@ func TestNewValidatedKeyframe(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+func TestValidateClaudeComplianceKeyframeAttributes(t *testing.T) {
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("Failed to create empty FCPXML: %v", err)
+	}
+
+	sequence := &fcpxml.Library.Events[0].Projects[0].Sequences[0]
+	sequence.Spine.Videos = append(sequence.Spine.Videos, Video{
+		Name:     "moving",
+		Offset:   "0s",
+		Duration: "240240/24000s",
+		AdjustTransform: &AdjustTransform{
+			Params: []Param{
+				{
+					Name: "position",
+					KeyframeAnimation: &KeyframeAnimation{
+						Keyframes: []Keyframe{
+							{Time: "0s", Value: "0 0"},
+							{Time: "120120/24000s", Value: "10 0", Curve: "linear"},
+						},
+					},
+				},
+				{
+					Name: "scale",
+					KeyframeAnimation: &KeyframeAnimation{
+						Keyframes: []Keyframe{
+							{Time: "0s", Value: "1 1", Curve: "linear"},
+							{Time: "120120/24000s", Value: "2 2", Interp: "easeIn"},
+						},
+					},
+				},
+			},
+		},
+	})
+
+	var positionViolations, scaleViolations []string
+	for _, violation := range ValidateClaudeCompliance(fcpxml) {
+		switch {
+		case strings.Contains(violation, "Position keyframe with interp/curve"):
+			positionViolations = append(positionViolations, violation)
+		case strings.Contains(violation, "Interp attribute on scale keyframe"):
+			scaleViolations = append(scaleViolations, violation)
+		}
+	}
+
+	if len(positionViolations) != 1 || !strings.Contains(positionViolations[0], "param 'position'[1]") {
+		t.Errorf("Expected one position violation at keyframe 1, got %v", positionViolations)
+	}
+	if len(scaleViolations) != 1 || !strings.Contains(scaleViolations[0], "param 'scale'[1]") {
+		t.Errorf("Expected one scale interp violation at keyframe 1, got %v", scaleViolations)
+	}
+}
@@ -0,0 +1,112 @@
+package fcp
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// customLUTEffectUID is FCP's built-in Custom LUT effect (see TestColorGradingEffects)
+const customLUTEffectUID = "FFCustomLUT"
+
+// Custom LUT param keys, as used in TestColorGradingEffects
+const (
+	customLUTFileKey = "9999/999166631/999166639/1"
+	customLUTMixKey  = "9999/999166631/999166639/2"
+)
+
+// ApplyLUT grades every video clip on the spine with the .cube file at lutPath through
+// FCP's Custom LUT effect. Clips that already carry the LUT effect get the new file instead
+// of a second LUT, so re-running with another look swaps it.
+//
+// 🚨 CLAUDE.md Rules Applied Here:
+// - LUT file checked on disk and referenced by absolute path, like media assets
+// - Custom LUT effect created once through the Transaction; its UID is checked against fictionalEffectUIDs
+// - Audio-only asset-clips are skipped: a LUT on a clip without video does nothing
+func ApplyLUT(fcpxml *FCPXML, lutPath string) error {
+	if !strings.EqualFold(filepath.Ext(lutPath), ".cube") {
+		return fmt.Errorf("LUT file must be a .cube file: %s", lutPath)
+	}
+	absPath, err := filepath.Abs(lutPath)
+	if err != nil {
+		return fmt.Errorf("failed to get absolute path: %v", err)
+	}
+	if _, err := os.Stat(absPath); err != nil {
+		return fmt.Errorf("LUT file does not exist: %s", absPath)
+	}
+
+	params := []Param{
+		{Name: "LUT File", Key: customLUTFileKey, Value: absPath},
+		{Name: "Mix", Key: customLUTMixKey, Value: "1"},
+	}
+	return applyGradeFilter(fcpxml, "Custom LUT", customLUTEffectUID, params)
+}
+
+// applyGradeFilter attaches the effect with uid to the spine's video clips and stills
+// (generators have no asset and are left alone), replacing the params of a copy that is already attached
+func applyGradeFilter(fcpxml *FCPXML, name, uid string, params []Param) error {
+	if fictionalEffectUIDs[uid] {
+		return fmt.Errorf("effect UID '%s' is not a built-in FCP effect", uid)
+	}
+
+	sequence, err := firstSequence(fcpxml)
+	if err != nil {
+		return err
+	}
+
+	videoAssets := make(map[string]bool)
+	for _, asset := range fcpxml.Resources.Assets {
+		if asset.HasVideo == "1" {
+			videoAssets[asset.ID] = true
+		}
+	}
+
+	var targets []*[]FilterVideo
+	for i := range sequence.Spine.AssetClips {
+		if videoAssets[sequence.Spine.AssetClips[i].Ref] {
+			targets = append(targets, &sequence.Spine.AssetClips[i].FilterVideos)
+		}
+	}
+	for i := range sequence.Spine.Videos {
+		if videoAssets[sequence.Spine.Videos[i].Ref] {
+			targets = append(targets, &sequence.Spine.Videos[i].FilterVideos)
+		}
+	}
+	if len(targets) == 0 {
+		return fmt.Errorf("no video clips on the timeline to grade")
+	}
+
+	effectID := findEffectIDByUID(fcpxml, uid)
+	if effectID == "" {
+		registry := NewResourceRegistry(fcpxml)
+		tx := NewTransaction(registry)
+		defer tx.Rollback()
+
+		effectID = tx.ReserveIDs(1)[0]
+		if _, err := tx.CreateEffect(effectID, name, uid); err != nil {
+			return fmt.Errorf("failed to create %s effect: %v", name, err)
+		}
+		if err := tx.Commit(); err != nil {
+			return fmt.Errorf("failed to commit transaction: %v", err)
+		}
+	}
+
+	for _, filters := range targets {
+		attached := false
+		for i := range *filters {
+			if (*filters)[i].Ref == effectID {
+				(*filters)[i].Params = append([]Param(nil), params...)
+				attached = true
+			}
+		}
+		if !attached {
+			*filters = append(*filters, FilterVideo{
+				Ref:    effectID,
+				Name:   name,
+				Params: append([]Param(nil), params...),
+			})
+		}
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package fcp
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// colorPresetLUTSize is the number of points per axis in a preset's .cube file
+const colorPresetLUTSize = 17
+
+// colorPresets are the looks ApplyColorPreset can grade with. FCP has no verified built-in
+// look effects, so each preset is a color transform written out as a .cube file and applied
+// through the same Custom LUT effect as ApplyLUT.
+var colorPresets = map[string]func(r, g, b float64) (float64, float64, float64){
+	// Warmer highlights, slightly cooler blues
+	"warm": func(r, g, b float64) (float64, float64, float64) {
+		return r*1.06 + 0.02, g*1.01 + 0.01, b * 0.9
+	},
+	// Bluer shadows and highlights with a touch less red
+	"cool": func(r, g, b float64) (float64, float64, float64) {
+		return r * 0.92, g * 1.0, b*1.06 + 0.02
+	},
+	// Rec. 709 luma in every channel
+	"mono": func(r, g, b float64) (float64, float64, float64) {
+		luma := 0.2126*r + 0.7152*g + 0.0722*b
+		return luma, luma, luma
+	},
+	// Lifted blacks and softened whites, like faded film stock
+	"fade": func(r, g, b float64) (float64, float64, float64) {
+		return r*0.85 + 0.08, g*0.85 + 0.08, b*0.85 + 0.08
+	},
+}
+
+// ColorPresetNames lists the looks ApplyColorPreset accepts, sorted
+func ColorPresetNames() []string {
+	names := make([]string, 0, len(colorPresets))
+	for name := range colorPresets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// WriteColorPresetLUT writes preset as cutlass_look_<preset>.cube in dir and returns its
+// absolute path. The file is the same for every run, so an existing one is overwritten.
+func WriteColorPresetLUT(preset, dir string) (string, error) {
+	transform, ok := colorPresets[preset]
+	if !ok {
+		return "", fmt.Errorf("unknown color preset '%s' (available: %s)", preset, strings.Join(ColorPresetNames(), ", "))
+	}
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", fmt.Errorf("failed to get absolute path: %v", err)
+	}
+
+	var cube strings.Builder
+	fmt.Fprintf(&cube, "TITLE \"cutlass %s\"\n", preset)
+	fmt.Fprintf(&cube, "LUT_3D_SIZE %d\n", colorPresetLUTSize)
+	clamp := func(v float64) float64 { return math.Max(0, math.Min(1, v)) }
+	step := 1.0 / float64(colorPresetLUTSize-1)
+	// .cube order: red changes fastest, then green, then blue
+	for bi := 0; bi < colorPresetLUTSize; bi++ {
+		for gi := 0; gi < colorPresetLUTSize; gi++ {
+			for ri := 0; ri < colorPresetLUTSize; ri++ {
+				r, g, b := transform(float64(ri)*step, float64(gi)*step, float64(bi)*step)
+				fmt.Fprintf(&cube, "%.6f %.6f %.6f\n", clamp(r), clamp(g), clamp(b))
+			}
+		}
+	}
+
+	lutPath := filepath.Join(absDir, fmt.Sprintf("cutlass_look_%s.cube", preset))
+	if err := os.WriteFile(lutPath, []byte(cube.String()), 0644); err != nil {
+		return "", fmt.Errorf("failed to write LUT file: %v", err)
+	}
+	return lutPath, nil
+}
+
+// ApplyColorPreset grades every video clip on the spine with one of the ColorPresetNames looks.
+// The look's .cube file is written to lutDir, which must be kept next to the project: FCP
+// reads the LUT from that path when it opens the file.
+func ApplyColorPreset(fcpxml *FCPXML, preset, lutDir string) error {
+	lutPath, err := WriteColorPresetLUT(preset, lutDir)
+	if err != nil {
+		return err
+	}
+	return ApplyLUT(fcpxml, lutPath)
+}
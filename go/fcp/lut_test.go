@@ -0,0 +1,146 @@
+package fcp
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestApplyLUT tests that every video clip gets one Custom LUT filter referencing the .cube file
+func TestApplyLUT(t *testing.T) {
+	tempDir := t.TempDir()
+	lutPath := filepath.Join(tempDir, "look.cube")
+	if err := os.WriteFile(lutPath, []byte("LUT_3D_SIZE 2\n"), 0644); err != nil {
+		t.Fatalf("Failed to create test LUT: %v", err)
+	}
+
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("GenerateEmpty failed: %v", err)
+	}
+	for _, name := range []string{"a.mp4", "b.mp4"} {
+		videoPath := filepath.Join(tempDir, name)
+		if err := os.WriteFile(videoPath, []byte("fake video data"), 0644); err != nil {
+			t.Fatalf("Failed to create test video: %v", err)
+		}
+		if err := AddVideo(fcpxml, videoPath); err != nil {
+			t.Fatalf("AddVideo failed: %v", err)
+		}
+	}
+
+	if err := ApplyLUT(fcpxml, filepath.Join(tempDir, "missing.cube")); err == nil || !strings.Contains(err.Error(), "does not exist") {
+		t.Errorf("Expected missing LUT error, got %v", err)
+	}
+	if err := ApplyLUT(fcpxml, filepath.Join(tempDir, "a.mp4")); err == nil || !strings.Contains(err.Error(), ".cube") {
+		t.Errorf("Expected non-.cube error, got %v", err)
+	}
+
+	// Applying twice must not stack a second LUT
+	for i := 0; i < 2; i++ {
+		if err := ApplyLUT(fcpxml, lutPath); err != nil {
+			t.Fatalf("ApplyLUT failed: %v", err)
+		}
+	}
+
+	lutID := findEffectIDByUID(fcpxml, customLUTEffectUID)
+	if lutID == "" {
+		t.Fatal("Expected a Custom LUT effect resource")
+	}
+	clips := fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine.AssetClips
+	if len(clips) != 2 {
+		t.Fatalf("Expected 2 asset-clips, got %d", len(clips))
+	}
+	for i, clip := range clips {
+		if len(clip.FilterVideos) != 1 || clip.FilterVideos[0].Ref != lutID {
+			t.Fatalf("Clip %d: expected exactly one Custom LUT filter, got %+v", i, clip.FilterVideos)
+		}
+		if clip.FilterVideos[0].Params[0].Key != customLUTFileKey || clip.FilterVideos[0].Params[0].Value != lutPath {
+			t.Errorf("Clip %d: expected LUT File %s, got %+v", i, lutPath, clip.FilterVideos[0].Params[0])
+		}
+	}
+
+}
+
+// TestApplyLUTToStillWithConnectedClips tests that a graded still with connected clips stays DTD-valid,
+// since the filter must come after anchored items in a <video>
+func TestApplyLUTToStillWithConnectedClips(t *testing.T) {
+	tempDir := t.TempDir()
+	lutPath := filepath.Join(tempDir, "look.cube")
+	if err := os.WriteFile(lutPath, []byte("LUT_3D_SIZE 2\n"), 0644); err != nil {
+		t.Fatalf("Failed to create test LUT: %v", err)
+	}
+	imagePath := filepath.Join(tempDir, "still.png")
+	if err := os.WriteFile(imagePath, []byte("fake png data"), 0644); err != nil {
+		t.Fatalf("Failed to create test image: %v", err)
+	}
+
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("GenerateEmpty failed: %v", err)
+	}
+	if err := AddImage(fcpxml, imagePath, 5); err != nil {
+		t.Fatalf("AddImage failed: %v", err)
+	}
+	if err := AddSafeAreaGuides(fcpxml, 0); err != nil {
+		t.Fatalf("AddSafeAreaGuides failed: %v", err)
+	}
+	if err := ApplyLUT(fcpxml, lutPath); err != nil {
+		t.Fatalf("ApplyLUT failed: %v", err)
+	}
+
+	still := fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine.Videos[0]
+	if len(still.FilterVideos) != 1 || len(still.NestedVideos) == 0 {
+		t.Fatalf("Expected a graded still with connected guides, got %d filters and %d nested videos", len(still.FilterVideos), len(still.NestedVideos))
+	}
+
+	outputPath := filepath.Join(tempDir, "graded.fcpxml")
+	if err := WriteToFile(fcpxml, outputPath); err != nil {
+		t.Fatalf("WriteToFile failed: %v", err)
+	}
+	requireDTDValid(t, outputPath)
+}
+
+// TestApplyColorPreset tests that a preset is written as a full .cube file and applied through Custom LUT
+func TestApplyColorPreset(t *testing.T) {
+	tempDir := t.TempDir()
+	videoPath := filepath.Join(tempDir, "a.mp4")
+	if err := os.WriteFile(videoPath, []byte("fake video data"), 0644); err != nil {
+		t.Fatalf("Failed to create test video: %v", err)
+	}
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("GenerateEmpty failed: %v", err)
+	}
+	if err := AddVideo(fcpxml, videoPath); err != nil {
+		t.Fatalf("AddVideo failed: %v", err)
+	}
+
+	if err := ApplyColorPreset(fcpxml, "sepia", tempDir); err == nil || !strings.Contains(err.Error(), "unknown color preset") {
+		t.Errorf("Expected unknown preset error, got %v", err)
+	}
+	if err := ApplyColorPreset(fcpxml, "mono", tempDir); err != nil {
+		t.Fatalf("ApplyColorPreset failed: %v", err)
+	}
+
+	lutPath := filepath.Join(tempDir, "cutlass_look_mono.cube")
+	data, err := os.ReadFile(lutPath)
+	if err != nil {
+		t.Fatalf("Expected the preset LUT to be written: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if len(lines) != 2+colorPresetLUTSize*colorPresetLUTSize*colorPresetLUTSize || lines[1] != "LUT_3D_SIZE 17" {
+		t.Fatalf("Expected a 17-point .cube file, got %d lines starting %q", len(lines), lines[:2])
+	}
+	for _, line := range lines[2:] {
+		if values := strings.Fields(line); values[0] != values[1] || values[1] != values[2] {
+			t.Fatalf("Expected equal channels in the mono LUT, got %q", line)
+		}
+	}
+
+	clip := fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine.AssetClips[0]
+	lutID := findEffectIDByUID(fcpxml, customLUTEffectUID)
+	if len(clip.FilterVideos) != 1 || clip.FilterVideos[0].Ref != lutID || clip.FilterVideos[0].Params[0].Value != lutPath {
+		t.Errorf("Expected one Custom LUT filter referencing %s, got %+v", lutPath, clip.FilterVideos)
+	}
+}
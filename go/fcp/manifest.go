@@ -0,0 +1,108 @@
+package fcp
+
+import (
+	"crypto/md5"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+)
+
+// Manifest formats accepted by WriteManifest
+const (
+	ManifestCSV  = "csv"
+	ManifestJSON = "json"
+)
+
+// ManifestEntry describes one media file referenced by the document. Offline files have no size or hash.
+type ManifestEntry struct {
+	AssetID string `json:"assetId"`
+	Name    string `json:"name"`
+	Path    string `json:"path"`
+	Online  bool   `json:"online"`
+	Size    int64  `json:"size"`
+	MD5     string `json:"md5,omitempty"`
+}
+
+// BuildManifest stats and hashes every asset's media file, once per path, in resource order.
+// Paths are resolved the same way as CheckMediaOnline; nothing is modified.
+func BuildManifest(fcpxml *FCPXML) ([]ManifestEntry, error) {
+	var entries []ManifestEntry
+	seen := map[string]bool{}
+	for _, asset := range fcpxml.Resources.Assets {
+		path := mediaSourcePath(asset.MediaRep.Src)
+		if path == "" || seen[path] {
+			continue
+		}
+		seen[path] = true
+
+		entry := ManifestEntry{AssetID: asset.ID, Name: asset.Name, Path: path}
+		resolved := resolveMediaPath(fcpxml, path)
+		if info, err := os.Stat(resolved); err == nil && !info.IsDir() {
+			sum, err := fileMD5(resolved)
+			if err != nil {
+				return nil, fmt.Errorf("failed to hash %s: %v", path, err)
+			}
+			entry.Online = true
+			entry.Size = info.Size()
+			entry.MD5 = sum
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// GenerateManifest writes a CSV manifest (asset_id,name,path,status,size,md5) of every media file
+func GenerateManifest(fcpxml *FCPXML, w io.Writer) error {
+	return WriteManifest(fcpxml, w, ManifestCSV)
+}
+
+// WriteManifest writes the media manifest as CSV or JSON; offline media is marked "offline"
+func WriteManifest(fcpxml *FCPXML, w io.Writer, format string) error {
+	entries, err := BuildManifest(fcpxml)
+	if err != nil {
+		return err
+	}
+
+	switch format {
+	case ManifestJSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		if entries == nil {
+			entries = []ManifestEntry{}
+		}
+		return encoder.Encode(entries)
+	case ManifestCSV:
+		writer := csv.NewWriter(w)
+		writer.Write([]string{"asset_id", "name", "path", "status", "size", "md5"})
+		for _, entry := range entries {
+			status, size := "offline", ""
+			if entry.Online {
+				status, size = "online", strconv.FormatInt(entry.Size, 10)
+			}
+			writer.Write([]string{entry.AssetID, entry.Name, entry.Path, status, size, entry.MD5})
+		}
+		writer.Flush()
+		return writer.Error()
+	default:
+		return fmt.Errorf("unknown manifest format '%s' (use %s or %s)", format, ManifestCSV, ManifestJSON)
+	}
+}
+
+// fileMD5 returns the hex md5 of a file's contents
+func fileMD5(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hasher := md5.New()
+	if _, err := io.Copy(hasher, file); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hasher.Sum(nil)), nil
+}
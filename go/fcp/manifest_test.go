@@ -0,0 +1,81 @@
+package fcp
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+)
+
+// TestGenerateManifest tests that online media is sized and hashed and missing media flagged offline
+func TestGenerateManifest(t *testing.T) {
+	tempDir := t.TempDir()
+	contents := map[string]string{
+		filepath.Join(tempDir, "a.mp4"):       "first fake video",
+		filepath.Join(tempDir, "b.mp4"):       "second, longer fake video",
+		filepath.Join(tempDir, "offline.mp4"): "soon gone",
+	}
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("GenerateEmpty failed: %v", err)
+	}
+	for _, name := range []string{"a.mp4", "b.mp4", "offline.mp4"} {
+		path := filepath.Join(tempDir, name)
+		if err := os.WriteFile(path, []byte(contents[path]), 0644); err != nil {
+			t.Fatalf("Failed to create test video: %v", err)
+		}
+		if err := AddVideo(fcpxml, path); err != nil {
+			t.Fatalf("AddVideo failed: %v", err)
+		}
+	}
+	offlinePath := filepath.Join(tempDir, "offline.mp4")
+	if err := os.Remove(offlinePath); err != nil {
+		t.Fatalf("Failed to remove test video: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := GenerateManifest(fcpxml, &buf); err != nil {
+		t.Fatalf("GenerateManifest failed: %v", err)
+	}
+	rows, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("Manifest is not valid CSV: %v", err)
+	}
+	if len(rows) != 4 {
+		t.Fatalf("Expected header and 3 rows, got %d", len(rows))
+	}
+	for _, row := range rows[1:] {
+		path, status, size, sum := row[2], row[3], row[4], row[5]
+		if path == offlinePath {
+			if status != "offline" || size != "" || sum != "" {
+				t.Errorf("Expected %s offline without size or hash, got %v", path, row)
+			}
+			continue
+		}
+		digest := md5.Sum([]byte(contents[path]))
+		if status != "online" || size != strconv.Itoa(len(contents[path])) || sum != hex.EncodeToString(digest[:]) {
+			t.Errorf("Unexpected manifest row for %s: %v", path, row)
+		}
+	}
+
+	buf.Reset()
+	if err := WriteManifest(fcpxml, &buf, ManifestJSON); err != nil {
+		t.Fatalf("WriteManifest JSON failed: %v", err)
+	}
+	var entries []ManifestEntry
+	if err := json.Unmarshal(buf.Bytes(), &entries); err != nil {
+		t.Fatalf("Manifest is not valid JSON: %v", err)
+	}
+	if len(entries) != 3 || !entries[0].Online || entries[2].Online {
+		t.Errorf("Unexpected JSON manifest: %+v", entries)
+	}
+
+	if err := WriteManifest(fcpxml, &buf, "xml"); err == nil {
+		t.Error("Expected error for unknown manifest format")
+	}
+}
@@ -0,0 +1,46 @@
+package fcp
+
+import (
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// CheckMediaOnline returns the source path of every asset whose media file is missing on disk.
+// The file:// scheme and URL escaping are removed; relative sources are resolved against the
+// library location (or the working directory when the library has none). Nothing is modified.
+func CheckMediaOnline(fcpxml *FCPXML) []string {
+	var missing []string
+	seen := map[string]bool{}
+	for _, asset := range fcpxml.Resources.Assets {
+		path := mediaSourcePath(asset.MediaRep.Src)
+		if path == "" || seen[path] {
+			continue
+		}
+		seen[path] = true
+
+		if _, err := os.Stat(resolveMediaPath(fcpxml, path)); err != nil {
+			missing = append(missing, path)
+		}
+	}
+
+	return missing
+}
+
+// mediaSourcePath turns a media-rep src (e.g. "file:///Users/me/My%20Clip.mov") into a file path
+func mediaSourcePath(src string) string {
+	path := strings.TrimPrefix(src, "file://")
+	if unescaped, err := url.PathUnescape(path); err == nil {
+		path = unescaped
+	}
+	return path
+}
+
+// resolveMediaPath resolves a relative media path against the library location, if it has one
+func resolveMediaPath(fcpxml *FCPXML, path string) string {
+	if filepath.IsAbs(path) || fcpxml.Library.Location == "" {
+		return path
+	}
+	return filepath.Join(mediaSourcePath(fcpxml.Library.Location), path)
+}
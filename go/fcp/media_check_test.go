@@ -0,0 +1,46 @@
+package fcp
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestCheckMediaOnline tests that only assets whose files are gone are reported
+func TestCheckMediaOnline(t *testing.T) {
+	tempDir := t.TempDir()
+	presentPath := filepath.Join(tempDir, "present.mp4")
+	missingPath := filepath.Join(tempDir, "missing.mp4")
+	for _, path := range []string{presentPath, missingPath} {
+		if err := os.WriteFile(path, []byte("fake video data"), 0644); err != nil {
+			t.Fatalf("Failed to create test video: %v", err)
+		}
+	}
+
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("GenerateEmpty failed: %v", err)
+	}
+	for _, path := range []string{presentPath, missingPath} {
+		if err := AddVideo(fcpxml, path); err != nil {
+			t.Fatalf("AddVideo failed: %v", err)
+		}
+	}
+	if err := os.Remove(missingPath); err != nil {
+		t.Fatalf("Failed to remove test video: %v", err)
+	}
+
+	missing := CheckMediaOnline(fcpxml)
+	if len(missing) != 1 || missing[0] != missingPath {
+		t.Errorf("Expected only %s to be reported, got %v", missingPath, missing)
+	}
+
+	// Relative sources resolve against the library location
+	fcpxml.Library.Location = "file://" + tempDir + "/"
+	fcpxml.Resources.Assets[0].MediaRep.Src = "file://present.mp4"
+	fcpxml.Resources.Assets[1].MediaRep.Src = "file://missing.mp4"
+	missing = CheckMediaOnline(fcpxml)
+	if len(missing) != 1 || missing[0] != "missing.mp4" {
+		t.Errorf("Expected only missing.mp4 to be reported for relative sources, got %v", missing)
+	}
+}
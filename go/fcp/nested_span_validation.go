@@ -0,0 +1,141 @@
+package fcp
+
+import (
+	"fmt"
+)
+
+// nestedSpan is a connected element's placement in its parent's local time
+type nestedSpan struct {
+	kind, name       string
+	offset, duration string
+}
+
+// nestedSpanViolations reports connected titles, videos and asset-clips placed outside their parent clip.
+// Children are placed in the parent's local time, which runs from the parent's start to
+// start + duration, so e.g. a title offset of 0s inside a clip whose start is 3600s is anchored
+// an hour before the clip begins.
+//
+// 🚨 CLAUDE.md Rules Applied Here:
+// - A child's offset (its anchor) must fall within the parent's start → start + duration
+// - FCP lets connected clips hang off the end of their parent (gallery captions, PNG pile images)
+// - So running past the parent is a warning, and only a violation when it also runs past the timeline's end
+func nestedSpanViolations(sequence *Sequence) []string {
+	var violations []string
+	timelineEnd := spineEndUnits(sequence)
+
+	check := func(parentKind, parentName, parentOffset, parentStart, parentDuration string, children []nestedSpan) {
+		start := timeUnits(parentStart)
+		end := start + timeUnits(parentDuration)
+		for _, child := range children {
+			childStart := timeUnits(child.offset)
+			childEnd := childStart + timeUnits(child.duration)
+			switch {
+			case childStart < start:
+				violations = append(violations, fmt.Sprintf("Nested %s '%s' in %s '%s' is anchored %.3fs before its parent starts (offset %s, parent starts at %.3fs) - nested offsets are in the parent's local time",
+					child.kind, child.name, parentKind, parentName, unitsToSeconds(start-childStart), child.offset, unitsToSeconds(start)))
+				continue
+			case childStart > end:
+				violations = append(violations, fmt.Sprintf("Nested %s '%s' in %s '%s' is anchored %.3fs after its parent ends (offset %s, parent ends at %.3fs) - nested offsets are in the parent's local time",
+					child.kind, child.name, parentKind, parentName, unitsToSeconds(childStart-end), child.offset, unitsToSeconds(end)))
+				continue
+			}
+
+			if childEnd <= end {
+				continue
+			}
+
+			// Where the child ends on the sequence timeline
+			overflow := timeUnits(parentOffset) + (childEnd - start) - timelineEnd
+			if overflow > 0 {
+				violations = append(violations, fmt.Sprintf("Nested %s '%s' in %s '%s' runs %.3fs past the end of its parent and %.3fs past the end of the timeline (ends at %.3fs, parent ends at %.3fs)",
+					child.kind, child.name, parentKind, parentName, unitsToSeconds(childEnd-end), unitsToSeconds(overflow), unitsToSeconds(childEnd), unitsToSeconds(end)))
+			} else {
+				violations = append(violations, fmt.Sprintf("%sNested %s '%s' in %s '%s' runs %.3fs past the end of its parent (ends at %.3fs, parent ends at %.3fs)",
+					ValidationWarningPrefix, child.kind, child.name, parentKind, parentName, unitsToSeconds(childEnd-end), unitsToSeconds(childEnd), unitsToSeconds(end)))
+			}
+		}
+	}
+
+	titleSpans := func(titles []Title) []nestedSpan {
+		spans := make([]nestedSpan, 0, len(titles))
+		for _, title := range titles {
+			spans = append(spans, nestedSpan{"title", title.Name, title.Offset, title.Duration})
+		}
+		return spans
+	}
+	videoSpans := func(videos []Video) []nestedSpan {
+		spans := make([]nestedSpan, 0, len(videos))
+		for _, video := range videos {
+			spans = append(spans, nestedSpan{"video", video.Name, video.Offset, video.Duration})
+		}
+		return spans
+	}
+	clipSpans := func(clips []AssetClip) []nestedSpan {
+		spans := make([]nestedSpan, 0, len(clips))
+		for _, clip := range clips {
+			spans = append(spans, nestedSpan{"asset-clip", clip.Name, clip.Offset, clip.Duration})
+		}
+		return spans
+	}
+	childSpans := func(titles []Title, videos []Video, clips []AssetClip) []nestedSpan {
+		return append(append(titleSpans(titles), videoSpans(videos)...), clipSpans(clips)...)
+	}
+
+	spine := &sequence.Spine
+	for _, clip := range spine.AssetClips {
+		check("asset-clip", clip.Name, clip.Offset, clip.Start, clip.Duration, childSpans(clip.Titles, clip.Videos, clip.NestedAssetClips))
+	}
+	for _, video := range spine.Videos {
+		check("video", video.Name, video.Offset, video.Start, video.Duration, childSpans(video.NestedTitles, video.NestedVideos, video.NestedAssetClips))
+	}
+	for _, gap := range spine.Gaps {
+		check("gap", gap.Name, gap.Offset, "0s", gap.Duration, titleSpans(gap.Titles))
+	}
+	for _, refClip := range spine.RefClips {
+		check("ref-clip", refClip.Name, refClip.Offset, refClip.Start, refClip.Duration, titleSpans(refClip.Titles))
+	}
+
+	return violations
+}
+
+// spineEndUnits is where the last spine element ends, or the sequence duration if that is later
+func spineEndUnits(sequence *Sequence) int {
+	end := timeUnits(sequence.Duration)
+	extend := func(offset, duration string) {
+		if e := timeUnits(offset) + timeUnits(duration); e > end {
+			end = e
+		}
+	}
+	spine := &sequence.Spine
+	for _, clip := range spine.AssetClips {
+		extend(clip.Offset, clip.Duration)
+	}
+	for _, video := range spine.Videos {
+		extend(video.Offset, video.Duration)
+	}
+	for _, title := range spine.Titles {
+		extend(title.Offset, title.Duration)
+	}
+	for _, gap := range spine.Gaps {
+		extend(gap.Offset, gap.Duration)
+	}
+	for _, refClip := range spine.RefClips {
+		extend(refClip.Offset, refClip.Duration)
+	}
+	return end
+}
+
+// timeUnits reads any FCP time ("3600s", "1001/24000s", "3523/600s") as 1/24000s units without
+// snapping it to a frame, so clips with whole-second starts compare correctly; unparseable is 0
+func timeUnits(value string) int {
+	units, err := parseKeyframeTime(value)
+	if err != nil {
+		return 0
+	}
+	return units
+}
+
+// unitsToSeconds converts timeUnits to seconds
+func unitsToSeconds(units int) float64 {
+	return float64(units) / 24000
+}
@@ -0,0 +1,87 @@
+package fcp
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestNestedSpanViolations tests that a nested title running past its parent and the timeline is
+// reported with both names, that one anchored before the parent's start is reported, and that a
+// title hanging past its parent but not the timeline is only a warning
+func TestNestedSpanViolations(t *testing.T) {
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("GenerateEmpty failed: %v", err)
+	}
+	sequence, _ := firstSequence(fcpxml)
+	sequence.Spine.Videos = []Video{
+		{
+			Name:     "Intro",
+			Offset:   "0s",
+			Start:    "3600s",
+			Duration: "10s",
+			NestedTitles: []Title{
+				{Name: "Caption", Offset: "3600s", Duration: "120120/24000s"},
+				{Name: "Too Long", Offset: "3605s", Duration: "6s"},
+				{Name: "Offset Zero", Offset: "0s", Duration: "24024/24000s"},
+			},
+		},
+	}
+	sequence.Duration = "10s"
+
+	violations := nestedSpanViolations(sequence)
+	if len(violations) != 2 {
+		t.Fatalf("Expected 2 violations, got %d: %v", len(violations), violations)
+	}
+	if !strings.Contains(violations[0], "'Too Long' in video 'Intro' runs 1.000s past the end of its parent") {
+		t.Errorf("Expected the overflowing title to be reported against its parent, got %q", violations[0])
+	}
+	if !strings.Contains(violations[0], "1.000s past the end of the timeline") {
+		t.Errorf("Expected the overflow amount in the message, got %q", violations[0])
+	}
+	if !strings.Contains(violations[1], "'Offset Zero' in video 'Intro' is anchored 3600.000s before its parent starts") {
+		t.Errorf("Expected the offset-zero title to be reported, got %q", violations[1])
+	}
+
+	// The same overflow inside an earlier clip that the timeline continues past is only a warning
+	sequence.Spine.Gaps = []Gap{{Name: "Gap", Offset: "10s", Duration: "5s"}}
+	sequence.Spine.Videos[0].NestedTitles = sequence.Spine.Videos[0].NestedTitles[:2]
+	violations, warnings := SplitValidationWarnings(nestedSpanViolations(sequence))
+	if len(violations) != 0 {
+		t.Errorf("Expected no violations once the timeline extends past the title, got %v", violations)
+	}
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "'Too Long' in video 'Intro' runs 1.000s past the end of its parent") {
+		t.Errorf("Expected a warning for the title hanging past its parent, got %v", warnings)
+	}
+
+	sequence.Spine.Gaps = nil
+	if violations := ValidateClaudeCompliance(fcpxml); len(violations) == 0 {
+		t.Errorf("Expected ValidateClaudeCompliance to report the overflowing title")
+	}
+}
+
+// TestNestedAssetClipSpan tests that connected asset-clips are held to their parent's span like titles and videos
+func TestNestedAssetClipSpan(t *testing.T) {
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("GenerateEmpty failed: %v", err)
+	}
+	sequence, _ := firstSequence(fcpxml)
+	sequence.Spine.AssetClips = []AssetClip{
+		{
+			Name:     "Interview",
+			Offset:   "0s",
+			Start:    "0s",
+			Duration: "10s",
+			NestedAssetClips: []AssetClip{
+				{Name: "Cutaway", Offset: "12s", Duration: "2s"},
+			},
+		},
+	}
+	sequence.Duration = "10s"
+
+	violations := nestedSpanViolations(sequence)
+	if len(violations) != 1 || !strings.Contains(violations[0], "Nested asset-clip 'Cutaway' in asset-clip 'Interview' is anchored 2.000s after its parent ends") {
+		t.Errorf("Expected the cutaway anchored past its parent to be reported, got %v", violations)
+	}
+}
@@ -0,0 +1,128 @@
+package fcp
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestNormalizeDurations tests that a non-aligned duration snaps to the nearest (frames*1001)/24000s
+func TestNormalizeDurations(t *testing.T) {
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("GenerateEmpty failed: %v", err)
+	}
+	sequence := &fcpxml.Library.Events[0].Projects[0].Sequences[0]
+	sequence.Spine.Videos = append(sequence.Spine.Videos, Video{
+		Name:     "Hand Edited",
+		Offset:   "2002/24000s",
+		Start:    "86399313/24000s",
+		Duration: "21600000/24000s",
+		NestedTitles: []Title{{
+			Name:     "Title",
+			Lane:     "1",
+			Offset:   "5s",
+			Duration: "1001/24000s",
+		}},
+	})
+	sequence.Duration = "21600000/24000s"
+
+	changed := NormalizeDurations(fcpxml)
+
+	video := sequence.Spine.Videos[0]
+	// 21600000/1001 = 21578.42 frames, so the nearest frame is 21578
+	if video.Duration != "21599578/24000s" {
+		t.Errorf("Expected duration 21599578/24000s, got %s", video.Duration)
+	}
+	if sequence.Duration != "21599578/24000s" {
+		t.Errorf("Expected sequence duration 21599578/24000s, got %s", sequence.Duration)
+	}
+	// 5s is 119.88 frames
+	if video.NestedTitles[0].Offset != "120120/24000s" {
+		t.Errorf("Expected nested title offset 120120/24000s, got %s", video.NestedTitles[0].Offset)
+	}
+	if video.Offset != "2002/24000s" || video.Start != "86399313/24000s" || video.NestedTitles[0].Duration != "1001/24000s" {
+		t.Errorf("Expected aligned values to be left alone, got offset %s start %s title duration %s", video.Offset, video.Start, video.NestedTitles[0].Duration)
+	}
+	if changed != 3 {
+		t.Errorf("Expected 3 values changed, got %d", changed)
+	}
+	for _, violation := range ValidateClaudeCompliance(fcpxml) {
+		if strings.Contains(violation, "frame-aligned") {
+			t.Errorf("Unexpected frame alignment violation after normalizing: %s", violation)
+		}
+	}
+
+	// A second pass has nothing left to do
+	if again := NormalizeDurations(fcpxml); again != 0 {
+		t.Errorf("Expected normalized document to stay unchanged, got %d changes", again)
+	}
+}
+
+// TestNormalizeDurationsSequenceTimebase tests that values snap to the sequence's own frame rate
+func TestNormalizeDurationsSequenceTimebase(t *testing.T) {
+	fr := FrameRate{FrameDuration: 100, Timebase: 3000}
+	cases := map[string]string{
+		"0s":           "0s",
+		"2s":           "2s",
+		"6100/3000s":   "6100/3000s",
+		"6140/3000s":   "6100/3000s",
+		"6160/3000s":   "6200/3000s",
+		"48048/24000s": "6000/3000s",
+	}
+	for value, want := range cases {
+		got, ok := fr.nearestFrame(value)
+		if !ok || got != want {
+			t.Errorf("nearestFrame(%s) = %s, %v; want %s", value, got, ok, want)
+		}
+	}
+	if _, ok := fr.nearestFrame("later"); ok {
+		t.Errorf("Expected unparseable value to be skipped")
+	}
+}
+
+// TestNormalizeDurationsLeavesFCPExport tests that a valid FCP export with 44.1kHz audio and
+// whole-second asset lengths comes through untouched
+func TestNormalizeDurationsLeavesFCPExport(t *testing.T) {
+	fcpxml, err := ReadFromFile("../../samples/imec.fcpxml")
+	if err != nil {
+		t.Fatalf("ReadFromFile failed: %v", err)
+	}
+	if changed := NormalizeDurations(fcpxml); changed != 0 {
+		t.Errorf("Expected a valid FCP export to need no changes, got %d", changed)
+	}
+}
+
+// TestNormalizeDurationsMediaLimit tests that assets and audio-only clips are skipped and that a
+// clip duration rounds down rather than past the end of its media
+func TestNormalizeDurationsMediaLimit(t *testing.T) {
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("GenerateEmpty failed: %v", err)
+	}
+	fcpxml.Resources.Assets = append(fcpxml.Resources.Assets,
+		Asset{ID: "r10", Name: "clip", Start: "0s", Duration: "3002/100s", HasVideo: "1"},
+		Asset{ID: "r11", Name: "hit", Start: "0s", Duration: "22285/44100s", HasAudio: "1"},
+	)
+	sequence := &fcpxml.Library.Events[0].Projects[0].Sequences[0]
+	sequence.Spine.AssetClips = append(sequence.Spine.AssetClips,
+		// 30.01176s is 719.55 frames, so the nearest frame (720 = 30.03s) runs past the 30.02s asset
+		AssetClip{Ref: "r10", Offset: "0s", Name: "clip", Duration: "3001176/100000s"},
+		AssetClip{Ref: "r11", Offset: "28799771/8000s", Name: "hit", Duration: "363837/720000s"},
+	)
+
+	NormalizeDurations(fcpxml)
+
+	if got := fcpxml.Resources.Assets[0].Duration; got != "3002/100s" {
+		t.Errorf("Expected asset duration to stay 3002/100s, got %s", got)
+	}
+	if got := fcpxml.Resources.Assets[1].Duration; got != "22285/44100s" {
+		t.Errorf("Expected audio asset duration to stay 22285/44100s, got %s", got)
+	}
+	if got := sequence.Spine.AssetClips[0].Duration; got != "719719/24000s" {
+		t.Errorf("Expected clip duration to round down to 719719/24000s, got %s", got)
+	}
+	audio := sequence.Spine.AssetClips[1]
+	if audio.Offset != "28799771/8000s" || audio.Duration != "363837/720000s" {
+		t.Errorf("Expected audio-only clip to stay sample-accurate, got offset %s duration %s", audio.Offset, audio.Duration)
+	}
+}
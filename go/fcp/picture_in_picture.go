@@ -0,0 +1,141 @@
+package fcp
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// Corner presets for AddPictureInPicture
+const (
+	PIPTopLeft     = "top-left"
+	PIPTopRight    = "top-right"
+	PIPBottomLeft  = "bottom-left"
+	PIPBottomRight = "bottom-right"
+)
+
+// pipMarginUnits is the gap between the PIP and the frame edges, in adjust-transform units
+// (100 units = frame height, so about 43px at 1080p)
+const pipMarginUnits = 4.0
+
+// pipCornerSigns maps each corner to the sign of its x and y position
+var pipCornerSigns = map[string][2]float64{
+	PIPTopLeft:     {-1, 1},
+	PIPTopRight:    {1, 1},
+	PIPBottomLeft:  {-1, -1},
+	PIPBottomRight: {1, -1},
+}
+
+// AddPictureInPicture overlays pipPath in a corner of the asset-clip at timeline position mainClipIndex,
+// scaled by scale, from atSeconds into the main clip for durationSeconds.
+//
+// 🚨 CLAUDE.md Rules Applied Here:
+// - The PIP nests on lane 1 of the main clip, so its offset is in the main clip's local time (Start + at)
+// - Position comes from the sequence size and the PIP's own aspect, as FCP fits it into the frame before scaling
+// - The PIP asset is created through the Transaction with CreateVideoAssetWithDetection
+func AddPictureInPicture(fcpxml *FCPXML, mainClipIndex int, pipPath string, corner string, scale float64, atSeconds, durationSeconds float64) error {
+	signs, ok := pipCornerSigns[corner]
+	if !ok {
+		return fmt.Errorf("unknown PIP corner '%s' (use %s, %s, %s or %s)", corner, PIPTopLeft, PIPTopRight, PIPBottomLeft, PIPBottomRight)
+	}
+	if scale <= 0 || scale >= 1 {
+		return fmt.Errorf("PIP scale must be between 0 and 1, got %.3f", scale)
+	}
+	if atSeconds < 0 || durationSeconds <= 0 {
+		return fmt.Errorf("PIP needs a non-negative start and a positive duration, got %.3fs for %.3fs", atSeconds, durationSeconds)
+	}
+
+	sequence, err := firstSequence(fcpxml)
+	if err != nil {
+		return err
+	}
+	mainClip, err := spineAssetClipAt(&sequence.Spine, mainClipIndex)
+	if err != nil {
+		return err
+	}
+
+	at := timeUnits(ConvertSecondsToFCPDuration(atSeconds))
+	duration := timeUnits(ConvertSecondsToFCPDuration(durationSeconds))
+	if at+duration > timeUnits(mainClip.Duration) {
+		return fmt.Errorf("PIP from %.3fs for %.3fs runs past the end of '%s' (%.3fs)", atSeconds, durationSeconds, mainClip.Name, unitsToSeconds(timeUnits(mainClip.Duration)))
+	}
+	if seconds, err := probeVideoDuration(pipPath); err == nil && seconds < durationSeconds {
+		return fmt.Errorf("PIP video %s is only %.3fs long, %.3fs requested", pipPath, seconds, durationSeconds)
+	}
+
+	frameWidth, frameHeight, err := sequenceFrameSize(fcpxml, sequence)
+	if err != nil {
+		return err
+	}
+
+	absPath, err := filepath.Abs(pipPath)
+	if err != nil {
+		return fmt.Errorf("failed to get absolute path: %v", err)
+	}
+	name := strings.TrimSuffix(filepath.Base(pipPath), filepath.Ext(pipPath))
+
+	registry := NewResourceRegistry(fcpxml)
+	tx := NewTransaction(registry)
+	defer tx.Rollback()
+
+	ids := tx.ReserveIDs(2)
+	if err := tx.CreateVideoAssetWithDetection(ids[0], absPath, name, formatFrameAlignedTime(duration), ids[1]); err != nil {
+		return fmt.Errorf("failed to create PIP asset: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	pipWidth, pipHeight := frameWidth, frameHeight
+	for _, format := range fcpxml.Resources.Formats {
+		if format.ID != ids[1] {
+			continue
+		}
+		width, widthErr := strconv.ParseFloat(format.Width, 64)
+		height, heightErr := strconv.ParseFloat(format.Height, 64)
+		if widthErr == nil && heightErr == nil && width > 0 && height > 0 {
+			pipWidth, pipHeight = width, height
+		}
+	}
+
+	x, y, err := pipPosition(signs, scale, frameWidth/frameHeight, pipWidth/pipHeight)
+	if err != nil {
+		return err
+	}
+
+	mainClip.NestedAssetClips = append(mainClip.NestedAssetClips, AssetClip{
+		Ref:      ids[0],
+		Lane:     "1",
+		Offset:   formatFrameAlignedTime(timeUnits(mainClip.Start) + at),
+		Name:     name,
+		Duration: formatFrameAlignedTime(duration),
+		Format:   ids[1],
+		TCFormat: "NDF",
+		AdjustTransform: &AdjustTransform{
+			Position: fmt.Sprintf("%.4f %.4f", x, y),
+			Scale:    fmt.Sprintf("%s %s", strconv.FormatFloat(scale, 'f', -1, 64), strconv.FormatFloat(scale, 'f', -1, 64)),
+		},
+	})
+	return nil
+}
+
+// pipPosition returns the adjust-transform position that puts a PIP of the given aspect, scaled
+// by scale, pipMarginUnits in from the corner given by signs. FCP fits the PIP inside the frame
+// first, so a wider source is limited by the frame width and a taller one by its height.
+func pipPosition(signs [2]float64, scale, frameAspect, pipAspect float64) (float64, float64, error) {
+	frameWidth := 100.0 * frameAspect
+	fittedWidth, fittedHeight := frameWidth, frameWidth/pipAspect
+	if pipAspect < frameAspect {
+		fittedWidth, fittedHeight = 100.0*pipAspect, 100.0
+	}
+	width, height := fittedWidth*scale, fittedHeight*scale
+
+	if width+2*pipMarginUnits > frameWidth || height+2*pipMarginUnits > 100.0 {
+		return 0, 0, fmt.Errorf("PIP at scale %.3f is too large to fit in a corner", scale)
+	}
+
+	x := signs[0] * (frameWidth/2 - pipMarginUnits - width/2)
+	y := signs[1] * (50.0 - pipMarginUnits - height/2)
+	return x, y, nil
+}
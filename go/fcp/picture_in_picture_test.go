@@ -0,0 +1,71 @@
+package fcp
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestAddPictureInPicture validates the bottom-right corner transform on the nested PIP clip
+func TestAddPictureInPicture(t *testing.T) {
+	originalProbe := probeVideoDuration
+	defer func() { probeVideoDuration = originalProbe }()
+	probeVideoDuration = func(string) (float64, error) { return 30.0, nil }
+
+	dir := t.TempDir()
+	mainPath := filepath.Join(dir, "screen.mp4")
+	pipPath := filepath.Join(dir, "webcam.mp4")
+	for _, path := range []string{mainPath, pipPath} {
+		if err := os.WriteFile(path, []byte("fake video"), 0644); err != nil {
+			t.Fatalf("Failed to create test video: %v", err)
+		}
+	}
+
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("GenerateEmpty failed: %v", err)
+	}
+	if err := AddVideo(fcpxml, mainPath); err != nil {
+		t.Fatalf("AddVideo failed: %v", err)
+	}
+
+	if err := AddPictureInPicture(fcpxml, 0, pipPath, PIPBottomRight, 0.25, 1, 5); err != nil {
+		t.Fatalf("AddPictureInPicture failed: %v", err)
+	}
+
+	mainClip := fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine.AssetClips[0]
+	if len(mainClip.NestedAssetClips) != 1 {
+		t.Fatalf("Expected 1 nested PIP clip, got %d", len(mainClip.NestedAssetClips))
+	}
+	pip := mainClip.NestedAssetClips[0]
+	if pip.Lane != "1" || pip.Name != "webcam" {
+		t.Errorf("Expected webcam on lane 1, got '%s' on lane '%s'", pip.Name, pip.Lane)
+	}
+	if pip.Offset != formatFrameAlignedTime(timeUnits(mainClip.Start)+timeUnits(ConvertSecondsToFCPDuration(1))) {
+		t.Errorf("Expected the PIP 1s into the main clip, got offset %s (main start %s)", pip.Offset, mainClip.Start)
+	}
+	if pip.Duration != ConvertSecondsToFCPDuration(5) {
+		t.Errorf("Expected 5s PIP, got %s", pip.Duration)
+	}
+
+	// 1920x1080: frame is 177.7778 units wide, the PIP 44.4444 x 25, 4 units in from the corner
+	if pip.AdjustTransform == nil {
+		t.Fatalf("PIP has no transform")
+	}
+	if pip.AdjustTransform.Position != "62.6667 -33.5000" {
+		t.Errorf("Expected bottom-right position '62.6667 -33.5000', got '%s'", pip.AdjustTransform.Position)
+	}
+	if pip.AdjustTransform.Scale != "0.25 0.25" {
+		t.Errorf("Expected scale '0.25 0.25', got '%s'", pip.AdjustTransform.Scale)
+	}
+
+	if err := AddPictureInPicture(fcpxml, 0, pipPath, "middle", 0.25, 0, 5); err == nil {
+		t.Errorf("Expected an error for an unknown corner")
+	}
+	if err := AddPictureInPicture(fcpxml, 0, pipPath, PIPTopLeft, 1.5, 0, 5); err == nil {
+		t.Errorf("Expected an error for a scale above 1")
+	}
+	if err := AddPictureInPicture(fcpxml, 2, pipPath, PIPTopLeft, 0.25, 0, 5); err == nil {
+		t.Errorf("Expected an error for a missing main clip")
+	}
+}
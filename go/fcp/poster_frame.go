@@ -0,0 +1,54 @@
+package fcp
+
+import "fmt"
+
+// posterFrameMarkerValue names the chapter marker that carries a clip's poster frame
+const posterFrameMarkerValue = "Poster Frame"
+
+// SetPosterFrame picks the frame atSeconds into the asset-clip at timeline position clipIndex as its thumbnail.
+// The FCPXML DTD has no poster attribute on asset or asset-clip; the only poster time it
+// defines is a chapter marker's posterOffset, so the poster is recorded as a chapter marker
+// at the head of the clip whose posterOffset points at the chosen frame.
+//
+// 🚨 CLAUDE.md Rules Applied Here:
+// - Metadata only: no resources are created and the clip's timing is untouched
+// - Marker start is in the clip's local time (its start), posterOffset is relative to the marker
+// - posterOffset is frame-aligned → ConvertSecondsToFCPDuration() and must fall inside the clip
+// - Setting a poster again replaces the previous one instead of adding markers
+func SetPosterFrame(fcpxml *FCPXML, clipIndex int, atSeconds float64) error {
+	sequence, err := firstSequence(fcpxml)
+	if err != nil {
+		return err
+	}
+	clip, err := spineAssetClipAt(&sequence.Spine, clipIndex)
+	if err != nil {
+		return err
+	}
+
+	if atSeconds < 0 {
+		return fmt.Errorf("poster time %.3fs must not be negative", atSeconds)
+	}
+	posterFrames := parseFCPDuration(ConvertSecondsToFCPDuration(atSeconds))
+	if posterFrames >= parseFCPDuration(clip.Duration) {
+		return fmt.Errorf("poster time %.3fs is past the end of clip %d (%s)", atSeconds, clipIndex, clip.Duration)
+	}
+
+	start := clip.Start
+	if start == "" {
+		start = "0s"
+	}
+
+	var markers []ChapterMarker
+	for _, marker := range clip.ChapterMarkers {
+		if marker.Value != posterFrameMarkerValue {
+			markers = append(markers, marker)
+		}
+	}
+	clip.ChapterMarkers = append(markers, ChapterMarker{
+		Start:        start,
+		Value:        posterFrameMarkerValue,
+		PosterOffset: formatFrameAlignedTime(posterFrames),
+	})
+
+	return nil
+}
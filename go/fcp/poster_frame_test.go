@@ -0,0 +1,61 @@
+package fcp
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestSetPosterFrame validates that a 3s poster on a 10s clip writes a frame-aligned posterOffset
+func TestSetPosterFrame(t *testing.T) {
+	dir := t.TempDir()
+	videoPath := filepath.Join(dir, "interview.mp4")
+	if err := os.WriteFile(videoPath, []byte("fake video"), 0644); err != nil {
+		t.Fatalf("Failed to create test video: %v", err)
+	}
+
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("GenerateEmpty failed: %v", err)
+	}
+	if err := AddVideo(fcpxml, videoPath); err != nil {
+		t.Fatalf("AddVideo failed: %v", err)
+	}
+	clip := &fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine.AssetClips[0]
+	clip.Duration = ConvertSecondsToFCPDuration(10)
+
+	if err := SetPosterFrame(fcpxml, 0, 3); err != nil {
+		t.Fatalf("SetPosterFrame failed: %v", err)
+	}
+	// Picking again must replace the poster, not stack markers
+	if err := SetPosterFrame(fcpxml, 0, 3); err != nil {
+		t.Fatalf("Second SetPosterFrame failed: %v", err)
+	}
+
+	if len(clip.ChapterMarkers) != 1 {
+		t.Fatalf("Expected 1 poster marker, got %d", len(clip.ChapterMarkers))
+	}
+	if clip.ChapterMarkers[0].PosterOffset != "72072/24000s" {
+		t.Errorf("Expected posterOffset 72072/24000s (frame 72), got %s", clip.ChapterMarkers[0].PosterOffset)
+	}
+
+	outputPath := filepath.Join(dir, "poster.fcpxml")
+	if err := WriteToFile(fcpxml, outputPath); err != nil {
+		t.Fatalf("WriteToFile failed: %v", err)
+	}
+	data, err := os.ReadFile(outputPath)
+	if err != nil {
+		t.Fatalf("Failed to read output: %v", err)
+	}
+	if !strings.Contains(string(data), `posterOffset="72072/24000s"`) {
+		t.Errorf("Expected posterOffset attribute in output")
+	}
+
+	if err := SetPosterFrame(fcpxml, 0, 10); err == nil {
+		t.Errorf("Expected an error for a poster at the clip's end")
+	}
+	if err := SetPosterFrame(fcpxml, 1, 1); err == nil {
+		t.Errorf("Expected an error for a missing clip")
+	}
+}
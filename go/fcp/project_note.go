@@ -0,0 +1,51 @@
+package fcp
+
+import (
+	"fmt"
+	"runtime/debug"
+	"sort"
+	"strings"
+	"time"
+)
+
+// SetProjectNote sets the note FCP shows in the project's inspector, replacing any earlier note.
+// The DTD only allows <note> inside the project's sequence, so it is written there; a document
+// without a sequence is left unchanged.
+func SetProjectNote(fcpxml *FCPXML, note string) {
+	sequence, err := firstSequence(fcpxml)
+	if err != nil {
+		return
+	}
+	sequence.Note = note
+}
+
+// RecordGenerationParams writes how the document was made - generator, parameters, date and
+// cutlass version - to the project note, so a file can be regenerated later. Generators call it
+// when their options ask for it (RecordParams). Parameters are written sorted as key=value pairs.
+func RecordGenerationParams(fcpxml *FCPXML, generator string, params map[string]string) {
+	SetProjectNote(fcpxml, formatGenerationParams(generator, params, time.Now()))
+}
+
+// formatGenerationParams builds the note text, e.g.
+// "cutlass fx-static-image (v1.2.0) 2025-06-13T11:46:22-07:00: effect=kaleido seed=42"
+func formatGenerationParams(generator string, params map[string]string, now time.Time) string {
+	keys := make([]string, 0, len(params))
+	for key := range params {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, len(keys))
+	for i, key := range keys {
+		pairs[i] = key + "=" + params[key]
+	}
+	return fmt.Sprintf("cutlass %s (%s) %s: %s", generator, cutlassVersion(), now.Format(time.RFC3339), strings.Join(pairs, " "))
+}
+
+// cutlassVersion is the module version cutlass was built at, or "devel" for local builds
+func cutlassVersion() string {
+	if info, ok := debug.ReadBuildInfo(); ok && info.Main.Version != "" && info.Main.Version != "(devel)" {
+		return info.Main.Version
+	}
+	return "devel"
+}
@@ -0,0 +1,60 @@
+package fcp
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestSetProjectNote tests that the note is written as the sequence's first child and reads back
+func TestSetProjectNote(t *testing.T) {
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("GenerateEmpty failed: %v", err)
+	}
+	SetProjectNote(fcpxml, "made for the Day 2 review")
+
+	path := filepath.Join(t.TempDir(), "note.fcpxml")
+	if err := WriteToFile(fcpxml, path); err != nil {
+		t.Fatalf("WriteToFile failed: %v", err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Failed to read output: %v", err)
+	}
+	xml := string(data)
+	note := strings.Index(xml, "<note>made for the Day 2 review</note>")
+	if note < 0 || note > strings.Index(xml, "<spine") {
+		t.Errorf("Expected <note> before <spine> in the sequence:\n%s", xml)
+	}
+
+	readBack, err := ReadFromFile(path)
+	if err != nil {
+		t.Fatalf("ReadFromFile failed: %v", err)
+	}
+	if got := readBack.Library.Events[0].Projects[0].Sequences[0].Note; got != "made for the Day 2 review" {
+		t.Errorf("Expected note to round-trip, got '%s'", got)
+	}
+}
+
+// TestRecordGenerationParams tests the note written for a generator's params
+func TestRecordGenerationParams(t *testing.T) {
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("GenerateEmpty failed: %v", err)
+	}
+	params := map[string]string{"seed": "42", "effect": "kaleido"}
+	sequence := &fcpxml.Library.Events[0].Projects[0].Sequences[0]
+
+	RecordGenerationParams(fcpxml, "fx-static-image", params)
+	if !strings.HasPrefix(sequence.Note, "cutlass fx-static-image (") || !strings.HasSuffix(sequence.Note, ": effect=kaleido seed=42") {
+		t.Errorf("Unexpected note '%s'", sequence.Note)
+	}
+
+	at := time.Date(2025, 6, 13, 11, 46, 22, 0, time.UTC)
+	if got := formatGenerationParams("montage", map[string]string{"seed": "1"}, at); !strings.HasSuffix(got, " 2025-06-13T11:46:22Z: seed=1") {
+		t.Errorf("Unexpected formatted note '%s'", got)
+	}
+}
@@ -0,0 +1,173 @@
+package fcp
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// DefaultProxyEdge is the longest edge (in pixels) proxies are generated at
+const DefaultProxyEdge = 960
+
+// Asset metadata keys recording both media paths, so a document can be switched either way later
+const (
+	proxyOriginalSrcKey      = "com.cutlass.proxy.originalSrc"
+	proxyOriginalBookmarkKey = "com.cutlass.proxy.originalBookmark"
+	proxyProxySrcKey         = "com.cutlass.proxy.proxySrc"
+)
+
+// transcodeVideoProxy writes a downscaled H.264 copy of a video; a variable so tests can avoid ffmpeg
+var transcodeVideoProxy = func(src, dst string, maxEdge int) error {
+	if _, err := exec.LookPath("ffmpeg"); err != nil {
+		return fmt.Errorf("ffmpeg is required to generate video proxies")
+	}
+	scale := fmt.Sprintf("scale=w=%d:h=%d:force_original_aspect_ratio=decrease,scale=trunc(iw/2)*2:trunc(ih/2)*2", maxEdge, maxEdge)
+	cmd := exec.Command("ffmpeg", "-v", "quiet", "-y", "-i", src, "-vf", scale,
+		"-c:v", "libx264", "-preset", "veryfast", "-crf", "28", "-c:a", "aac", dst)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("ffmpeg failed: %v\nOutput: %s", err, string(output))
+	}
+	return nil
+}
+
+// GenerateProxies creates a low-resolution copy of every video and image asset in proxyDir
+// (longest edge DefaultProxyEdge) and records the original and proxy paths in the asset's
+// metadata. Asset sources are not changed - call SwitchToProxies to edit against the proxies.
+//
+// 🚨 CLAUDE.md Rules Applied Here:
+// - Asset IDs, UIDs, durations and formats are untouched, so the timeline is identical with either media
+// - The format keeps the original dimensions; FCP conforms the smaller proxy frame to it
+// - Audio-only assets are skipped - they have nothing to downscale
+func GenerateProxies(fcpxml *FCPXML, proxyDir string) error {
+	return GenerateProxiesWithEdge(fcpxml, proxyDir, DefaultProxyEdge)
+}
+
+// GenerateProxiesWithEdge is GenerateProxies with a custom longest proxy edge
+func GenerateProxiesWithEdge(fcpxml *FCPXML, proxyDir string, maxEdge int) error {
+	if maxEdge <= 0 {
+		return fmt.Errorf("proxy edge must be greater than 0, got %d", maxEdge)
+	}
+	absProxyDir, err := filepath.Abs(proxyDir)
+	if err != nil {
+		return fmt.Errorf("failed to resolve proxy directory: %v", err)
+	}
+	if err := os.MkdirAll(absProxyDir, 0755); err != nil {
+		return fmt.Errorf("failed to create proxy directory: %v", err)
+	}
+
+	for i := range fcpxml.Resources.Assets {
+		asset := &fcpxml.Resources.Assets[i]
+
+		originalSrc := assetMetadataValue(asset, proxyOriginalSrcKey)
+		if originalSrc == "" {
+			originalSrc = asset.MediaRep.Src
+		}
+		if !strings.HasPrefix(originalSrc, "file://") {
+			continue
+		}
+		originalPath := mediaSourcePath(originalSrc)
+		if isAudioFile(originalPath) {
+			continue
+		}
+
+		ext := filepath.Ext(originalPath)
+		name := strings.TrimSuffix(filepath.Base(originalPath), ext)
+		var proxyPath string
+		if isImageFile(originalPath) {
+			proxyPath = filepath.Join(absProxyDir, fmt.Sprintf("%s_%s_proxy%s", asset.ID, name, ext))
+			width, height, err := imageDimensions(originalPath)
+			if err != nil {
+				return fmt.Errorf("failed to read %s: %v", originalPath, err)
+			}
+			if width > maxEdge || height > maxEdge {
+				width, height = fitWithinEdge(width, height, maxEdge)
+			}
+			if err := resizeImageFile(originalPath, proxyPath, width, height); err != nil {
+				return fmt.Errorf("failed to create proxy for %s: %v", originalPath, err)
+			}
+		} else {
+			proxyPath = filepath.Join(absProxyDir, fmt.Sprintf("%s_%s_proxy.mp4", asset.ID, name))
+			if err := transcodeVideoProxy(originalPath, proxyPath, maxEdge); err != nil {
+				return fmt.Errorf("failed to create proxy for %s: %v", originalPath, err)
+			}
+		}
+
+		if assetMetadataValue(asset, proxyOriginalSrcKey) == "" {
+			setAssetMetadataValue(asset, proxyOriginalSrcKey, asset.MediaRep.Src)
+			if asset.MediaRep.Bookmark != "" {
+				setAssetMetadataValue(asset, proxyOriginalBookmarkKey, asset.MediaRep.Bookmark)
+			}
+		}
+		setAssetMetadataValue(asset, proxyProxySrcKey, "file://"+proxyPath)
+	}
+
+	return nil
+}
+
+// SwitchToProxies points every asset with a generated proxy at its proxy file and returns how
+// many assets were switched. The original bookmark is dropped because it would resolve to the
+// original file ahead of src.
+func SwitchToProxies(fcpxml *FCPXML) (int, error) {
+	switched := 0
+	for i := range fcpxml.Resources.Assets {
+		asset := &fcpxml.Resources.Assets[i]
+		proxySrc := assetMetadataValue(asset, proxyProxySrcKey)
+		if proxySrc == "" {
+			continue
+		}
+		proxyPath := mediaSourcePath(proxySrc)
+		if _, err := os.Stat(proxyPath); err != nil {
+			return switched, fmt.Errorf("proxy for asset %s is missing: %s", asset.ID, proxyPath)
+		}
+		asset.MediaRep.Src = proxySrc
+		asset.MediaRep.Bookmark = ""
+		switched++
+	}
+	return switched, nil
+}
+
+// SwitchToOriginals restores the original src (and bookmark) of every asset that has a proxy
+// recorded and returns how many assets were switched back.
+func SwitchToOriginals(fcpxml *FCPXML) (int, error) {
+	switched := 0
+	for i := range fcpxml.Resources.Assets {
+		asset := &fcpxml.Resources.Assets[i]
+		originalSrc := assetMetadataValue(asset, proxyOriginalSrcKey)
+		if originalSrc == "" {
+			continue
+		}
+		asset.MediaRep.Src = originalSrc
+		asset.MediaRep.Bookmark = assetMetadataValue(asset, proxyOriginalBookmarkKey)
+		switched++
+	}
+	return switched, nil
+}
+
+// assetMetadataValue returns the value of an asset md key, or "" when it isn't set
+func assetMetadataValue(asset *Asset, key string) string {
+	if asset.Metadata == nil {
+		return ""
+	}
+	for _, md := range asset.Metadata.MDs {
+		if md.Key == key {
+			return md.Value
+		}
+	}
+	return ""
+}
+
+// setAssetMetadataValue adds or replaces an asset md key
+func setAssetMetadataValue(asset *Asset, key, value string) {
+	if asset.Metadata == nil {
+		asset.Metadata = &Metadata{}
+	}
+	for i := range asset.Metadata.MDs {
+		if asset.Metadata.MDs[i].Key == key {
+			asset.Metadata.MDs[i].Value = value
+			return
+		}
+	}
+	asset.Metadata.MDs = append(asset.Metadata.MDs, MetadataItem{Key: key, Value: value})
+}
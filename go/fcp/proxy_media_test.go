@@ -0,0 +1,87 @@
+package fcp
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestGenerateAndSwitchProxies tests that every asset gets a proxy file and sources switch both ways
+func TestGenerateAndSwitchProxies(t *testing.T) {
+	originalTranscode := transcodeVideoProxy
+	defer func() { transcodeVideoProxy = originalTranscode }()
+	transcodeVideoProxy = func(src, dst string, maxEdge int) error {
+		return os.WriteFile(dst, []byte("proxy video"), 0644)
+	}
+
+	dir := t.TempDir()
+	imagePath := filepath.Join(dir, "still.png")
+	writeTestPNG(t, imagePath, 200, 100)
+	videoPath := filepath.Join(dir, "interview.mp4")
+	if err := os.WriteFile(videoPath, []byte("fake video"), 0644); err != nil {
+		t.Fatalf("Failed to create test video: %v", err)
+	}
+
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("GenerateEmpty failed: %v", err)
+	}
+	if err := AddImage(fcpxml, imagePath, 3); err != nil {
+		t.Fatalf("AddImage failed: %v", err)
+	}
+	if err := AddVideo(fcpxml, videoPath); err != nil {
+		t.Fatalf("AddVideo failed: %v", err)
+	}
+
+	originals := map[string]string{}
+	for _, asset := range fcpxml.Resources.Assets {
+		originals[asset.ID] = asset.MediaRep.Src
+	}
+
+	proxyDir := filepath.Join(dir, "proxies")
+	if err := GenerateProxiesWithEdge(fcpxml, proxyDir, 50); err != nil {
+		t.Fatalf("GenerateProxies failed: %v", err)
+	}
+
+	entries, err := os.ReadDir(proxyDir)
+	if err != nil {
+		t.Fatalf("Failed to read proxy directory: %v", err)
+	}
+	if len(entries) != len(fcpxml.Resources.Assets) {
+		t.Errorf("Expected %d proxy files, got %d", len(fcpxml.Resources.Assets), len(entries))
+	}
+	for _, asset := range fcpxml.Resources.Assets {
+		if asset.MediaRep.Src != originals[asset.ID] {
+			t.Errorf("GenerateProxies should not switch asset %s yet", asset.ID)
+		}
+	}
+
+	switched, err := SwitchToProxies(fcpxml)
+	if err != nil {
+		t.Fatalf("SwitchToProxies failed: %v", err)
+	}
+	if switched != 2 {
+		t.Errorf("Expected 2 assets switched to proxies, got %d", switched)
+	}
+	for _, asset := range fcpxml.Resources.Assets {
+		if !strings.HasPrefix(asset.MediaRep.Src, "file://"+proxyDir) {
+			t.Errorf("Asset %s should point into the proxy directory, got %s", asset.ID, asset.MediaRep.Src)
+		}
+		if strings.HasSuffix(asset.MediaRep.Src, ".png") {
+			width, height, err := imageDimensions(mediaSourcePath(asset.MediaRep.Src))
+			if err != nil || width != 50 || height != 25 {
+				t.Errorf("Expected a 50x25 image proxy, got %dx%d (%v)", width, height, err)
+			}
+		}
+	}
+
+	if _, err := SwitchToOriginals(fcpxml); err != nil {
+		t.Fatalf("SwitchToOriginals failed: %v", err)
+	}
+	for _, asset := range fcpxml.Resources.Assets {
+		if asset.MediaRep.Src != originals[asset.ID] {
+			t.Errorf("Asset %s not restored: got %s, want %s", asset.ID, asset.MediaRep.Src, originals[asset.ID])
+		}
+	}
+}
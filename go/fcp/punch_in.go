@@ -0,0 +1,96 @@
+package fcp
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// punchInRampFrames is how many frames the zoom takes to snap in and back out
+const punchInRampFrames = 3
+
+// AddPunchIn zooms the asset-clip at timeline position clipIndex to zoom at atSeconds, holds it for
+// holdSeconds and snaps back to 1.0, the "punch in" used to emphasise a moment in talking-head edits.
+// Any existing scale animation or static scale on the clip is replaced.
+//
+// 🚨 CLAUDE.md Rules Applied Here:
+// - Four scale keyframes: 1.0 at atSeconds, zoom after the ramp, zoom after the hold, 1.0 after the ramp out
+// - Times are seconds from the clip's start, frame-aligned and offset by its start attribute
+// - Scale keyframes carry curve="linear" so the snap is a constant-speed ramp
+func AddPunchIn(fcpxml *FCPXML, clipIndex int, atSeconds, holdSeconds, zoom float64) error {
+	if zoom <= 0 {
+		return fmt.Errorf("punch-in zoom must be greater than 0, got %g", zoom)
+	}
+	if atSeconds < 0 {
+		return fmt.Errorf("punch-in time must not be negative, got %g", atSeconds)
+	}
+	if holdSeconds <= 0 {
+		return fmt.Errorf("punch-in hold must be greater than 0, got %g", holdSeconds)
+	}
+
+	sequence, err := firstSequence(fcpxml)
+	if err != nil {
+		return err
+	}
+	clip, err := spineAssetClipAt(&sequence.Spine, clipIndex)
+	if err != nil {
+		return err
+	}
+	clipStart := timeUnits(clip.Start)
+	clipUnits := timeUnits(clip.Duration)
+
+	rampUnits := punchInRampFrames * 1001
+	atUnits := timeUnits(formatFrameAlignedTime(timeUnits(ConvertSecondsToFCPDuration(atSeconds))))
+	holdUnits := timeUnits(formatFrameAlignedTime(timeUnits(ConvertSecondsToFCPDuration(holdSeconds))))
+	offsets := []int{atUnits, atUnits + rampUnits, atUnits + rampUnits + holdUnits, atUnits + 2*rampUnits + holdUnits}
+	if end := offsets[len(offsets)-1]; end > clipUnits {
+		return fmt.Errorf("punch-in from %.3fs to %.3fs runs past the end of the %.3fs clip",
+			unitsToSeconds(atUnits), unitsToSeconds(end), unitsToSeconds(clipUnits))
+	}
+
+	zoomValue := strconv.FormatFloat(zoom, 'f', -1, 64)
+	zoomed := zoomValue + " " + zoomValue
+	values := []string{"1 1", zoomed, zoomed, "1 1"}
+
+	builder := NewAnimationBuilder("scale")
+	for i, offset := range offsets {
+		at := Time(formatFrameAlignedTime(clipStart + offset))
+		if err := builder.AddKeyframe(at, values[i], WithCurve(CurveLinear)); err != nil {
+			return fmt.Errorf("punch-in keyframe %d: %v", i, err)
+		}
+	}
+	param, err := builder.Build()
+	if err != nil {
+		return fmt.Errorf("failed to build punch-in scale: %v", err)
+	}
+
+	if clip.AdjustTransform == nil {
+		clip.AdjustTransform = &AdjustTransform{}
+	}
+	transform := clip.AdjustTransform
+	var kept []Param
+	for _, existing := range transform.Params {
+		if existing.Name != "scale" {
+			kept = append(kept, existing)
+		}
+	}
+	transform.Params = append(kept, *param)
+	transform.Scale = ""
+	return nil
+}
+
+// ParsePunchIn parses a "at,hold,zoom" punch-in spec such as "5,2,1.5"
+func ParsePunchIn(spec string) (atSeconds, holdSeconds, zoom float64, err error) {
+	fields := strings.Split(spec, ",")
+	if len(fields) != 3 {
+		return 0, 0, 0, fmt.Errorf("punch-in must be 'at,hold,zoom', got '%s'", spec)
+	}
+	var values [3]float64
+	for i, field := range fields {
+		values[i], err = strconv.ParseFloat(strings.TrimSpace(field), 64)
+		if err != nil {
+			return 0, 0, 0, fmt.Errorf("invalid punch-in value '%s': %v", field, err)
+		}
+	}
+	return values[0], values[1], values[2], nil
+}
@@ -0,0 +1,66 @@
+package fcp
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestAddPunchIn tests that a punch-in becomes four frame-aligned linear scale keyframes
+func TestAddPunchIn(t *testing.T) {
+	tempDir := t.TempDir()
+	videoPath := filepath.Join(tempDir, "clip.mp4")
+	if err := os.WriteFile(videoPath, []byte("fake video data"), 0644); err != nil {
+		t.Fatalf("Failed to create test video: %v", err)
+	}
+
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("GenerateEmpty failed: %v", err)
+	}
+	if err := AddVideo(fcpxml, videoPath); err != nil {
+		t.Fatalf("AddVideo failed: %v", err)
+	}
+	clip := &fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine.AssetClips[0]
+
+	at, hold, zoom, err := ParsePunchIn("5,2,1.5")
+	if err != nil {
+		t.Fatalf("ParsePunchIn failed: %v", err)
+	}
+	if err := AddPunchIn(fcpxml, 0, at, hold, zoom); err != nil {
+		t.Fatalf("AddPunchIn failed: %v", err)
+	}
+
+	params := clip.AdjustTransform.Params
+	if len(params) != 1 || params[0].Name != "scale" {
+		t.Fatalf("Expected a single scale param, got %+v", params)
+	}
+	// 5s is frame 120 at 23.976fps; the ramp is 3 frames and the 2s hold is 48 frames
+	expected := []Keyframe{
+		{Time: "120120/24000s", Value: "1 1", Curve: "linear"},
+		{Time: "123123/24000s", Value: "1.5 1.5", Curve: "linear"},
+		{Time: "171171/24000s", Value: "1.5 1.5", Curve: "linear"},
+		{Time: "174174/24000s", Value: "1 1", Curve: "linear"},
+	}
+	keyframes := params[0].KeyframeAnimation.Keyframes
+	if len(keyframes) != len(expected) {
+		t.Fatalf("Expected %d keyframes, got %d", len(expected), len(keyframes))
+	}
+	for i, want := range expected {
+		got := keyframes[i]
+		if got.Time != want.Time || got.Value != want.Value || got.Curve != want.Curve {
+			t.Errorf("Keyframe %d: expected %+v, got %+v", i, want, got)
+		}
+	}
+
+	// The punch must end inside the 10s clip
+	if err := AddPunchIn(fcpxml, 0, 9, 1, 1.5); err == nil {
+		t.Error("Expected error for punch-in running past the clip end")
+	}
+	if err := AddPunchIn(fcpxml, 0, 1, 1, 0); err == nil {
+		t.Error("Expected error for zero zoom")
+	}
+	if _, _, _, err := ParsePunchIn("5,2"); err == nil {
+		t.Error("Expected error for incomplete punch-in spec")
+	}
+}
@@ -124,6 +124,15 @@ func (r *ReferenceRegistry) ValidateReference(ref ID, refType string) error {
 		if _, exists := r.effects[ref]; !exists {
 			return fmt.Errorf("dangling effect reference: %s", ref)
 		}
+	case "generator":
+		// Generators (e.g. Vivid, Shapes) are Motion templates; filters (.moef) can't be video sources
+		effect, exists := r.effects[ref]
+		if !exists {
+			return fmt.Errorf("dangling generator reference: %s", ref)
+		}
+		if !strings.HasSuffix(effect.UID, ".motn") {
+			return fmt.Errorf("effect %s (%s) is not a generator", ref, effect.Name)
+		}
 	case "media":
 		if _, exists := r.media[ref]; !exists {
 			return fmt.Errorf("dangling media reference: %s", ref)
@@ -171,6 +180,19 @@ func (r *ReferenceRegistry) GetMedia(id ID) (*Media, bool) {
 	return media, exists
 }
 
+// validateVideoReference validates a <video> ref, which points at an image asset or a generator effect
+func (r *ReferenceRegistry) validateVideoReference(ref ID) error {
+	r.mu.RLock()
+	_, isAsset := r.assets[ref]
+	_, isEffect := r.effects[ref]
+	r.mu.RUnlock()
+
+	if isEffect && !isAsset {
+		return r.ValidateReference(ref, "generator")
+	}
+	return r.ValidateReference(ref, "asset")
+}
+
 // ValidateAllReferences validates all references in an FCPXML document
 func (r *ReferenceRegistry) ValidateAllReferences(fcpxml *FCPXML) error {
 	errors := []string{}
@@ -220,7 +242,7 @@ func (r *ReferenceRegistry) validateSpineReferences(spine Spine) error {
 	
 	// Validate video references
 	for i, video := range spine.Videos {
-		if err := r.ValidateReference(ID(video.Ref), "asset"); err != nil {
+		if err := r.validateVideoReference(ID(video.Ref)); err != nil {
 			errors = append(errors, fmt.Sprintf("video %d: %v", i, err))
 		}
 		
@@ -237,6 +259,15 @@ func (r *ReferenceRegistry) validateSpineReferences(spine Spine) error {
 		}
 	}
 	
+	// Validate transition effect references
+	for i, transition := range spine.Transitions {
+		for j, filter := range transition.FilterVideos {
+			if err := r.ValidateReference(ID(filter.Ref), "effect"); err != nil {
+				errors = append(errors, fmt.Sprintf("transition %d filter-video %d: %v", i, j, err))
+			}
+		}
+	}
+	
 	if len(errors) > 0 {
 		return fmt.Errorf("spine validation failed: %s", strings.Join(errors, "; "))
 	}
@@ -257,7 +288,7 @@ func (r *ReferenceRegistry) validateNestedReferencesInAssetClip(clip AssetClip)
 	
 	// Validate nested videos
 	for i, nested := range clip.Videos {
-		if err := r.ValidateReference(ID(nested.Ref), "asset"); err != nil {
+		if err := r.validateVideoReference(ID(nested.Ref)); err != nil {
 			errors = append(errors, fmt.Sprintf("nested video %d: %v", i, err))
 		}
 	}
@@ -296,7 +327,7 @@ func (r *ReferenceRegistry) validateNestedReferencesInVideo(video Video) error {
 	
 	// Validate nested videos
 	for i, nested := range video.NestedVideos {
-		if err := r.ValidateReference(ID(nested.Ref), "asset"); err != nil {
+		if err := r.validateVideoReference(ID(nested.Ref)); err != nil {
 			errors = append(errors, fmt.Sprintf("nested video %d: %v", i, err))
 		}
 	}
@@ -125,6 +125,25 @@ func (r *ResourceRegistry) ReserveIDs(count int) []string {
 	return ids
 }
 
+// ReleaseIDs frees reserved IDs that were never used so the next reservation can hand them out again
+func (r *ResourceRegistry) ReleaseIDs(ids []string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, id := range ids {
+		if _, registered := r.resources[id]; registered {
+			continue
+		}
+		delete(r.usedIDs, id)
+
+		// Rewind so ReserveIDs starts from the lowest free ID again
+		var number int
+		if _, err := fmt.Sscanf(id, "r%d", &number); err == nil && number < r.nextResourceID {
+			r.nextResourceID = number
+		}
+	}
+}
+
 // ReserveNextID reserves a single ID
 func (r *ResourceRegistry) ReserveNextID() string {
 	return r.ReserveIDs(1)[0]
@@ -0,0 +1,60 @@
+package fcp
+
+import (
+	"fmt"
+	"os"
+)
+
+// Default size thresholds for generated timelines. Past these FCP imports slowly (if at all)
+// and building the document in memory risks running out of it.
+const (
+	DefaultMaxTimelineElements = 10000
+	DefaultMaxTimelineSeconds  = 2 * 60 * 60
+)
+
+// RenderLimits caps how large a generated timeline may get. Zero thresholds use the defaults.
+// Without Strict an oversized timeline only prints a warning; with Strict it fails with *ErrTooLarge.
+type RenderLimits struct {
+	MaxElements int     // Maximum number of timeline elements (clips, nested clips, titles)
+	MaxSeconds  float64 // Maximum total timeline duration in seconds
+	Strict      bool    // Return *ErrTooLarge instead of warning
+}
+
+// ErrTooLarge reports a requested timeline that exceeds its RenderLimits
+type ErrTooLarge struct {
+	Elements    int
+	Seconds     float64
+	MaxElements int
+	MaxSeconds  float64
+}
+
+func (e *ErrTooLarge) Error() string {
+	return fmt.Sprintf("timeline too large: %d elements over %.1fs (limits: %d elements, %.1fs)",
+		e.Elements, e.Seconds, e.MaxElements, e.MaxSeconds)
+}
+
+func (l RenderLimits) withDefaults() RenderLimits {
+	if l.MaxElements <= 0 {
+		l.MaxElements = DefaultMaxTimelineElements
+	}
+	if l.MaxSeconds <= 0 {
+		l.MaxSeconds = DefaultMaxTimelineSeconds
+	}
+	return l
+}
+
+// Check compares an estimated element count and duration against the limits. Generators call it
+// before building anything, so an accidental --duration 36000 fails fast instead of exhausting memory.
+func (l RenderLimits) Check(elements int, seconds float64) error {
+	l = l.withDefaults()
+	if elements <= l.MaxElements && seconds <= l.MaxSeconds {
+		return nil
+	}
+
+	tooLarge := &ErrTooLarge{Elements: elements, Seconds: seconds, MaxElements: l.MaxElements, MaxSeconds: l.MaxSeconds}
+	if l.Strict {
+		return tooLarge
+	}
+	fmt.Fprintf(os.Stderr, "Warning: %v\n", tooLarge)
+	return nil
+}
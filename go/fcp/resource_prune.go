@@ -0,0 +1,156 @@
+package fcp
+
+// PruneUnusedResources removes assets, formats, effects and media that no timeline element
+// references anymore (e.g. after RemoveClipAt or TrimTimeline) and returns how many were pruned.
+//
+// 🚨 CLAUDE.md Rules Applied Here:
+// - Referenced IDs come from walking the structs (spine, nested lanes, filters, gaps) - no XML string scanning
+// - Sequence formats are always kept, as are formats used only by a kept asset
+// - Compound clip media keep whatever their own sequence references
+func PruneUnusedResources(fcpxml *FCPXML) int {
+	used := map[string]bool{}
+	for _, event := range fcpxml.Library.Events {
+		for _, project := range event.Projects {
+			for _, sequence := range project.Sequences {
+				collectSequenceRefs(sequence, used)
+			}
+		}
+	}
+
+	// Media referenced by a clip may reference further resources (including other media)
+	scanned := map[string]bool{}
+	for grew := true; grew; {
+		grew = false
+		for _, media := range fcpxml.Resources.Media {
+			if used[media.ID] && !scanned[media.ID] {
+				scanned[media.ID] = true
+				collectSequenceRefs(media.Sequence, used)
+				grew = true
+			}
+		}
+	}
+
+	pruned := 0
+
+	var assets []Asset
+	for _, asset := range fcpxml.Resources.Assets {
+		if !used[asset.ID] {
+			pruned++
+			continue
+		}
+		assets = append(assets, asset)
+		if asset.Format != "" {
+			used[asset.Format] = true
+		}
+	}
+
+	var effects []Effect
+	for _, effect := range fcpxml.Resources.Effects {
+		if !used[effect.ID] {
+			pruned++
+			continue
+		}
+		effects = append(effects, effect)
+	}
+
+	var media []Media
+	for _, item := range fcpxml.Resources.Media {
+		if !used[item.ID] {
+			pruned++
+			continue
+		}
+		media = append(media, item)
+	}
+
+	// Formats last: kept assets above may have marked theirs as used
+	var formats []Format
+	for _, format := range fcpxml.Resources.Formats {
+		if !used[format.ID] {
+			pruned++
+			continue
+		}
+		formats = append(formats, format)
+	}
+
+	fcpxml.Resources.Assets = assets
+	fcpxml.Resources.Effects = effects
+	fcpxml.Resources.Media = media
+	fcpxml.Resources.Formats = formats
+
+	return pruned
+}
+
+// collectSequenceRefs marks the sequence format and every resource its spine references
+func collectSequenceRefs(sequence Sequence, used map[string]bool) {
+	markRef(used, sequence.Format)
+	for _, clip := range sequence.Spine.AssetClips {
+		collectAssetClipRefs(clip, used)
+	}
+	for _, video := range sequence.Spine.Videos {
+		collectVideoRefs(video, used)
+	}
+	for _, title := range sequence.Spine.Titles {
+		markRef(used, title.Ref)
+	}
+	for _, refClip := range sequence.Spine.RefClips {
+		markRef(used, refClip.Ref)
+		for _, title := range refClip.Titles {
+			markRef(used, title.Ref)
+		}
+	}
+	for _, gap := range sequence.Spine.Gaps {
+		for _, title := range gap.Titles {
+			markRef(used, title.Ref)
+		}
+		for _, generator := range gap.GeneratorClips {
+			markRef(used, generator.Ref)
+		}
+	}
+	for _, transition := range sequence.Spine.Transitions {
+		for _, filter := range transition.FilterVideos {
+			markRef(used, filter.Ref)
+		}
+	}
+}
+
+// collectAssetClipRefs marks an asset-clip's asset, format, filters and nested lanes
+func collectAssetClipRefs(clip AssetClip, used map[string]bool) {
+	markRef(used, clip.Ref)
+	markRef(used, clip.Format)
+	for _, filter := range clip.FilterVideos {
+		markRef(used, filter.Ref)
+	}
+	for _, nested := range clip.NestedAssetClips {
+		collectAssetClipRefs(nested, used)
+	}
+	for _, video := range clip.Videos {
+		collectVideoRefs(video, used)
+	}
+	for _, title := range clip.Titles {
+		markRef(used, title.Ref)
+	}
+}
+
+// collectVideoRefs marks a video's asset or generator effect, filters and nested lanes
+func collectVideoRefs(video Video, used map[string]bool) {
+	markRef(used, video.Ref)
+	for _, filter := range video.FilterVideos {
+		markRef(used, filter.Ref)
+	}
+	for _, nested := range video.NestedVideos {
+		collectVideoRefs(nested, used)
+	}
+	for _, clip := range video.NestedAssetClips {
+		collectAssetClipRefs(clip, used)
+	}
+	for _, title := range video.NestedTitles {
+		markRef(used, title.Ref)
+	}
+}
+
+// markRef records id as referenced, ignoring empty attributes
+func markRef(used map[string]bool, id string) {
+	if id != "" {
+		used[id] = true
+	}
+}
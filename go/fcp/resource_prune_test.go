@@ -0,0 +1,80 @@
+package fcp
+
+import (
+	"testing"
+)
+
+// TestPruneUnusedResources tests that an unused effect is removed while in-use resources stay
+func TestPruneUnusedResources(t *testing.T) {
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("Failed to create empty FCPXML: %v", err)
+	}
+	if err := AddSingleText(fcpxml, "Keep me", 0, 5); err != nil {
+		t.Fatalf("AddSingleText failed: %v", err)
+	}
+
+	registry := NewResourceRegistry(fcpxml)
+	tx := NewTransaction(registry)
+	unusedID := tx.ReserveIDs(1)[0]
+	if _, err := tx.CreateEffect(unusedID, "Gaussian Blur", "FFGaussianBlur"); err != nil {
+		t.Fatalf("CreateEffect failed: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatalf("Commit failed: %v", err)
+	}
+
+	// A format referenced only by an asset must survive even though no clip uses it directly
+	usedAssetFormat := Format{ID: "r90", Name: "FFVideoFormatRateUndefined"}
+	fcpxml.Resources.Formats = append(fcpxml.Resources.Formats, usedAssetFormat)
+	fcpxml.Resources.Assets = append(fcpxml.Resources.Assets, Asset{ID: "r91", Name: "still", Format: "r90", Duration: "0s"})
+	fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine.Videos = append(
+		fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine.Videos,
+		Video{Ref: "r91", Offset: "0s", Name: "still", Duration: "240240/24000s"},
+	)
+
+	sequenceFormat := fcpxml.Library.Events[0].Projects[0].Sequences[0].Format
+	textEffectID := fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine.Titles[0].Ref
+
+	if pruned := PruneUnusedResources(fcpxml); pruned != 1 {
+		t.Errorf("Expected 1 pruned resource, got %d", pruned)
+	}
+
+	for _, effect := range fcpxml.Resources.Effects {
+		if effect.ID == unusedID {
+			t.Errorf("Expected unused effect %s to be pruned", unusedID)
+		}
+	}
+	if !hasEffectID(fcpxml, textEffectID) {
+		t.Errorf("Expected in-use text effect %s to be kept", textEffectID)
+	}
+	if !hasFormatID(fcpxml, sequenceFormat) {
+		t.Errorf("Expected sequence format %s to be kept", sequenceFormat)
+	}
+	if !hasFormatID(fcpxml, "r90") {
+		t.Error("Expected asset-only format r90 to be kept")
+	}
+
+	// A second pass has nothing left to do
+	if pruned := PruneUnusedResources(fcpxml); pruned != 0 {
+		t.Errorf("Expected second prune to remove nothing, got %d", pruned)
+	}
+}
+
+func hasEffectID(fcpxml *FCPXML, id string) bool {
+	for _, effect := range fcpxml.Resources.Effects {
+		if effect.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+func hasFormatID(fcpxml *FCPXML, id string) bool {
+	for _, format := range fcpxml.Resources.Formats {
+		if format.ID == id {
+			return true
+		}
+	}
+	return false
+}
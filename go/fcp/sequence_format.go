@@ -0,0 +1,57 @@
+package fcp
+
+import "fmt"
+
+// FixSequenceFormat points every sequence whose format is missing or undefined at a default
+// 720p 23.976 format (the one GenerateEmpty uses) and returns the names of the projects it fixed.
+//
+// 🚨 CLAUDE.md Rules Applied Here:
+// - The replacement format is created once through the Transaction and shared by all dangling sequences
+// - Sequences that already reference a defined format are left untouched
+// - An empty format is fixed too: the DTD requires one even though the validator only flags dangling IDs
+func FixSequenceFormat(fcpxml *FCPXML) ([]string, error) {
+	formatIDs := make(map[string]bool)
+	for _, format := range fcpxml.Resources.Formats {
+		formatIDs[format.ID] = true
+	}
+
+	type danglingSequence struct {
+		event, project, sequence int
+	}
+	var dangling []danglingSequence
+	for e, event := range fcpxml.Library.Events {
+		for p, project := range event.Projects {
+			for s, sequence := range project.Sequences {
+				if !formatIDs[sequence.Format] {
+					dangling = append(dangling, danglingSequence{e, p, s})
+				}
+			}
+		}
+	}
+	if len(dangling) == 0 {
+		return nil, nil
+	}
+
+	registry := NewResourceRegistry(fcpxml)
+	tx := NewTransaction(registry)
+	defer tx.Rollback()
+
+	formatID := tx.ReserveIDs(1)[0]
+	format, err := tx.CreateFormatWithFrameDuration(formatID, "1001/24000s", "1280", "720", "1-1-1 (Rec. 709)")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create sequence format: %v", err)
+	}
+	format.Name = "FFVideoFormat720p2398"
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	var fixed []string
+	for _, d := range dangling {
+		project := &fcpxml.Library.Events[d.event].Projects[d.project]
+		project.Sequences[d.sequence].Format = formatID
+		fixed = append(fixed, project.Name)
+	}
+	return fixed, nil
+}
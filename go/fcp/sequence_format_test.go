@@ -0,0 +1,58 @@
+package fcp
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestFixSequenceFormat tests that a dangling sequence format is reported and then repaired
+func TestFixSequenceFormat(t *testing.T) {
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("Failed to create empty FCPXML: %v", err)
+	}
+	fcpxml.Library.Events[0].Projects[0].Sequences[0].Format = "r99"
+
+	found := false
+	for _, violation := range ValidateClaudeCompliance(fcpxml) {
+		if strings.Contains(violation, "Missing sequence format") && strings.Contains(violation, "'wiki'") && strings.Contains(violation, "'r99'") {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("Expected a missing sequence format violation naming project 'wiki' and format 'r99'")
+	}
+
+	fixed, err := FixSequenceFormat(fcpxml)
+	if err != nil {
+		t.Fatalf("FixSequenceFormat failed: %v", err)
+	}
+	if len(fixed) != 1 || fixed[0] != "wiki" {
+		t.Errorf("Expected project 'wiki' to be fixed, got %v", fixed)
+	}
+
+	sequenceFormat := fcpxml.Library.Events[0].Projects[0].Sequences[0].Format
+	var format *Format
+	for i := range fcpxml.Resources.Formats {
+		if fcpxml.Resources.Formats[i].ID == sequenceFormat {
+			format = &fcpxml.Resources.Formats[i]
+		}
+	}
+	if format == nil {
+		t.Fatalf("Sequence format '%s' is still undefined after the fix", sequenceFormat)
+	}
+	if format.FrameDuration != "1001/24000s" || format.Width != "1280" || format.Height != "720" {
+		t.Errorf("Expected a 1280x720 23.976 format, got %+v", *format)
+	}
+
+	for _, violation := range ValidateClaudeCompliance(fcpxml) {
+		if strings.Contains(violation, "Missing sequence format") {
+			t.Errorf("Unexpected violation after fix: %s", violation)
+		}
+	}
+
+	// A valid tree needs no fixing
+	if fixed, err := FixSequenceFormat(fcpxml); err != nil || len(fixed) != 0 {
+		t.Errorf("Expected nothing to fix, got %v (err %v)", fixed, err)
+	}
+}
@@ -0,0 +1,71 @@
+package fcp
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// SortSpineByOffset stably sorts each of the spine's element slices by offset. MarshalXML already
+// writes the spine in time order, but code that walks Videos or AssetClips directly (e.g. "the
+// last clip", "the clip after this one") assumes the slices are in order too, which edits that
+// move or insert clips can break, so those edits call this. Elements with equal offsets keep
+// their relative order.
+func SortSpineByOffset(sequence *Sequence) {
+	spine := &sequence.Spine
+	sort.SliceStable(spine.AssetClips, func(i, j int) bool {
+		return parseFCPDurationForSort(spine.AssetClips[i].Offset) < parseFCPDurationForSort(spine.AssetClips[j].Offset)
+	})
+	sort.SliceStable(spine.Videos, func(i, j int) bool {
+		return parseFCPDurationForSort(spine.Videos[i].Offset) < parseFCPDurationForSort(spine.Videos[j].Offset)
+	})
+	sort.SliceStable(spine.Titles, func(i, j int) bool {
+		return parseFCPDurationForSort(spine.Titles[i].Offset) < parseFCPDurationForSort(spine.Titles[j].Offset)
+	})
+	sort.SliceStable(spine.Gaps, func(i, j int) bool {
+		return parseFCPDurationForSort(spine.Gaps[i].Offset) < parseFCPDurationForSort(spine.Gaps[j].Offset)
+	})
+	sort.SliceStable(spine.RefClips, func(i, j int) bool {
+		return parseFCPDurationForSort(spine.RefClips[i].Offset) < parseFCPDurationForSort(spine.RefClips[j].Offset)
+	})
+	sort.SliceStable(spine.Transitions, func(i, j int) bool {
+		return parseFCPDurationForSort(spine.Transitions[i].Offset) < parseFCPDurationForSort(spine.Transitions[j].Offset)
+	})
+}
+
+// unsortedSpineSlices names the spine slices whose elements are not in offset order
+func unsortedSpineSlices(spine *Spine) []string {
+	var unsorted []string
+	check := func(name string, count int, offset func(int) string) {
+		for i := 1; i < count; i++ {
+			if parseFCPDurationForSort(offset(i)) < parseFCPDurationForSort(offset(i-1)) {
+				unsorted = append(unsorted, name)
+				return
+			}
+		}
+	}
+	check("AssetClips", len(spine.AssetClips), func(i int) string { return spine.AssetClips[i].Offset })
+	check("Videos", len(spine.Videos), func(i int) string { return spine.Videos[i].Offset })
+	check("Titles", len(spine.Titles), func(i int) string { return spine.Titles[i].Offset })
+	check("Gaps", len(spine.Gaps), func(i int) string { return spine.Gaps[i].Offset })
+	check("RefClips", len(spine.RefClips), func(i int) string { return spine.RefClips[i].Offset })
+	check("Transitions", len(spine.Transitions), func(i int) string { return spine.Transitions[i].Offset })
+	return unsorted
+}
+
+// unsortedSpineWarnings returns a ValidateClaudeCompliance warning for each sequence whose spine
+// slices are out of offset order. It is a warning rather than a violation: the XML is still
+// written in time order.
+func unsortedSpineWarnings(fcpxml *FCPXML) []string {
+	var warnings []string
+	for _, event := range fcpxml.Library.Events {
+		for _, project := range event.Projects {
+			for _, sequence := range project.Sequences {
+				if unsorted := unsortedSpineSlices(&sequence.Spine); len(unsorted) > 0 {
+					warnings = append(warnings, fmt.Sprintf("%sspine %s of project '%s' are out of offset order (SortSpineByOffset fixes this)", ValidationWarningPrefix, strings.Join(unsorted, ", "), project.Name))
+				}
+			}
+		}
+	}
+	return warnings
+}
@@ -0,0 +1,78 @@
+package fcp
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+// TestSortSpineByOffset tests that out-of-order Videos and Titles are reported and sorted ascending
+// by offset, with equal offsets keeping their order
+func TestSortSpineByOffset(t *testing.T) {
+	sequence := &Sequence{}
+	sequence.Spine.Videos = []Video{
+		{Name: "third", Offset: ConvertSecondsToFCPDuration(6)},
+		{Name: "first", Offset: "0s"},
+		{Name: "second-a", Offset: ConvertSecondsToFCPDuration(3)},
+		{Name: "second-b", Offset: ConvertSecondsToFCPDuration(3)},
+	}
+	sequence.Spine.Titles = []Title{
+		{Name: "late", Offset: ConvertSecondsToFCPDuration(2)},
+		{Name: "early", Offset: ConvertSecondsToFCPDuration(1)},
+	}
+
+	if unsorted := unsortedSpineSlices(&sequence.Spine); len(unsorted) != 2 || unsorted[0] != "Videos" || unsorted[1] != "Titles" {
+		t.Errorf("Expected Videos and Titles reported unsorted, got %v", unsorted)
+	}
+
+	SortSpineByOffset(sequence)
+
+	want := []string{"first", "second-a", "second-b", "third"}
+	for i, video := range sequence.Spine.Videos {
+		if video.Name != want[i] {
+			t.Errorf("Video %d: expected %s, got %s", i, want[i], video.Name)
+		}
+	}
+	if sequence.Spine.Titles[0].Name != "early" {
+		t.Errorf("Expected titles sorted too, got %s first", sequence.Spine.Titles[0].Name)
+	}
+	if unsorted := unsortedSpineSlices(&sequence.Spine); len(unsorted) != 0 {
+		t.Errorf("Expected no unsorted slices after sorting, got %v", unsorted)
+	}
+}
+
+// TestWriteToLeavesSpineOrder validates that exporting writes the spine in time order without
+// reordering the caller's slices, and that validation warns about the unsorted slices
+func TestWriteToLeavesSpineOrder(t *testing.T) {
+	fcpxml := buildThreeImageTimeline(t)
+	videos := fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine.Videos
+	videos[0], videos[2] = videos[2], videos[0]
+	names := []string{videos[0].Name, videos[1].Name, videos[2].Name}
+
+	violations, warnings := SplitValidationWarnings(ValidateClaudeCompliance(fcpxml))
+	if len(violations) != 0 {
+		t.Errorf("Unexpected violations: %v", violations)
+	}
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "spine Videos of project") {
+		t.Errorf("Expected a warning about the unsorted Videos slice, got %v", warnings)
+	}
+
+	var buf bytes.Buffer
+	if err := WriteTo(fcpxml, &buf); err != nil {
+		t.Fatalf("WriteTo failed: %v", err)
+	}
+	for i, video := range videos {
+		if video.Name != names[i] {
+			t.Errorf("Video %d: WriteTo reordered the slice, expected %s, got %s", i, names[i], video.Name)
+		}
+	}
+
+	// The written spine is still in time order
+	output := buf.String()
+	first := strings.Index(output, `offset="0s"`)
+	for _, video := range videos {
+		if video.Offset != "0s" && strings.Index(output, `offset="`+video.Offset+`"`) < first {
+			t.Errorf("Video at %s was written before the one at 0s", video.Offset)
+		}
+	}
+}
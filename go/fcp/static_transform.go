@@ -0,0 +1,119 @@
+package fcp
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// SetStaticTransform places a clip with fixed adjust-transform attributes (e.g. scale="2 2")
+// instead of single-keyframe animations. Empty arguments leave that property as it is.
+// position is "x y", scale is "x y" (a single value scales uniformly), rotation is degrees.
+//
+// 🚨 CLAUDE.md Rules Applied Here:
+// - Static values are AdjustTransform attributes; keyframed params for the same property are dropped so they can't conflict
+// - Keyframes on other properties (e.g. an animated rotation under a static scale) are kept
+func SetStaticTransform(clip *Video, position, scale, rotation string) error {
+	return setStaticTransform(&clip.AdjustTransform, position, scale, rotation)
+}
+
+// setStaticTransform is SetStaticTransform for any element's adjust-transform
+func setStaticTransform(adjust **AdjustTransform, position, scale, rotation string) error {
+	position, err := normalizeTransformPair("position", position, false)
+	if err != nil {
+		return err
+	}
+	scale, err = normalizeTransformPair("scale", scale, true)
+	if err != nil {
+		return err
+	}
+	if rotation != "" {
+		degrees, err := strconv.ParseFloat(strings.TrimSpace(rotation), 64)
+		if err != nil {
+			return fmt.Errorf("rotation must be a number of degrees, got '%s'", rotation)
+		}
+		rotation = strconv.FormatFloat(degrees, 'f', -1, 64)
+	}
+	if position == "" && scale == "" && rotation == "" {
+		return nil
+	}
+
+	if *adjust == nil {
+		*adjust = &AdjustTransform{}
+	}
+	transform := *adjust
+
+	replaced := map[string]bool{}
+	if position != "" {
+		transform.Position = position
+		replaced["position"] = true
+	}
+	if scale != "" {
+		transform.Scale = scale
+		replaced["scale"] = true
+	}
+	if rotation != "" {
+		transform.Rotation = rotation
+		replaced["rotation"] = true
+	}
+
+	var kept []Param
+	for _, param := range transform.Params {
+		if !replaced[strings.ToLower(param.Name)] {
+			kept = append(kept, param)
+		}
+	}
+	transform.Params = kept
+
+	return nil
+}
+
+// normalizeTransformPair checks an "x y" transform value; allowSingle accepts "2" as "2 2"
+func normalizeTransformPair(name, value string, allowSingle bool) (string, error) {
+	fields := strings.Fields(value)
+	if len(fields) == 0 {
+		return "", nil
+	}
+	if len(fields) == 1 && allowSingle {
+		fields = append(fields, fields[0])
+	}
+	if len(fields) != 2 {
+		return "", fmt.Errorf("%s must be 'x y', got '%s'", name, value)
+	}
+
+	parts := make([]string, 2)
+	for i, field := range fields {
+		number, err := strconv.ParseFloat(field, 64)
+		if err != nil {
+			return "", fmt.Errorf("invalid %s component '%s': %v", name, field, err)
+		}
+		parts[i] = strconv.FormatFloat(number, 'f', -1, 64)
+	}
+	return strings.Join(parts, " "), nil
+}
+
+// AddImageWithStaticTransform adds an image like AddImageWithOptions and places it with a fixed
+// position and/or scale (see SetStaticTransform) - no keyframes are written.
+func AddImageWithStaticTransform(fcpxml *FCPXML, imagePath string, durationSeconds float64, position, scale string, opts ImageOptions) error {
+	// Validate before anything is added so a typo doesn't leave a half-placed image
+	if _, err := normalizeTransformPair("position", position, false); err != nil {
+		return err
+	}
+	if _, err := normalizeTransformPair("scale", scale, true); err != nil {
+		return err
+	}
+
+	if err := AddImageWithOptions(fcpxml, imagePath, durationSeconds, opts); err != nil {
+		return err
+	}
+
+	sequence, err := firstSequence(fcpxml)
+	if err != nil {
+		return err
+	}
+	image, err := lastAddedImage(sequence, opts)
+	if err != nil {
+		return err
+	}
+	return setStaticTransform(image.transform, position, scale, "")
+}
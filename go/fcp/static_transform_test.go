@@ -0,0 +1,76 @@
+package fcp
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestAddImageWithStaticTransform tests that a static scale is written as an attribute, not a keyframe animation
+func TestAddImageWithStaticTransform(t *testing.T) {
+	dir := t.TempDir()
+	imagePath := filepath.Join(dir, "logo.png")
+	writeTestPNG(t, imagePath, 32, 18)
+
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("GenerateEmpty failed: %v", err)
+	}
+	if err := AddImageWithStaticTransform(fcpxml, imagePath, 5, "10 -20", "2", ImageOptions{}); err != nil {
+		t.Fatalf("AddImageWithStaticTransform failed: %v", err)
+	}
+
+	video := fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine.Videos[0]
+	if video.AdjustTransform == nil || video.AdjustTransform.Scale != "2 2" || video.AdjustTransform.Position != "10 -20" {
+		t.Fatalf("Expected static scale '2 2' and position '10 -20', got %+v", video.AdjustTransform)
+	}
+	if len(video.AdjustTransform.Params) != 0 {
+		t.Errorf("Expected no keyframed params, got %d", len(video.AdjustTransform.Params))
+	}
+
+	outputPath := filepath.Join(dir, "static.fcpxml")
+	if err := WriteToFile(fcpxml, outputPath); err != nil {
+		t.Fatalf("WriteToFile failed: %v", err)
+	}
+	data, err := os.ReadFile(outputPath)
+	if err != nil {
+		t.Fatalf("Failed to read output: %v", err)
+	}
+	output := string(data)
+	if !strings.Contains(output, `scale="2 2"`) {
+		t.Errorf("Expected scale=\"2 2\" attribute in output")
+	}
+	if strings.Contains(output, "<keyframeAnimation") {
+		t.Errorf("Static transform should not write keyframe animations")
+	}
+}
+
+// TestSetStaticTransformReplacesKeyframes tests that a static value replaces keyframes for the same property only
+func TestSetStaticTransformReplacesKeyframes(t *testing.T) {
+	video := Video{
+		AdjustTransform: &AdjustTransform{
+			Params: []Param{
+				{Name: "scale", KeyframeAnimation: &KeyframeAnimation{Keyframes: []Keyframe{{Time: "0s", Value: "1 1"}}}},
+				{Name: "rotation", KeyframeAnimation: &KeyframeAnimation{Keyframes: []Keyframe{{Time: "0s", Value: "0"}}}},
+			},
+		},
+	}
+
+	if err := SetStaticTransform(&video, "", "1.5 1.5", ""); err != nil {
+		t.Fatalf("SetStaticTransform failed: %v", err)
+	}
+	if video.AdjustTransform.Scale != "1.5 1.5" {
+		t.Errorf("Expected static scale 1.5 1.5, got '%s'", video.AdjustTransform.Scale)
+	}
+	if len(video.AdjustTransform.Params) != 1 || video.AdjustTransform.Params[0].Name != "rotation" {
+		t.Errorf("Expected only the rotation animation to remain, got %+v", video.AdjustTransform.Params)
+	}
+
+	if err := SetStaticTransform(&video, "10", "", ""); err == nil {
+		t.Errorf("Expected an error for a one-component position")
+	}
+	if err := SetStaticTransform(&video, "", "", "quarter"); err == nil {
+		t.Errorf("Expected an error for a non-numeric rotation")
+	}
+}
@@ -0,0 +1,79 @@
+package fcp
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"strings"
+)
+
+// TextOccurrence is one piece of on-screen text; times are timeline seconds, including for
+// titles nested in clips
+type TextOccurrence struct {
+	Text            string
+	Name            string // The title's name, e.g. "Caption 3"
+	OffsetSeconds   float64
+	DurationSeconds float64
+	Lane            int
+}
+
+// ExtractText lists the text of every title in the first sequence - spine titles and titles
+// nested on clip lanes - in timeline order, for proofreading. Empty titles are skipped.
+//
+// 🚨 CLAUDE.md Rules Applied Here:
+// - Walks the same timeline model as ExportTimelineJSON, so nested offsets map to timeline time
+// - Text runs of a title (e.g. shadow or styled words) are joined in order
+// - Read-only: the FCPXML is not modified
+func ExtractText(fcpxml *FCPXML) []TextOccurrence {
+	sequence, err := firstSequence(fcpxml)
+	if err != nil {
+		return nil
+	}
+
+	var titles []ClipJSON
+	var collect func(clips []ClipJSON)
+	collect = func(clips []ClipJSON) {
+		for _, clip := range clips {
+			if clip.Type == "title" && strings.TrimSpace(clip.Text) != "" {
+				titles = append(titles, clip)
+			}
+			collect(clip.Children)
+		}
+	}
+	collect(newTimelineExporter(fcpxml).spineClips(sequence.Spine))
+
+	// Children are only ordered within their parent; put everything on one timeline
+	sortClipsByOffset(titles)
+
+	occurrences := make([]TextOccurrence, 0, len(titles))
+	for _, title := range titles {
+		occurrences = append(occurrences, TextOccurrence{
+			Text:            title.Text,
+			Name:            title.Name,
+			OffsetSeconds:   title.OffsetSeconds,
+			DurationSeconds: title.DurationSeconds,
+			Lane:            title.Lane,
+		})
+	}
+	return occurrences
+}
+
+// WriteTextReport prints occurrences as "[start - end] text" lines; multi-line titles are
+// joined with " / " so each title stays on one line
+func WriteTextReport(w io.Writer, occurrences []TextOccurrence) error {
+	for _, occurrence := range occurrences {
+		text := strings.Join(strings.Fields(strings.ReplaceAll(occurrence.Text, "\n", " / ")), " ")
+		start := formatReportTimestamp(occurrence.OffsetSeconds)
+		end := formatReportTimestamp(occurrence.OffsetSeconds + occurrence.DurationSeconds)
+		if _, err := fmt.Fprintf(w, "[%s - %s] %s\n", start, end, text); err != nil {
+			return fmt.Errorf("failed to write text report: %v", err)
+		}
+	}
+	return nil
+}
+
+// formatReportTimestamp writes seconds as HH:MM:SS.mmm
+func formatReportTimestamp(seconds float64) string {
+	millis := int(math.Round(seconds * 1000))
+	return fmt.Sprintf("%02d:%02d:%02d.%03d", millis/3600000, millis/60000%60, millis/1000%60, millis%1000)
+}
@@ -0,0 +1,70 @@
+package fcp
+
+import (
+	"bytes"
+	"math"
+	"strings"
+	"testing"
+)
+
+// TestExtractText tests that three titles yield three ordered occurrences with their times
+func TestExtractText(t *testing.T) {
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("GenerateEmpty failed: %v", err)
+	}
+	if err := AddKineticText(fcpxml, []string{"One", "Two", "Three"}, 2.0); err != nil {
+		t.Fatalf("AddKineticText failed: %v", err)
+	}
+
+	// An empty title is not proofreadable text
+	sequence := &fcpxml.Library.Events[0].Projects[0].Sequences[0]
+	empty := sequence.Spine.Titles[0]
+	empty.Name = "Empty"
+	empty.Text = &TitleText{TextStyles: []TextStyleRef{{Ref: empty.Text.TextStyles[0].Ref, Text: "  "}}}
+	sequence.Spine.Titles = append(sequence.Spine.Titles, empty)
+
+	occurrences := ExtractText(fcpxml)
+	if len(occurrences) != 3 {
+		t.Fatalf("Expected 3 text occurrences, got %d: %+v", len(occurrences), occurrences)
+	}
+	for i, expected := range []string{"One", "Two", "Three"} {
+		occurrence := occurrences[i]
+		if occurrence.Text != expected {
+			t.Errorf("Occurrence %d: expected text %q, got %q", i, expected, occurrence.Text)
+		}
+		if math.Abs(occurrence.OffsetSeconds-2.0*float64(i)) > 0.05 || math.Abs(occurrence.DurationSeconds-2.0) > 0.05 {
+			t.Errorf("Occurrence %d: expected %.1fs for 2s, got %.3fs for %.3fs", i, 2.0*float64(i), occurrence.OffsetSeconds, occurrence.DurationSeconds)
+		}
+	}
+
+	var report bytes.Buffer
+	if err := WriteTextReport(&report, occurrences); err != nil {
+		t.Fatalf("WriteTextReport failed: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(report.String()), "\n")
+	if len(lines) != 3 || !strings.HasPrefix(lines[0], "[00:00:00.000 - 00:00:02.002] One") {
+		t.Errorf("Unexpected report:\n%s", report.String())
+	}
+}
+
+// TestExtractTextNested tests that titles connected to a clip report timeline times
+func TestExtractTextNested(t *testing.T) {
+	fcpxml, err := GenerateCountdownWithOptions(3, 1.0, CountdownOptions{BackgroundColor: "0 0 0 1"})
+	if err != nil {
+		t.Fatalf("GenerateCountdownWithOptions failed: %v", err)
+	}
+
+	occurrences := ExtractText(fcpxml)
+	if len(occurrences) != 3 {
+		t.Fatalf("Expected 3 nested text occurrences, got %d", len(occurrences))
+	}
+	for i, expected := range []string{"3", "2", "1"} {
+		if occurrences[i].Text != expected || occurrences[i].Lane != 1 {
+			t.Errorf("Occurrence %d: expected %q on lane 1, got %q on lane %d", i, expected, occurrences[i].Text, occurrences[i].Lane)
+		}
+		if math.Abs(occurrences[i].OffsetSeconds-float64(i)) > 0.05 {
+			t.Errorf("Occurrence %d: expected about %ds, got %.3fs", i, i, occurrences[i].OffsetSeconds)
+		}
+	}
+}
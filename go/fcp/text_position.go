@@ -0,0 +1,69 @@
+package fcp
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// titlePositionCells maps a named screen position to its column and row in a 3x3 grid over
+// the title-safe area: -1 is left/bottom, 0 center, 1 right/top
+var titlePositionCells = map[string][2]int{
+	"top-left":      {-1, 1},
+	"top-center":    {0, 1},
+	"top-right":     {1, 1},
+	"center-left":   {-1, 0},
+	"center":        {0, 0},
+	"center-right":  {1, 0},
+	"bottom-left":   {-1, -1},
+	"bottom-center": {0, -1},
+	"bottom-right":  {1, -1},
+}
+
+// TitlePositionNames lists the named screen positions in a stable order, for help and errors
+func TitlePositionNames() []string {
+	names := make([]string, 0, len(titlePositionCells))
+	for name := range titlePositionCells {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// TitlePositionValue returns the "x y" Position param value that puts a title at the named
+// position in a width x height frame, moved by offset ("x y" pixels, +y up; empty for none).
+// Positions are the centers of a 3x3 grid over the title-safe area, so a title of moderate
+// width at top-left or bottom-right still sits inside the safe area. Units are frame pixels
+// from the center, as in the AutoFit stagger layout.
+func TitlePositionValue(name, offset string, width, height float64) (string, error) {
+	cell, ok := titlePositionCells[strings.ToLower(strings.TrimSpace(name))]
+	if !ok {
+		return "", fmt.Errorf("unknown position '%s' (use %s)", name, strings.Join(TitlePositionNames(), ", "))
+	}
+	dx, dy, err := parsePositionOffset(offset)
+	if err != nil {
+		return "", err
+	}
+
+	x := float64(cell[0])*width*titleSafeScale/3 + dx
+	y := float64(cell[1])*height*titleSafeScale/3 + dy
+	return strconv.FormatFloat(x, 'f', -1, 64) + " " + strconv.FormatFloat(y, 'f', -1, 64), nil
+}
+
+// parsePositionOffset reads an "x y" pixel nudge; empty means no nudge
+func parsePositionOffset(offset string) (float64, float64, error) {
+	if strings.TrimSpace(offset) == "" {
+		return 0, 0, nil
+	}
+	fields := strings.Fields(offset)
+	if len(fields) != 2 {
+		return 0, 0, fmt.Errorf("position offset must be 'x y', got '%s'", offset)
+	}
+	x, err1 := strconv.ParseFloat(fields[0], 64)
+	y, err2 := strconv.ParseFloat(fields[1], 64)
+	if err1 != nil || err2 != nil {
+		return 0, 0, fmt.Errorf("position offset must be numeric 'x y', got '%s'", offset)
+	}
+	return x, y, nil
+}
@@ -0,0 +1,74 @@
+package fcp
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// TestAddSingleTextNamedPosition tests that bottom-center in a 1080-tall sequence is centered in the lower third
+func TestAddSingleTextNamedPosition(t *testing.T) {
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("Failed to create empty FCPXML: %v", err)
+	}
+	sequence := &fcpxml.Library.Events[0].Projects[0].Sequences[0]
+	for i := range fcpxml.Resources.Formats {
+		if fcpxml.Resources.Formats[i].ID == sequence.Format {
+			fcpxml.Resources.Formats[i].Width = "1920"
+			fcpxml.Resources.Formats[i].Height = "1080"
+		}
+	}
+
+	if err := AddSingleTextStyled(fcpxml, "Lower", 0, 5, TextStyleOptions{Position: "bottom-center"}); err != nil {
+		t.Fatalf("AddSingleTextStyled failed: %v", err)
+	}
+	x, y := titlePosition(t, lastSingleText(fcpxml))
+	if x != 0 {
+		t.Errorf("Expected bottom-center to be horizontally centered, got x=%g", x)
+	}
+	// The lower third of a 1080-tall frame is below -180 (center is 0, bottom edge -540)
+	if y >= -180 || y <= -540 {
+		t.Errorf("Expected bottom-center in the lower third of the frame, got y=%g", y)
+	}
+
+	if err := AddSingleTextStyled(fcpxml, "Nudged", 0, 5, TextStyleOptions{Position: "Top-Right", PositionOffset: "-20 10"}); err != nil {
+		t.Fatalf("AddSingleTextStyled failed: %v", err)
+	}
+	x, y = titlePosition(t, lastSingleText(fcpxml))
+	if x != 512-20 || y != 288+10 {
+		t.Errorf("Expected nudged top-right at 492 298, got %g %g", x, y)
+	}
+}
+
+// TestTitlePositionValueErrors tests that unknown names and malformed offsets are rejected
+func TestTitlePositionValueErrors(t *testing.T) {
+	if _, err := TitlePositionValue("middle", "", 1920, 1080); err == nil || !strings.Contains(err.Error(), "bottom-center") {
+		t.Errorf("Expected unknown position error listing the names, got %v", err)
+	}
+	if _, err := TitlePositionValue("center", "10", 1920, 1080); err == nil {
+		t.Errorf("Expected an error for a one-component offset")
+	}
+	if err := (TextStyleOptions{Position: "upper-left"}).Validate(); err == nil {
+		t.Errorf("Expected Validate to reject an unknown position")
+	}
+}
+
+// titlePosition returns the numeric Position param of title
+func titlePosition(t *testing.T, title *Title) (float64, float64) {
+	t.Helper()
+	if title == nil {
+		t.Fatalf("Expected a title on the timeline")
+	}
+	for _, param := range title.Params {
+		if param.Name == "Position" {
+			var x, y float64
+			if _, err := fmt.Sscanf(param.Value, "%g %g", &x, &y); err != nil {
+				t.Fatalf("Unparseable position '%s': %v", param.Value, err)
+			}
+			return x, y
+		}
+	}
+	t.Fatalf("Title has no Position param")
+	return 0, 0
+}
@@ -0,0 +1,134 @@
+package fcp
+
+import (
+	"fmt"
+)
+
+// Stagger axes for multi-line text reveals
+const (
+	StaggerAxisVertical   = "vertical"
+	StaggerAxisHorizontal = "horizontal"
+)
+
+// DefaultStaggerPixelStep is the distance between consecutive staggered text elements
+const DefaultStaggerPixelStep = 300
+
+// minAutoFitPixelStep is the tightest line spacing AutoFit allows before wrapping into another column
+const minAutoFitPixelStep = 60
+
+// StaggerConfig controls how AddTextFromFile spreads lines across the frame and over time.
+// The zero value reproduces the original layout: lines step 300px down, each starting
+// half a duration after the previous one. The steps are pointers so an explicit 0 (every
+// line on one spot, or all revealed at once) is distinct from unset.
+type StaggerConfig struct {
+	Axis            string   // StaggerAxisVertical (down) or StaggerAxisHorizontal (left-to-right); empty = vertical
+	PixelStep       *int     // Pixels between lines; nil uses DefaultStaggerPixelStep
+	TimeStepSeconds *float64 // Delay between lines; nil uses half of the text duration
+	AutoFit         bool     // Tighten the spacing (or wrap into columns) so every line stays title-safe
+
+	// Layout computed by fitToFrame; the zero values keep the original center-anchored single column
+	originX, originY int // Position of the first line
+	rows             int // Lines per column; 0 means one column
+	columnStep       int // Distance between columns, across the stagger axis
+}
+
+// Validate rejects unknown axes and negative spacing
+func (cfg StaggerConfig) Validate() error {
+	switch cfg.Axis {
+	case "", StaggerAxisVertical, StaggerAxisHorizontal:
+	default:
+		return fmt.Errorf("invalid stagger axis '%s' (use %s or %s)", cfg.Axis, StaggerAxisVertical, StaggerAxisHorizontal)
+	}
+	if cfg.PixelStep != nil && *cfg.PixelStep < 0 {
+		return fmt.Errorf("stagger pixel step must not be negative, got %d", *cfg.PixelStep)
+	}
+	if cfg.TimeStepSeconds != nil && *cfg.TimeStepSeconds < 0 {
+		return fmt.Errorf("stagger time step must not be negative, got %g", *cfg.TimeStepSeconds)
+	}
+	return nil
+}
+
+// timeStep returns the delay between lines for text lasting durationSeconds
+func (cfg StaggerConfig) timeStep(durationSeconds float64) float64 {
+	if cfg.TimeStepSeconds != nil {
+		return *cfg.TimeStepSeconds
+	}
+	return durationSeconds * 0.5
+}
+
+// step returns the configured distance between consecutive lines
+func (cfg StaggerConfig) step() int {
+	if cfg.PixelStep == nil {
+		return DefaultStaggerPixelStep
+	}
+	return *cfg.PixelStep
+}
+
+// position returns the "x y" Position param value for line index i
+func (cfg StaggerConfig) position(i int) string {
+	row, column := i, 0
+	if cfg.rows > 0 {
+		row, column = i%cfg.rows, i/cfg.rows
+	}
+	if cfg.Axis == StaggerAxisHorizontal {
+		return fmt.Sprintf("%d %d", cfg.originX+row*cfg.step(), cfg.originY-column*cfg.columnStep)
+	}
+	return fmt.Sprintf("%d %d", cfg.originX+column*cfg.columnStep, cfg.originY-row*cfg.step())
+}
+
+// fitToFrame lays count lines out inside the title-safe area of a width x height frame when
+// AutoFit is set. Lines that already fit keep the original layout; otherwise the run starts at
+// the safe edge and the step shrinks to span the safe area, wrapping into extra columns once
+// lines would sit closer than minAutoFitPixelStep.
+func (cfg StaggerConfig) fitToFrame(count int, width, height float64) StaggerConfig {
+	if !cfg.AutoFit || count < 2 {
+		return cfg
+	}
+
+	along, across := height, width
+	if cfg.Axis == StaggerAxisHorizontal {
+		along, across = width, height
+	}
+	safeAlong := int(along * titleSafeScale)
+	safeAcross := int(across * titleSafeScale)
+
+	// The original layout starts at the center, so it has half the safe area to work with
+	if (count-1)*cfg.step() <= safeAlong/2 {
+		return cfg
+	}
+
+	rows, columns := count, 1
+	if safeAlong/(count-1) < minAutoFitPixelStep {
+		rows = safeAlong/minAutoFitPixelStep + 1
+		columns = (count + rows - 1) / rows
+		cfg.rows = rows
+		cfg.columnStep = safeAcross / columns
+	}
+	if fitted := safeAlong / (rows - 1); fitted < cfg.step() {
+		cfg.PixelStep = &fitted
+	}
+
+	// First line on the top (vertical) or left (horizontal) safe edge; extra columns start
+	// from the left (vertical) or top (horizontal) safe edge
+	if cfg.Axis == StaggerAxisHorizontal {
+		cfg.originX = -safeAlong / 2
+		if columns > 1 {
+			cfg.originY = safeAcross / 2
+		}
+	} else {
+		cfg.originY = safeAlong / 2
+		if columns > 1 {
+			cfg.originX = -safeAcross / 2
+		}
+	}
+	return cfg
+}
+
+// lane returns the lane for line index i of count lines.
+// Vertical stacks keep the first line on top; horizontal reveals layer each new line over the previous one.
+func (cfg StaggerConfig) lane(i, count int) int {
+	if cfg.Axis == StaggerAxisHorizontal {
+		return i + 1
+	}
+	return count - i
+}
@@ -0,0 +1,96 @@
+package fcp
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Text shadow defaults match FCP's inspector defaults for a drop shadow
+const (
+	DefaultTextShadowColor  = "0 0 0 0.75"
+	DefaultTextShadowOffset = "5 315"
+	DefaultTextOutlineColor = "0 0 0 1"
+)
+
+// TextStyleOptions adds legibility styling to generated text-style elements
+type TextStyleOptions struct {
+	ShadowColor  string  // "r g b a" (0.0-1.0); empty disables the shadow
+	ShadowOffset string  // "distance angle", e.g. "5 315" (down-right); empty uses DefaultTextShadowOffset
+	ShadowBlur   float64 // Shadow blur radius; 0 leaves FCP's default
+	OutlineColor string  // "r g b a" (0.0-1.0); empty uses DefaultTextOutlineColor
+	OutlineWidth float64 // Stroke width; 0 disables the outline
+
+	// Placement for AddSingleTextStyled; empty keeps the default lower position
+	Position       string // Named screen position, e.g. "bottom-center" (see TitlePositionValue)
+	PositionOffset string // "x y" pixels to nudge the position by, +y up; implies "center" without Position
+}
+
+// Validate checks color components are in 0.0-1.0 and widths/offsets are non-negative
+func (opts TextStyleOptions) Validate() error {
+	if opts.ShadowColor != "" {
+		if _, err := ParseRGBA(opts.ShadowColor); err != nil {
+			return fmt.Errorf("invalid shadow color: %v", err)
+		}
+	}
+	if opts.ShadowOffset != "" {
+		fields := strings.Fields(opts.ShadowOffset)
+		if len(fields) != 2 {
+			return fmt.Errorf("shadow offset must be 'distance angle', got '%s'", opts.ShadowOffset)
+		}
+		distance, err1 := strconv.ParseFloat(fields[0], 64)
+		_, err2 := strconv.ParseFloat(fields[1], 64)
+		if err1 != nil || err2 != nil {
+			return fmt.Errorf("shadow offset must be numeric 'distance angle', got '%s'", opts.ShadowOffset)
+		}
+		if distance < 0 {
+			return fmt.Errorf("shadow distance must not be negative, got %g", distance)
+		}
+	}
+	if opts.ShadowBlur < 0 {
+		return fmt.Errorf("shadow blur must not be negative, got %g", opts.ShadowBlur)
+	}
+	if opts.OutlineColor != "" {
+		if _, err := ParseRGBA(opts.OutlineColor); err != nil {
+			return fmt.Errorf("invalid outline color: %v", err)
+		}
+	}
+	if opts.OutlineWidth < 0 {
+		return fmt.Errorf("outline width must not be negative, got %g", opts.OutlineWidth)
+	}
+	if opts.Position != "" || opts.PositionOffset != "" {
+		if _, err := TitlePositionValue(opts.positionName(), opts.PositionOffset, 1920, 1080); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// positionName is the named position to place the title at; a bare offset nudges from the center
+func (opts TextStyleOptions) positionName() string {
+	if opts.Position == "" {
+		return "center"
+	}
+	return opts.Position
+}
+
+// applyTo sets the shadow/stroke attributes on style; disabled options leave it untouched
+func (opts TextStyleOptions) applyTo(style *TextStyle) {
+	if opts.ShadowColor != "" {
+		style.ShadowColor = opts.ShadowColor
+		style.ShadowOffset = opts.ShadowOffset
+		if style.ShadowOffset == "" {
+			style.ShadowOffset = DefaultTextShadowOffset
+		}
+		if opts.ShadowBlur > 0 {
+			style.ShadowBlurRadius = strconv.FormatFloat(opts.ShadowBlur, 'f', -1, 64)
+		}
+	}
+	if opts.OutlineWidth > 0 {
+		style.StrokeColor = opts.OutlineColor
+		if style.StrokeColor == "" {
+			style.StrokeColor = DefaultTextOutlineColor
+		}
+		style.StrokeWidth = strconv.FormatFloat(opts.OutlineWidth, 'f', -1, 64)
+	}
+}
@@ -771,4 +771,9 @@ func (tsv *TextStyleValidator) ValidateTextConfiguration(config *TextConfigurati
 // floatPtr returns a pointer to a float64 value
 func floatPtr(f float64) *float64 {
 	return &f
+}
+
+// intPtr returns a pointer to an int value
+func intPtr(i int) *int {
+	return &i
 }
\ No newline at end of file
@@ -0,0 +1,276 @@
+package fcp
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+)
+
+// Spine element kinds handled by the editing API
+const (
+	spineKindAssetClip = "asset-clip"
+	spineKindVideo     = "video"
+	spineKindTitle     = "title"
+	spineKindGap       = "gap"
+	spineKindRefClip   = "ref-clip"
+)
+
+// spineElement points at the timing attributes of one spine element so edits can be made in place
+type spineElement struct {
+	kind     string
+	index    int // index within its typed spine slice
+	offset   *string
+	duration *string
+	start    *string // nil for gaps, which have no start attribute
+	enabled  *string // nil for gaps, which can't be disabled
+}
+
+// spineElementsInOrder lists every spine element sorted by offset (ties keep slice order)
+func spineElementsInOrder(spine *Spine) []spineElement {
+	var elements []spineElement
+	for i := range spine.AssetClips {
+		clip := &spine.AssetClips[i]
+		elements = append(elements, spineElement{spineKindAssetClip, i, &clip.Offset, &clip.Duration, &clip.Start, &clip.Enabled})
+	}
+	for i := range spine.Videos {
+		video := &spine.Videos[i]
+		elements = append(elements, spineElement{spineKindVideo, i, &video.Offset, &video.Duration, &video.Start, &video.Enabled})
+	}
+	for i := range spine.Titles {
+		title := &spine.Titles[i]
+		elements = append(elements, spineElement{spineKindTitle, i, &title.Offset, &title.Duration, &title.Start, &title.Enabled})
+	}
+	for i := range spine.Gaps {
+		gap := &spine.Gaps[i]
+		elements = append(elements, spineElement{spineKindGap, i, &gap.Offset, &gap.Duration, nil, nil})
+	}
+	for i := range spine.RefClips {
+		refClip := &spine.RefClips[i]
+		elements = append(elements, spineElement{spineKindRefClip, i, &refClip.Offset, &refClip.Duration, &refClip.Start, &refClip.Enabled})
+	}
+
+	sort.SliceStable(elements, func(i, j int) bool {
+		return parseFCPDuration(*elements[i].offset) < parseFCPDuration(*elements[j].offset)
+	})
+
+	return elements
+}
+
+// spineElementAt returns the spine element at index in timeline order. Every editing call that
+// takes a clip index counts this way, gaps included, so one index names the same element everywhere.
+func spineElementAt(spine *Spine, index int) (spineElement, error) {
+	elements := spineElementsInOrder(spine)
+	if index < 0 || index >= len(elements) {
+		return spineElement{}, fmt.Errorf("clip index %d out of range (timeline has %d elements)", index, len(elements))
+	}
+	return elements[index], nil
+}
+
+// spineAssetClipAt returns the asset-clip at index in timeline order, for edits that only apply to media clips
+func spineAssetClipAt(spine *Spine, index int) (*AssetClip, error) {
+	element, err := spineElementAt(spine, index)
+	if err != nil {
+		return nil, err
+	}
+	if element.kind != spineKindAssetClip {
+		return nil, fmt.Errorf("clip index %d is a %s, not an asset-clip", index, element.kind)
+	}
+	return &spine.AssetClips[element.index], nil
+}
+
+// TimelineElementCount returns how many elements the first sequence's spine holds, gaps included.
+// The element added last by an append-style call sits at index TimelineElementCount()-1.
+func TimelineElementCount(fcpxml *FCPXML) int {
+	sequence, err := firstSequence(fcpxml)
+	if err != nil {
+		return 0
+	}
+	return len(spineElementsInOrder(&sequence.Spine))
+}
+
+// RemoveClipAt deletes the spine element at index (in timeline order) from the first sequence.
+//
+// 🚨 CLAUDE.md Rules Applied Here:
+// - Edits the spine structs directly - no XML string manipulation
+// - Ripple shifts every later element earlier by exactly the removed duration, in the sequence's timebase
+// - Transitions on the removed element's cuts go with it; later transitions ripple with their clips
+// - Sequence duration is recomputed with editedSequenceDuration()
+// - Resources stay in place; call PruneUnusedResources() to drop assets that are no longer used
+func RemoveClipAt(fcpxml *FCPXML, index int, ripple bool) error {
+	sequence, err := firstSequence(fcpxml)
+	if err != nil {
+		return err
+	}
+
+	removed, err := spineElementAt(&sequence.Spine, index)
+	if err != nil {
+		return err
+	}
+	removedOffset, removedEnd, err := elementSpan(removed)
+	if err != nil {
+		return fmt.Errorf("clip index %d: %v", index, err)
+	}
+	removedDuration := new(big.Rat).Sub(removedEnd, removedOffset)
+	rate := SequenceFrameRate(fcpxml, sequence)
+	dropTransitionsOverlapping(&sequence.Spine, removedOffset, removedEnd)
+
+	if ripple {
+		shiftTransitionsFrom(&sequence.Spine, removedEnd, new(big.Rat).Neg(removedDuration), rate)
+		for i, element := range spineElementsInOrder(&sequence.Spine) {
+			if i == index {
+				continue
+			}
+			offset, err := parseFCPTimeRat(*element.offset)
+			if err == nil && offset.Cmp(removedEnd) >= 0 {
+				*element.offset = formatInTimebase(offset.Sub(offset, removedDuration), rate)
+			}
+		}
+	}
+
+	deleteSpineElements(&sequence.Spine, []spineElement{removed})
+	sequence.Duration = editedSequenceDuration(fcpxml, sequence)
+
+	return nil
+}
+
+// TrimTimeline keeps only the content between startSeconds and endSeconds of the first sequence.
+// Elements outside the window are removed, clips crossing a boundary are trimmed (their start
+// advances for a head trim), and the remaining content is shifted so the window begins at 0s.
+//
+// 🚨 CLAUDE.md Rules Applied Here:
+// - Window boundaries are rounded to the sequence's frames; every new time is exact in its timebase
+// - Nested lanes are untouched; they follow their parent clip's local time
+// - Transitions wholly inside the window shift with their clips; any crossing a boundary is dropped
+// - Sequence duration is recomputed with editedSequenceDuration()
+func TrimTimeline(fcpxml *FCPXML, startSeconds, endSeconds float64) error {
+	if startSeconds < 0 || endSeconds <= startSeconds {
+		return fmt.Errorf("invalid trim window %.3fs-%.3fs", startSeconds, endSeconds)
+	}
+
+	sequence, err := firstSequence(fcpxml)
+	if err != nil {
+		return err
+	}
+
+	rate := SequenceFrameRate(fcpxml, sequence)
+	windowStart, windowEnd := rate.frameTime(startSeconds), rate.frameTime(endSeconds)
+
+	// Read every span before editing, so an unreadable time leaves the timeline untouched
+	elements := spineElementsInOrder(&sequence.Spine)
+	offsets, ends := make([]*big.Rat, len(elements)), make([]*big.Rat, len(elements))
+	for i, element := range elements {
+		if offsets[i], ends[i], err = elementSpan(element); err != nil {
+			return err
+		}
+	}
+
+	var outside []spineElement
+	for i, element := range elements {
+		offset, end := offsets[i], ends[i]
+		if end.Cmp(windowStart) <= 0 || offset.Cmp(windowEnd) >= 0 {
+			outside = append(outside, element)
+			continue
+		}
+
+		newOffset := offset
+		if offset.Cmp(windowStart) < 0 {
+			newOffset = windowStart
+			if element.start != nil {
+				start := new(big.Rat)
+				if *element.start != "" {
+					if start, err = parseFCPTimeRat(*element.start); err != nil {
+						return fmt.Errorf("spine %s at %s: invalid start: %v", element.kind, *element.offset, err)
+					}
+				}
+				start.Add(start, new(big.Rat).Sub(windowStart, offset))
+				*element.start = formatInTimebase(start, rate)
+			}
+		}
+		newEnd := end
+		if newEnd.Cmp(windowEnd) > 0 {
+			newEnd = windowEnd
+		}
+
+		*element.offset = formatInTimebase(new(big.Rat).Sub(newOffset, windowStart), rate)
+		*element.duration = formatInTimebase(new(big.Rat).Sub(newEnd, newOffset), rate)
+	}
+
+	kept := sequence.Spine.Transitions[:0]
+	for _, transition := range sequence.Spine.Transitions {
+		offset, end, ok := transitionSpan(transition)
+		if ok && offset.Cmp(windowStart) >= 0 && end.Cmp(windowEnd) <= 0 {
+			transition.Offset = formatInTimebase(offset.Sub(offset, windowStart), rate)
+			kept = append(kept, transition)
+		}
+	}
+	sequence.Spine.Transitions = kept
+
+	deleteSpineElements(&sequence.Spine, outside)
+	sequence.Duration = editedSequenceDuration(fcpxml, sequence)
+
+	return nil
+}
+
+// elementSpan returns the exact offset and end of a spine element
+func elementSpan(element spineElement) (*big.Rat, *big.Rat, error) {
+	offset, err := parseFCPTimeRat(*element.offset)
+	if err != nil {
+		return nil, nil, fmt.Errorf("spine %s has an invalid offset: %v", element.kind, err)
+	}
+	duration, err := parseFCPTimeRat(*element.duration)
+	if err != nil {
+		return nil, nil, fmt.Errorf("spine %s at %s has an invalid duration: %v", element.kind, *element.offset, err)
+	}
+	return offset, new(big.Rat).Add(offset, duration), nil
+}
+
+// editedSequenceDuration is a sequence's duration after an edit moved its elements: the exact end
+// of its spine in the sequence's timebase, or calculateTimelineDuration() if a time doesn't parse
+func editedSequenceDuration(fcpxml *FCPXML, sequence *Sequence) string {
+	end, ok := exactSpineEnd(sequence)
+	if !ok {
+		return calculateTimelineDuration(sequence)
+	}
+	return formatInTimebase(end, SequenceFrameRate(fcpxml, sequence))
+}
+
+// deleteSpineElements removes the given elements from their typed spine slices
+func deleteSpineElements(spine *Spine, elements []spineElement) {
+	drop := map[string]map[int]bool{}
+	for _, element := range elements {
+		if drop[element.kind] == nil {
+			drop[element.kind] = map[int]bool{}
+		}
+		drop[element.kind][element.index] = true
+	}
+
+	spine.AssetClips = withoutIndices(spine.AssetClips, drop[spineKindAssetClip])
+	spine.Videos = withoutIndices(spine.Videos, drop[spineKindVideo])
+	spine.Titles = withoutIndices(spine.Titles, drop[spineKindTitle])
+	spine.Gaps = withoutIndices(spine.Gaps, drop[spineKindGap])
+	spine.RefClips = withoutIndices(spine.RefClips, drop[spineKindRefClip])
+}
+
+// withoutIndices returns items minus the indices in drop, preserving order
+func withoutIndices[T any](items []T, drop map[int]bool) []T {
+	if len(drop) == 0 {
+		return items
+	}
+	kept := items[:0]
+	for i, item := range items {
+		if !drop[i] {
+			kept = append(kept, item)
+		}
+	}
+	return kept
+}
+
+// firstSequence returns the sequence the generators write to
+func firstSequence(fcpxml *FCPXML) (*Sequence, error) {
+	if len(fcpxml.Library.Events) == 0 ||
+		len(fcpxml.Library.Events[0].Projects) == 0 ||
+		len(fcpxml.Library.Events[0].Projects[0].Sequences) == 0 {
+		return nil, fmt.Errorf("FCPXML has no sequence")
+	}
+	return &fcpxml.Library.Events[0].Projects[0].Sequences[0], nil
+}
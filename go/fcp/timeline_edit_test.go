@@ -0,0 +1,302 @@
+package fcp
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// buildThreeImageTimeline creates images of 3s, 4s and 5s back to back
+func buildThreeImageTimeline(t *testing.T) *FCPXML {
+	tempDir := t.TempDir()
+
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("Failed to create empty FCPXML: %v", err)
+	}
+	for i, seconds := range []float64{3, 4, 5} {
+		imagePath := filepath.Join(tempDir, fmt.Sprintf("clip%d.png", i))
+		writeTestPNG(t, imagePath, 32, 18)
+		if err := AddImage(fcpxml, imagePath, seconds); err != nil {
+			t.Fatalf("AddImage failed: %v", err)
+		}
+	}
+	return fcpxml
+}
+
+// TestClipIndexTimelineOrder tests that every clip-index API counts spine elements the same way:
+// timeline order with gaps included. The spine is an image, a gap and two videos whose asset-clips
+// are stored in reverse order, so timeline position 2 is the first video but AssetClips[1].
+func TestClipIndexTimelineOrder(t *testing.T) {
+	originalDetect, originalAudio, originalVideo := detectSourceFrameRate, probeAudioDuration, probeVideoDuration
+	defer func() {
+		detectSourceFrameRate, probeAudioDuration, probeVideoDuration = originalDetect, originalAudio, originalVideo
+	}()
+	detectSourceFrameRate = func(string) (float64, error) { return 25, nil }
+	probeAudioDuration = func(string) (float64, error) { return 20, nil }
+	probeVideoDuration = func(string) (float64, error) { return 30, nil }
+
+	tempDir := t.TempDir()
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("GenerateEmpty failed: %v", err)
+	}
+	imagePath := filepath.Join(tempDir, "still.png")
+	writeTestPNG(t, imagePath, 32, 18)
+	if err := AddImage(fcpxml, imagePath, 3); err != nil {
+		t.Fatalf("AddImage failed: %v", err)
+	}
+	if err := AddGap(fcpxml, 1); err != nil {
+		t.Fatalf("AddGap failed: %v", err)
+	}
+	writeMedia := func(name string) string {
+		path := filepath.Join(tempDir, name)
+		if err := os.WriteFile(path, []byte("fake media"), 0644); err != nil {
+			t.Fatalf("Failed to create %s: %v", name, err)
+		}
+		return path
+	}
+	for _, name := range []string{"first.mp4", "second.mp4"} {
+		if err := AddVideo(fcpxml, writeMedia(name)); err != nil {
+			t.Fatalf("AddVideo failed: %v", err)
+		}
+	}
+	for i := range fcpxml.Resources.Assets {
+		fcpxml.Resources.Assets[i].HasAudio = "1"
+	}
+
+	// Leave a second of handle either side of the cut between the videos, then store them out of order
+	spine := &fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine
+	spine.AssetClips[0].Duration = ConvertSecondsToFCPDuration(9)
+	spine.AssetClips[1].Offset = ConvertSecondsToFCPDuration(13)
+	spine.AssetClips[1].Start = ConvertSecondsToFCPDuration(1)
+	spine.AssetClips[1].Duration = ConvertSecondsToFCPDuration(9)
+	spine.AssetClips[0], spine.AssetClips[1] = spine.AssetClips[1], spine.AssetClips[0]
+
+	if count := TimelineElementCount(fcpxml); count != 4 {
+		t.Fatalf("Expected 4 timeline elements, got %d", count)
+	}
+	curvesPath := writeMedia("curves.json")
+	if err := os.WriteFile(curvesPath, []byte(`{"position": [{"t": 0, "value": "0 0"}, {"t": 2, "value": "100 0"}]}`), 0644); err != nil {
+		t.Fatalf("Failed to write curves: %v", err)
+	}
+	audioPath, pipPath := writeMedia("recorder.wav"), writeMedia("webcam.mp4")
+
+	const first = 2
+	// A slice, not a map: ScaleAnimationSpeed needs the keyframes written by the calls before it
+	calls := []struct {
+		name string
+		call func() error
+	}{
+		{"SetClipVolume", func() error { return SetClipVolume(fcpxml, first, -6) }},
+		{"AddChromaKey", func() error { return AddChromaKey(fcpxml, first, [4]float64{0, 1, 0, 1}) }},
+		{"SetAssetClipAudioRole", func() error { return SetAssetClipAudioRole(fcpxml, first, "music") }},
+		{"AddCustomAnimation", func() error { return AddCustomAnimation(fcpxml, first, curvesPath) }},
+		{"AddPunchIn", func() error { return AddPunchIn(fcpxml, first, 1, 1, 1.5) }},
+		{"ScaleAnimationSpeed", func() error { return ScaleAnimationSpeed(fcpxml, first, 0.5) }},
+		{"SetPosterFrame", func() error { return SetPosterFrame(fcpxml, first, 1) }},
+		{"SyncExternalAudio", func() error { return SyncExternalAudio(fcpxml, first, audioPath, 0) }},
+		{"AddPictureInPicture", func() error { return AddPictureInPicture(fcpxml, first, pipPath, PIPTopLeft, 0.25, 0, 2) }},
+		{"SetClipEnabled", func() error { return SetClipEnabled(fcpxml, first, false) }},
+		{"ConformAssetClip", func() error {
+			_, err := ConformAssetClip(fcpxml, first, "first.mp4", ConformFloor)
+			return err
+		}},
+	}
+	for _, c := range calls {
+		if err := c.call(); err != nil {
+			t.Errorf("%s(%d) failed: %v", c.name, first, err)
+		}
+	}
+	if err := AddTransition(fcpxml, first, TransitionCrossDissolve, 1); err != nil {
+		t.Errorf("AddTransition(%d) failed: %v", first, err)
+	}
+
+	clip, other := spine.AssetClips[1], spine.AssetClips[0]
+	if clip.Name != "first" {
+		t.Fatalf("Expected AssetClips[1] to be the first video, got %s", clip.Name)
+	}
+	if clip.AdjustVolume == nil || len(clip.FilterVideos) != 1 || clip.AudioRole != "music" || clip.AdjustTransform == nil ||
+		len(clip.ChapterMarkers) != 1 || len(clip.NestedAssetClips) != 2 || clip.Enabled != "0" || clip.ConformRate == nil {
+		t.Errorf("Expected every call to edit the first video, got %+v", clip)
+	}
+	if other.AdjustVolume != nil || len(other.FilterVideos) != 0 || other.AudioRole == "music" || other.AdjustTransform != nil ||
+		len(other.ChapterMarkers) != 0 || len(other.NestedAssetClips) != 0 || other.Enabled != "" || other.ConformRate != nil {
+		t.Errorf("Expected the second video to be untouched, got %+v", other)
+	}
+	cut := timeUnits(ConvertSecondsToFCPDuration(13))
+	if len(spine.Transitions) != 1 || timeUnits(spine.Transitions[0].Offset) != cut-12*1001 {
+		t.Errorf("Expected one transition centred on the cut at 13s, got %+v", spine.Transitions)
+	}
+
+	// Position 0 is an image and position 1 a gap, so asset-clip calls refuse both
+	if err := SetClipVolume(fcpxml, 0, -6); err == nil {
+		t.Errorf("Expected an error for an image with an asset-clip-only call")
+	}
+	if err := AddTransition(fcpxml, 1, TransitionCrossDissolve, 1); err == nil {
+		t.Errorf("Expected an error for a transition from a gap")
+	}
+	if err := SetClipEnabled(fcpxml, 1, false); err == nil {
+		t.Errorf("Expected an error disabling a gap")
+	}
+	if err := AddTransition(fcpxml, 3, TransitionCrossDissolve, 1); err == nil {
+		t.Errorf("Expected an error for the last element")
+	}
+
+	if err := SetClipSpeed(fcpxml, 3, 50); err == nil {
+		t.Errorf("Expected a retime across the transition to be refused")
+	}
+	if err := RemoveClipAt(fcpxml, 3, false); err != nil {
+		t.Fatalf("RemoveClipAt failed: %v", err)
+	}
+	if len(spine.AssetClips) != 1 || spine.AssetClips[0].Name != "first" {
+		t.Errorf("Expected RemoveClipAt to remove the second video, got %+v", spine.AssetClips)
+	}
+}
+
+// TestRemoveClipAtRipple tests that removing the middle clip with ripple closes the gap
+func TestRemoveClipAtRipple(t *testing.T) {
+	fcpxml := buildThreeImageTimeline(t)
+	sequence := &fcpxml.Library.Events[0].Projects[0].Sequences[0]
+	lastRef := sequence.Spine.Videos[2].Ref
+
+	if err := RemoveClipAt(fcpxml, 1, true); err != nil {
+		t.Fatalf("RemoveClipAt failed: %v", err)
+	}
+
+	videos := sequence.Spine.Videos
+	if len(videos) != 2 {
+		t.Fatalf("Expected 2 videos after removal, got %d", len(videos))
+	}
+	if videos[1].Ref != lastRef {
+		t.Errorf("Expected third clip (%s) to remain, got %s", lastRef, videos[1].Ref)
+	}
+
+	firstEnd := parseOffsetAndDuration(videos[0].Offset, videos[0].Duration)
+	if parseFCPDuration(videos[1].Offset) != firstEnd {
+		t.Errorf("Expected clips to be contiguous: second offset %s, first ends at %d", videos[1].Offset, firstEnd)
+	}
+
+	expectedDuration := parseFCPDuration(ConvertSecondsToFCPDuration(3)) + parseFCPDuration(ConvertSecondsToFCPDuration(5))
+	if parseFCPDuration(sequence.Duration) != expectedDuration {
+		t.Errorf("Expected sequence duration %d, got %s", expectedDuration, sequence.Duration)
+	}
+
+	// The removed image's asset and its image format are now orphaned
+	formatsBefore := len(fcpxml.Resources.Formats)
+	removed := PruneUnusedResources(fcpxml)
+	if removed != 2 || len(fcpxml.Resources.Assets) != 2 || len(fcpxml.Resources.Formats) != formatsBefore-1 {
+		t.Errorf("Expected asset and format pruned, got %d pruned, %d assets, %d formats", removed, len(fcpxml.Resources.Assets), len(fcpxml.Resources.Formats))
+	}
+	if err := fcpxml.ValidateStructure(); err != nil {
+		t.Errorf("Pruned FCPXML failed validation: %v", err)
+	}
+}
+
+// TestRemoveClipAtNoRipple tests that removing without ripple leaves a hole
+func TestRemoveClipAtNoRipple(t *testing.T) {
+	fcpxml := buildThreeImageTimeline(t)
+	sequence := &fcpxml.Library.Events[0].Projects[0].Sequences[0]
+	lastOffset := sequence.Spine.Videos[2].Offset
+
+	if err := RemoveClipAt(fcpxml, 1, false); err != nil {
+		t.Fatalf("RemoveClipAt failed: %v", err)
+	}
+	if sequence.Spine.Videos[1].Offset != lastOffset {
+		t.Errorf("Expected last clip to stay at %s, got %s", lastOffset, sequence.Spine.Videos[1].Offset)
+	}
+
+	if err := RemoveClipAt(fcpxml, 5, true); err == nil {
+		t.Error("Expected error for out of range index")
+	}
+}
+
+// buildTimeline25 is buildThreeImageTimeline re-timed onto a 25fps sequence: 3s, 4s and 5s
+// clips written in the 1/25s timebase
+func buildTimeline25(t *testing.T) *FCPXML {
+	fcpxml := buildThreeImageTimeline(t)
+	sequence := &fcpxml.Library.Events[0].Projects[0].Sequences[0]
+	for i := range fcpxml.Resources.Formats {
+		if fcpxml.Resources.Formats[i].ID == sequence.Format {
+			fcpxml.Resources.Formats[i].FrameDuration = "1/25s"
+		}
+	}
+	offset := 0
+	for i, frames := range []int{75, 100, 125} {
+		video := &sequence.Spine.Videos[i]
+		video.Offset = FrameRate{1, 25}.formatTicks(offset)
+		video.Duration = FrameRate{1, 25}.formatTicks(frames)
+		video.Start = "3600s"
+		offset += frames
+	}
+	sequence.Duration = "300/25s"
+	return fcpxml
+}
+
+// TestTimelineEditsSequenceFrameRate tests that removing and trimming on a 25fps sequence keep
+// every time on its 1/25s frames instead of the 23.976fps grid
+func TestTimelineEditsSequenceFrameRate(t *testing.T) {
+	fcpxml := buildTimeline25(t)
+	sequence := &fcpxml.Library.Events[0].Projects[0].Sequences[0]
+	if err := RemoveClipAt(fcpxml, 0, true); err != nil {
+		t.Fatalf("RemoveClipAt failed: %v", err)
+	}
+	videos := sequence.Spine.Videos
+	if videos[0].Offset != "0s" || videos[1].Offset != "100/25s" || sequence.Duration != "225/25s" {
+		t.Errorf("Expected offsets 0s and 100/25s in a 225/25s sequence, got %s, %s and %s", videos[0].Offset, videos[1].Offset, sequence.Duration)
+	}
+
+	fcpxml = buildTimeline25(t)
+	sequence = &fcpxml.Library.Events[0].Projects[0].Sequences[0]
+	// 2.01s rounds to frame 50; keep 2s-8s
+	if err := TrimTimeline(fcpxml, 2.01, 8); err != nil {
+		t.Fatalf("TrimTimeline failed: %v", err)
+	}
+	videos = sequence.Spine.Videos
+	if len(videos) != 3 {
+		t.Fatalf("Expected 3 videos inside the window, got %d", len(videos))
+	}
+	if videos[0].Offset != "0s" || videos[0].Duration != "25/25s" || videos[0].Start != "90050/25s" {
+		t.Errorf("Expected the first clip at 0s for 25/25s from 90050/25s, got %s, %s, %s", videos[0].Offset, videos[0].Duration, videos[0].Start)
+	}
+	if videos[2].Offset != "125/25s" || videos[2].Duration != "25/25s" || sequence.Duration != "150/25s" {
+		t.Errorf("Expected the last clip at 125/25s for 25/25s in a 150/25s sequence, got %s, %s and %s", videos[2].Offset, videos[2].Duration, sequence.Duration)
+	}
+}
+
+// TestTrimTimeline tests that boundary clips are trimmed and the window starts at 0s
+func TestTrimTimeline(t *testing.T) {
+	fcpxml := buildThreeImageTimeline(t)
+	sequence := &fcpxml.Library.Events[0].Projects[0].Sequences[0]
+	originalStart := parseFCPDuration(sequence.Spine.Videos[0].Start)
+
+	// Keep 2s-8s: last second of clip 1, all of clip 2, first second of clip 3
+	if err := TrimTimeline(fcpxml, 2, 8); err != nil {
+		t.Fatalf("TrimTimeline failed: %v", err)
+	}
+
+	videos := sequence.Spine.Videos
+	if len(videos) != 3 {
+		t.Fatalf("Expected 3 videos inside the window, got %d", len(videos))
+	}
+
+	oneSecond := parseFCPDuration(ConvertSecondsToFCPDuration(1))
+	twoSeconds := parseFCPDuration(ConvertSecondsToFCPDuration(2))
+	if videos[0].Offset != "0s" || parseFCPDuration(videos[0].Duration) != oneSecond {
+		t.Errorf("Expected first clip trimmed to 0s+1s, got offset %s duration %s", videos[0].Offset, videos[0].Duration)
+	}
+	if parseFCPDuration(videos[0].Start) != originalStart+twoSeconds {
+		t.Errorf("Expected head trim to advance start by 2s, got %s", videos[0].Start)
+	}
+	if parseFCPDuration(videos[2].Duration) != oneSecond {
+		t.Errorf("Expected last clip trimmed to 1s, got %s", videos[2].Duration)
+	}
+	if parseFCPDuration(sequence.Duration) != parseFCPDuration(ConvertSecondsToFCPDuration(6)) {
+		t.Errorf("Expected 6s sequence, got %s", sequence.Duration)
+	}
+
+	if err := TrimTimeline(fcpxml, 5, 1); err == nil {
+		t.Error("Expected error for inverted trim window")
+	}
+}
@@ -0,0 +1,69 @@
+package fcp
+
+import (
+	"fmt"
+)
+
+// HoldToDuration pads the first sequence so it ends exactly at totalSeconds, e.g. to match a
+// fixed-length song. By default the last element is held longer; with useGap the images keep
+// their length and a gap fills the remaining time instead.
+//
+// 🚨 CLAUDE.md Rules Applied Here:
+// - The extension is the frame-aligned difference between the target and the current timeline end
+// - Only stills, titles and gaps are held - extending an asset-clip would run past its media
+// - Transitions stay on their cuts since only the end moves; one dangling past the last clip is refused
+// - Sequence duration is recomputed with calculateTimelineDuration()
+func HoldToDuration(fcpxml *FCPXML, totalSeconds float64, useGap bool) error {
+	if totalSeconds <= 0 {
+		return fmt.Errorf("total duration must be greater than 0, got %.2f", totalSeconds)
+	}
+
+	sequence, err := firstSequence(fcpxml)
+	if err != nil {
+		return err
+	}
+
+	elements := spineElementsInOrder(&sequence.Spine)
+	if len(elements) == 0 {
+		return fmt.Errorf("spine has no clips to hold")
+	}
+
+	// The element ending last, which is not necessarily the one starting last
+	last := elements[0]
+	currentEnd := 0
+	for _, element := range elements {
+		if end := parseOffsetAndDuration(*element.offset, *element.duration); end >= currentEnd {
+			last, currentEnd = element, end
+		}
+	}
+	for _, transition := range sequence.Spine.Transitions {
+		if end := parseOffsetAndDuration(transition.Offset, transition.Duration); end > currentEnd {
+			return fmt.Errorf("transition '%s' runs past the last clip; remove it before holding the timeline", transition.Name)
+		}
+	}
+
+	targetEnd := parseFCPDuration(ConvertSecondsToFCPDuration(totalSeconds))
+	extension := targetEnd - currentEnd
+	if extension < 0 {
+		return fmt.Errorf("timeline is already %.2fs, longer than the %.2fs total", float64(currentEnd)/24000.0, totalSeconds)
+	}
+	if extension == 0 {
+		return nil
+	}
+
+	if useGap {
+		sequence.Spine.Gaps = append(sequence.Spine.Gaps, Gap{
+			Name:     "Gap",
+			Offset:   formatFrameAlignedTime(currentEnd),
+			Duration: formatFrameAlignedTime(extension),
+		})
+	} else {
+		if last.kind == spineKindAssetClip || last.kind == spineKindRefClip {
+			return fmt.Errorf("last clip is video and can't be held past its media; use a gap instead")
+		}
+		*last.duration = formatFrameAlignedTime(parseFCPDuration(*last.duration) + extension)
+	}
+
+	sequence.Duration = calculateTimelineDuration(sequence)
+	return nil
+}
@@ -0,0 +1,70 @@
+package fcp
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+)
+
+// TestHoldToDuration tests that five 4-second images held to 30s end with a 14-second last image
+func TestHoldToDuration(t *testing.T) {
+	dir := t.TempDir()
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("GenerateEmpty failed: %v", err)
+	}
+	for i := 0; i < 5; i++ {
+		imagePath := filepath.Join(dir, fmt.Sprintf("slide_%d.png", i))
+		writeTestPNG(t, imagePath, 32, 18)
+		if err := AddImage(fcpxml, imagePath, 4); err != nil {
+			t.Fatalf("AddImage failed: %v", err)
+		}
+	}
+
+	if err := HoldToDuration(fcpxml, 30, false); err != nil {
+		t.Fatalf("HoldToDuration failed: %v", err)
+	}
+
+	sequence := fcpxml.Library.Events[0].Projects[0].Sequences[0]
+	videos := sequence.Spine.Videos
+	last := videos[len(videos)-1]
+	// 14s, frame-aligned so the four earlier images plus the last one land exactly on the 30s frame
+	expectedHold := parseFCPDuration(ConvertSecondsToFCPDuration(30)) - 4*parseFCPDuration(ConvertSecondsToFCPDuration(4))
+	if parseFCPDuration(last.Duration) != expectedHold {
+		t.Errorf("Expected the last image to be held for %d/24000s (14s), got %s", expectedHold, last.Duration)
+	}
+	if diff := parseFCPDuration(last.Duration) - parseFCPDuration(ConvertSecondsToFCPDuration(14)); diff < -1001 || diff > 1001 {
+		t.Errorf("Expected the held image to be within a frame of 14s, got %s", last.Duration)
+	}
+	if videos[0].Duration != ConvertSecondsToFCPDuration(4) {
+		t.Errorf("Earlier images should keep their 4s duration, got %s", videos[0].Duration)
+	}
+	if parseFCPDuration(sequence.Duration) != parseFCPDuration(ConvertSecondsToFCPDuration(30)) {
+		t.Errorf("Expected a 30s sequence, got %s", sequence.Duration)
+	}
+
+	if err := HoldToDuration(fcpxml, 20, false); err == nil {
+		t.Errorf("Expected an error when the images already exceed the total")
+	}
+}
+
+// TestHoldToDurationWithGap tests that the gap option leaves the images alone and fills the rest
+func TestHoldToDurationWithGap(t *testing.T) {
+	fcpxml := buildThreeImageTimeline(t)
+
+	if err := HoldToDuration(fcpxml, 20, true); err != nil {
+		t.Fatalf("HoldToDuration failed: %v", err)
+	}
+
+	sequence := fcpxml.Library.Events[0].Projects[0].Sequences[0]
+	if len(sequence.Spine.Gaps) != 1 {
+		t.Fatalf("Expected one trailing gap, got %d", len(sequence.Spine.Gaps))
+	}
+	gap := sequence.Spine.Gaps[0]
+	if parseOffsetAndDuration(gap.Offset, gap.Duration) != parseFCPDuration(ConvertSecondsToFCPDuration(20)) {
+		t.Errorf("Expected the gap to end at 20s, got offset %s duration %s", gap.Offset, gap.Duration)
+	}
+	if parseFCPDuration(sequence.Duration) != parseFCPDuration(ConvertSecondsToFCPDuration(20)) {
+		t.Errorf("Expected a 20s sequence, got %s", sequence.Duration)
+	}
+}
@@ -0,0 +1,253 @@
+package fcp
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// TimelineJSON is the simplified, read-only timeline model written by ExportTimelineJSON
+type TimelineJSON struct {
+	Sequence SequenceJSON `json:"sequence"`
+	Clips    []ClipJSON   `json:"clips"`
+}
+
+// SequenceJSON describes the exported sequence
+type SequenceJSON struct {
+	Name            string  `json:"name"`
+	Width           string  `json:"width,omitempty"`
+	Height          string  `json:"height,omitempty"`
+	FrameDuration   string  `json:"frameDuration,omitempty"`
+	DurationSeconds float64 `json:"durationSeconds"`
+}
+
+// ClipJSON is one timeline element. OffsetSeconds is always timeline time, including for
+// children, whose FCPXML offsets are in their parent's local time.
+type ClipJSON struct {
+	Type            string     `json:"type"`
+	Name            string     `json:"name"`
+	Source          string     `json:"source,omitempty"`
+	Text            string     `json:"text,omitempty"`
+	OffsetSeconds   float64    `json:"offsetSeconds"`
+	DurationSeconds float64    `json:"durationSeconds"`
+	Lane            int        `json:"lane,omitempty"`
+	Effects         []string   `json:"effects,omitempty"`
+	Children        []ClipJSON `json:"children,omitempty"`
+}
+
+// ExportTimelineJSON writes the first sequence of fcpxml to w as indented JSON for external
+// tools. Times are converted to seconds with fcpDurationToSeconds and nested lanes become
+// children of their parent clip. The FCPXML itself is not modified.
+func ExportTimelineJSON(fcpxml *FCPXML, w io.Writer) error {
+	sequence, err := firstSequence(fcpxml)
+	if err != nil {
+		return err
+	}
+
+	exporter := newTimelineExporter(fcpxml)
+	timeline := TimelineJSON{
+		Sequence: SequenceJSON{
+			Name:            fcpxml.Library.Events[0].Projects[0].Name,
+			DurationSeconds: fcpDurationToSeconds(sequence.Duration),
+		},
+		Clips: exporter.spineClips(sequence.Spine),
+	}
+	if format, ok := exporter.formats[sequence.Format]; ok {
+		timeline.Sequence.Width = format.Width
+		timeline.Sequence.Height = format.Height
+		timeline.Sequence.FrameDuration = format.FrameDuration
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(timeline); err != nil {
+		return fmt.Errorf("failed to encode timeline JSON: %v", err)
+	}
+	return nil
+}
+
+// timelineExporter resolves refs against the document's resources
+type timelineExporter struct {
+	assets  map[string]Asset
+	effects map[string]Effect
+	media   map[string]Media
+	formats map[string]Format
+}
+
+func newTimelineExporter(fcpxml *FCPXML) *timelineExporter {
+	exporter := &timelineExporter{
+		assets:  map[string]Asset{},
+		effects: map[string]Effect{},
+		media:   map[string]Media{},
+		formats: map[string]Format{},
+	}
+	for _, asset := range fcpxml.Resources.Assets {
+		exporter.assets[asset.ID] = asset
+	}
+	for _, effect := range fcpxml.Resources.Effects {
+		exporter.effects[effect.ID] = effect
+	}
+	for _, media := range fcpxml.Resources.Media {
+		exporter.media[media.ID] = media
+	}
+	for _, format := range fcpxml.Resources.Formats {
+		exporter.formats[format.ID] = format
+	}
+	return exporter
+}
+
+// spineClips exports every spine element in timeline order
+func (e *timelineExporter) spineClips(spine Spine) []ClipJSON {
+	var clips []ClipJSON
+	for _, clip := range spine.AssetClips {
+		clips = append(clips, e.assetClip(clip, 0, 0))
+	}
+	for _, video := range spine.Videos {
+		clips = append(clips, e.video(video, 0, 0))
+	}
+	for _, title := range spine.Titles {
+		clips = append(clips, e.title(title, 0, 0))
+	}
+	for _, gap := range spine.Gaps {
+		clip := ClipJSON{
+			Type:            "gap",
+			Name:            gap.Name,
+			OffsetSeconds:   fcpDurationToSeconds(gap.Offset),
+			DurationSeconds: fcpDurationToSeconds(gap.Duration),
+		}
+		for _, title := range gap.Titles {
+			clip.Children = append(clip.Children, e.title(title, clip.OffsetSeconds, 0))
+		}
+		clips = append(clips, clip)
+	}
+	for _, refClip := range spine.RefClips {
+		clip := ClipJSON{
+			Type:            "ref-clip",
+			Name:            refClip.Name,
+			Source:          e.media[refClip.Ref].Name,
+			OffsetSeconds:   fcpDurationToSeconds(refClip.Offset),
+			DurationSeconds: fcpDurationToSeconds(refClip.Duration),
+			Effects:         adjustmentNames(refClip.AdjustTransform != nil, false, false, false),
+		}
+		clips = append(clips, clip)
+	}
+
+	sortClipsByOffset(clips)
+	return clips
+}
+
+// assetClip exports an asset-clip; parentOffset/parentStart map its offset to timeline time
+func (e *timelineExporter) assetClip(clip AssetClip, parentOffset, parentStart float64) ClipJSON {
+	result := e.element("asset-clip", clip.Name, clip.Ref, clip.Offset, clip.Duration, clip.Lane, parentOffset, parentStart)
+	result.Effects = append(adjustmentNames(clip.AdjustTransform != nil, clip.AdjustCrop != nil, clip.AdjustBlend != nil, clip.AdjustVolume != nil), e.filterNames(clip.FilterVideos)...)
+
+	start := fcpDurationToSeconds(clip.Start)
+	for _, nested := range clip.NestedAssetClips {
+		result.Children = append(result.Children, e.assetClip(nested, result.OffsetSeconds, start))
+	}
+	for _, video := range clip.Videos {
+		result.Children = append(result.Children, e.video(video, result.OffsetSeconds, start))
+	}
+	for _, title := range clip.Titles {
+		result.Children = append(result.Children, e.title(title, result.OffsetSeconds, start))
+	}
+	sortClipsByOffset(result.Children)
+
+	return result
+}
+
+// video exports an image/generator video element and its nested lanes
+func (e *timelineExporter) video(video Video, parentOffset, parentStart float64) ClipJSON {
+	result := e.element("video", video.Name, video.Ref, video.Offset, video.Duration, video.Lane, parentOffset, parentStart)
+	result.Effects = append(adjustmentNames(video.AdjustTransform != nil, video.AdjustCrop != nil, video.AdjustBlend != nil, false), e.filterNames(video.FilterVideos)...)
+
+	start := fcpDurationToSeconds(video.Start)
+	for _, nested := range video.NestedVideos {
+		result.Children = append(result.Children, e.video(nested, result.OffsetSeconds, start))
+	}
+	for _, clip := range video.NestedAssetClips {
+		result.Children = append(result.Children, e.assetClip(clip, result.OffsetSeconds, start))
+	}
+	for _, title := range video.NestedTitles {
+		result.Children = append(result.Children, e.title(title, result.OffsetSeconds, start))
+	}
+	sortClipsByOffset(result.Children)
+
+	return result
+}
+
+// title exports a title with its text runs joined
+func (e *timelineExporter) title(title Title, parentOffset, parentStart float64) ClipJSON {
+	result := e.element("title", title.Name, title.Ref, title.Offset, title.Duration, title.Lane, parentOffset, parentStart)
+	if title.Text != nil {
+		var text strings.Builder
+		for _, run := range title.Text.TextStyles {
+			text.WriteString(run.Text)
+		}
+		result.Text = text.String()
+	}
+	return result
+}
+
+// element fills the fields shared by every clip type. Children convert their local offset to
+// timeline time as parentOffset + (offset - parentStart).
+func (e *timelineExporter) element(kind, name, ref, offset, duration, lane string, parentOffset, parentStart float64) ClipJSON {
+	result := ClipJSON{
+		Type:            kind,
+		Name:            name,
+		OffsetSeconds:   parentOffset + fcpDurationToSeconds(offset) - parentStart,
+		DurationSeconds: fcpDurationToSeconds(duration),
+	}
+	if laneNumber, err := strconv.Atoi(lane); err == nil {
+		result.Lane = laneNumber
+	}
+
+	if asset, ok := e.assets[ref]; ok {
+		result.Source = mediaSourcePath(asset.MediaRep.Src)
+	} else if effect, ok := e.effects[ref]; ok {
+		result.Source = effect.Name
+	}
+
+	return result
+}
+
+// filterNames lists the effect names of a clip's video filters
+func (e *timelineExporter) filterNames(filters []FilterVideo) []string {
+	var names []string
+	for _, filter := range filters {
+		name := filter.Name
+		if effect, ok := e.effects[filter.Ref]; ok && name == "" {
+			name = effect.Name
+		}
+		names = append(names, name)
+	}
+	return names
+}
+
+// adjustmentNames summarizes which built-in adjustments a clip uses
+func adjustmentNames(transform, crop, blend, volume bool) []string {
+	var names []string
+	if transform {
+		names = append(names, "transform")
+	}
+	if crop {
+		names = append(names, "crop")
+	}
+	if blend {
+		names = append(names, "blend")
+	}
+	if volume {
+		names = append(names, "volume")
+	}
+	return names
+}
+
+// sortClipsByOffset orders exported clips by timeline position (ties keep input order)
+func sortClipsByOffset(clips []ClipJSON) {
+	sort.SliceStable(clips, func(i, j int) bool {
+		return clips[i].OffsetSeconds < clips[j].OffsetSeconds
+	})
+}
@@ -0,0 +1,81 @@
+package fcp
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"path/filepath"
+	"testing"
+)
+
+// TestExportTimelineJSON tests that each spine clip becomes a JSON object with times in seconds
+func TestExportTimelineJSON(t *testing.T) {
+	tempDir := t.TempDir()
+	firstPath := filepath.Join(tempDir, "first.png")
+	secondPath := filepath.Join(tempDir, "second.png")
+	writeTestPNG(t, firstPath, 64, 64)
+	writeTestPNG(t, secondPath, 64, 64)
+
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("GenerateEmpty failed: %v", err)
+	}
+	if err := AddImage(fcpxml, firstPath, 2); err != nil {
+		t.Fatalf("AddImage failed: %v", err)
+	}
+	if err := AddImage(fcpxml, secondPath, 3); err != nil {
+		t.Fatalf("AddImage failed: %v", err)
+	}
+
+	var buffer bytes.Buffer
+	if err := ExportTimelineJSON(fcpxml, &buffer); err != nil {
+		t.Fatalf("ExportTimelineJSON failed: %v", err)
+	}
+
+	var timeline TimelineJSON
+	if err := json.Unmarshal(buffer.Bytes(), &timeline); err != nil {
+		t.Fatalf("Output is not valid JSON: %v\n%s", err, buffer.String())
+	}
+	if len(timeline.Clips) != 2 {
+		t.Fatalf("Expected 2 clips, got %d", len(timeline.Clips))
+	}
+
+	// Durations are frame-aligned, so allow one frame of difference from the requested seconds
+	const oneFrame = 1001.0 / 24000.0
+	expected := []struct {
+		source   string
+		offset   float64
+		duration float64
+	}{
+		{firstPath, 0, 2},
+		{secondPath, 2, 3},
+	}
+	for i, want := range expected {
+		clip := timeline.Clips[i]
+		if clip.Type != "video" || clip.Source != want.source {
+			t.Errorf("Clip %d: expected video from %s, got %s from %s", i, want.source, clip.Type, clip.Source)
+		}
+		if math.Abs(clip.OffsetSeconds-want.offset) > oneFrame || math.Abs(clip.DurationSeconds-want.duration) > oneFrame {
+			t.Errorf("Clip %d: expected offset %.3f duration %.3f, got %.3f/%.3f", i, want.offset, want.duration, clip.OffsetSeconds, clip.DurationSeconds)
+		}
+	}
+	if math.Abs(timeline.Sequence.DurationSeconds-5) > 2*oneFrame {
+		t.Errorf("Expected sequence duration ~5s, got %.3f", timeline.Sequence.DurationSeconds)
+	}
+}
+
+// TestFCPDurationToSeconds tests rational and plain second values
+func TestFCPDurationToSeconds(t *testing.T) {
+	cases := map[string]float64{
+		"0s":           0,
+		"5s":           5,
+		"24024/24000s": 1.001,
+		"3/2s":         1.5,
+		"":             0,
+	}
+	for input, want := range cases {
+		if got := fcpDurationToSeconds(input); math.Abs(got-want) > 1e-9 {
+			t.Errorf("fcpDurationToSeconds(%q) = %g, want %g", input, got, want)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package fcp
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// ShuffleSpine reorders the elements of the first sequence's spine with a seeded RNG and lays them
+// back to back from where the timeline started. The same seed always gives the same order, so a
+// variation can be regenerated or shared by its seed alone.
+//
+// 🚨 CLAUDE.md Rules Applied Here:
+// - Whole spine structs move, so nested titles/videos/audio travel with their parent clip
+// - Nested offsets are in the parent's local time and need no adjustment
+// - New offsets are contiguous sums of the existing frame-aligned durations (ripple, no gaps)
+// - Each typed spine slice is re-sorted by offset so document order matches timeline order
+// - Spines with transitions are refused: shuffling changes which clips meet at every cut
+func ShuffleSpine(fcpxml *FCPXML, seed int64) error {
+	sequence, err := firstSequence(fcpxml)
+	if err != nil {
+		return err
+	}
+	if err := rejectTransitions(sequence, "shuffle"); err != nil {
+		return err
+	}
+
+	elements := spineElementsInOrder(&sequence.Spine)
+	if len(elements) == 0 {
+		return fmt.Errorf("spine has no clips to shuffle")
+	}
+
+	timelineStart := parseFCPDuration(*elements[0].offset)
+	rng := rand.New(rand.NewSource(seed))
+	rng.Shuffle(len(elements), func(i, j int) {
+		elements[i], elements[j] = elements[j], elements[i]
+	})
+
+	position := timelineStart
+	for _, element := range elements {
+		*element.offset = formatFrameAlignedTime(position)
+		position += parseFCPDuration(*element.duration)
+	}
+
+	SortSpineByOffset(sequence)
+	sequence.Duration = calculateTimelineDuration(sequence)
+
+	return nil
+}
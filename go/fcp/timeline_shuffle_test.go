@@ -0,0 +1,64 @@
+package fcp
+
+import (
+	"testing"
+)
+
+// TestShuffleSpine tests that a seed reproduces the same order and the shuffled clips stay gap-free
+func TestShuffleSpine(t *testing.T) {
+	shuffledRefs := func(seed int64) ([]string, *FCPXML) {
+		fcpxml := buildThreeImageTimeline(t)
+		sequence := &fcpxml.Library.Events[0].Projects[0].Sequences[0]
+		sequence.Spine.Videos[0].NestedTitles = []Title{{Ref: "r99", Lane: "1", Offset: "0s", Name: "Caption", Duration: "24024/24000s"}}
+
+		if err := ShuffleSpine(fcpxml, seed); err != nil {
+			t.Fatalf("ShuffleSpine failed: %v", err)
+		}
+		var refs []string
+		for _, video := range sequence.Spine.Videos {
+			refs = append(refs, video.Ref)
+		}
+		return refs, fcpxml
+	}
+
+	first, fcpxml := shuffledRefs(42)
+	second, _ := shuffledRefs(42)
+	for i := range first {
+		if first[i] != second[i] {
+			t.Fatalf("Same seed gave different orders: %v vs %v", first, second)
+		}
+	}
+
+	sequence := fcpxml.Library.Events[0].Projects[0].Sequences[0]
+	videos := sequence.Spine.Videos
+	if len(videos) != 3 {
+		t.Fatalf("Expected 3 videos after shuffle, got %d", len(videos))
+	}
+	if videos[0].Offset != "0s" {
+		t.Errorf("Expected the timeline to still start at 0s, got %s", videos[0].Offset)
+	}
+	for i := 1; i < len(videos); i++ {
+		previousEnd := parseOffsetAndDuration(videos[i-1].Offset, videos[i-1].Duration)
+		if parseFCPDuration(videos[i].Offset) != previousEnd {
+			t.Errorf("Clip %d starts at %s, expected %d (no gap after clip %d)", i, videos[i].Offset, previousEnd, i-1)
+		}
+	}
+
+	expectedDuration := parseFCPDuration(ConvertSecondsToFCPDuration(12))
+	if parseFCPDuration(sequence.Duration) != expectedDuration {
+		t.Errorf("Expected sequence duration of 12s, got %s", sequence.Duration)
+	}
+
+	captioned := 0
+	for _, video := range videos {
+		if len(video.NestedTitles) == 1 {
+			captioned++
+			if video.Duration != ConvertSecondsToFCPDuration(3) {
+				t.Errorf("Nested caption should move with the 3s clip, found it on a %s clip", video.Duration)
+			}
+		}
+	}
+	if captioned != 1 {
+		t.Errorf("Expected exactly one clip to carry the nested caption, got %d", captioned)
+	}
+}
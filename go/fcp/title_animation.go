@@ -0,0 +1,161 @@
+package fcp
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Title entrance/exit animation names; "" (or TitleAnimationNone) keeps the title static
+const (
+	TitleAnimationNone      = "none"
+	TitleAnimationFade      = "fade"
+	TitleAnimationSlideUp   = "slide-up"
+	TitleAnimationScaleUp   = "scale-up"
+	TitleAnimationScaleDown = "scale-down"
+)
+
+// titleAnimationSeconds is how long an entrance or exit takes; shorter titles use a third each
+const titleAnimationSeconds = 0.6
+
+// titleSlideDistance is how far slide-up travels, in Basic Text position units
+const titleSlideDistance = 1200.0
+
+// titleEntrances and titleExits are the accepted values for each end of a title
+var (
+	titleEntrances = []string{TitleAnimationNone, TitleAnimationFade, TitleAnimationSlideUp, TitleAnimationScaleUp}
+	titleExits     = []string{TitleAnimationNone, TitleAnimationFade, TitleAnimationSlideUp, TitleAnimationScaleDown}
+)
+
+// AddSingleTextAnimated is AddSingleText with an entrance and an exit: the card animates in over
+// its first ~0.6s, holds still, then animates out over its final ~0.6s ("hold then reveal").
+//
+// 🚨 CLAUDE.md Rules Applied Here:
+// - entrance: none, fade, slide-up (rises into place), scale-up; exit: none, fade, slide-up (leaves upward), scale-down
+// - Keyframes are in the title's local time and frame-aligned relative to the title's start and end
+// - Position/Scale/Opacity keyframes carry NO interp/curve attributes; the hold needs no keyframes
+func AddSingleTextAnimated(fcpxml *FCPXML, text string, offsetSeconds float64, durationSeconds float64, entrance, exit string) error {
+	entrance, exit, err := normalizeTitleAnimations(entrance, exit)
+	if err != nil {
+		return err
+	}
+
+	if err := AddSingleText(fcpxml, text, offsetSeconds, durationSeconds); err != nil {
+		return err
+	}
+
+	title := lastSingleText(fcpxml)
+	if title == nil {
+		return fmt.Errorf("text element was not added to the timeline")
+	}
+	applyTitleAnimation(title, entrance, exit)
+	return nil
+}
+
+// normalizeTitleAnimations maps "" to TitleAnimationNone and checks both ends are supported
+func normalizeTitleAnimations(entrance, exit string) (string, string, error) {
+	if entrance == "" {
+		entrance = TitleAnimationNone
+	}
+	if exit == "" {
+		exit = TitleAnimationNone
+	}
+	if !containsString(titleEntrances, entrance) {
+		return "", "", fmt.Errorf("invalid entrance '%s' (must be one of: %s)", entrance, strings.Join(titleEntrances, ", "))
+	}
+	if !containsString(titleExits, exit) {
+		return "", "", fmt.Errorf("invalid exit '%s' (must be one of: %s)", exit, strings.Join(titleExits, ", "))
+	}
+	return entrance, exit, nil
+}
+
+// lastSingleText finds the title AddSingleText just appended, mirroring where it places titles
+func lastSingleText(fcpxml *FCPXML) *Title {
+	sequence, err := firstSequence(fcpxml)
+	if err != nil {
+		return nil
+	}
+
+	var titles []Title
+	switch {
+	case len(sequence.Spine.Videos) > 0:
+		titles = sequence.Spine.Videos[0].NestedTitles
+	case len(sequence.Spine.AssetClips) > 0:
+		titles = sequence.Spine.AssetClips[0].Titles
+	default:
+		titles = sequence.Spine.Titles
+	}
+	if len(titles) == 0 {
+		return nil
+	}
+	return &titles[len(titles)-1]
+}
+
+// applyTitleAnimation keyframes the title's Position/Scale/Opacity for the given entrance and exit
+func applyTitleAnimation(title *Title, entrance, exit string) {
+	startFrames := parseFCPDuration(title.Start)
+	durationFrames := parseFCPDuration(title.Duration)
+	endFrames := startFrames + durationFrames
+	animFrames := min(parseFCPDuration(ConvertSecondsToFCPDuration(titleAnimationSeconds)), durationFrames/3/1001*1001)
+
+	position := titleParam(title, "Position", "9999/10003/13260/3296672360/1/100/101", "0 0")
+	baseX, baseY := 0.0, 0.0
+	fmt.Sscanf(position.Value, "%g %g", &baseX, &baseY)
+	basePosition := position.Value
+	below := fmt.Sprintf("%g %g", baseX, baseY-titleSlideDistance)
+	above := fmt.Sprintf("%g %g", baseX, baseY+titleSlideDistance)
+
+	// Each animated param gets entrance keyframes (start → start+anim) and/or exit keyframes (end-anim → end)
+	type animation struct {
+		name, key, rest   string
+		enterFrom, exitTo string
+	}
+	var animations []animation
+	switch entrance {
+	case TitleAnimationFade:
+		animations = append(animations, animation{name: "Opacity", key: titleOpacityKey, rest: "1", enterFrom: "0"})
+	case TitleAnimationSlideUp:
+		animations = append(animations, animation{name: "Position", key: position.Key, rest: basePosition, enterFrom: below})
+	case TitleAnimationScaleUp:
+		animations = append(animations, animation{name: "Scale", key: titleScaleKey, rest: "1 1", enterFrom: "0 0"})
+	}
+	switch exit {
+	case TitleAnimationFade:
+		animations = append(animations, animation{name: "Opacity", key: titleOpacityKey, rest: "1", exitTo: "0"})
+	case TitleAnimationSlideUp:
+		animations = append(animations, animation{name: "Position", key: position.Key, rest: basePosition, exitTo: above})
+	case TitleAnimationScaleDown:
+		animations = append(animations, animation{name: "Scale", key: titleScaleKey, rest: "1 1", exitTo: "0 0"})
+	}
+
+	for _, anim := range animations {
+		param := titleParam(title, anim.name, anim.key, anim.rest)
+		if param.KeyframeAnimation == nil {
+			param.KeyframeAnimation = &KeyframeAnimation{}
+		}
+		param.Value = ""
+
+		if anim.enterFrom != "" {
+			param.KeyframeAnimation.Keyframes = append([]Keyframe{
+				{Time: formatFrameAlignedTime(startFrames), Value: anim.enterFrom},
+				{Time: formatFrameAlignedTime(startFrames + animFrames), Value: anim.rest},
+			}, param.KeyframeAnimation.Keyframes...)
+		}
+		if anim.exitTo != "" {
+			param.KeyframeAnimation.Keyframes = append(param.KeyframeAnimation.Keyframes,
+				Keyframe{Time: formatFrameAlignedTime(endFrames - animFrames), Value: anim.rest},
+				Keyframe{Time: formatFrameAlignedTime(endFrames), Value: anim.exitTo},
+			)
+		}
+	}
+}
+
+// titleParam returns the title's param with key, adding it with a static value when missing
+func titleParam(title *Title, name, key, value string) *Param {
+	for i := range title.Params {
+		if title.Params[i].Key == key {
+			return &title.Params[i]
+		}
+	}
+	title.Params = append(title.Params, Param{Name: name, Key: key, Value: value})
+	return &title.Params[len(title.Params)-1]
+}
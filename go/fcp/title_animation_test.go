@@ -0,0 +1,90 @@
+package fcp
+
+import "testing"
+
+// TestAddSingleTextAnimatedFadeExit validates that a fade exit ramps opacity to 0 exactly at the title end
+func TestAddSingleTextAnimatedFadeExit(t *testing.T) {
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("GenerateEmpty failed: %v", err)
+	}
+
+	if err := AddSingleTextAnimated(fcpxml, "Chapter One", 0, 4, "", "fade"); err != nil {
+		t.Fatalf("AddSingleTextAnimated failed: %v", err)
+	}
+
+	title := lastSingleText(fcpxml)
+	if title == nil {
+		t.Fatalf("Expected a title on the timeline")
+	}
+
+	var opacity, position *Param
+	for i := range title.Params {
+		switch title.Params[i].Key {
+		case titleOpacityKey:
+			opacity = &title.Params[i]
+		case "9999/10003/13260/3296672360/1/100/101":
+			position = &title.Params[i]
+		}
+	}
+	if opacity == nil || opacity.KeyframeAnimation == nil {
+		t.Fatalf("Expected keyframed opacity for a fade exit")
+	}
+
+	keyframes := opacity.KeyframeAnimation.Keyframes
+	if len(keyframes) != 2 {
+		t.Fatalf("Expected a two keyframe exit ramp (static hold before it), got %d keyframes", len(keyframes))
+	}
+
+	endFrames := parseFCPDuration(title.Start) + parseFCPDuration(title.Duration)
+	if keyframes[1].Time != formatFrameAlignedTime(endFrames) || keyframes[1].Value != "0" {
+		t.Errorf("Expected opacity 0 at title end %s, got %s at %s", formatFrameAlignedTime(endFrames), keyframes[1].Value, keyframes[1].Time)
+	}
+	exitFrames := endFrames - parseFCPDuration(keyframes[0].Time)
+	if keyframes[0].Value != "1" || exitFrames != parseFCPDuration(ConvertSecondsToFCPDuration(titleAnimationSeconds)) {
+		t.Errorf("Expected the ramp to start from 1 over the last %.1fs, got %s over %d units", titleAnimationSeconds, keyframes[0].Value, exitFrames)
+	}
+	for _, keyframe := range keyframes {
+		if parseFCPDuration(keyframe.Time)%1001 != 0 || keyframe.Curve != "" || keyframe.Interp != "" {
+			t.Errorf("Expected frame-aligned keyframe without curve/interp, got %+v", keyframe)
+		}
+	}
+
+	if position == nil || position.KeyframeAnimation != nil {
+		t.Errorf("Expected position to stay static for a fade-only exit")
+	}
+
+	if err := AddSingleTextAnimated(fcpxml, "Bad", 0, 4, "spin", ""); err == nil {
+		t.Errorf("Expected error for unknown entrance")
+	}
+}
+
+// TestAddSingleTextAnimatedSlideInAndOut validates entrance and exit keyframes share one Position param
+func TestAddSingleTextAnimatedSlideInAndOut(t *testing.T) {
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("GenerateEmpty failed: %v", err)
+	}
+
+	if err := AddSingleTextAnimated(fcpxml, "Intro", 1, 5, "slide-up", "slide-up"); err != nil {
+		t.Fatalf("AddSingleTextAnimated failed: %v", err)
+	}
+
+	title := lastSingleText(fcpxml)
+	for _, param := range title.Params {
+		if param.Key != "9999/10003/13260/3296672360/1/100/101" {
+			continue
+		}
+		if param.KeyframeAnimation == nil || len(param.KeyframeAnimation.Keyframes) != 4 {
+			t.Fatalf("Expected four position keyframes, got %+v", param)
+		}
+		values := []string{"0 -4271", "0 -3071", "0 -3071", "0 -1871"}
+		for i, keyframe := range param.KeyframeAnimation.Keyframes {
+			if keyframe.Value != values[i] {
+				t.Errorf("Keyframe %d: expected %s, got %s", i, values[i], keyframe.Value)
+			}
+		}
+		return
+	}
+	t.Fatalf("Expected a Position param")
+}
@@ -0,0 +1,159 @@
+package fcp
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// TitleTemplate is a reusable title look stored as JSON, e.g. a lower third:
+//
+//	{"name": "lower-third", "font": "Helvetica Neue", "fontSize": 120, "color": "1 1 1 1",
+//	 "position": "0 -800", "entrance": "slide-up", "exit": "fade", "outlineWidth": 4}
+type TitleTemplate struct {
+	Name         string  `json:"name,omitempty"`
+	Font         string  `json:"font"`                   // Required
+	FontSize     float64 `json:"fontSize"`               // Required, in Basic Text units (AddSingleText uses 2040)
+	FontFace     string  `json:"fontFace,omitempty"`     // e.g. "Bold"; defaults to "Regular"
+	Color        string  `json:"color"`                  // Required "r g b a" (0.0-1.0)
+	Position     string  `json:"position,omitempty"`     // "x y" in Basic Text position units; empty keeps the default
+	Entrance     string  `json:"entrance,omitempty"`     // none, fade, slide-up, scale-up
+	Exit         string  `json:"exit,omitempty"`         // none, fade, slide-up, scale-down
+	ShadowColor  string  `json:"shadowColor,omitempty"`  // "r g b a"; empty disables the shadow
+	ShadowOffset string  `json:"shadowOffset,omitempty"` // "distance angle"
+	ShadowBlur   float64 `json:"shadowBlur,omitempty"`
+	OutlineColor string  `json:"outlineColor,omitempty"` // "r g b a"
+	OutlineWidth float64 `json:"outlineWidth,omitempty"` // 0 disables the outline
+}
+
+// styleOptions returns the template's shadow/outline settings
+func (tmpl TitleTemplate) styleOptions() TextStyleOptions {
+	return TextStyleOptions{
+		ShadowColor:  tmpl.ShadowColor,
+		ShadowOffset: tmpl.ShadowOffset,
+		ShadowBlur:   tmpl.ShadowBlur,
+		OutlineColor: tmpl.OutlineColor,
+		OutlineWidth: tmpl.OutlineWidth,
+	}
+}
+
+// Validate checks required fields are set and every value is one FCP accepts
+func (tmpl TitleTemplate) Validate() error {
+	var missing []string
+	if strings.TrimSpace(tmpl.Font) == "" {
+		missing = append(missing, "font")
+	}
+	if tmpl.FontSize == 0 {
+		missing = append(missing, "fontSize")
+	}
+	if tmpl.Color == "" {
+		missing = append(missing, "color")
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("title template is missing required fields: %s", strings.Join(missing, ", "))
+	}
+
+	if tmpl.FontSize < 0 {
+		return fmt.Errorf("fontSize must be positive, got %g", tmpl.FontSize)
+	}
+	if _, err := ParseRGBA(tmpl.Color); err != nil {
+		return fmt.Errorf("invalid color: %v", err)
+	}
+	if tmpl.Position != "" {
+		var x, y float64
+		if n, err := fmt.Sscanf(tmpl.Position, "%g %g", &x, &y); err != nil || n != 2 || len(strings.Fields(tmpl.Position)) != 2 {
+			return fmt.Errorf("position must be 'x y', got '%s'", tmpl.Position)
+		}
+	}
+	if _, _, err := normalizeTitleAnimations(tmpl.Entrance, tmpl.Exit); err != nil {
+		return err
+	}
+	return tmpl.styleOptions().Validate()
+}
+
+// LoadTitleTemplate reads and validates a JSON title template. Unknown or misspelled keys are
+// rejected so a "fontsize" typo fails loudly instead of being ignored.
+func LoadTitleTemplate(path string) (TitleTemplate, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return TitleTemplate{}, fmt.Errorf("failed to read title template: %v", err)
+	}
+
+	// encoding/json matches keys case-insensitively, so check spelling against the tags first
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return TitleTemplate{}, fmt.Errorf("failed to parse title template %s: %v", path, err)
+	}
+	known := titleTemplateKeys()
+	var unknown []string
+	for key := range raw {
+		if !known[key] {
+			unknown = append(unknown, key)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return TitleTemplate{}, fmt.Errorf("title template %s has unknown keys: %s", path, strings.Join(unknown, ", "))
+	}
+
+	var tmpl TitleTemplate
+	if err := json.Unmarshal(data, &tmpl); err != nil {
+		return TitleTemplate{}, fmt.Errorf("failed to parse title template %s: %v", path, err)
+	}
+
+	if err := tmpl.Validate(); err != nil {
+		return TitleTemplate{}, fmt.Errorf("invalid title template %s: %v", path, err)
+	}
+	return tmpl, nil
+}
+
+// titleTemplateKeys returns the JSON keys TitleTemplate accepts, read from its struct tags
+func titleTemplateKeys() map[string]bool {
+	keys := make(map[string]bool)
+	templateType := reflect.TypeOf(TitleTemplate{})
+	for i := 0; i < templateType.NumField(); i++ {
+		name := strings.Split(templateType.Field(i).Tag.Get("json"), ",")[0]
+		keys[name] = true
+	}
+	return keys
+}
+
+// AddTextFromTemplate adds a single title styled and animated by tmpl.
+//
+// 🚨 CLAUDE.md Rules Applied Here:
+// - Built on AddSingleTextStyled, so the Text effect goes through the Transaction as usual
+// - Font/size/face/color land on the title's own text-style-def; shadow/outline reuse TextStyleOptions
+// - The template position becomes the rest position that entrance/exit animations move from
+func AddTextFromTemplate(fcpxml *FCPXML, text string, tmpl TitleTemplate, offsetSeconds float64, durationSeconds float64) error {
+	if err := tmpl.Validate(); err != nil {
+		return err
+	}
+	entrance, exit, _ := normalizeTitleAnimations(tmpl.Entrance, tmpl.Exit)
+
+	if err := AddSingleTextStyled(fcpxml, text, offsetSeconds, durationSeconds, tmpl.styleOptions()); err != nil {
+		return err
+	}
+
+	title := lastSingleText(fcpxml)
+	if title == nil || len(title.TextStyleDefs) == 0 {
+		return fmt.Errorf("text element was not added to the timeline")
+	}
+
+	style := &title.TextStyleDefs[0].TextStyle
+	style.Font = tmpl.Font
+	style.FontSize = strconv.FormatFloat(tmpl.FontSize, 'f', -1, 64)
+	style.FontColor = tmpl.Color
+	if tmpl.FontFace != "" {
+		style.FontFace = tmpl.FontFace
+	}
+	if tmpl.Position != "" {
+		titleParam(title, "Position", "9999/10003/13260/3296672360/1/100/101", tmpl.Position).Value = strings.Join(strings.Fields(tmpl.Position), " ")
+	}
+
+	applyTitleAnimation(title, entrance, exit)
+	return nil
+}
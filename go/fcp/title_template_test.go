@@ -0,0 +1,91 @@
+package fcp
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestAddTextFromTemplate validates that a loaded template sets the title's font, size, color and position
+func TestAddTextFromTemplate(t *testing.T) {
+	dir := t.TempDir()
+	templatePath := filepath.Join(dir, "lower-third.json")
+	template := `{
+		"name": "lower-third",
+		"font": "Helvetica Neue",
+		"fontSize": 120,
+		"fontFace": "Bold",
+		"color": "1 0.8 0 1",
+		"position": "0 -800",
+		"entrance": "fade",
+		"outlineWidth": 4
+	}`
+	if err := os.WriteFile(templatePath, []byte(template), 0644); err != nil {
+		t.Fatalf("Failed to write template: %v", err)
+	}
+
+	tmpl, err := LoadTitleTemplate(templatePath)
+	if err != nil {
+		t.Fatalf("LoadTitleTemplate failed: %v", err)
+	}
+
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("GenerateEmpty failed: %v", err)
+	}
+	if err := AddTextFromTemplate(fcpxml, "Jane Doe", tmpl, 1, 5); err != nil {
+		t.Fatalf("AddTextFromTemplate failed: %v", err)
+	}
+
+	title := lastSingleText(fcpxml)
+	if title == nil {
+		t.Fatalf("Expected a title on the timeline")
+	}
+	style := title.TextStyleDefs[0].TextStyle
+	if style.Font != "Helvetica Neue" || style.FontSize != "120" || style.FontFace != "Bold" || style.FontColor != "1 0.8 0 1" {
+		t.Errorf("Title style does not match template: %+v", style)
+	}
+	if style.StrokeWidth != "4" {
+		t.Errorf("Expected outline width 4 from template, got '%s'", style.StrokeWidth)
+	}
+
+	foundPosition, foundFade := false, false
+	for _, param := range title.Params {
+		if param.Name == "Position" && param.Value == "0 -800" {
+			foundPosition = true
+		}
+		if param.Key == titleOpacityKey && param.KeyframeAnimation != nil {
+			foundFade = true
+		}
+	}
+	if !foundPosition {
+		t.Errorf("Expected template position '0 -800'")
+	}
+	if !foundFade {
+		t.Errorf("Expected fade entrance keyframes from template")
+	}
+}
+
+// TestLoadTitleTemplateRejectsBadTemplates validates required fields and unknown keys
+func TestLoadTitleTemplateRejectsBadTemplates(t *testing.T) {
+	cases := map[string]struct {
+		json, wantErr string
+	}{
+		"missing fields": {`{"font": "Arial"}`, "fontSize, color"},
+		"unknown key":    {`{"font": "Arial", "fontsize": 90, "color": "1 1 1 1"}`, "unknown keys: fontsize"},
+		"bad entrance":   {`{"font": "Arial", "fontSize": 90, "color": "1 1 1 1", "entrance": "spin"}`, "invalid entrance"},
+		"bad color":      {`{"font": "Arial", "fontSize": 90, "color": "white"}`, "invalid color"},
+	}
+
+	for name, c := range cases {
+		path := filepath.Join(t.TempDir(), "template.json")
+		if err := os.WriteFile(path, []byte(c.json), 0644); err != nil {
+			t.Fatalf("Failed to write template: %v", err)
+		}
+		_, err := LoadTitleTemplate(path)
+		if err == nil || !strings.Contains(err.Error(), c.wantErr) {
+			t.Errorf("%s: expected error containing '%s', got %v", name, c.wantErr, err)
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"encoding/xml"
 	"fmt"
+	"os"
 	"os/exec"
 	"path/filepath"
 	"strconv"
@@ -12,10 +13,12 @@ import (
 
 // ResourceTransaction provides atomic multi-resource operations
 type ResourceTransaction struct {
-	registry *ResourceRegistry
-	reserved []string
-	created  []Resource
-	rolled   bool
+	registry  *ResourceRegistry
+	reserved  []string
+	created   []Resource
+	tempFiles []string // Media copies deleted again if the transaction rolls back
+	rolled    bool
+	committed bool
 }
 
 // NewTransaction creates a new resource transaction
@@ -303,6 +306,23 @@ func (tx *ResourceTransaction) CreateEffect(id, name, uid string) (*Effect, erro
 	return effect, nil
 }
 
+// CreateMedia creates a compound clip media resource wrapping sequence with transaction management
+func (tx *ResourceTransaction) CreateMedia(id, name string, sequence Sequence) (*Media, error) {
+	if tx.rolled {
+		return nil, fmt.Errorf("transaction has been rolled back")
+	}
+
+	media := &Media{
+		ID:       id,
+		Name:     name,
+		UID:      generateRandomUID(),
+		Sequence: sequence,
+	}
+
+	tx.created = append(tx.created, &MediaWrapper{media})
+	return media, nil
+}
+
 // createCompoundClipSpineContent creates the spine content for a compound clip using structs
 func (tx *ResourceTransaction) createCompoundClipSpineContent(videoAssetID, audioAssetID, baseName, duration string) string {
 	// Create audio asset-clip struct
@@ -365,13 +385,39 @@ func (tx *ResourceTransaction) Commit() error {
 		}
 	}
 
+	tx.committed = true
 	return nil
 }
 
-// Rollback rolls back the transaction (IDs remain reserved)
+// Rollback discards an uncommitted transaction: its reserved IDs are released back to the
+// registry and the media copies it made are deleted. After Commit it does nothing, so it is
+// safe to defer right after NewTransaction.
 func (tx *ResourceTransaction) Rollback() {
+	if tx.committed {
+		return
+	}
+	if !tx.rolled {
+		tx.registry.ReleaseIDs(tx.reserved)
+		for _, path := range tx.tempFiles {
+			os.Remove(path)
+		}
+	}
+
 	tx.rolled = true
+	tx.reserved = nil
 	tx.created = nil
+	tx.tempFiles = nil
+}
+
+// createUniqueMediaCopy is createUniqueMediaCopy for media used by this transaction; the copy
+// is deleted if the transaction rolls back instead of committing
+func (tx *ResourceTransaction) createUniqueMediaCopy(originalPath, prefix string) (string, error) {
+	uniquePath, err := createUniqueMediaCopy(originalPath, prefix)
+	if err != nil {
+		return uniquePath, err
+	}
+	tx.tempFiles = append(tx.tempFiles, uniquePath)
+	return uniquePath, nil
 }
 
 // VideoProperties holds detected video file properties
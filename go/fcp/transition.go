@@ -0,0 +1,171 @@
+package fcp
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// Transition kinds accepted by AddTransition. Only FCP's Cross Dissolve is offered: other
+// transitions (wipes, slides) need effect UIDs and keyed params that haven't been verified.
+const (
+	TransitionCrossDissolve = "cross-dissolve"
+)
+
+// crossDissolveEffectUID is FCP's built-in Cross Dissolve transition
+const crossDissolveEffectUID = "FxPlug:4731E73A-8DAC-4113-9A30-AE85B1761265"
+
+// transitionKinds maps each kind to its effect's display name and UID
+var transitionKinds = map[string]struct{ name, uid string }{
+	TransitionCrossDissolve: {"Cross Dissolve", crossDissolveEffectUID},
+}
+
+// AddTransition puts a kind transition of durationSeconds on the cut between the asset-clip at
+// timeline position clipIndex and the element after it, which must also be an asset-clip.
+// A transition already on that cut is replaced.
+//
+// 🚨 CLAUDE.md Rules Applied Here:
+// - Transition effect created once through the Transaction; its UID is checked against fictionalEffectUIDs
+// - The transition is centred on the cut and both clips keep their offsets, so the timeline length is unchanged
+// - Each clip needs half the transition of unused media (handles) past the cut; that is checked against its asset
+func AddTransition(fcpxml *FCPXML, clipIndex int, kind string, durationSeconds float64) error {
+	effect, ok := transitionKinds[kind]
+	if !ok {
+		return fmt.Errorf("unknown transition '%s' (available: %s)", kind, TransitionCrossDissolve)
+	}
+	if fictionalEffectUIDs[effect.uid] {
+		return fmt.Errorf("transition UID '%s' is not a built-in FCP effect", effect.uid)
+	}
+
+	sequence, err := firstSequence(fcpxml)
+	if err != nil {
+		return err
+	}
+	if count := TimelineElementCount(fcpxml); clipIndex < 0 || clipIndex+1 >= count {
+		return fmt.Errorf("clip index %d has no following clip to transition to (timeline has %d elements)", clipIndex, count)
+	}
+	outgoing, err := spineAssetClipAt(&sequence.Spine, clipIndex)
+	if err != nil {
+		return err
+	}
+	incoming, err := spineAssetClipAt(&sequence.Spine, clipIndex+1)
+	if err != nil {
+		return err
+	}
+
+	offset, duration, err := transitionOverlap(fcpxml, *outgoing, *incoming, durationSeconds)
+	if err != nil {
+		return err
+	}
+
+	effectID := findEffectIDByUID(fcpxml, effect.uid)
+	if effectID == "" {
+		registry := NewResourceRegistry(fcpxml)
+		tx := NewTransaction(registry)
+		defer tx.Rollback()
+
+		effectID = tx.ReserveIDs(1)[0]
+		if _, err := tx.CreateEffect(effectID, effect.name, effect.uid); err != nil {
+			return fmt.Errorf("failed to create %s effect: %v", effect.name, err)
+		}
+		if err := tx.Commit(); err != nil {
+			return fmt.Errorf("failed to commit transaction: %v", err)
+		}
+	}
+
+	transition := Transition{
+		Name:         effect.name,
+		Offset:       offset,
+		Duration:     duration,
+		FilterVideos: []FilterVideo{{Ref: effectID, Name: effect.name}},
+	}
+
+	for i := range sequence.Spine.Transitions {
+		if sequence.Spine.Transitions[i].Offset == offset {
+			sequence.Spine.Transitions[i] = transition
+			return nil
+		}
+	}
+	sequence.Spine.Transitions = append(sequence.Spine.Transitions, transition)
+	return nil
+}
+
+// transitionOverlap returns the offset and duration of a transition centred on the cut from
+// outgoing to incoming. The duration is rounded to an even number of frames so each side of
+// the cut gets whole frames, and both clips must have that half in media beyond the cut.
+func transitionOverlap(fcpxml *FCPXML, outgoing, incoming AssetClip, durationSeconds float64) (string, string, error) {
+	frames := timeUnits(ConvertSecondsToFCPDuration(durationSeconds)) / 1001
+	if frames < 2 {
+		return "", "", fmt.Errorf("transition must be at least two frames, got %.3fs", durationSeconds)
+	}
+	half := frames / 2 * 1001
+
+	cut := timeUnits(outgoing.Offset) + timeUnits(outgoing.Duration)
+	if timeUnits(incoming.Offset) != cut {
+		return "", "", fmt.Errorf("clips '%s' and '%s' are not adjacent; a transition needs a straight cut", outgoing.Name, incoming.Name)
+	}
+	if half > timeUnits(outgoing.Duration) || half > timeUnits(incoming.Duration) {
+		return "", "", fmt.Errorf("a %.3fs transition is longer than twice '%s' or '%s'", durationSeconds, outgoing.Name, incoming.Name)
+	}
+
+	for _, asset := range fcpxml.Resources.Assets {
+		assetStart := timeUnits(asset.Start)
+		if asset.ID == outgoing.Ref {
+			mediaEnd := assetStart + timeUnits(asset.Duration)
+			if timeUnits(outgoing.Start)+timeUnits(outgoing.Duration)+half > mediaEnd {
+				return "", "", fmt.Errorf("'%s' needs %.3fs of extra media after its out point for the transition", outgoing.Name, unitsToSeconds(half))
+			}
+		}
+		if asset.ID == incoming.Ref && timeUnits(incoming.Start)-half < assetStart {
+			return "", "", fmt.Errorf("'%s' needs %.3fs of extra media before its in point for the transition", incoming.Name, unitsToSeconds(half))
+		}
+	}
+
+	return formatFrameAlignedTime(cut - half), formatFrameAlignedTime(2 * half), nil
+}
+
+// rejectTransitions returns an error when the spine has transitions, for edits that reorder cuts
+// or change the media around them, which would leave a transition blending the wrong clips
+func rejectTransitions(sequence *Sequence, edit string) error {
+	if count := len(sequence.Spine.Transitions); count > 0 {
+		return fmt.Errorf("can't %s a spine with %d transition(s); remove the transitions first", edit, count)
+	}
+	return nil
+}
+
+// dropTransitionsOverlapping removes transitions that overlap start → end, i.e. those on the
+// cuts at either end of a span that is being removed or cut away
+func dropTransitionsOverlapping(spine *Spine, start, end *big.Rat) {
+	kept := spine.Transitions[:0]
+	for _, transition := range spine.Transitions {
+		transitionStart, transitionEnd, ok := transitionSpan(transition)
+		if ok && transitionStart.Cmp(end) < 0 && transitionEnd.Cmp(start) > 0 {
+			continue
+		}
+		kept = append(kept, transition)
+	}
+	spine.Transitions = kept
+}
+
+// shiftTransitionsFrom moves every transition starting at or after from by delta, writing the
+// new offsets in rate's timebase, so transitions ripple along with the clips they sit between
+func shiftTransitionsFrom(spine *Spine, from, delta *big.Rat, rate FrameRate) {
+	for i := range spine.Transitions {
+		offset, err := parseFCPTimeRat(spine.Transitions[i].Offset)
+		if err == nil && offset.Cmp(from) >= 0 {
+			spine.Transitions[i].Offset = formatInTimebase(offset.Add(offset, delta), rate)
+		}
+	}
+}
+
+// transitionSpan returns the exact start and end of transition; ok is false if either doesn't parse
+func transitionSpan(transition Transition) (*big.Rat, *big.Rat, bool) {
+	start, err := parseFCPTimeRat(transition.Offset)
+	if err != nil {
+		return nil, nil, false
+	}
+	duration, err := parseFCPTimeRat(transition.Duration)
+	if err != nil {
+		return nil, nil, false
+	}
+	return start, new(big.Rat).Add(start, duration), true
+}
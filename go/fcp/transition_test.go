@@ -0,0 +1,159 @@
+package fcp
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestAddTransitionCrossDissolve tests a cross-dissolve centred on the cut between two trimmed clips
+func TestAddTransitionCrossDissolve(t *testing.T) {
+	tempDir := t.TempDir()
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("GenerateEmpty failed: %v", err)
+	}
+	for _, name := range []string{"first.mp4", "second.mp4"} {
+		videoPath := filepath.Join(tempDir, name)
+		if err := os.WriteFile(videoPath, []byte("fake video data"), 0644); err != nil {
+			t.Fatalf("Failed to create test video: %v", err)
+		}
+		if err := AddVideo(fcpxml, videoPath); err != nil {
+			t.Fatalf("AddVideo failed: %v", err)
+		}
+	}
+
+	// Untrimmed clips use all their media, leaving no handles for the transition
+	if err := AddTransition(fcpxml, 0, TransitionCrossDissolve, 1); err == nil || !strings.Contains(err.Error(), "extra media") {
+		t.Fatalf("Expected missing handles error, got %v", err)
+	}
+
+	// Trim 2s off the end of the first clip and 2s off the head of the second
+	sequence := &fcpxml.Library.Events[0].Projects[0].Sequences[0]
+	clips := sequence.Spine.AssetClips
+	clips[0].Duration = ConvertSecondsToFCPDuration(8)
+	clips[1].Offset = clips[0].Duration
+	clips[1].Start = ConvertSecondsToFCPDuration(2)
+	clips[1].Duration = ConvertSecondsToFCPDuration(8)
+
+	if err := AddTransition(fcpxml, 0, "wipe", 1); err == nil || !strings.Contains(err.Error(), "unknown transition") {
+		t.Errorf("Expected an unknown transition error for a wipe, got %v", err)
+	}
+	if err := AddTransition(fcpxml, 1, TransitionCrossDissolve, 1); err == nil {
+		t.Error("Expected an error for the last clip")
+	}
+	if err := AddTransition(fcpxml, 0, TransitionCrossDissolve, 1); err != nil {
+		t.Fatalf("AddTransition failed: %v", err)
+	}
+
+	if len(sequence.Spine.Transitions) != 1 {
+		t.Fatalf("Expected 1 transition, got %d", len(sequence.Spine.Transitions))
+	}
+	transition := sequence.Spine.Transitions[0]
+	dissolveID := findEffectIDByUID(fcpxml, crossDissolveEffectUID)
+	if transition.Name != "Cross Dissolve" || dissolveID == "" || transition.FilterVideos[0].Ref != dissolveID {
+		t.Fatalf("Expected a Cross Dissolve transition referencing its effect, got %+v", transition)
+	}
+
+	// 1s at 23.976fps is 24 frames: 12 either side of the cut at 8s
+	cut := timeUnits(clips[1].Offset)
+	if timeUnits(transition.Duration) != 24*1001 || timeUnits(transition.Offset) != cut-12*1001 {
+		t.Errorf("Expected 24 frames centred on the cut, got offset %s duration %s", transition.Offset, transition.Duration)
+	}
+
+	outputPath := filepath.Join(tempDir, "dissolve.fcpxml")
+	if err := WriteToFile(fcpxml, outputPath); err != nil {
+		t.Fatalf("WriteToFile failed: %v", err)
+	}
+	xmlOut, err := os.ReadFile(outputPath)
+	if err != nil {
+		t.Fatalf("Failed to read output: %v", err)
+	}
+	if !strings.Contains(string(xmlOut), `<transition name="Cross Dissolve" offset="`+transition.Offset+`" duration="`+transition.Duration+`">`) {
+		t.Errorf("Expected a transition element in the spine, got:\n%s", xmlOut)
+	}
+}
+
+// transitionTestTimeline builds three 8s clips, each with a second of handle either side, joined
+// by half-second cross-dissolves on the cuts at 8s and 16s
+func transitionTestTimeline(t *testing.T) *FCPXML {
+	tempDir := t.TempDir()
+	fcpxml, err := GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("GenerateEmpty failed: %v", err)
+	}
+	for _, name := range []string{"first.mp4", "second.mp4", "third.mp4"} {
+		videoPath := filepath.Join(tempDir, name)
+		if err := os.WriteFile(videoPath, []byte("fake video data"), 0644); err != nil {
+			t.Fatalf("Failed to create test video: %v", err)
+		}
+		if err := AddVideo(fcpxml, videoPath); err != nil {
+			t.Fatalf("AddVideo failed: %v", err)
+		}
+	}
+
+	clips := fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine.AssetClips
+	for i := range clips {
+		clips[i].Offset = ConvertSecondsToFCPDuration(float64(8 * i))
+		clips[i].Start = ConvertSecondsToFCPDuration(1)
+		clips[i].Duration = ConvertSecondsToFCPDuration(8)
+	}
+	for cut := 0; cut < 2; cut++ {
+		if err := AddTransition(fcpxml, cut, TransitionCrossDissolve, 0.5); err != nil {
+			t.Fatalf("AddTransition failed: %v", err)
+		}
+	}
+	return fcpxml
+}
+
+// TestTransitionsFollowRippleEdits tests that removing and trimming clips carries their transitions
+// along, and that edits which would strand a transition are refused
+func TestTransitionsFollowRippleEdits(t *testing.T) {
+	halfTransition := timeUnits(ConvertSecondsToFCPDuration(0.25))
+
+	fcpxml := transitionTestTimeline(t)
+	sequence := &fcpxml.Library.Events[0].Projects[0].Sequences[0]
+	if err := RemoveClipAt(fcpxml, 0, true); err != nil {
+		t.Fatalf("RemoveClipAt failed: %v", err)
+	}
+	if len(sequence.Spine.Transitions) != 1 {
+		t.Fatalf("Expected the first clip's transition to go with it, got %d transitions", len(sequence.Spine.Transitions))
+	}
+	if offset := timeUnits(sequence.Spine.Transitions[0].Offset); offset != timeUnits(sequence.Spine.AssetClips[1].Offset)-halfTransition {
+		t.Errorf("Expected the remaining transition to stay on its cut, got offset %s", sequence.Spine.Transitions[0].Offset)
+	}
+	if PruneUnusedResources(fcpxml); findEffectIDByUID(fcpxml, crossDissolveEffectUID) == "" {
+		t.Error("Expected the transition effect to survive pruning")
+	}
+	if err := WriteToFile(fcpxml, filepath.Join(t.TempDir(), "removed.fcpxml")); err != nil {
+		t.Errorf("WriteToFile failed: %v", err)
+	}
+
+	fcpxml = transitionTestTimeline(t)
+	sequence = &fcpxml.Library.Events[0].Projects[0].Sequences[0]
+	if err := TrimTimeline(fcpxml, 9, 24); err != nil {
+		t.Fatalf("TrimTimeline failed: %v", err)
+	}
+	if len(sequence.Spine.Transitions) != 1 {
+		t.Fatalf("Expected only the transition inside the window, got %d", len(sequence.Spine.Transitions))
+	}
+	if offset := timeUnits(sequence.Spine.Transitions[0].Offset); offset != timeUnits(ConvertSecondsToFCPDuration(7))-halfTransition {
+		t.Errorf("Expected the transition on the cut now at 7s, got offset %s", sequence.Spine.Transitions[0].Offset)
+	}
+
+	fcpxml = transitionTestTimeline(t)
+	if err := ShuffleSpine(fcpxml, 1); err == nil || !strings.Contains(err.Error(), "transition") {
+		t.Errorf("Expected shuffle to refuse a spine with transitions, got %v", err)
+	}
+	if err := SetClipSpeed(fcpxml, 1, 200); err == nil || !strings.Contains(err.Error(), "transition") {
+		t.Errorf("Expected a retime across a transition to be refused, got %v", err)
+	}
+	before := fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine.Transitions[1].Offset
+	if err := HoldToDuration(fcpxml, 30, true); err != nil {
+		t.Fatalf("HoldToDuration failed: %v", err)
+	}
+	if after := fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine.Transitions[1].Offset; after != before {
+		t.Errorf("Expected holding to leave transitions on their cuts, %s moved to %s", before, after)
+	}
+}
@@ -107,7 +107,9 @@ type RefClip struct {
 	Ref             string           `xml:"ref,attr"`
 	Offset          string           `xml:"offset,attr"`
 	Name            string           `xml:"name,attr"`
+	Start           string           `xml:"start,attr,omitempty"`
 	Duration        string           `xml:"duration,attr"`
+	Enabled         string           `xml:"enabled,attr,omitempty"` // "0" disables the clip (see SetClipEnabled); empty is enabled
 	AdjustTransform *AdjustTransform `xml:"adjust-transform,omitempty"`
 	Titles          []Title          `xml:"title,omitempty"`
 }
@@ -138,6 +140,7 @@ type Sequence struct {
 	TCFormat    string `xml:"tcFormat,attr"`
 	AudioLayout string `xml:"audioLayout,attr"`
 	AudioRate   string `xml:"audioRate,attr"`
+	Note        string `xml:"note,omitempty"`
 	Spine       Spine  `xml:"spine"`
 }
 
@@ -154,11 +157,22 @@ type TimelineElement interface {
 // spine.Content = fmt.Sprintf("<asset-clip...") ❌ CRITICAL VIOLATION!
 // FOR durations → USE ConvertSecondsToFCPDuration() function
 type Spine struct {
-	XMLName    xml.Name    `xml:"spine"`
-	AssetClips []AssetClip `xml:"asset-clip,omitempty"`
-	Gaps       []Gap       `xml:"gap,omitempty"`
-	Titles     []Title     `xml:"title,omitempty"`
-	Videos     []Video     `xml:"video,omitempty"`
+	XMLName     xml.Name     `xml:"spine"`
+	AssetClips  []AssetClip  `xml:"asset-clip,omitempty"`
+	Gaps        []Gap        `xml:"gap,omitempty"`
+	Titles      []Title      `xml:"title,omitempty"`
+	Videos      []Video      `xml:"video,omitempty"`
+	RefClips    []RefClip    `xml:"ref-clip,omitempty"`
+	Transitions []Transition `xml:"transition,omitempty"`
+}
+
+// Transition blends the two spine clips it straddles; its filter-video references the transition effect
+type Transition struct {
+	XMLName      xml.Name      `xml:"transition"`
+	Name         string        `xml:"name,attr"`
+	Offset       string        `xml:"offset,attr"`
+	Duration     string        `xml:"duration,attr"`
+	FilterVideos []FilterVideo `xml:"filter-video,omitempty"`
 }
 
 // MarshalXML implements custom XML marshaling to maintain chronological order
@@ -200,6 +214,18 @@ func (s Spine) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 			element: gap,
 		})
 	}
+	for _, refClip := range s.RefClips {
+		elements = append(elements, elementWithOffset{
+			offset:  parseFCPDurationForSort(refClip.Offset),
+			element: refClip,
+		})
+	}
+	for _, transition := range s.Transitions {
+		elements = append(elements, elementWithOffset{
+			offset:  parseFCPDurationForSort(transition.Offset),
+			element: transition,
+		})
+	}
 
 	// Sort by offset
 	for i := 0; i < len(elements)-1; i++ {
@@ -268,15 +294,44 @@ type AssetClip struct {
 	Format          string           `xml:"format,attr,omitempty"`
 	TCFormat        string           `xml:"tcFormat,attr,omitempty"`
 	AudioRole       string           `xml:"audioRole,attr,omitempty"`
+	Enabled         string           `xml:"enabled,attr,omitempty"` // "0" disables the clip (see SetClipEnabled); empty is enabled
 	ConformRate     *ConformRate     `xml:"conform-rate,omitempty"`
+	TimeMap         *TimeMap         `xml:"timeMap,omitempty"` // Retime (see SetClipSpeed); nil plays at 100%
 	AdjustCrop      *AdjustCrop      `xml:"adjust-crop,omitempty"`
 	AdjustTransform *AdjustTransform `xml:"adjust-transform,omitempty"`
+	AdjustBlend     *AdjustBlend     `xml:"adjust-blend,omitempty"`
+	AdjustVolume    *AdjustVolume    `xml:"adjust-volume,omitempty"`
 	NestedAssetClips []AssetClip     `xml:"asset-clip,omitempty"`
 	Titles          []Title          `xml:"title,omitempty"`
 	Videos          []Video          `xml:"video,omitempty"`
+	ChapterMarkers  []ChapterMarker  `xml:"chapter-marker,omitempty"`
 	FilterVideos    []FilterVideo    `xml:"filter-video,omitempty"`
 }
 
+// TimeMap retimes a clip: each timept maps a time in the clip's own (retimed) local time to a
+// time in its source media
+type TimeMap struct {
+	XMLName xml.Name `xml:"timeMap"`
+	Timepts []Timept `xml:"timept"`
+}
+
+// Timept is one point of a TimeMap; interp is linear, smooth or smooth2
+type Timept struct {
+	Time   string `xml:"time,attr"`
+	Value  string `xml:"value,attr"`
+	Interp string `xml:"interp,attr,omitempty"`
+}
+
+// ChapterMarker marks a chapter in a clip's local time; posterOffset (relative to start)
+// picks the frame FCP shows as the chapter's thumbnail
+type ChapterMarker struct {
+	XMLName      xml.Name `xml:"chapter-marker"`
+	Start        string   `xml:"start,attr"`
+	Duration     string   `xml:"duration,attr,omitempty"`
+	Value        string   `xml:"value,attr"`
+	PosterOffset string   `xml:"posterOffset,attr,omitempty"`
+}
+
 // GetOffset implements TimelineElement interface
 func (ac AssetClip) GetOffset() string {
 	return ac.Offset
@@ -306,6 +361,7 @@ type Title struct {
 	Name         string         `xml:"name,attr"`
 	Duration     string         `xml:"duration,attr"`
 	Start        string         `xml:"start,attr,omitempty"`
+	Enabled      string         `xml:"enabled,attr,omitempty"` // "0" disables the title; empty is enabled
 	Params       []Param        `xml:"param,omitempty"`
 	Text         *TitleText     `xml:"text,omitempty"`         // Pointer so it can be nil
 	TextStyleDefs []TextStyleDef `xml:"text-style-def,omitempty"` // 🚨 BREAKING CHANGE: Was single TextStyleDef, now slice for shadow text
@@ -320,13 +376,15 @@ type Video struct {
 	Name          string         `xml:"name,attr"`
 	Duration      string         `xml:"duration,attr"`
 	Start         string         `xml:"start,attr,omitempty"`
+	Enabled       string         `xml:"enabled,attr,omitempty"` // "0" disables the video; empty is enabled
 	Params        []Param        `xml:"param,omitempty"`
 	AdjustCrop      *AdjustCrop      `xml:"adjust-crop,omitempty"`
 	AdjustTransform *AdjustTransform `xml:"adjust-transform,omitempty"`
-	FilterVideos     []FilterVideo   `xml:"filter-video,omitempty"`   // Support filter-video effects
+	AdjustBlend     *AdjustBlend     `xml:"adjust-blend,omitempty"`
 	NestedVideos     []Video     `xml:"video,omitempty"`      // Support nested video elements with lanes
 	NestedAssetClips []AssetClip `xml:"asset-clip,omitempty"` // Support nested asset-clip elements with lanes
 	NestedTitles     []Title     `xml:"title,omitempty"`      // Support nested title elements with lanes
+	FilterVideos     []FilterVideo   `xml:"filter-video,omitempty"`   // Support filter-video effects; the DTD puts them after anchored items
 }
 
 // GetOffset implements TimelineElement interface
@@ -342,8 +400,9 @@ func (v Video) GetEndOffset() string {
 }
 
 type ConformRate struct {
-	ScaleEnabled string `xml:"scaleEnabled,attr,omitempty"`
-	SrcFrameRate string `xml:"srcFrameRate,attr,omitempty"`
+	ScaleEnabled  string `xml:"scaleEnabled,attr,omitempty"`
+	SrcFrameRate  string `xml:"srcFrameRate,attr,omitempty"`
+	FrameSampling string `xml:"frameSampling,attr,omitempty"`
 }
 
 type AdjustCrop struct {
@@ -376,9 +435,24 @@ type AdjustTransform struct {
 	Position string  `xml:"position,attr,omitempty"`
 	Scale    string  `xml:"scale,attr,omitempty"`
 	Rotation string  `xml:"rotation,attr,omitempty"`
+	Anchor   string  `xml:"anchor,attr,omitempty"`
 	Params   []Param `xml:"param,omitempty"`
 }
 
+// AdjustBlend sets a clip's compositing opacity (amount 0.0-1.0) and blend mode
+type AdjustBlend struct {
+	Amount string  `xml:"amount,attr,omitempty"`
+	Mode   string  `xml:"mode,attr,omitempty"`
+	Params []Param `xml:"param,omitempty"`
+}
+
+// AdjustVolume sets a clip's audio level (e.g. amount="-6dB"); an "amount" param with a
+// keyframeAnimation automates the level over the clip's local time
+type AdjustVolume struct {
+	Amount string  `xml:"amount,attr,omitempty"`
+	Params []Param `xml:"param,omitempty"`
+}
+
 
 type GeneratorClip struct {
 	Ref      string  `xml:"ref,attr"`
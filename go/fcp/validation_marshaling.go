@@ -3,6 +3,7 @@ package fcp
 import (
 	"encoding/xml"
 	"fmt"
+	"os"
 	"strconv"
 	"strings"
 )
@@ -130,7 +131,10 @@ func (fcpxml *FCPXML) ValidateStructure() error {
 
 	// 🚨 CRITICAL: Run CLAUDE.md compliance validation 
 	// This catches asset-clip on images and other critical violations
-	violations := ValidateClaudeCompliance(fcpxml)
+	violations, warnings := SplitValidationWarnings(ValidateClaudeCompliance(fcpxml))
+	for _, warning := range warnings {
+		fmt.Fprintln(os.Stderr, warning)
+	}
 	if len(violations) > 0 {
 		return fmt.Errorf("CLAUDE.md compliance violations detected:\n  - %s", strings.Join(violations, "\n  - "))
 	}
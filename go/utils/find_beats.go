@@ -116,8 +116,8 @@ func TestWAVHeader(filename string) {
 	}
 }
 
-// HandleFindBeatsCommand processes the find-beats command
-func HandleFindBeatsCommand(args []string) error {
+// HandleFindBeatsCommand processes the find-beats command; writeOpts guards the output file
+func HandleFindBeatsCommand(args []string, writeOpts fcp.WriteOptions) error {
 	if len(args) < 1 {
 		return fmt.Errorf("usage: find-beats <file.wav> [output.fcpxml]")
 	}
@@ -150,7 +150,7 @@ func HandleFindBeatsCommand(args []string) error {
 	}
 
 	// Generate FCPXML with alternating colors using PNG backgrounds
-	err = GenerateBeatsVisualizationPNG(wavFile, beats, outputFile)
+	err = GenerateBeatsVisualizationPNG(wavFile, beats, outputFile, writeOpts)
 	if err != nil {
 		return fmt.Errorf("failed to generate FCPXML: %v", err)
 	}
@@ -500,7 +500,7 @@ func (a *AudioAnalyzer) filterStrongBeats(beats []BeatDetection, minIntensity fl
 }
 
 // GenerateBeatsVisualization creates an FCPXML with alternating background colors and audio using PNG images
-func GenerateBeatsVisualization(wavFile string, beats []BeatDetection, outputFile string) error {
+func GenerateBeatsVisualization(wavFile string, beats []BeatDetection, outputFile string, writeOpts fcp.WriteOptions) error {
 	// Get absolute path for WAV file
 	absWavPath, err := filepath.Abs(wavFile)
 	if err != nil {
@@ -669,5 +669,5 @@ func GenerateBeatsVisualization(wavFile string, beats []BeatDetection, outputFil
 	sequence.Duration = fcp.ConvertSecondsToFCPDuration(totalDuration)
 
 	// Write the FCPXML file
-	return fcp.WriteToFile(fcpxml, outputFile)
+	return writeFCPXML(fcpxml, outputFile, writeOpts)
 }
\ No newline at end of file
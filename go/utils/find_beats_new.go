@@ -48,7 +48,7 @@ func createColorPNGs() error {
 }
 
 // GenerateBeatsVisualizationPNG creates an FCPXML with alternating background colors using PNG images
-func GenerateBeatsVisualizationPNG(wavFile string, beats []BeatDetection, outputFile string) error {
+func GenerateBeatsVisualizationPNG(wavFile string, beats []BeatDetection, outputFile string, writeOpts fcp.WriteOptions) error {
 	// Get absolute path for WAV file
 	absWavPath, err := filepath.Abs(wavFile)
 	if err != nil {
@@ -142,7 +142,7 @@ func GenerateBeatsVisualizationPNG(wavFile string, beats []BeatDetection, output
 	}
 
 	// Write FCPXML file
-	err = fcp.WriteToFile(fcpxml, outputFile)
+	err = writeFCPXML(fcpxml, outputFile, writeOpts)
 	if err != nil {
 		return fmt.Errorf("failed to write FCPXML: %v", err)
 	}
@@ -0,0 +1,80 @@
+package utils
+
+import (
+	"fmt"
+	"os"
+
+	"cutlass/fcp"
+)
+
+// applyAllUnsupported are effects that build extra elements around the last spine video
+// (or pick a random effect per image), so they can't restyle every clip in place
+var applyAllUnsupported = map[string]bool{
+	"particle-emitter": true,
+	"word-bounce":      true,
+	"variety-pack":     true,
+}
+
+// ApplyEffectToAll animates every image in the spine of an existing timeline with effectType,
+// leaving images that already have an adjust-transform alone.
+func ApplyEffectToAll(fcpxml *fcp.FCPXML, effectType string) error {
+	_, err := ApplyEffectToAllWithOptions(fcpxml, effectType, false)
+	return err
+}
+
+// ApplyEffectToAllWithOptions is ApplyEffectToAll that can also replace existing transforms.
+// It returns how many images were animated.
+//
+// 🎬 PER-CLIP TIMING: each image is animated over its own duration, with keyframes starting at
+// the clip's own start (its local time at its timeline offset), so timelines built by concat
+// or with mixed clip lengths are handled - nothing assumes back-to-back 10s blocks.
+func ApplyEffectToAllWithOptions(fcpxml *fcp.FCPXML, effectType string, replace bool) (int, error) {
+	if err := ValidateEffectType(effectType); err != nil {
+		return 0, err
+	}
+	if applyAllUnsupported[effectType] {
+		return 0, fmt.Errorf("the %s effect can't be applied to every clip", effectType)
+	}
+
+	if len(fcpxml.Library.Events) == 0 || len(fcpxml.Library.Events[0].Projects) == 0 || len(fcpxml.Library.Events[0].Projects[0].Sequences) == 0 {
+		return 0, fmt.Errorf("no sequence found in FCPXML")
+	}
+	sequence := &fcpxml.Library.Events[0].Projects[0].Sequences[0]
+
+	// Only videos that reference an asset are images; generators reference effects
+	imageAssets := make(map[string]bool)
+	for _, asset := range fcpxml.Resources.Assets {
+		imageAssets[asset.ID] = true
+	}
+
+	applied := 0
+	for i := range sequence.Spine.Videos {
+		video := &sequence.Spine.Videos[i]
+		if !imageAssets[video.Ref] {
+			continue
+		}
+		if video.AdjustTransform != nil && !replace {
+			fmt.Fprintf(os.Stderr, "⏭️  Skipping '%s': already has a transform (use --replace to restyle it)\n", video.Name)
+			continue
+		}
+
+		if video.Start == "" {
+			video.Start = "0s" // Same local time FCP assumes without a start attribute
+		}
+		numerator, timebase, err := fcp.ParseFCPTime(video.Duration)
+		if err != nil || numerator <= 0 {
+			return applied, fmt.Errorf("image '%s' at %s has an unusable duration '%s'", video.Name, video.Offset, video.Duration)
+		}
+
+		video.AdjustTransform = nil
+		if err := applyDynamicImageEffects(fcpxml, video, float64(numerator)/float64(timebase), effectType, "", "", FXOptions{}); err != nil {
+			return applied, fmt.Errorf("failed to apply %s to '%s' at %s: %v", effectType, video.Name, video.Offset, err)
+		}
+		applied++
+	}
+
+	if applied == 0 {
+		fmt.Fprintf(os.Stderr, "No images were restyled with '%s'\n", effectType)
+	}
+	return applied, nil
+}
@@ -0,0 +1,115 @@
+package utils
+
+import (
+	"cutlass/fcp"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+// FXBatchResult records the outcome of rendering one input image in an fx-batch run
+type FXBatchResult struct {
+	Input  string
+	Output string
+	Err    error
+}
+
+// HandleFXBatchCommand renders one FCPXML per image matching pattern, each with the same effect
+func HandleFXBatchCommand(pattern string, effectType string, outDir string, outTemplate string, jobs int, durationSeconds float64, writeOpts fcp.WriteOptions) error {
+	if !isValidEffectType(effectType) {
+		return fmt.Errorf("unknown effect type '%s'", effectType)
+	}
+
+	results, err := GenerateFXBatchWithTemplate(pattern, effectType, outDir, outTemplate, jobs, durationSeconds, writeOpts)
+	if err != nil {
+		return err
+	}
+
+	failed := 0
+	for _, result := range results {
+		if result.Err != nil {
+			failed++
+			fmt.Printf("❌ %s: %v\n", result.Input, result.Err)
+			continue
+		}
+		fmt.Printf("✅ %s → %s\n", result.Input, result.Output)
+	}
+
+	fmt.Printf("🎬 Batch complete: %d/%d files rendered with '%s' effect\n", len(results)-failed, len(results), effectType)
+	if failed > 0 {
+		return fmt.Errorf("%d of %d files failed", failed, len(results))
+	}
+
+	return nil
+}
+
+// GenerateFXBatch expands pattern (sorted) and writes outDir/<name>_fx.fcpxml for each match like
+// GenerateFXStaticImage. Files are independent, so they are rendered by a pool of jobs workers.
+// A failing file is recorded in its FXBatchResult and never stops the rest of the batch.
+func GenerateFXBatch(pattern string, effectType string, outDir string, jobs int, durationSeconds float64) ([]FXBatchResult, error) {
+	return GenerateFXBatchWithTemplate(pattern, effectType, outDir, DefaultFXBatchTemplate, jobs, durationSeconds, fcp.WriteOptions{})
+}
+
+// GenerateFXBatchWithTemplate is GenerateFXBatch with output names from an ExpandOutTemplate
+// template relative to outDir. All names are checked for collisions before anything renders;
+// writeOpts decides whether existing outputs are replaced.
+func GenerateFXBatchWithTemplate(pattern string, effectType string, outDir string, outTemplate string, jobs int, durationSeconds float64, writeOpts fcp.WriteOptions) ([]FXBatchResult, error) {
+	inputs, err := filepath.Glob(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid glob pattern '%s': %v", pattern, err)
+	}
+	if len(inputs) == 0 {
+		return nil, fmt.Errorf("no files match '%s'", pattern)
+	}
+	sort.Strings(inputs)
+
+	if outTemplate == "" {
+		outTemplate = DefaultFXBatchTemplate
+	}
+	outputs, err := batchOutputPaths(outDir, outTemplate, inputs, effectType, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	for _, output := range outputs {
+		if err := os.MkdirAll(filepath.Dir(output), 0755); err != nil {
+			return nil, fmt.Errorf("failed to create output directory: %v", err)
+		}
+	}
+
+	if jobs < 1 {
+		jobs = 1
+	}
+	if jobs > len(inputs) {
+		jobs = len(inputs)
+	}
+
+	// Results are indexed by input position so the report stays in glob order
+	results := make([]FXBatchResult, len(inputs))
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+
+	for w := 0; w < jobs; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				results[i] = FXBatchResult{
+					Input:  inputs[i],
+					Output: outputs[i],
+					Err:    GenerateFXStaticImagesWithOptions([]string{inputs[i]}, outputs[i], durationSeconds, effectType, defaultFXFontColor, defaultFXOutlineColor, FXOptions{Write: writeOpts}),
+				}
+			}
+		}()
+	}
+
+	for i := range inputs {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	return results, nil
+}
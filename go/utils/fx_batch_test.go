@@ -0,0 +1,142 @@
+package utils
+
+import (
+	"cutlass/fcp"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestGenerateFXBatch validates one output per image and that a bad input doesn't stop the batch
+func TestGenerateFXBatch(t *testing.T) {
+	inputDir := t.TempDir()
+	outDir := filepath.Join(t.TempDir(), "out")
+
+	for _, name := range []string{"c.png", "a.png", "b.png"} {
+		if err := os.WriteFile(filepath.Join(inputDir, name), []byte("fake png data"), 0644); err != nil {
+			t.Fatalf("Failed to create test image: %v", err)
+		}
+	}
+	// Not an image - GenerateFXStaticImage rejects it
+	if err := os.WriteFile(filepath.Join(inputDir, "bad.txt"), []byte("not an image"), 0644); err != nil {
+		t.Fatalf("Failed to create bad input: %v", err)
+	}
+
+	results, err := GenerateFXBatch(filepath.Join(inputDir, "*"), "spiral", outDir, 2, 5.0)
+	if err != nil {
+		t.Fatalf("GenerateFXBatch failed: %v", err)
+	}
+
+	if len(results) != 4 {
+		t.Fatalf("Expected 4 results, got %d", len(results))
+	}
+
+	// Glob results are sorted
+	expectedOrder := []string{"a.png", "b.png", "bad.txt", "c.png"}
+	for i, result := range results {
+		if filepath.Base(result.Input) != expectedOrder[i] {
+			t.Errorf("Result %d: expected %s, got %s", i, expectedOrder[i], filepath.Base(result.Input))
+		}
+	}
+
+	for _, result := range results {
+		if filepath.Base(result.Input) == "bad.txt" {
+			if result.Err == nil {
+				t.Errorf("Expected bad input to be reported as an error")
+			}
+			continue
+		}
+		if result.Err != nil {
+			t.Errorf("Unexpected error for %s: %v", result.Input, result.Err)
+			continue
+		}
+		if _, err := os.Stat(result.Output); err != nil {
+			t.Errorf("Expected output file %s: %v", result.Output, err)
+		}
+	}
+
+	for _, name := range []string{"a_fx.fcpxml", "b_fx.fcpxml", "c_fx.fcpxml"} {
+		if _, err := os.Stat(filepath.Join(outDir, name)); err != nil {
+			t.Errorf("Expected %s in output directory: %v", name, err)
+		}
+	}
+}
+
+// TestGenerateFXBatchNoMatches validates that an empty glob is an error
+func TestGenerateFXBatchNoMatches(t *testing.T) {
+	if _, err := GenerateFXBatch(filepath.Join(t.TempDir(), "*.jpg"), "spiral", t.TempDir(), 1, 5.0); err == nil {
+		t.Errorf("Expected error when no files match")
+	}
+}
+
+// TestGenerateFXBatchOutTemplate validates templated output names and collision detection
+func TestGenerateFXBatchOutTemplate(t *testing.T) {
+	inputDir := t.TempDir()
+	outDir := filepath.Join(t.TempDir(), "out")
+
+	for _, name := range []string{"a.png", "b.png"} {
+		if err := os.WriteFile(filepath.Join(inputDir, name), []byte("fake png data"), 0644); err != nil {
+			t.Fatalf("Failed to create test image: %v", err)
+		}
+	}
+
+	results, err := GenerateFXBatchWithTemplate(filepath.Join(inputDir, "*.png"), "glow", outDir, "{index}_{effect}", 2, 5.0, fcp.WriteOptions{})
+	if err != nil {
+		t.Fatalf("GenerateFXBatchWithTemplate failed: %v", err)
+	}
+	for i, name := range []string{"0_glow.fcpxml", "1_glow.fcpxml"} {
+		if results[i].Err != nil {
+			t.Fatalf("Unexpected error for %s: %v", results[i].Input, results[i].Err)
+		}
+		if results[i].Output != filepath.Join(outDir, name) {
+			t.Errorf("Result %d: expected %s, got %s", i, name, results[i].Output)
+		}
+		if _, err := os.Stat(filepath.Join(outDir, name)); err != nil {
+			t.Errorf("Expected %s in output directory: %v", name, err)
+		}
+	}
+
+	// Subdirectories in the template are created
+	results, err = GenerateFXBatchWithTemplate(filepath.Join(inputDir, "*.png"), "glow", outDir, "{effect}/{name}.fcpxml", 1, 5.0, fcp.WriteOptions{})
+	if err != nil {
+		t.Fatalf("GenerateFXBatchWithTemplate failed for nested template: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(outDir, "glow", "b.fcpxml")); err != nil || results[1].Err != nil {
+		t.Errorf("Expected glow/b.fcpxml: %v %v", err, results[1].Err)
+	}
+
+	if _, err := GenerateFXBatchWithTemplate(filepath.Join(inputDir, "*.png"), "glow", outDir, "{effect}", 1, 5.0, fcp.WriteOptions{}); err == nil || !strings.Contains(err.Error(), "writes both") {
+		t.Errorf("Expected collision error, got %v", err)
+	}
+	if _, err := GenerateFXBatchWithTemplate(filepath.Join(inputDir, "*.png"), "glow", outDir, "{name}_{size}", 1, 5.0, fcp.WriteOptions{}); err == nil || !strings.Contains(err.Error(), "unknown output template token") {
+		t.Errorf("Expected unknown token error, got %v", err)
+	}
+}
+
+// TestGenerateFXBatchWriteOptions validates that a rerun only replaces earlier outputs with Force
+func TestGenerateFXBatchWriteOptions(t *testing.T) {
+	inputDir := t.TempDir()
+	outDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(inputDir, "a.png"), []byte("fake png data"), 0644); err != nil {
+		t.Fatalf("Failed to create test image: %v", err)
+	}
+	pattern := filepath.Join(inputDir, "*.png")
+
+	for _, run := range []struct {
+		opts    fcp.WriteOptions
+		wantErr bool
+	}{
+		{fcp.WriteOptions{}, false},
+		{fcp.WriteOptions{}, true},
+		{fcp.WriteOptions{Force: true}, false},
+	} {
+		results, err := GenerateFXBatchWithTemplate(pattern, "glow", outDir, DefaultFXBatchTemplate, 1, 5.0, run.opts)
+		if err != nil {
+			t.Fatalf("GenerateFXBatchWithTemplate failed: %v", err)
+		}
+		if gotErr := results[0].Err != nil; gotErr != run.wantErr {
+			t.Errorf("With %+v expected error %v, got %v", run.opts, run.wantErr, results[0].Err)
+		}
+	}
+}
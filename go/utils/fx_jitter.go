@@ -0,0 +1,162 @@
+package utils
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"strconv"
+	"strings"
+
+	"cutlass/fcp"
+)
+
+// effectJitter scales one image's effect so repeated effect types don't move identically
+type effectJitter struct {
+	Amplitude     float64 // Multiplies position/rotation offsets and the scale's distance from 1
+	Speed         float64 // >1 front-loads the motion, <1 holds it back; start and end times stay put
+	Mirror        bool    // Flip horizontal position and rotation direction
+	ScaleRotation bool    // false for effects that must finish whole turns (360-pan, flip, ...)
+}
+
+// Jitter bounds: amplitude and speed vary by up to ± these fractions
+const (
+	jitterAmplitudeRange = 0.2
+	jitterSpeedRange     = 0.15
+)
+
+// fullTurnEffects rotate through whole turns and land upright; scaling their rotation would
+// leave the image crooked at the end, so only their direction is jittered
+var fullTurnEffects = map[string]bool{
+	"flip":     true,
+	"360-tilt": true,
+	"360-pan":  true,
+	"spiral":   true,
+}
+
+// jitterEffectParams draws the jitter for one image with effectType from rng, so the same seed
+// gives the same variations
+func jitterEffectParams(effectType string, rng *rand.Rand) effectJitter {
+	return effectJitter{
+		Amplitude:     1 + (rng.Float64()*2-1)*jitterAmplitudeRange,
+		Speed:         1 + (rng.Float64()*2-1)*jitterSpeedRange,
+		Mirror:        rng.Intn(2) == 1,
+		ScaleRotation: !fullTurnEffects[effectType],
+	}
+}
+
+// applyEffectJitter rescales the position, scale and rotation keyframes of imageVideo's transform
+// and warps their times by the jitter's speed within the effect's [start, start+duration] span,
+// keeping them on rate's frame grid
+func applyEffectJitter(imageVideo *fcp.Video, jitter effectJitter, rate fcp.FrameRate, videoStartTime string, durationSeconds float64) {
+	if imageVideo.AdjustTransform == nil {
+		return
+	}
+
+	for i := range imageVideo.AdjustTransform.Params {
+		param := &imageVideo.AdjustTransform.Params[i]
+		anim := param.KeyframeAnimation
+		if anim == nil {
+			continue
+		}
+
+		for j := range anim.Keyframes {
+			anim.Keyframes[j].Value = jitterKeyframeValue(param.Name, anim.Keyframes[j].Value, jitter)
+		}
+		warpKeyframeTimes(anim.Keyframes, jitter.Speed, rate, videoStartTime, durationSeconds)
+	}
+}
+
+// jitterKeyframeValue applies the jitter to one "x y" position/scale or rotation value; other
+// params and values it can't parse are returned unchanged
+func jitterKeyframeValue(name, value string, jitter effectJitter) string {
+	fields := strings.Fields(value)
+	numbers := make([]float64, len(fields))
+	for i, field := range fields {
+		number, err := strconv.ParseFloat(field, 64)
+		if err != nil {
+			return value
+		}
+		numbers[i] = number
+	}
+
+	direction := 1.0
+	if jitter.Mirror {
+		direction = -1
+	}
+
+	switch {
+	case name == "position" && len(numbers) == 2:
+		numbers[0] *= jitter.Amplitude * direction
+		numbers[1] *= jitter.Amplitude
+	case name == "scale" && len(numbers) == 2:
+		for i := range numbers {
+			numbers[i] = math.Max(0.05, 1+(numbers[i]-1)*jitter.Amplitude)
+		}
+	case name == "rotation" && len(numbers) == 1:
+		numbers[0] *= direction
+		if jitter.ScaleRotation {
+			numbers[0] *= jitter.Amplitude
+		}
+	default:
+		return value
+	}
+
+	parts := make([]string, len(numbers))
+	for i, number := range numbers {
+		parts[i] = strconv.FormatFloat(math.Round(number*1e4)/1e4, 'f', -1, 64)
+	}
+	return strings.Join(parts, " ")
+}
+
+// warpKeyframeTimes remaps each keyframe's fraction u of the effect to u^(1/speed), keeping the
+// first and last times. Dense animations whose warped frames would collide are left as authored.
+func warpKeyframeTimes(keyframes []fcp.Keyframe, speed float64, rate fcp.FrameRate, videoStartTime string, durationSeconds float64) {
+	if speed == 1 || len(keyframes) < 3 || durationSeconds <= 0 {
+		return
+	}
+	startSeconds, err := fcpTimeSeconds(videoStartTime)
+	if err != nil {
+		return
+	}
+
+	// Work in whole frames: keyframes were placed on the frame grid, including the last one
+	framesPerSecond := float64(rate.Timebase) / float64(rate.FrameDuration)
+	durationFrames := math.Round(durationSeconds * framesPerSecond)
+
+	warped := make([]string, len(keyframes))
+	previousFrame := -1.0
+	for i, keyframe := range keyframes {
+		seconds, err := fcpTimeSeconds(keyframe.Time)
+		if err != nil {
+			return
+		}
+		u := math.Round((seconds-startSeconds)*framesPerSecond) / durationFrames
+		if u < 0 || u > 1 {
+			return
+		}
+
+		frame := math.Round(durationFrames * math.Pow(u, 1/speed))
+		if frame <= previousFrame {
+			return
+		}
+		previousFrame = frame
+
+		warped[i], err = rate.AddSeconds(videoStartTime, frame/framesPerSecond)
+		if err != nil {
+			return
+		}
+	}
+
+	for i := range keyframes {
+		keyframes[i].Time = warped[i]
+	}
+}
+
+// fcpTimeSeconds converts an FCP time such as "86399313/24000s" to seconds
+func fcpTimeSeconds(value string) (float64, error) {
+	numerator, timebase, err := fcp.ParseFCPTime(value)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse time: %v", err)
+	}
+	return float64(numerator) / float64(timebase), nil
+}
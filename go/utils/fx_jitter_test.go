@@ -0,0 +1,95 @@
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"cutlass/fcp"
+)
+
+// TestEffectJitterVariesRepeatedEffects checks two shake images get different but valid keyframes,
+// and that the same seed reproduces them
+func TestEffectJitterVariesRepeatedEffects(t *testing.T) {
+	tempDir := t.TempDir()
+	var imagePaths []string
+	for _, name := range []string{"one.png", "two.png"} {
+		imagePath := filepath.Join(tempDir, name)
+		if err := os.WriteFile(imagePath, []byte("fake png data"), 0644); err != nil {
+			t.Fatalf("Failed to create test image: %v", err)
+		}
+		imagePaths = append(imagePaths, imagePath)
+	}
+
+	render := func(name string, seed int64) []fcp.Video {
+		outputPath := filepath.Join(tempDir, name)
+		opts := FXOptions{Jitter: true, Seed: seed}
+		if err := GenerateFXStaticImagesWithOptions(imagePaths, outputPath, 10.0, "shake", "", "", opts); err != nil {
+			t.Fatalf("GenerateFXStaticImagesWithOptions failed: %v", err)
+		}
+		fcpxml, err := fcp.ReadFromFile(outputPath)
+		if err != nil {
+			t.Fatalf("Failed to read output: %v", err)
+		}
+		return fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine.Videos
+	}
+
+	videos := render("first.fcpxml", 42)
+	if len(videos) != 2 {
+		t.Fatalf("Expected 2 image videos, got %d", len(videos))
+	}
+	if reflect.DeepEqual(videos[0].AdjustTransform.Params, videos[1].AdjustTransform.Params) {
+		t.Error("Expected the two shake images to animate differently")
+	}
+
+	for _, video := range videos {
+		startSeconds, err := fcpTimeSeconds(video.Start)
+		if err != nil {
+			t.Fatalf("Bad start %s: %v", video.Start, err)
+		}
+		for _, param := range video.AdjustTransform.Params {
+			if param.KeyframeAnimation == nil {
+				continue
+			}
+			previous := -1.0
+			for _, keyframe := range param.KeyframeAnimation.Keyframes {
+				seconds, err := fcpTimeSeconds(keyframe.Time)
+				if err != nil {
+					t.Fatalf("%s %s: bad keyframe time %s", video.Name, param.Name, keyframe.Time)
+				}
+				offset := seconds - startSeconds
+				// 10s rounds up to 240 frames at 23.976fps
+				if offset <= previous || offset < 0 || offset > 240*1001/24000.0+1e-9 {
+					t.Errorf("%s %s: keyframe at %.4fs out of order or outside the clip", video.Name, param.Name, offset)
+				}
+				previous = offset
+				for _, field := range strings.Fields(keyframe.Value) {
+					if _, err := strconv.ParseFloat(field, 64); err != nil {
+						t.Errorf("%s %s: invalid value %q", video.Name, param.Name, keyframe.Value)
+					}
+				}
+			}
+		}
+	}
+
+	again := render("second.fcpxml", 42)
+	for i := range videos {
+		if !reflect.DeepEqual(videos[i].AdjustTransform.Params, again[i].AdjustTransform.Params) {
+			t.Errorf("Image %d: expected the same seed to reproduce the same keyframes", i)
+		}
+	}
+}
+
+// TestJitterKeepsFullTurns checks rotation of full-turn effects is only mirrored, never rescaled
+func TestJitterKeepsFullTurns(t *testing.T) {
+	jitter := effectJitter{Amplitude: 1.2, Speed: 1, Mirror: true, ScaleRotation: false}
+	if got := jitterKeyframeValue("rotation", "360", jitter); got != "-360" {
+		t.Errorf("Expected a mirrored full turn, got %s", got)
+	}
+	if got := jitterKeyframeValue("scale", "1.1 1.1", effectJitter{Amplitude: 1.5}); got != "1.15 1.15" {
+		t.Errorf("Expected scale offset from 1 to grow by the amplitude, got %s", got)
+	}
+}
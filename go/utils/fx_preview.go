@@ -0,0 +1,150 @@
+package utils
+
+import (
+	"fmt"
+
+	"cutlass/fcp"
+)
+
+// DefaultFXPreviewSeconds is the length of each effect's segment in an fx preview
+const DefaultFXPreviewSeconds = 3.0
+
+// fxPreviewSkipped are effect names that don't animate a single image in place:
+// variety-pack only picks other effects, particle-emitter adds its own spine elements,
+// and word-bounce overlays titles that would collide with the effect label
+var fxPreviewSkipped = map[string]bool{
+	"variety-pack":     true,
+	"particle-emitter": true,
+	"word-bounce":      true,
+}
+
+// FXPreviewEffects returns the effects shown by an fx preview, in ValidEffectTypes order
+func FXPreviewEffects() []string {
+	var effects []string
+	for _, effect := range ValidEffectTypes() {
+		if !fxPreviewSkipped[effect] {
+			effects = append(effects, effect)
+		}
+	}
+	return effects
+}
+
+// BuildFXPreview creates a storyboard with one segment per effect, each showing imagePath with
+// that effect applied and a title naming it.
+//
+// 🚨 CLAUDE.md COMPLIANCE:
+// ✅ Each segment goes through fcp.AddImage + addDynamicImageEffects (the fx-static-image path)
+// ✅ Effect labels are nested titles inside their segment, offset from the image's start
+// ✅ One shared Text effect created through ResourceRegistry/Transaction
+func BuildFXPreview(imagePath string, segmentSeconds float64) (*fcp.FCPXML, error) {
+	if segmentSeconds <= 0 {
+		return nil, fmt.Errorf("segment length must be positive, got %g", segmentSeconds)
+	}
+
+	fcpxml, err := fcp.GenerateEmpty("")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create base FCPXML: %v", err)
+	}
+
+	for _, effect := range FXPreviewEffects() {
+		if err := fcp.AddImage(fcpxml, imagePath, segmentSeconds); err != nil {
+			return nil, fmt.Errorf("failed to add image for %s: %v", effect, err)
+		}
+		if err := addDynamicImageEffects(fcpxml, segmentSeconds, effect, "", "", FXOptions{}); err != nil {
+			return nil, fmt.Errorf("failed to apply %s: %v", effect, err)
+		}
+		if err := addFXPreviewLabel(fcpxml, effect, segmentSeconds); err != nil {
+			return nil, fmt.Errorf("failed to label %s: %v", effect, err)
+		}
+	}
+
+	return fcpxml, nil
+}
+
+// GenerateFXPreview writes an fx preview storyboard for imagePath to outputPath, guarded by writeOpts
+func GenerateFXPreview(imagePath, outputPath string, writeOpts fcp.WriteOptions) error {
+	fcpxml, err := BuildFXPreview(imagePath, DefaultFXPreviewSeconds)
+	if err != nil {
+		return err
+	}
+
+	if err := writeFCPXML(fcpxml, outputPath, writeOpts); err != nil {
+		return fmt.Errorf("failed to write FCPXML: %v", err)
+	}
+
+	return nil
+}
+
+// addFXPreviewLabel nests a title naming effect in the most recently added image
+func addFXPreviewLabel(fcpxml *fcp.FCPXML, effect string, durationSeconds float64) error {
+	sequence := &fcpxml.Library.Events[0].Projects[0].Sequences[0]
+	imageVideo := &sequence.Spine.Videos[len(sequence.Spine.Videos)-1]
+
+	textEffectID := ""
+	for _, existing := range fcpxml.Resources.Effects {
+		if existing.UID == ".../Titles.localized/Basic Text.localized/Text.localized/Text.moti" {
+			textEffectID = existing.ID
+			break
+		}
+	}
+	if textEffectID == "" {
+		registry := fcp.NewResourceRegistry(fcpxml)
+		tx := fcp.NewTransaction(registry)
+		defer tx.Rollback()
+
+		textEffectID = tx.ReserveIDs(1)[0]
+		if _, err := tx.CreateEffect(textEffectID, "Text", ".../Titles.localized/Basic Text.localized/Text.localized/Text.moti"); err != nil {
+			return fmt.Errorf("failed to create text effect: %v", err)
+		}
+		if err := tx.Commit(); err != nil {
+			return fmt.Errorf("failed to commit transaction: %v", err)
+		}
+	}
+
+	textStyleID := fcp.GenerateTextStyleID(effect, "fx_preview_label")
+	imageVideo.NestedTitles = append(imageVideo.NestedTitles, fcp.Title{
+		Ref:      textEffectID,
+		Lane:     "1",
+		Offset:   imageVideo.Start,
+		Name:     effect + " - Label",
+		Start:    "86486400/24000s",
+		Duration: fcp.ConvertSecondsToFCPDuration(durationSeconds),
+		Params: []fcp.Param{
+			{
+				Name:  "Position",
+				Key:   "9999/10003/13260/3296672360/1/100/101",
+				Value: "0 -1500", // Bottom center, clear of most effect motion
+			},
+			{
+				Name:  "Alignment",
+				Key:   "9999/10003/13260/3296672360/2/354/3296667315/401",
+				Value: "1 (Center)",
+			},
+		},
+		Text: &fcp.TitleText{
+			TextStyles: []fcp.TextStyleRef{
+				{
+					Ref:  textStyleID,
+					Text: effect,
+				},
+			},
+		},
+		TextStyleDefs: []fcp.TextStyleDef{
+			{
+				ID: textStyleID,
+				TextStyle: fcp.TextStyle{
+					Font:         "Helvetica Neue",
+					FontSize:     "140",
+					FontFace:     "Bold",
+					FontColor:    "1 1 1 1",
+					Bold:         "1",
+					Alignment:    "center",
+					ShadowColor:  fcp.DefaultTextShadowColor,
+					ShadowOffset: fcp.DefaultTextShadowOffset,
+				},
+			},
+		},
+	})
+
+	return nil
+}
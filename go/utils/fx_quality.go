@@ -0,0 +1,89 @@
+package utils
+
+import (
+	"fmt"
+	"math"
+
+	"cutlass/fcp"
+)
+
+// EffectQuality is the keyframe density of fx-static-image effects: fewer keyframes make lighter
+// files that play back faster in FCP, at the cost of smoothness
+type EffectQuality string
+
+const (
+	EffectQualityLow    EffectQuality = "low"    // About a third of the authored keyframes
+	EffectQualityMedium EffectQuality = "medium" // About two thirds of the authored keyframes
+	EffectQualityHigh   EffectQuality = "high"   // Every authored keyframe (default)
+)
+
+// ParseEffectQuality validates a --quality value; "" means high
+func ParseEffectQuality(value string) (EffectQuality, error) {
+	switch EffectQuality(value) {
+	case "", EffectQualityHigh:
+		return EffectQualityHigh, nil
+	case EffectQualityMedium, EffectQualityLow:
+		return EffectQuality(value), nil
+	}
+	return "", fmt.Errorf("unknown quality '%s' (use low, medium or high)", value)
+}
+
+// keyframeSamples is how many of an animation's authored keyframes quality keeps. Effects are
+// authored at high density, e.g. shake position has 11 keyframes: low keeps 4, medium 7.
+// Animations with motion in them always keep at least 3, so low still moves.
+func (q EffectQuality) keyframeSamples(authored int) int {
+	var samples int
+	switch q {
+	case EffectQualityLow:
+		samples = (authored-1)/3 + 1
+	case EffectQualityMedium:
+		samples = (authored-1)*2/3 + 1
+	default:
+		return authored
+	}
+	if minimum := int(math.Min(3, float64(authored))); samples < minimum {
+		samples = minimum
+	}
+	return samples
+}
+
+// sampleKeyframes keeps quality.keyframeSamples evenly spaced keyframes, always including the first
+// and last, so the effect still starts and ends where it was authored to
+func sampleKeyframes(keyframes []fcp.Keyframe, quality EffectQuality) []fcp.Keyframe {
+	samples := quality.keyframeSamples(len(keyframes))
+	if samples >= len(keyframes) {
+		return keyframes
+	}
+
+	sampled := make([]fcp.Keyframe, samples)
+	for i := range sampled {
+		index := int(math.Round(float64(i) * float64(len(keyframes)-1) / float64(samples-1)))
+		sampled[i] = keyframes[index]
+	}
+	return sampled
+}
+
+// applyEffectQuality resamples every animation an effect put on imageVideo: its transform and
+// the params of its filters
+func applyEffectQuality(imageVideo *fcp.Video, quality EffectQuality) {
+	if quality == EffectQualityHigh || quality == "" {
+		return
+	}
+
+	var sample func(params []fcp.Param)
+	sample = func(params []fcp.Param) {
+		for i := range params {
+			if anim := params[i].KeyframeAnimation; anim != nil {
+				anim.Keyframes = sampleKeyframes(anim.Keyframes, quality)
+			}
+			sample(params[i].NestedParams)
+		}
+	}
+
+	if imageVideo.AdjustTransform != nil {
+		sample(imageVideo.AdjustTransform.Params)
+	}
+	for i := range imageVideo.FilterVideos {
+		sample(imageVideo.FilterVideos[i].Params)
+	}
+}
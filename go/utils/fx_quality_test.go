@@ -0,0 +1,79 @@
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"cutlass/fcp"
+)
+
+func TestEffectQualityKeyframeDensity(t *testing.T) {
+	imagePath := filepath.Join(t.TempDir(), "test.png")
+	if err := os.WriteFile(imagePath, []byte("fake png data"), 0644); err != nil {
+		t.Fatalf("Failed to create test image: %v", err)
+	}
+
+	keyframesFor := func(effect string, quality EffectQuality) map[string]int {
+		fcpxml, err := fcp.GenerateEmpty("")
+		if err != nil {
+			t.Fatalf("Failed to create base FCPXML: %v", err)
+		}
+		if err := fcp.AddImage(fcpxml, imagePath, 10.0); err != nil {
+			t.Fatalf("Failed to add image: %v", err)
+		}
+		if err := addDynamicImageEffects(fcpxml, 10.0, effect, "", "", FXOptions{Quality: quality}); err != nil {
+			t.Fatalf("Failed to add %s effect: %v", effect, err)
+		}
+		counts := map[string]int{}
+		video := fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine.Videos[0]
+		for _, param := range video.AdjustTransform.Params {
+			if param.KeyframeAnimation != nil {
+				counts[param.Name] = len(param.KeyframeAnimation.Keyframes)
+				counts["total"] += len(param.KeyframeAnimation.Keyframes)
+			}
+		}
+		return counts
+	}
+
+	high := keyframesFor("shake", EffectQualityHigh)
+	low := keyframesFor("shake", EffectQualityLow)
+	if high["position"] != 11 || low["position"] != 4 {
+		t.Errorf("Expected shake position to have 11 keyframes at high and 4 at low, got %d and %d", high["position"], low["position"])
+	}
+
+	for _, effect := range []string{"shake", "inner-collapse", "shatter-archive"} {
+		high := keyframesFor(effect, EffectQualityHigh)["total"]
+		medium := keyframesFor(effect, EffectQualityMedium)["total"]
+		low := keyframesFor(effect, EffectQualityLow)["total"]
+		if !(low < medium && medium < high) || low*2 > high {
+			t.Errorf("%s: expected low < medium < high with low at most half of high, got %d/%d/%d", effect, low, medium, high)
+		}
+	}
+}
+
+func TestSampleKeyframesKeepsEnds(t *testing.T) {
+	keyframes := createShakePositionKeyframes(10.0, newKeyframeClock("0s", fcp.DefaultFrameRate))
+	sampled := sampleKeyframes(keyframes, EffectQualityLow)
+	if sampled[0] != keyframes[0] || sampled[len(sampled)-1] != keyframes[len(keyframes)-1] {
+		t.Errorf("Expected first and last keyframes to be kept, got %+v", sampled)
+	}
+
+	// Short animations keep enough keyframes to still move
+	three := keyframes[:3]
+	if got := len(sampleKeyframes(three, EffectQualityLow)); got != 3 {
+		t.Errorf("Expected a 3-keyframe animation to be kept whole, got %d", got)
+	}
+}
+
+func TestParseEffectQuality(t *testing.T) {
+	for value, want := range map[string]EffectQuality{"": EffectQualityHigh, "high": EffectQualityHigh, "medium": EffectQualityMedium, "low": EffectQualityLow} {
+		got, err := ParseEffectQuality(value)
+		if err != nil || got != want {
+			t.Errorf("ParseEffectQuality(%q) = %q, %v; want %q", value, got, err, want)
+		}
+	}
+	if _, err := ParseEffectQuality("ultra"); err == nil {
+		t.Errorf("Expected an error for an unknown quality")
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"math/rand"
 	"path/filepath"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -85,10 +86,73 @@ func HandleFXStaticImageCommandWithColor(args []string, fontColor string) {
 
 // HandleFXStaticImageCommandWithColorAndDuration processes a PNG image and generates FCPXML with dynamic animation effects, custom font color, outline color, and duration
 func HandleFXStaticImageCommandWithColorAndDuration(args []string, fontColor string, outlineColor string, duration float64) {
+	HandleFXStaticImageCommandWithOptions(args, fontColor, outlineColor, duration, FXOptions{})
+}
+
+// HandleFXStaticImageCommandWithOptions is HandleFXStaticImageCommandWithColorAndDuration plus extra effect options (e.g. anchor)
+func HandleFXStaticImageCommandWithOptions(args []string, fontColor string, outlineColor string, duration float64, opts FXOptions) {
 	// Convert color names to RGBA format
 	rgbaFontColor := colorNameToRGBA(fontColor)
 	rgbaOutlineColor := colorNameToRGBA(outlineColor)
-	handleFXStaticImageCommandInternalWithDuration(args, rgbaFontColor, rgbaOutlineColor, duration)
+	handleFXStaticImageCommandInternalWithDuration(args, rgbaFontColor, rgbaOutlineColor, duration, opts)
+}
+
+// FXOptions holds optional tweaks layered on top of an effect's built-in animation
+type FXOptions struct {
+	Anchor         string           // Static anchor "x y" for rotation-based effects (360-tilt, spiral, flip); "" means center
+	MotionBlur     int              // Number of trailing ghost copies simulating motion blur; 0 disables
+	Particles      ParticleOptions  // Count, spread, distance and lifetime of the particle-emitter burst
+	Limits         fcp.RenderLimits // Image count/total duration caps; Strict turns the warning into *fcp.ErrTooLarge
+	TotalSeconds   float64          // Hold the last image (or add a gap) so the timeline ends exactly here; 0 disables
+	HoldWithGap    bool             // With TotalSeconds, fill the remaining time with a gap instead of holding the image
+	Simplify       float64          // Drop keyframes within this distance of their neighbors' line before writing; 0 disables
+	Quality        EffectQuality    // Keyframe density of the effect; "" is EffectQualityHigh
+	SkipBad        bool             // Leave out images that are almost entirely black, blown out or undecodable
+	VignetteAmount float64          // Darken the edges with a Vignette filter on top of the effect (0-1); 0 disables
+	Jitter         bool             // Vary each image's effect amplitude, speed and direction so repeats differ
+	Seed           int64            // Seeds variety-pack picks and Jitter for reproducible output; 0 uses the clock
+	Smooth         bool             // Use smooth (bezier) curves on scale/rotation/anchor keyframes instead of linear
+	Dedupe         bool             // Drop images byte-identical to one just before them (repeats are always warned about)
+	RecordParams   bool             // Write the effect, resolved seed and other settings to the project note
+	Write          fcp.WriteOptions // Overwrite guard for the output file; the zero value refuses to replace one
+
+	jitterRNG *rand.Rand // Shared by every image of one run so each draws different jitter
+}
+
+// ParseAnchor validates a normalized "x y" anchor point and returns it in FCP param format.
+// Each component must be within [-1, 1] where "0 0" is the image center.
+func ParseAnchor(value string) (string, error) {
+	fields := strings.Fields(value)
+	if len(fields) != 2 {
+		return "", fmt.Errorf("anchor must have 2 components (x y), got %d", len(fields))
+	}
+
+	parts := make([]string, 2)
+	for i, field := range fields {
+		component, err := strconv.ParseFloat(field, 64)
+		if err != nil {
+			return "", fmt.Errorf("invalid anchor component '%s': %v", field, err)
+		}
+		if component < -1 || component > 1 {
+			return "", fmt.Errorf("anchor component %s out of range -1.0 to 1.0", field)
+		}
+		parts[i] = strconv.FormatFloat(component, 'f', -1, 64)
+	}
+
+	return strings.Join(parts, " "), nil
+}
+
+// withStaticAnchor pins the rotation pivot of a transform to a fixed anchor point.
+// Center ("" or "0 0") leaves the transform untouched so default output is unchanged.
+func withStaticAnchor(transform *fcp.AdjustTransform, anchor string) *fcp.AdjustTransform {
+	if anchor == "" || anchor == "0 0" {
+		return transform
+	}
+
+	// A static pivot is the adjust-transform anchor attribute (see FCPXMLv1_13.dtd), not a param
+	transform.Anchor = anchor
+
+	return transform
 }
 
 // HandleFXStaticImageCommand processes a PNG image and generates FCPXML with dynamic animation effects
@@ -111,15 +175,15 @@ func HandleFXStaticImageCommand(args []string) {
 func handleFXStaticImageCommandInternal(args []string, fontColor string) {
 	// Use default black outline color
 	outlineColor := colorNameToRGBA("black")
-	handleFXStaticImageCommandInternalWithDuration(args, fontColor, outlineColor, 10.0)
+	handleFXStaticImageCommandInternalWithDuration(args, fontColor, outlineColor, 10.0, FXOptions{})
 }
 
 // Internal function that handles the actual processing with custom duration
-func handleFXStaticImageCommandInternalWithDuration(args []string, fontColor string, outlineColor string, customDuration float64) {
+func handleFXStaticImageCommandInternalWithDuration(args []string, fontColor string, outlineColor string, customDuration float64, opts FXOptions) {
 	if len(args) < 1 {
 		fmt.Println("Usage: fx-static-image <image.png|image1.png,image2.png> [output.fcpxml] [effect-type]")
 		fmt.Println("Standard effects: shake, perspective, flip, 360-tilt, 360-pan, light-rays, glow, cinematic (default)")
-		fmt.Println("Creative effects: parallax, breathe, pendulum, elastic, spiral, figure8, heartbeat, wind, kaleido, particle-emitter")
+		fmt.Println("Creative effects: parallax, breathe, pendulum, elastic, spiral, figure8, heartbeat, wind, kaleido, particle-emitter, pixel-reveal, zoom-to-face, vignette")
 		fmt.Println("Advanced effects: inner-collapse (digital mind breakdown with complex multi-layer animation)")
 		fmt.Println("Cinematic effects: shatter-archive (nostalgic stop-motion with analog photography decay)")
 		fmt.Println("Text effects: word-bounce (use WORDS='anger,tattle,entertainment,compilation' env var)")
@@ -149,14 +213,22 @@ func handleFXStaticImageCommandInternalWithDuration(args []string, fontColor str
 	// Smart argument parsing: detect if arg1 is an effect type or output file
 	if len(args) > 1 {
 		arg1 := args[1]
-		// Check if arg1 looks like an effect type (no file extension)
-		if !strings.Contains(arg1, ".") && isValidEffectType(arg1) {
+		// Check if arg1 looks like an effect type (no file extension) - a typo must not become the output path
+		if !strings.Contains(arg1, ".") {
+			if err := ValidateEffectType(arg1); err != nil {
+				fmt.Printf("Error: %v\n", err)
+				return
+			}
 			effectType = arg1
 			fmt.Printf("🎯 Detected '%s' as effect type in position 1\n", effectType)
 		} else {
 			outputFile = arg1
 			fmt.Printf("📁 Using '%s' as output file\n", outputFile)
 			if len(args) > 2 {
+				if err := ValidateEffectType(args[2]); err != nil {
+					fmt.Printf("Error: %v\n", err)
+					return
+				}
 				effectType = args[2]
 				fmt.Printf("🎯 Using '%s' as effect type in position 2\n", effectType)
 			}
@@ -175,7 +247,7 @@ func handleFXStaticImageCommandInternalWithDuration(args []string, fontColor str
 		fmt.Printf("⏱️  Using custom duration: %.1f seconds for word-bounce effect\n", duration)
 	}
 
-	if err := GenerateFXStaticImages(imageFiles, outputFile, duration, effectType, fontColor, outlineColor); err != nil {
+	if err := GenerateFXStaticImagesWithOptions(imageFiles, outputFile, duration, effectType, fontColor, outlineColor, opts); err != nil {
 		fmt.Printf("Error generating FX static image: %v\n", err)
 		return
 	}
@@ -200,16 +272,46 @@ func handleFXStaticImageCommandInternalWithDuration(args []string, fontColor str
 // ✅ Frame-aligned timing with ConvertSecondsToFCPDuration()
 // ✅ Uses proven effect UIDs from samples/ directory only
 func GenerateFXStaticImages(imagePaths []string, outputPath string, durationSeconds float64, effectType string, fontColor string, outlineColor string) error {
+	return GenerateFXStaticImagesWithOptions(imagePaths, outputPath, durationSeconds, effectType, fontColor, outlineColor, FXOptions{})
+}
+
+// GenerateFXStaticImagesWithOptions is GenerateFXStaticImages with extra effect options such as a custom anchor point
+func GenerateFXStaticImagesWithOptions(imagePaths []string, outputPath string, durationSeconds float64, effectType string, fontColor string, outlineColor string, opts FXOptions) error {
+	if opts.SkipBad {
+		imagePaths = fcp.FilterBadImages(imagePaths)
+		if len(imagePaths) == 0 {
+			return fmt.Errorf("no usable images left after skipping bad images")
+		}
+	}
+
+	// A folder with an accidental copy would show the same photo twice in a row
+	if len(imagePaths) > 1 {
+		imagePaths = fcp.DedupeImages(imagePaths, opts.Dedupe)
+	}
+
+	if err := opts.Limits.Check(len(imagePaths), float64(len(imagePaths))*durationSeconds); err != nil {
+		return err
+	}
+
 	// Create base FCPXML using existing infrastructure
 	fcpxml, err := fcp.GenerateEmpty("")
 	if err != nil {
 		return fmt.Errorf("failed to create base FCPXML: %v", err)
 	}
 
+	seed := opts.Seed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	rng := rand.New(rand.NewSource(seed))
+	if opts.Jitter {
+		opts.jitterRNG = rng
+	}
+
 	// Handle variety-pack special case: generate random effects for each image
 	var effectsToUse []string
 	if effectType == "variety-pack" {
-		effectsToUse = generateRandomEffectsForImages(len(imagePaths))
+		effectsToUse = generateRandomEffectsForImagesWithRNG(len(imagePaths), rng)
 		fmt.Printf("🎲 Variety pack: %v\n", effectsToUse)
 	} else {
 		// Use the same effect for all images
@@ -230,21 +332,59 @@ func GenerateFXStaticImages(imagePaths []string, outputPath string, durationSeco
 		}
 
 		// Apply dynamic animation effects to the most recently added image
-		if err := addDynamicImageEffectsAtTime(fcpxml, durationSeconds, currentEffect, currentStartTime, fontColor, outlineColor); err != nil {
+		if err := addDynamicImageEffectsAtTime(fcpxml, durationSeconds, currentEffect, currentStartTime, fontColor, outlineColor, opts); err != nil {
 			return fmt.Errorf("failed to add dynamic effects to %s: %v", imagePath, err)
 		}
 
 		currentStartTime += durationSeconds
 	}
 
+	// Pad the slideshow out to a fixed length (e.g. the song it is cut to)
+	if opts.TotalSeconds > 0 {
+		if err := fcp.HoldToDuration(fcpxml, opts.TotalSeconds, opts.HoldWithGap); err != nil {
+			return fmt.Errorf("failed to reach %.2fs total: %v", opts.TotalSeconds, err)
+		}
+	}
+
+	// Dense effects such as inner-collapse emit many nearly collinear keyframes
+	if opts.Simplify > 0 {
+		removed := fcp.SimplifyAnimations(fcpxml, opts.Simplify)
+		fmt.Printf("✂️  Simplify: removed %d redundant keyframes (tolerance %.2f)\n", removed, opts.Simplify)
+	}
+
+	// Position keyframes can't take a curve, so only scale/rotation/anchor get smoothed
+	if opts.Smooth {
+		smoothed := fcp.SmoothKeyframeCurves(fcpxml)
+		fmt.Printf("〰️  Smooth: %d keyframes now use smooth curves\n", smoothed)
+	}
+
+	// The resolved seed is recorded, so a clock-seeded run can be reproduced
+	if opts.RecordParams {
+		fcp.RecordGenerationParams(fcpxml, "fx-static-image", map[string]string{
+			"effect":   effectType,
+			"seed":     strconv.FormatInt(seed, 10),
+			"duration": strconv.FormatFloat(durationSeconds, 'f', -1, 64),
+			"images":   strconv.Itoa(len(imagePaths)),
+			"quality":  string(opts.Quality),
+			"jitter":   strconv.FormatBool(opts.Jitter),
+			"smooth":   strconv.FormatBool(opts.Smooth),
+		})
+	}
+
 	// Write the FCPXML to file
-	if err := fcp.WriteToFile(fcpxml, outputPath); err != nil {
+	if err := writeFCPXML(fcpxml, outputPath, opts.Write); err != nil {
 		return fmt.Errorf("failed to write FCPXML: %v", err)
 	}
 
 	return nil
 }
 
+// Pink text with a black outline, the colors GenerateFXStaticImage has always used
+const (
+	defaultFXFontColor    = "0.985542 0.00945401 0.999181 1"
+	defaultFXOutlineColor = "0 0 0 1"
+)
+
 // GenerateFXStaticImage creates a dynamic FCPXML with animated effects for static PNG images (single image version)
 //
 // 🎬 ARCHITECTURE: Uses fcp.GenerateEmpty() infrastructure + ResourceRegistry/Transaction pattern
@@ -259,13 +399,13 @@ func GenerateFXStaticImages(imagePaths []string, outputPath string, durationSeco
 // ✅ Uses proven effect UIDs from samples/ directory only
 func GenerateFXStaticImage(imagePath, outputPath string, durationSeconds float64, effectType string) error {
 	// Use default pink color for backward compatibility
-	return GenerateFXStaticImages([]string{imagePath}, outputPath, durationSeconds, effectType, "0.985542 0.00945401 0.999181 1", "0 0 0 1")
+	return GenerateFXStaticImages([]string{imagePath}, outputPath, durationSeconds, effectType, defaultFXFontColor, defaultFXOutlineColor)
 }
 
 // addDynamicImageEffectsAtTime applies effects to the most recently added image at a specific timeline position
-func addDynamicImageEffectsAtTime(fcpxml *fcp.FCPXML, durationSeconds float64, effectType string, startTimeSeconds float64, fontColor string, outlineColor string) error {
+func addDynamicImageEffectsAtTime(fcpxml *fcp.FCPXML, durationSeconds float64, effectType string, startTimeSeconds float64, fontColor string, outlineColor string, opts FXOptions) error {
 	// Apply dynamic animation effects to the most recently added image
-	return addDynamicImageEffects(fcpxml, durationSeconds, effectType, fontColor, outlineColor)
+	return addDynamicImageEffects(fcpxml, durationSeconds, effectType, fontColor, outlineColor, opts)
 }
 
 // addDynamicImageEffects applies sophisticated animation effects to transform static images into dynamic video
@@ -286,7 +426,7 @@ func addDynamicImageEffectsAtTime(fcpxml *fcp.FCPXML, durationSeconds float64, e
 // - Animation: Direct keyframe animation on the image itself
 // - Effects: NONE (to prevent crashes)
 // - Based on samples/slide.fcpxml which shows Video with adjust-transform working
-func addDynamicImageEffects(fcpxml *fcp.FCPXML, durationSeconds float64, effectType string, fontColor string, outlineColor string) error {
+func addDynamicImageEffects(fcpxml *fcp.FCPXML, durationSeconds float64, effectType string, fontColor string, outlineColor string, opts FXOptions) error {
 	// 🚨 CRITICAL CHANGE: Apply animation directly to image Video element
 	// This follows the working pattern from samples/slide.fcpxml
 
@@ -297,58 +437,99 @@ func addDynamicImageEffects(fcpxml *fcp.FCPXML, durationSeconds float64, effectT
 
 	// Get the existing image Video element and add animation directly to it
 	imageVideo := &sequence.Spine.Videos[len(sequence.Spine.Videos)-1]
+	return applyDynamicImageEffects(fcpxml, imageVideo, durationSeconds, effectType, fontColor, outlineColor, opts)
+}
+
+// applyDynamicImageEffects animates imageVideo with effectType over durationSeconds of its local time.
+// particle-emitter, word-bounce and motion trails add elements around the last spine video, so
+// imageVideo must be that video when using them.
+func applyDynamicImageEffects(fcpxml *fcp.FCPXML, imageVideo *fcp.Video, durationSeconds float64, effectType string, fontColor string, outlineColor string, opts FXOptions) error {
 	videoStartTime := imageVideo.Start
 
+	// Every keyframe is placed relative to the start; refuse to guess if it can't be parsed
+	rate := fxFrameRate(fcpxml)
+	if _, err := rate.AddSeconds(videoStartTime, 0); err != nil {
+		return fmt.Errorf("image '%s' has an unusable start time: %v", imageVideo.Name, err)
+	}
+
+	// Trails clone the image's own transform; these effects animate separate elements instead
+	if opts.MotionBlur > 0 && (effectType == "particle-emitter" || effectType == "word-bounce") {
+		return fmt.Errorf("motion blur is not supported with the %s effect", effectType)
+	}
+
+	clock := newKeyframeClock(videoStartTime, rate)
+
 	// Apply sophisticated animation directly to the image (crash-safe approach)
 	// This creates visible movement since it affects the actual image
 	switch effectType {
 	case "shake":
-		imageVideo.AdjustTransform = createCameraShakeAnimation(durationSeconds, videoStartTime)
+		imageVideo.AdjustTransform = createCameraShakeAnimation(durationSeconds, clock)
 	case "perspective":
-		imageVideo.AdjustTransform = createPerspective3DAnimation(durationSeconds, videoStartTime)
+		imageVideo.AdjustTransform = createPerspective3DAnimation(durationSeconds, clock)
 	case "flip":
-		imageVideo.AdjustTransform = createFlip3DAnimation(durationSeconds, videoStartTime)
+		imageVideo.AdjustTransform = createFlip3DAnimation(durationSeconds, clock, opts.Anchor)
 	case "360-tilt":
-		imageVideo.AdjustTransform = create360TiltAnimation(durationSeconds, videoStartTime)
+		imageVideo.AdjustTransform = create360TiltAnimation(durationSeconds, clock, opts.Anchor)
 	case "360-pan":
-		imageVideo.AdjustTransform = create360PanAnimation(durationSeconds, videoStartTime)
+		imageVideo.AdjustTransform = create360PanAnimation(durationSeconds, clock)
 	case "light-rays":
-		imageVideo.AdjustTransform = createLightRaysAnimation(durationSeconds, videoStartTime)
+		imageVideo.AdjustTransform = createLightRaysAnimation(durationSeconds, clock)
 	case "glow":
-		imageVideo.AdjustTransform = createGlowAnimation(durationSeconds, videoStartTime)
+		imageVideo.AdjustTransform = createGlowAnimation(durationSeconds, clock)
 	// Creative effects
 	case "parallax":
-		imageVideo.AdjustTransform = createParallaxDepthAnimation(durationSeconds, videoStartTime)
+		imageVideo.AdjustTransform = createParallaxDepthAnimation(durationSeconds, clock)
 	case "breathe":
-		imageVideo.AdjustTransform = createBreathingAnimation(durationSeconds, videoStartTime)
+		imageVideo.AdjustTransform = createBreathingAnimation(durationSeconds, clock)
 	case "pendulum":
-		imageVideo.AdjustTransform = createPendulumAnimation(durationSeconds, videoStartTime)
+		imageVideo.AdjustTransform = createPendulumAnimation(durationSeconds, clock)
 	case "elastic":
-		imageVideo.AdjustTransform = createElasticBounceAnimation(durationSeconds, videoStartTime)
+		imageVideo.AdjustTransform = createElasticBounceAnimation(durationSeconds, clock)
 	case "spiral":
-		imageVideo.AdjustTransform = createSpiralVortexAnimation(durationSeconds, videoStartTime)
+		imageVideo.AdjustTransform = createSpiralVortexAnimation(durationSeconds, clock, opts.Anchor)
 	case "figure8":
-		imageVideo.AdjustTransform = createFigure8Animation(durationSeconds, videoStartTime)
+		imageVideo.AdjustTransform = createFigure8Animation(durationSeconds, clock)
 	case "heartbeat":
-		imageVideo.AdjustTransform = createHeartbeatAnimation(durationSeconds, videoStartTime)
+		imageVideo.AdjustTransform = createHeartbeatAnimation(durationSeconds, clock)
 	case "wind":
-		imageVideo.AdjustTransform = createWindSwayAnimation(durationSeconds, videoStartTime)
+		imageVideo.AdjustTransform = createWindSwayAnimation(durationSeconds, clock)
 	case "inner-collapse":
-		imageVideo.AdjustTransform = createInnerCollapseAnimation(durationSeconds, videoStartTime)
+		imageVideo.AdjustTransform = createInnerCollapseAnimation(durationSeconds, clock)
 	case "shatter-archive":
-		imageVideo.AdjustTransform = createShatterArchiveAnimation(durationSeconds, videoStartTime)
+		imageVideo.AdjustTransform = createShatterArchiveAnimation(durationSeconds, clock)
 	case "potpourri":
-		imageVideo.AdjustTransform = createPotpourriAnimation(durationSeconds, videoStartTime)
+		imageVideo.AdjustTransform = createPotpourriAnimation(durationSeconds, clock)
 	case "kaleido":
 		// Apply both basic transform and kaleidoscope filter
-		imageVideo.AdjustTransform = createKaleidoAnimation(durationSeconds, videoStartTime)
+		imageVideo.AdjustTransform = createKaleidoAnimation(durationSeconds, clock)
 		// Add the kaleidoscope filter effect - this will be implemented next
 		if err := addKaleidoscopeFilter(fcpxml, imageVideo, durationSeconds, videoStartTime); err != nil {
 			return fmt.Errorf("failed to add kaleidoscope filter: %v", err)
 		}
+	case "pixel-reveal":
+		// Gentle push-in while a Pixellate filter resolves to sharp
+		imageVideo.AdjustTransform = createPixelRevealAnimation(durationSeconds, clock)
+		if err := addPixelRevealFilter(fcpxml, imageVideo, durationSeconds, videoStartTime); err != nil {
+			return fmt.Errorf("failed to add pixel reveal filter: %v", err)
+		}
+	case "vignette":
+		// Slow push-in under edges that darken and lighten like a held breath
+		imageVideo.AdjustTransform = createVignetteAnimation(durationSeconds, clock)
+		amount := opts.VignetteAmount
+		if amount == 0 {
+			amount = DefaultVignetteAmount
+		}
+		if err := addPulsingVignetteFilter(fcpxml, imageVideo, amount, durationSeconds, videoStartTime); err != nil {
+			return fmt.Errorf("failed to add vignette filter: %v", err)
+		}
+	case "zoom-to-face":
+		// Ken Burns push that ends framed on the largest detected face
+		if err := addZoomToFaceEffect(fcpxml, imageVideo, durationSeconds, videoStartTime); err != nil {
+			return fmt.Errorf("failed to add zoom-to-face effect: %v", err)
+		}
 	case "particle-emitter":
 		// Create multiple sparkle particles flying out like a fairy wand
-		if err := createParticleEmitterEffect(fcpxml, durationSeconds, videoStartTime); err != nil {
+		if err := createParticleEmitterEffect(fcpxml, durationSeconds, videoStartTime, opts.Particles); err != nil {
 			return fmt.Errorf("failed to create particle emitter effect: %v", err)
 		}
 	case "word-bounce":
@@ -357,7 +538,30 @@ func addDynamicImageEffects(fcpxml *fcp.FCPXML, durationSeconds float64, effectT
 			return fmt.Errorf("failed to create word bounce effect: %v", err)
 		}
 	default: // "cinematic"
-		imageVideo.AdjustTransform = createCinematicCameraAnimation(durationSeconds, videoStartTime)
+		imageVideo.AdjustTransform = createCinematicCameraAnimation(durationSeconds, clock)
+	}
+	if clock.err != nil {
+		return fmt.Errorf("failed to place %s keyframes: %v", effectType, clock.err)
+	}
+
+	// A requested vignette stacks on the motion effect; the vignette effect already has its own
+	if opts.VignetteAmount > 0 && effectType != "vignette" {
+		if err := addVignetteFilter(fcpxml, imageVideo, opts.VignetteAmount, durationSeconds, videoStartTime); err != nil {
+			return fmt.Errorf("failed to add vignette filter: %v", err)
+		}
+	}
+
+	if opts.jitterRNG != nil {
+		applyEffectJitter(imageVideo, jitterEffectParams(effectType, opts.jitterRNG), rate, videoStartTime, durationSeconds)
+	}
+
+	// Thin the effect before trails copy its transform, so the copies match
+	applyEffectQuality(imageVideo, opts.Quality)
+
+	if opts.MotionBlur > 0 {
+		if err := addMotionTrail(fcpxml, durationSeconds, videoStartTime, opts.MotionBlur); err != nil {
+			return fmt.Errorf("failed to add motion trail: %v", err)
+		}
 	}
 
 	return nil
@@ -365,11 +569,7 @@ func addDynamicImageEffects(fcpxml *fcp.FCPXML, durationSeconds float64, effectT
 
 // isValidEffectType checks if the given string is a valid effect type
 func isValidEffectType(effectType string) bool {
-	validEffects := []string{
-		"shake", "perspective", "flip", "360-tilt", "360-pan", "light-rays", "glow", "cinematic",
-		"parallax", "breathe", "pendulum", "elastic", "spiral", "figure8", "heartbeat", "wind", "inner-collapse", "shatter-archive", "potpourri", "variety-pack", "kaleido", "particle-emitter", "word-bounce",
-	}
-	for _, valid := range validEffects {
+	for _, valid := range validEffectTypes {
 		if effectType == valid {
 			return true
 		}
@@ -377,20 +577,94 @@ func isValidEffectType(effectType string) bool {
 	return false
 }
 
+// validEffectTypes is the canonical list of fx-static-image effect names
+var validEffectTypes = []string{
+	"shake", "perspective", "flip", "360-tilt", "360-pan", "light-rays", "glow", "cinematic",
+	"parallax", "breathe", "pendulum", "elastic", "spiral", "figure8", "heartbeat", "wind", "inner-collapse", "shatter-archive", "potpourri", "variety-pack", "kaleido", "particle-emitter", "word-bounce", "pixel-reveal", "zoom-to-face", "vignette",
+}
+
+// ValidEffectTypes returns every effect name accepted by fx-static-image, in help-text order
+func ValidEffectTypes() []string {
+	return append([]string(nil), validEffectTypes...)
+}
+
+// ValidateEffectType returns an error listing every valid effect (and the closest match) for an unknown name
+func ValidateEffectType(effectType string) error {
+	if isValidEffectType(effectType) {
+		return nil
+	}
+
+	suggestion := ""
+	if closest, distance := closestEffectType(effectType); distance <= len(closest)/2 {
+		suggestion = fmt.Sprintf(" (did you mean '%s'?)", closest)
+	}
+	return fmt.Errorf("unknown effect '%s'%s\nValid effects: %s", effectType, suggestion, strings.Join(validEffectTypes, ", "))
+}
+
+// closestEffectType finds the valid effect with the smallest edit distance to name
+func closestEffectType(name string) (string, int) {
+	best, bestDistance := "", -1
+	for _, effect := range validEffectTypes {
+		if distance := levenshteinDistance(strings.ToLower(name), effect); bestDistance < 0 || distance < bestDistance {
+			best, bestDistance = effect, distance
+		}
+	}
+	return best, bestDistance
+}
+
+// levenshteinDistance counts the single-character edits needed to turn a into b
+func levenshteinDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	previous := make([]int, len(rb)+1)
+	current := make([]int, len(rb)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		current[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+
+	return previous[len(rb)]
+}
+
+// varietyPackExcluded are effects variety-pack never picks: the meta effects (recursion), the
+// filter/overlay effects that add resources or elements, and the text-driven word-bounce
+var varietyPackExcluded = map[string]bool{
+	"potpourri":        true,
+	"variety-pack":     true,
+	"kaleido":          true,
+	"particle-emitter": true,
+	"word-bounce":      true,
+	"pixel-reveal":     true,
+	"vignette":         true,
+}
+
 // generateRandomEffectsForImages creates a list of random effects for multiple images
 // 🎲 VARIETY PACK STRATEGY: Each image gets a different random effect for maximum visual variety
 // Excludes potpourri and variety-pack from random selection to avoid recursion
 // Ensures good distribution across effect categories (standard, creative)
 func generateRandomEffectsForImages(numImages int) []string {
-	// Initialize random seed based on current time + process ID for better randomness
-	rand.Seed(time.Now().UnixNano() + int64(numImages)*1000)
+	return generateRandomEffectsForImagesWithRNG(numImages, rand.New(rand.NewSource(time.Now().UnixNano()+int64(numImages)*1000)))
+}
 
-	// Available effects for random selection (excluding special effects)
-	availableEffects := []string{
-		// Standard effects
-		"shake", "perspective", "flip", "360-tilt", "360-pan", "light-rays", "glow", "cinematic",
-		// Creative effects
-		"parallax", "breathe", "pendulum", "elastic", "spiral", "figure8", "heartbeat", "wind", "inner-collapse", "shatter-archive",
+// generateRandomEffectsForImagesWithRNG is generateRandomEffectsForImages drawing from rng, so a
+// seeded rng picks the same effects every run
+func generateRandomEffectsForImagesWithRNG(numImages int, rng *rand.Rand) []string {
+	// Available effects for random selection: every valid effect minus the special ones
+	var availableEffects []string
+	for _, effect := range ValidEffectTypes() {
+		if !varietyPackExcluded[effect] {
+			availableEffects = append(availableEffects, effect)
+		}
 	}
 
 	effects := make([]string, numImages)
@@ -402,7 +676,7 @@ func generateRandomEffectsForImages(numImages int) []string {
 
 	// Fisher-Yates shuffle
 	for i := len(shuffled) - 1; i > 0; i-- {
-		j := rand.Intn(i + 1)
+		j := rng.Intn(i + 1)
 		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
 	}
 
@@ -414,7 +688,7 @@ func generateRandomEffectsForImages(numImages int) []string {
 		if i > 0 && i%len(shuffled) == 0 {
 			// Re-shuffle for next cycle
 			for k := len(shuffled) - 1; k > 0; k-- {
-				j := rand.Intn(k + 1)
+				j := rng.Intn(k + 1)
 				shuffled[k], shuffled[j] = shuffled[j], shuffled[k]
 			}
 		}
@@ -448,7 +722,7 @@ func generateRandomEffectsForImages(numImages int) []string {
 // - Scale: Zoom cycles (100% → 140% → 90% → 125% for dynamic range)
 // - Rotation: Dramatic tilt changes (-3° to +4° with rapid transitions)
 // - Anchor: Dynamic pivot points for more interesting rotation centers
-func createCinematicCameraAnimation(durationSeconds float64, videoStartTime string) *fcp.AdjustTransform {
+func createCinematicCameraAnimation(durationSeconds float64, clock *keyframeClock) *fcp.AdjustTransform {
 	// Create sophisticated multi-phase parameter animations with variable speeds
 	return &fcp.AdjustTransform{
 		Params: []fcp.Param{
@@ -456,56 +730,71 @@ func createCinematicCameraAnimation(durationSeconds float64, videoStartTime stri
 			{
 				Name: "position",
 				KeyframeAnimation: &fcp.KeyframeAnimation{
-					Keyframes: createMultiPhasePositionKeyframes(durationSeconds, videoStartTime),
+					Keyframes: createMultiPhasePositionKeyframes(durationSeconds, clock),
 				},
 			},
 			// Scale Animation: Dynamic zoom cycles with speed variations
 			{
 				Name: "scale",
 				KeyframeAnimation: &fcp.KeyframeAnimation{
-					Keyframes: createMultiPhaseScaleKeyframes(durationSeconds, videoStartTime),
+					Keyframes: createMultiPhaseScaleKeyframes(durationSeconds, clock),
 				},
 			},
 			// Rotation Animation: Dramatic tilt changes with variable speeds
 			{
 				Name: "rotation",
 				KeyframeAnimation: &fcp.KeyframeAnimation{
-					Keyframes: createMultiPhaseRotationKeyframes(durationSeconds, videoStartTime),
+					Keyframes: createMultiPhaseRotationKeyframes(durationSeconds, clock),
 				},
 			},
 			// Anchor Animation: Dynamic pivot points for interesting rotation centers
 			{
 				Name: "anchor",
 				KeyframeAnimation: &fcp.KeyframeAnimation{
-					Keyframes: createMultiPhaseAnchorKeyframes(durationSeconds, videoStartTime),
+					Keyframes: createMultiPhaseAnchorKeyframes(durationSeconds, clock),
 				},
 			},
 		},
 	}
 }
 
-// calculateAbsoluteTime converts a video start time and offset into absolute timeline position
+// fxFrameRate is the frame rate of the sequence effects are added to, which keyframes snap to
+func fxFrameRate(fcpxml *fcp.FCPXML) fcp.FrameRate {
+	return fcp.SequenceFrameRate(fcpxml, &fcpxml.Library.Events[0].Projects[0].Sequences[0])
+}
+
+// calculateAbsoluteTime converts a video start time and offset into absolute timeline position,
+// rounded to a whole frame of rate.
 // This matches the pattern from working samples where keyframes use absolute timeline positions
-func calculateAbsoluteTime(videoStartTime string, offsetSeconds float64) string {
-	// Parse the video start time (e.g., "86399313/24000s")
-	if offsetSeconds == 0 {
-		return videoStartTime
+func calculateAbsoluteTime(rate fcp.FrameRate, videoStartTime string, offsetSeconds float64) (string, error) {
+	absolute, err := rate.AddSeconds(videoStartTime, offsetSeconds)
+	if err != nil {
+		return "", fmt.Errorf("invalid video start time %q: %v", videoStartTime, err)
 	}
+	return absolute, nil
+}
 
-	// Parse the start time to extract numerator and denominator
-	var startNumerator, timeBase int
-	if _, err := fmt.Sscanf(videoStartTime, "%d/%ds", &startNumerator, &timeBase); err != nil {
-		// Fallback to known good values from samples
-		startNumerator = 86399313
-		timeBase = 24000
-	}
+// keyframeClock places keyframes at offsets from a video's start time. Like bufio.Scanner it
+// keeps the first error rather than returning one per keyframe, so the builders below stay
+// literal keyframe tables; whoever creates a clock must check err before using the keyframes.
+type keyframeClock struct {
+	start string
+	rate  fcp.FrameRate
+	err   error
+}
 
-	// Add our offset in the same timebase
-	// Convert seconds to frames using the proper 23.976fps timebase
-	offsetFrames := int(offsetSeconds * float64(timeBase) / 1.001)
-	endNumerator := startNumerator + offsetFrames
+// newKeyframeClock returns a clock for keyframes relative to videoStartTime on rate's frame grid
+func newKeyframeClock(videoStartTime string, rate fcp.FrameRate) *keyframeClock {
+	return &keyframeClock{start: videoStartTime, rate: rate}
+}
 
-	return fmt.Sprintf("%d/%ds", endNumerator, timeBase)
+// at returns the absolute time offsetSeconds after the start, recording the first failure
+func (c *keyframeClock) at(offsetSeconds float64) string {
+	absolute, err := calculateAbsoluteTime(c.rate, c.start, offsetSeconds)
+	if err != nil && c.err == nil {
+		c.err = err
+	}
+	return absolute
 }
 
 // createMultiPhasePositionKeyframes generates dramatic camera movement with variable speeds
@@ -515,31 +804,31 @@ func calculateAbsoluteTime(videoStartTime string, offsetSeconds float64) string
 // Phase 2 (25-50%): FAST panning (-20,10) → (60,-30)  
 // Phase 3 (50-75%): SUPER FAST dramatic movement (60,-30) → (-80,45)
 // Phase 4 (75-100%): SLOW elegant settle (-80,45) → (15,-10)
-func createMultiPhasePositionKeyframes(duration float64, videoStartTime string) []fcp.Keyframe {
+func createMultiPhasePositionKeyframes(duration float64, clock *keyframeClock) []fcp.Keyframe {
 	return []fcp.Keyframe{
 		{
-			Time:  videoStartTime, // Phase 1 Start: SLOW
-			Value: "0 0",          // Start at center
+			Time:  clock.start, // Phase 1 Start: SLOW
+			Value: "0 0",       // Start at center
 			// NO interp/curve attributes for position (like working samples)
 		},
 		{
-			Time:  calculateAbsoluteTime(videoStartTime, duration*0.25), // 25% mark
-			Value: "-20 10",                                             // Gentle drift
+			Time:  clock.at(duration * 0.25), // 25% mark
+			Value: "-20 10",                  // Gentle drift
 			// NO interp/curve attributes for position
 		},
 		{
-			Time:  calculateAbsoluteTime(videoStartTime, duration*0.50), // 50% mark: FAST
-			Value: "60 -30",                                             // Fast panning movement
+			Time:  clock.at(duration * 0.50), // 50% mark: FAST
+			Value: "60 -30",                  // Fast panning movement
 			// NO interp/curve attributes for position
 		},
 		{
-			Time:  calculateAbsoluteTime(videoStartTime, duration*0.75), // 75% mark: SUPER FAST
-			Value: "-80 45",                                             // Dramatic movement
+			Time:  clock.at(duration * 0.75), // 75% mark: SUPER FAST
+			Value: "-80 45",                  // Dramatic movement
 			// NO interp/curve attributes for position
 		},
 		{
-			Time:  calculateAbsoluteTime(videoStartTime, duration), // End: SLOW settle
-			Value: "15 -10",                                        // Final elegant position
+			Time:  clock.at(duration), // End: SLOW settle
+			Value: "15 -10",           // Final elegant position
 			// NO interp/curve attributes for position
 		},
 	}
@@ -552,32 +841,32 @@ func createMultiPhasePositionKeyframes(duration float64, videoStartTime string)
 // Phase 2 (25-50%): FAST zoom-out 140% → 90%
 // Phase 3 (50-75%): SUPER FAST zoom-in 90% → 160%
 // Phase 4 (75-100%): SLOW final zoom 160% → 125%
-func createMultiPhaseScaleKeyframes(duration float64, videoStartTime string) []fcp.Keyframe {
+func createMultiPhaseScaleKeyframes(duration float64, clock *keyframeClock) []fcp.Keyframe {
 	return []fcp.Keyframe{
 		{
-			Time:  videoStartTime, // Phase 1 Start: SLOW zoom-in
-			Value: "1 1",          // Start at 100%
-			Curve: "linear",       // Only curve attribute for scale (like working samples)
+			Time:  clock.start, // Phase 1 Start: SLOW zoom-in
+			Value: "1 1",       // Start at 100%
+			Curve: "linear",    // Only curve attribute for scale (like working samples)
 		},
 		{
-			Time:  calculateAbsoluteTime(videoStartTime, duration*0.25), // 25% mark
-			Value: "1.4 1.4",                                            // Zoom to 140%
-			Curve: "linear",                                             // Only curve attribute for scale
+			Time:  clock.at(duration * 0.25), // 25% mark
+			Value: "1.4 1.4",                 // Zoom to 140%
+			Curve: "linear",                  // Only curve attribute for scale
 		},
 		{
-			Time:  calculateAbsoluteTime(videoStartTime, duration*0.50), // 50% mark: FAST zoom-out
-			Value: "0.9 0.9",                                            // Quick zoom-out to 90%
-			Curve: "linear",                                             // Only curve attribute for scale
+			Time:  clock.at(duration * 0.50), // 50% mark: FAST zoom-out
+			Value: "0.9 0.9",                 // Quick zoom-out to 90%
+			Curve: "linear",                  // Only curve attribute for scale
 		},
 		{
-			Time:  calculateAbsoluteTime(videoStartTime, duration*0.75), // 75% mark: SUPER FAST zoom-in
-			Value: "1.6 1.6",                                            // Dramatic zoom to 160%
-			Curve: "linear",                                             // Only curve attribute for scale
+			Time:  clock.at(duration * 0.75), // 75% mark: SUPER FAST zoom-in
+			Value: "1.6 1.6",                 // Dramatic zoom to 160%
+			Curve: "linear",                  // Only curve attribute for scale
 		},
 		{
-			Time:  calculateAbsoluteTime(videoStartTime, duration), // End: SLOW final zoom
-			Value: "1.25 1.25",                                     // Elegant final scale at 125%
-			Curve: "linear",                                        // Only curve attribute for scale
+			Time:  clock.at(duration), // End: SLOW final zoom
+			Value: "1.25 1.25",        // Elegant final scale at 125%
+			Curve: "linear",           // Only curve attribute for scale
 		},
 	}
 }
@@ -589,32 +878,32 @@ func createMultiPhaseScaleKeyframes(duration float64, videoStartTime string) []f
 // Phase 2 (25-50%): FAST rotation -1.5° → +3°
 // Phase 3 (50-75%): SUPER FAST dramatic tilt +3° → -4°
 // Phase 4 (75-100%): SLOW elegant settle -4° → +1.2°
-func createMultiPhaseRotationKeyframes(duration float64, videoStartTime string) []fcp.Keyframe {
+func createMultiPhaseRotationKeyframes(duration float64, clock *keyframeClock) []fcp.Keyframe {
 	return []fcp.Keyframe{
 		{
-			Time:  videoStartTime, // Phase 1 Start: SLOW
-			Value: "0",            // Start perfectly level
-			Curve: "linear",       // Only curve attribute for rotation (like working samples)
+			Time:  clock.start, // Phase 1 Start: SLOW
+			Value: "0",         // Start perfectly level
+			Curve: "linear",    // Only curve attribute for rotation (like working samples)
 		},
 		{
-			Time:  calculateAbsoluteTime(videoStartTime, duration*0.25), // 25% mark
-			Value: "-1.5",                                               // Gentle left tilt
-			Curve: "linear",                                             // Only curve attribute for rotation
+			Time:  clock.at(duration * 0.25), // 25% mark
+			Value: "-1.5",                    // Gentle left tilt
+			Curve: "linear",                  // Only curve attribute for rotation
 		},
 		{
-			Time:  calculateAbsoluteTime(videoStartTime, duration*0.50), // 50% mark: FAST
-			Value: "3",                                                  // Quick right tilt
-			Curve: "linear",                                             // Only curve attribute for rotation
+			Time:  clock.at(duration * 0.50), // 50% mark: FAST
+			Value: "3",                       // Quick right tilt
+			Curve: "linear",                  // Only curve attribute for rotation
 		},
 		{
-			Time:  calculateAbsoluteTime(videoStartTime, duration*0.75), // 75% mark: SUPER FAST
-			Value: "-4",                                                 // Dramatic left tilt
-			Curve: "linear",                                             // Only curve attribute for rotation
+			Time:  clock.at(duration * 0.75), // 75% mark: SUPER FAST
+			Value: "-4",                      // Dramatic left tilt
+			Curve: "linear",                  // Only curve attribute for rotation
 		},
 		{
-			Time:  calculateAbsoluteTime(videoStartTime, duration), // End: SLOW settle
-			Value: "1.2",                                           // Final elegant tilt
-			Curve: "linear",                                        // Only curve attribute for rotation
+			Time:  clock.at(duration), // End: SLOW settle
+			Value: "1.2",              // Final elegant tilt
+			Curve: "linear",           // Only curve attribute for rotation
 		},
 	}
 }
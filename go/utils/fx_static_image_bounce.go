@@ -149,21 +149,9 @@ func createWordBounceEffect(fcpxml *fcp.FCPXML, durationSeconds float64, videoSt
 			bladeStartTime := float64(bladeIndex) * (durationSeconds / float64(totalBlades))
 
 			// Use proper frame-aligned offset calculation
-			bladeOffset := videoStartTime
-			if bladeStartTime > 0 {
-				// Parse the video start time and add frame-aligned offset
-				var startNumerator, timeBase int
-				if _, err := fmt.Sscanf(videoStartTime, "%d/%ds", &startNumerator, &timeBase); err != nil {
-					startNumerator = 86399313
-					timeBase = 24000
-				}
-
-				// Convert blade start time to frame-aligned offset using ConvertSecondsToFCPDuration
-				bladeOffsetDuration := fcp.ConvertSecondsToFCPDuration(bladeStartTime)
-				var offsetNumerator int
-				if _, err := fmt.Sscanf(bladeOffsetDuration, "%d/%ds", &offsetNumerator, &timeBase); err == nil {
-					bladeOffset = fmt.Sprintf("%d/%ds", startNumerator+offsetNumerator, timeBase)
-				}
+			bladeOffset, err := calculateAbsoluteTime(fxFrameRate(fcpxml), videoStartTime, bladeStartTime)
+			if err != nil {
+				return err
 			}
 
 			bladeDurationFCP := fcp.ConvertSecondsToFCPDuration(bladeDuration)
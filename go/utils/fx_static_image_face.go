@@ -0,0 +1,223 @@
+package utils
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	_ "image/jpeg"
+	_ "image/png"
+	"math"
+	"os"
+	"strings"
+
+	"cutlass/fcp"
+)
+
+// faceGridCells is the resolution of the skin map on the image's longer side
+const faceGridCells = 64
+
+// Zoom limits for zoom-to-face: the face ends up about faceTargetHeight of the frame tall
+const (
+	faceTargetHeight   = 0.45
+	faceMinEndScale    = 1.2
+	faceMaxEndScale    = 3.0
+	centerZoomEndScale = 1.3
+)
+
+// detectPrimaryFace finds the largest face-like region in an image and returns its bounding box
+// as [x, y, width, height] fractions of the image (origin top-left). This is a pure-Go skin-tone
+// pass rather than a trained detector: pixels are classified in YCbCr space, grouped into
+// connected regions on a coarse grid, and the largest region with face-like proportions wins.
+func detectPrimaryFace(imagePath string) (box [4]float64, ok bool) {
+	file, err := os.Open(imagePath)
+	if err != nil {
+		return box, false
+	}
+	defer file.Close()
+
+	img, _, err := image.Decode(file)
+	if err != nil {
+		return box, false
+	}
+
+	return detectPrimaryFaceInImage(img)
+}
+
+// detectPrimaryFaceInImage runs the skin-region pass of detectPrimaryFace on a decoded image
+func detectPrimaryFaceInImage(img image.Image) (box [4]float64, ok bool) {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width == 0 || height == 0 {
+		return box, false
+	}
+
+	// Sample the center of each grid cell; fine enough for framing, cheap for large photos
+	cellSize := math.Max(float64(width), float64(height)) / faceGridCells
+	cols := int(math.Ceil(float64(width) / cellSize))
+	rows := int(math.Ceil(float64(height) / cellSize))
+
+	skin := make([]bool, cols*rows)
+	for row := 0; row < rows; row++ {
+		for col := 0; col < cols; col++ {
+			x := bounds.Min.X + min(int((float64(col)+0.5)*cellSize), width-1)
+			y := bounds.Min.Y + min(int((float64(row)+0.5)*cellSize), height-1)
+			skin[row*cols+col] = isSkinTone(img.At(x, y))
+		}
+	}
+
+	// Flood fill connected skin cells and keep the biggest plausible face
+	visited := make([]bool, len(skin))
+	bestArea := 0
+	var best [4]int // minCol, minRow, maxCol, maxRow
+	for start := range skin {
+		if !skin[start] || visited[start] {
+			continue
+		}
+
+		area := 0
+		region := [4]int{cols, rows, -1, -1}
+		stack := []int{start}
+		visited[start] = true
+		for len(stack) > 0 {
+			cell := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			col, row := cell%cols, cell/cols
+			area++
+			region[0], region[1] = min(region[0], col), min(region[1], row)
+			region[2], region[3] = max(region[2], col), max(region[3], row)
+
+			for _, next := range [][2]int{{col - 1, row}, {col + 1, row}, {col, row - 1}, {col, row + 1}} {
+				if next[0] < 0 || next[0] >= cols || next[1] < 0 || next[1] >= rows {
+					continue
+				}
+				index := next[1]*cols + next[0]
+				if skin[index] && !visited[index] {
+					visited[index] = true
+					stack = append(stack, index)
+				}
+			}
+		}
+
+		regionWidth := float64(region[2] - region[0] + 1)
+		regionHeight := float64(region[3] - region[1] + 1)
+		aspect := regionWidth / regionHeight
+
+		// Ignore specks (noise) and long strips (arms, wooden floors, sand)
+		if area < len(skin)/200 || aspect < 0.4 || aspect > 2.0 {
+			continue
+		}
+		if area > bestArea {
+			bestArea = area
+			best = region
+		}
+	}
+
+	if bestArea == 0 {
+		return box, false
+	}
+
+	box = [4]float64{
+		float64(best[0]) * cellSize / float64(width),
+		float64(best[1]) * cellSize / float64(height),
+		math.Min(float64(best[2]-best[0]+1)*cellSize/float64(width), 1),
+		math.Min(float64(best[3]-best[1]+1)*cellSize/float64(height), 1),
+	}
+	return box, true
+}
+
+// isSkinTone applies the classic Chai & Ngan chroma range, which holds across skin tones
+// because it ignores luma
+func isSkinTone(c color.Color) bool {
+	r, g, b, a := c.RGBA()
+	if a == 0 {
+		return false
+	}
+	_, cb, cr := color.RGBToYCbCr(uint8(r>>8), uint8(g>>8), uint8(b>>8))
+	return cb >= 77 && cb <= 127 && cr >= 133 && cr <= 173
+}
+
+// imageAspectRatio returns width/height of an image file, or 0 when it can't be read
+func imageAspectRatio(imagePath string) float64 {
+	file, err := os.Open(imagePath)
+	if err != nil {
+		return 0
+	}
+	defer file.Close()
+
+	config, _, err := image.DecodeConfig(file)
+	if err != nil || config.Height == 0 {
+		return 0
+	}
+	return float64(config.Width) / float64(config.Height)
+}
+
+// videoImagePath resolves the source file of the image asset a spine video references
+func videoImagePath(fcpxml *fcp.FCPXML, video *fcp.Video) (string, error) {
+	for _, asset := range fcpxml.Resources.Assets {
+		if asset.ID == video.Ref {
+			return strings.TrimPrefix(asset.MediaRep.Src, "file://"), nil
+		}
+	}
+	return "", fmt.Errorf("asset '%s' for video '%s' not found", video.Ref, video.Name)
+}
+
+// createZoomToFaceAnimation is a Ken Burns push that ends centered and zoomed on the face box.
+// Positions are in percent of frame height with +Y up, and the image is assumed to fill the
+// frame height (how FCP conforms stills), so aspect converts box fractions into those units.
+// Without a face (ok=false) it falls back to a plain center zoom.
+func createZoomToFaceAnimation(durationSeconds float64, clock *keyframeClock, box [4]float64, ok bool, aspect float64) *fcp.AdjustTransform {
+	endScale := centerZoomEndScale
+	endX, endY := 0.0, 0.0
+
+	if ok && aspect > 0 {
+		endScale = math.Max(faceMinEndScale, math.Min(faceMaxEndScale, faceTargetHeight/box[3]))
+
+		// Face center relative to the image center, then moved to frame center at the end scale
+		faceX := (box[0] + box[2]/2 - 0.5) * 100 * aspect
+		faceY := (0.5 - (box[1] + box[3]/2)) * 100
+		endX, endY = -faceX*endScale, -faceY*endScale
+	}
+
+	return &fcp.AdjustTransform{
+		Params: []fcp.Param{
+			{
+				Name: "position",
+				KeyframeAnimation: &fcp.KeyframeAnimation{
+					Keyframes: []fcp.Keyframe{
+						{Time: clock.start, Value: "0 0"},
+						{Time: clock.at(durationSeconds), Value: fmt.Sprintf("%.2f %.2f", endX, endY)},
+					},
+				},
+			},
+			{
+				Name: "scale",
+				KeyframeAnimation: &fcp.KeyframeAnimation{
+					Keyframes: []fcp.Keyframe{
+						{Time: clock.start, Value: "1 1", Curve: "linear"},
+						{Time: clock.at(durationSeconds), Value: fmt.Sprintf("%.3f %.3f", endScale, endScale), Curve: "linear"},
+					},
+				},
+			},
+		},
+	}
+}
+
+// addZoomToFaceEffect detects the face in the video's source image and applies the face-framed Ken Burns
+func addZoomToFaceEffect(fcpxml *fcp.FCPXML, imageVideo *fcp.Video, durationSeconds float64, videoStartTime string) error {
+	imagePath, err := videoImagePath(fcpxml, imageVideo)
+	if err != nil {
+		return err
+	}
+
+	box, ok := detectPrimaryFace(imagePath)
+	if !ok {
+		fmt.Printf("🙂 No face found in %s, using center zoom\n", imagePath)
+	}
+	clock := newKeyframeClock(videoStartTime, fxFrameRate(fcpxml))
+	transform := createZoomToFaceAnimation(durationSeconds, clock, box, ok, imageAspectRatio(imagePath))
+	if clock.err != nil {
+		return clock.err
+	}
+	imageVideo.AdjustTransform = transform
+	return nil
+}
@@ -0,0 +1,92 @@
+package utils
+
+import (
+	"fmt"
+
+	"cutlass/fcp"
+)
+
+// motionTrailFrameDelay is how many frames each ghost lags behind the one in front of it
+const motionTrailFrameDelay = 2
+
+// motionTrailMaxOpacity is the opacity of the ghost closest to the image; later ghosts fade toward 0
+const motionTrailMaxOpacity = 0.6
+
+// MaxMotionTrailCopies keeps the trail readable and the timeline light
+const MaxMotionTrailCopies = 8
+
+// addMotionTrail simulates motion blur by trailing faint copies of the last image behind its animation.
+// Like the particle emitter it clones the source video, but the ghosts are nested in the image on
+// negative lanes, so they composite behind it (nearest ghost on top at -1), each with its own copy
+// of the image's AdjustTransform. Each ghost starts a few frames later while keeping the same
+// start, so it replays the motion delayed by that amount.
+func addMotionTrail(fcpxml *fcp.FCPXML, durationSeconds float64, videoStartTime string, copies int) error {
+	if copies < 1 || copies > MaxMotionTrailCopies {
+		return fmt.Errorf("motion trail copies must be between 1 and %d, got %d", MaxMotionTrailCopies, copies)
+	}
+
+	sequence := &fcpxml.Library.Events[0].Projects[0].Sequences[0]
+	if len(sequence.Spine.Videos) == 0 {
+		return fmt.Errorf("no video elements found for motion trail")
+	}
+	imageVideo := &sequence.Spine.Videos[len(sequence.Spine.Videos)-1]
+	if imageVideo.AdjustTransform == nil {
+		return fmt.Errorf("motion trail needs an animated image (effect has no transform)")
+	}
+
+	rate := fxFrameRate(fcpxml)
+	frameDuration := float64(rate.FrameDuration) / float64(rate.Timebase)
+	for i := 1; i <= copies; i++ {
+		delaySeconds := float64(i*motionTrailFrameDelay) * frameDuration
+		if delaySeconds >= durationSeconds {
+			break
+		}
+
+		opacity := motionTrailMaxOpacity * float64(copies+1-i) / float64(copies+1)
+		offset, err := calculateAbsoluteTime(rate, videoStartTime, delaySeconds)
+		if err != nil {
+			return err
+		}
+
+		ghost := fcp.Video{
+			Ref:             imageVideo.Ref, // Same image asset as the source
+			Lane:            fmt.Sprintf("%d", -i),
+			Offset:          offset,
+			Name:            fmt.Sprintf("%s Trail %d", imageVideo.Name, i),
+			Duration:        fcp.ConvertSecondsToFCPDuration(durationSeconds - delaySeconds),
+			Start:           videoStartTime,
+			AdjustTransform: cloneAdjustTransform(imageVideo.AdjustTransform),
+			AdjustBlend:     &fcp.AdjustBlend{Amount: fmt.Sprintf("%.3f", opacity)},
+		}
+
+		imageVideo.NestedVideos = append(imageVideo.NestedVideos, ghost)
+	}
+
+	return nil
+}
+
+// cloneAdjustTransform deep-copies a transform so a ghost's keyframes can be edited (e.g. by
+// effect quality or jitter) without touching the image's
+func cloneAdjustTransform(transform *fcp.AdjustTransform) *fcp.AdjustTransform {
+	clone := *transform
+	clone.Params = cloneParams(transform.Params)
+	return &clone
+}
+
+// cloneParams deep-copies params, including their keyframes and nested params
+func cloneParams(params []fcp.Param) []fcp.Param {
+	if params == nil {
+		return nil
+	}
+	clones := make([]fcp.Param, len(params))
+	for i, param := range params {
+		clones[i] = param
+		if param.KeyframeAnimation != nil {
+			clones[i].KeyframeAnimation = &fcp.KeyframeAnimation{
+				Keyframes: append([]fcp.Keyframe(nil), param.KeyframeAnimation.Keyframes...),
+			}
+		}
+		clones[i].NestedParams = cloneParams(param.NestedParams)
+	}
+	return clones
+}
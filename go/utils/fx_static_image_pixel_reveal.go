@@ -0,0 +1,94 @@
+package utils
+
+import (
+	"fmt"
+
+	"cutlass/fcp"
+)
+
+// pixellateEffectUID is Final Cut Pro's built-in Stylize > Pixellate filter. It is addressed the
+// way FCP exports the Stylize filters it ships, as in the Simple Border UID taken from Info.fcpxml
+// for png-pile (fcp/generator_main.go), and is checked against fcp's fictional UID list before use.
+const pixellateEffectUID = ".../Effects.localized/Stylize.localized/Pixellate.localized/Pixellate.moef"
+
+// pixelRevealMaxAmount is the starting block size - heavy enough that the image is unrecognizable
+const pixelRevealMaxAmount = 100.0
+
+// createPixelRevealAnimation adds a gentle push-in while the image resolves from pixelated to sharp
+func createPixelRevealAnimation(durationSeconds float64, clock *keyframeClock) *fcp.AdjustTransform {
+	return &fcp.AdjustTransform{
+		Params: []fcp.Param{
+			{
+				Name: "scale",
+				KeyframeAnimation: &fcp.KeyframeAnimation{
+					Keyframes: []fcp.Keyframe{
+						{Time: clock.start, Value: "1 1", Curve: "linear"},
+						{Time: clock.at(durationSeconds), Value: "1.06 1.06", Curve: "linear"},
+					},
+				},
+			},
+		},
+	}
+}
+
+// addPixelRevealFilter adds a Pixellate filter whose Amount resolves from heavy blocks to 0 (sharp),
+// giving a "loading" reveal. The curve decelerates so most of the detail arrives early.
+func addPixelRevealFilter(fcpxml *fcp.FCPXML, imageVideo *fcp.Video, durationSeconds float64, videoStartTime string) error {
+	if err := fcp.ValidateEffectUID(pixellateEffectUID); err != nil {
+		return err
+	}
+	clock := newKeyframeClock(videoStartTime, fxFrameRate(fcpxml))
+	// Use ResourceRegistry to get the next available effect ID
+	registry := fcp.NewResourceRegistry(fcpxml)
+	tx := fcp.NewTransaction(registry)
+	defer tx.Rollback()
+
+	// Reserve an ID for the pixellate effect
+	ids := tx.ReserveIDs(1)
+	pixellateEffectID := ids[0]
+
+	if _, err := tx.CreateEffect(pixellateEffectID, "Pixellate", pixellateEffectUID); err != nil {
+		return fmt.Errorf("failed to create pixellate effect: %v", err)
+	}
+
+	// Create the pixellate filter with the animated block size
+	pixellateFilter := fcp.FilterVideo{
+		Ref:  pixellateEffectID,
+		Name: "Pixellate",
+		Params: []fcp.Param{
+			{
+				Name: "Amount",
+				KeyframeAnimation: &fcp.KeyframeAnimation{
+					Keyframes: createPixelRevealAmountKeyframes(durationSeconds, clock),
+				},
+			},
+		},
+	}
+
+	if clock.err != nil {
+		return clock.err
+	}
+
+	// Add the filter to the video
+	imageVideo.FilterVideos = append(imageVideo.FilterVideos, pixellateFilter)
+
+	// Commit the transaction
+	return tx.Commit()
+}
+
+// createPixelRevealAmountKeyframes steps the block size down to 0 by the end of the clip
+func createPixelRevealAmountKeyframes(duration float64, clock *keyframeClock) []fcp.Keyframe {
+	return []fcp.Keyframe{
+		// Start fully pixelated
+		{Time: clock.start, Value: fmt.Sprintf("%.0f", pixelRevealMaxAmount), Curve: "linear"},
+
+		// Resolve quickly at first, then refine the last details slowly
+		{Time: clock.at(duration * 0.20), Value: "50", Curve: "linear"},
+		{Time: clock.at(duration * 0.40), Value: "24", Curve: "linear"},
+		{Time: clock.at(duration * 0.60), Value: "10", Curve: "linear"},
+		{Time: clock.at(duration * 0.80), Value: "3", Curve: "linear"},
+
+		// Fully sharp at the end
+		{Time: clock.at(duration), Value: "0", Curve: "linear"},
+	}
+}
@@ -3,49 +3,49 @@ package utils
 import "cutlass/fcp"
 
 // LIGHT RAYS EFFECT KEYFRAMES
-func createLightRaysScaleKeyframes(duration float64, videoStartTime string) []fcp.Keyframe {
+func createLightRaysScaleKeyframes(duration float64, clock *keyframeClock) []fcp.Keyframe {
 	return []fcp.Keyframe{
-		{Time: videoStartTime, Value: "1 1", Curve: "linear"},
-		{Time: calculateAbsoluteTime(videoStartTime, duration*0.2), Value: "1.1 1.1", Curve: "linear"},
-		{Time: calculateAbsoluteTime(videoStartTime, duration*0.4), Value: "1.4 1.4", Curve: "linear"},
-		{Time: calculateAbsoluteTime(videoStartTime, duration*0.6), Value: "1.2 1.2", Curve: "linear"},
-		{Time: calculateAbsoluteTime(videoStartTime, duration*0.8), Value: "1.3 1.3", Curve: "linear"},
-		{Time: calculateAbsoluteTime(videoStartTime, duration), Value: "1 1", Curve: "linear"},
+		{Time: clock.start, Value: "1 1", Curve: "linear"},
+		{Time: clock.at(duration * 0.2), Value: "1.1 1.1", Curve: "linear"},
+		{Time: clock.at(duration * 0.4), Value: "1.4 1.4", Curve: "linear"},
+		{Time: clock.at(duration * 0.6), Value: "1.2 1.2", Curve: "linear"},
+		{Time: clock.at(duration * 0.8), Value: "1.3 1.3", Curve: "linear"},
+		{Time: clock.at(duration), Value: "1 1", Curve: "linear"},
 	}
 }
 
-func createLightRaysPositionKeyframes(duration float64, videoStartTime string) []fcp.Keyframe {
+func createLightRaysPositionKeyframes(duration float64, clock *keyframeClock) []fcp.Keyframe {
 	return []fcp.Keyframe{
-		{Time: videoStartTime, Value: "0 0"},
-		{Time: calculateAbsoluteTime(videoStartTime, duration*0.33), Value: "5 -8"},
-		{Time: calculateAbsoluteTime(videoStartTime, duration*0.66), Value: "-8 12"},
-		{Time: calculateAbsoluteTime(videoStartTime, duration), Value: "0 0"},
+		{Time: clock.start, Value: "0 0"},
+		{Time: clock.at(duration * 0.33), Value: "5 -8"},
+		{Time: clock.at(duration * 0.66), Value: "-8 12"},
+		{Time: clock.at(duration), Value: "0 0"},
 	}
 }
 
-func createLightRaysRotationKeyframes(duration float64, videoStartTime string) []fcp.Keyframe {
+func createLightRaysRotationKeyframes(duration float64, clock *keyframeClock) []fcp.Keyframe {
 	return []fcp.Keyframe{
-		{Time: videoStartTime, Value: "0", Curve: "linear"},
-		{Time: calculateAbsoluteTime(videoStartTime, duration), Value: "45", Curve: "linear"},
+		{Time: clock.start, Value: "0", Curve: "linear"},
+		{Time: clock.at(duration), Value: "45", Curve: "linear"},
 	}
 }
 
 // GLOW EFFECT KEYFRAMES
-func createGlowScaleKeyframes(duration float64, videoStartTime string) []fcp.Keyframe {
+func createGlowScaleKeyframes(duration float64, clock *keyframeClock) []fcp.Keyframe {
 	return []fcp.Keyframe{
-		{Time: videoStartTime, Value: "1 1", Curve: "linear"},
-		{Time: calculateAbsoluteTime(videoStartTime, duration*0.25), Value: "1.05 1.05", Curve: "linear"},
-		{Time: calculateAbsoluteTime(videoStartTime, duration*0.5), Value: "1.15 1.15", Curve: "linear"},
-		{Time: calculateAbsoluteTime(videoStartTime, duration*0.75), Value: "1.08 1.08", Curve: "linear"},
-		{Time: calculateAbsoluteTime(videoStartTime, duration), Value: "1 1", Curve: "linear"},
+		{Time: clock.start, Value: "1 1", Curve: "linear"},
+		{Time: clock.at(duration * 0.25), Value: "1.05 1.05", Curve: "linear"},
+		{Time: clock.at(duration * 0.5), Value: "1.15 1.15", Curve: "linear"},
+		{Time: clock.at(duration * 0.75), Value: "1.08 1.08", Curve: "linear"},
+		{Time: clock.at(duration), Value: "1 1", Curve: "linear"},
 	}
 }
 
-func createGlowPositionKeyframes(duration float64, videoStartTime string) []fcp.Keyframe {
+func createGlowPositionKeyframes(duration float64, clock *keyframeClock) []fcp.Keyframe {
 	return []fcp.Keyframe{
-		{Time: videoStartTime, Value: "0 0"},
-		{Time: calculateAbsoluteTime(videoStartTime, duration*0.5), Value: "0 -3"},
-		{Time: calculateAbsoluteTime(videoStartTime, duration), Value: "0 0"},
+		{Time: clock.start, Value: "0 0"},
+		{Time: clock.at(duration * 0.5), Value: "0 -3"},
+		{Time: clock.at(duration), Value: "0 0"},
 	}
 }
 
@@ -58,25 +58,25 @@ func createGlowPositionKeyframes(duration float64, videoStartTime string) []fcp.
 // Position: Large slow movement simulating distant background
 // Scale: Subtle perspective changes to enhance depth
 // Rotation: Minimal tilt to add dimensionality
-func createParallaxDepthAnimation(durationSeconds float64, videoStartTime string) *fcp.AdjustTransform {
+func createParallaxDepthAnimation(durationSeconds float64, clock *keyframeClock) *fcp.AdjustTransform {
 	return &fcp.AdjustTransform{
 		Params: []fcp.Param{
 			{
 				Name: "position",
 				KeyframeAnimation: &fcp.KeyframeAnimation{
-					Keyframes: createParallaxPositionKeyframes(durationSeconds, videoStartTime),
+					Keyframes: createParallaxPositionKeyframes(durationSeconds, clock),
 				},
 			},
 			{
 				Name: "scale",
 				KeyframeAnimation: &fcp.KeyframeAnimation{
-					Keyframes: createParallaxScaleKeyframes(durationSeconds, videoStartTime),
+					Keyframes: createParallaxScaleKeyframes(durationSeconds, clock),
 				},
 			},
 			{
 				Name: "rotation",
 				KeyframeAnimation: &fcp.KeyframeAnimation{
-					Keyframes: createParallaxRotationKeyframes(durationSeconds, videoStartTime),
+					Keyframes: createParallaxRotationKeyframes(durationSeconds, clock),
 				},
 			},
 		},
@@ -88,25 +88,25 @@ func createParallaxDepthAnimation(durationSeconds float64, videoStartTime string
 // Scale: Gentle pulsing (0.95 to 1.08) with organic timing
 // Position: Subtle floating movement synchronized with breathing
 // Rotation: Minimal organic tilt variations
-func createBreathingAnimation(durationSeconds float64, videoStartTime string) *fcp.AdjustTransform {
+func createBreathingAnimation(durationSeconds float64, clock *keyframeClock) *fcp.AdjustTransform {
 	return &fcp.AdjustTransform{
 		Params: []fcp.Param{
 			{
 				Name: "scale",
 				KeyframeAnimation: &fcp.KeyframeAnimation{
-					Keyframes: createBreathingScaleKeyframes(durationSeconds, videoStartTime),
+					Keyframes: createBreathingScaleKeyframes(durationSeconds, clock),
 				},
 			},
 			{
 				Name: "position",
 				KeyframeAnimation: &fcp.KeyframeAnimation{
-					Keyframes: createBreathingPositionKeyframes(durationSeconds, videoStartTime),
+					Keyframes: createBreathingPositionKeyframes(durationSeconds, clock),
 				},
 			},
 			{
 				Name: "rotation",
 				KeyframeAnimation: &fcp.KeyframeAnimation{
-					Keyframes: createBreathingRotationKeyframes(durationSeconds, videoStartTime),
+					Keyframes: createBreathingRotationKeyframes(durationSeconds, clock),
 				},
 			},
 		},
@@ -118,25 +118,25 @@ func createBreathingAnimation(durationSeconds float64, videoStartTime string) *f
 // Position: Arc motion with gravity-like deceleration at peaks
 // Rotation: Synchronized tilt following the swing direction
 // Scale: Subtle perspective changes during swing
-func createPendulumAnimation(durationSeconds float64, videoStartTime string) *fcp.AdjustTransform {
+func createPendulumAnimation(durationSeconds float64, clock *keyframeClock) *fcp.AdjustTransform {
 	return &fcp.AdjustTransform{
 		Params: []fcp.Param{
 			{
 				Name: "position",
 				KeyframeAnimation: &fcp.KeyframeAnimation{
-					Keyframes: createPendulumPositionKeyframes(durationSeconds, videoStartTime),
+					Keyframes: createPendulumPositionKeyframes(durationSeconds, clock),
 				},
 			},
 			{
 				Name: "rotation",
 				KeyframeAnimation: &fcp.KeyframeAnimation{
-					Keyframes: createPendulumRotationKeyframes(durationSeconds, videoStartTime),
+					Keyframes: createPendulumRotationKeyframes(durationSeconds, clock),
 				},
 			},
 			{
 				Name: "scale",
 				KeyframeAnimation: &fcp.KeyframeAnimation{
-					Keyframes: createPendulumScaleKeyframes(durationSeconds, videoStartTime),
+					Keyframes: createPendulumScaleKeyframes(durationSeconds, clock),
 				},
 			},
 		},
@@ -148,25 +148,25 @@ func createPendulumAnimation(durationSeconds float64, videoStartTime string) *fc
 // Scale: Dramatic stretching (0.6 to 1.8) with elastic recovery
 // Position: Compensating movement to maintain visual center
 // Rotation: Wobble effect during elastic deformation
-func createElasticBounceAnimation(durationSeconds float64, videoStartTime string) *fcp.AdjustTransform {
+func createElasticBounceAnimation(durationSeconds float64, clock *keyframeClock) *fcp.AdjustTransform {
 	return &fcp.AdjustTransform{
 		Params: []fcp.Param{
 			{
 				Name: "scale",
 				KeyframeAnimation: &fcp.KeyframeAnimation{
-					Keyframes: createElasticScaleKeyframes(durationSeconds, videoStartTime),
+					Keyframes: createElasticScaleKeyframes(durationSeconds, clock),
 				},
 			},
 			{
 				Name: "position",
 				KeyframeAnimation: &fcp.KeyframeAnimation{
-					Keyframes: createElasticPositionKeyframes(durationSeconds, videoStartTime),
+					Keyframes: createElasticPositionKeyframes(durationSeconds, clock),
 				},
 			},
 			{
 				Name: "rotation",
 				KeyframeAnimation: &fcp.KeyframeAnimation{
-					Keyframes: createElasticRotationKeyframes(durationSeconds, videoStartTime),
+					Keyframes: createElasticRotationKeyframes(durationSeconds, clock),
 				},
 			},
 		},
@@ -178,27 +178,28 @@ func createElasticBounceAnimation(durationSeconds float64, videoStartTime string
 // Rotation: Continuous spinning with acceleration phases
 // Scale: Dramatic zoom cycles (0.3 to 2.0) synchronized with rotation
 // Position: Spiral path with increasing/decreasing radius
-func createSpiralVortexAnimation(durationSeconds float64, videoStartTime string) *fcp.AdjustTransform {
-	return &fcp.AdjustTransform{
+// Anchor: Optional static pivot point (defaults to center)
+func createSpiralVortexAnimation(durationSeconds float64, clock *keyframeClock, anchor string) *fcp.AdjustTransform {
+	return withStaticAnchor(&fcp.AdjustTransform{
 		Params: []fcp.Param{
 			{
 				Name: "rotation",
 				KeyframeAnimation: &fcp.KeyframeAnimation{
-					Keyframes: createSpiralRotationKeyframes(durationSeconds, videoStartTime),
+					Keyframes: createSpiralRotationKeyframes(durationSeconds, clock),
 				},
 			},
 			{
 				Name: "scale",
 				KeyframeAnimation: &fcp.KeyframeAnimation{
-					Keyframes: createSpiralScaleKeyframes(durationSeconds, videoStartTime),
+					Keyframes: createSpiralScaleKeyframes(durationSeconds, clock),
 				},
 			},
 			{
 				Name: "position",
 				KeyframeAnimation: &fcp.KeyframeAnimation{
-					Keyframes: createSpiralPositionKeyframes(durationSeconds, videoStartTime),
+					Keyframes: createSpiralPositionKeyframes(durationSeconds, clock),
 				},
 			},
 		},
-	}
+	}, anchor)
 }
@@ -5,13 +5,107 @@ import (
 	"fmt"
 	"math"
 	"math/rand"
-	"time"
+	"strconv"
+	"strings"
 )
 
+// MaxParticles keeps the emitter from flooding the spine with thousands of video elements
+const MaxParticles = 200
+
+// particleEmitterSeed seeds the sparkle RNG so the same options always produce the same burst
+const particleEmitterSeed = 1
+
+// particleEmitterDirection is where a partial spread is aimed: straight up, like sparks off a wand
+const particleEmitterDirection = 90.0
+
+// DefaultParticleSpread is the original burst's full circle
+const DefaultParticleSpread = 360.0
+
+// ParticleOptions shapes the particle-emitter burst. Zero fields fall back to DefaultParticleOptions;
+// Spread is a pointer because a 0° cone (every sparkle straight up) is a valid choice.
+type ParticleOptions struct {
+	Count       int      // Number of sparkle videos
+	Spread      *float64 // Cone angle in degrees, centered straight up; nil uses DefaultParticleSpread
+	MinDistance float64  // Shortest flight distance in pixels
+	MaxDistance float64  // Longest flight distance in pixels
+	MinLifetime float64  // Shortest sparkle life in seconds
+	MaxLifetime float64  // Longest sparkle life in seconds
+}
+
+// DefaultParticleOptions is the original fairy wand burst: 30 sparkles in a full circle
+var DefaultParticleOptions = ParticleOptions{
+	Count:       30,
+	MinDistance: 400,
+	MaxDistance: 700,
+	MinLifetime: 2,
+	MaxLifetime: 4,
+}
+
+// withDefaults fills unset fields from DefaultParticleOptions
+func (o ParticleOptions) withDefaults() ParticleOptions {
+	if o.Count == 0 {
+		o.Count = DefaultParticleOptions.Count
+	}
+	if o.MinDistance == 0 && o.MaxDistance == 0 {
+		o.MinDistance, o.MaxDistance = DefaultParticleOptions.MinDistance, DefaultParticleOptions.MaxDistance
+	}
+	if o.MinLifetime == 0 && o.MaxLifetime == 0 {
+		o.MinLifetime, o.MaxLifetime = DefaultParticleOptions.MinLifetime, DefaultParticleOptions.MaxLifetime
+	}
+	return o
+}
+
+// spread returns the cone angle in degrees
+func (o ParticleOptions) spread() float64 {
+	if o.Spread == nil {
+		return DefaultParticleSpread
+	}
+	return *o.Spread
+}
+
+// Validate checks the options after defaults are applied
+func (o ParticleOptions) Validate() error {
+	o = o.withDefaults()
+	if o.Count < 1 || o.Count > MaxParticles {
+		return fmt.Errorf("particle count must be between 1 and %d, got %d", MaxParticles, o.Count)
+	}
+	if spread := o.spread(); spread < 0 || spread > 360 {
+		return fmt.Errorf("spread must be between 0 and 360 degrees, got %g", spread)
+	}
+	if o.MinDistance < 0 || o.MaxDistance < o.MinDistance {
+		return fmt.Errorf("invalid distance range %g-%g (min must be >= 0 and <= max)", o.MinDistance, o.MaxDistance)
+	}
+	if o.MinLifetime <= 0 || o.MaxLifetime < o.MinLifetime {
+		return fmt.Errorf("invalid lifetime range %g-%g (min must be > 0 and <= max)", o.MinLifetime, o.MaxLifetime)
+	}
+	return nil
+}
+
+// ParseLifetimeRange parses a "min-max" range of seconds such as "2-4" (a single value fixes both ends)
+func ParseLifetimeRange(value string) (float64, float64, error) {
+	parts := strings.SplitN(value, "-", 2)
+	minLifetime, err := strconv.ParseFloat(strings.TrimSpace(parts[0]), 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid lifetime '%s': %v", value, err)
+	}
+	maxLifetime := minLifetime
+	if len(parts) == 2 {
+		if maxLifetime, err = strconv.ParseFloat(strings.TrimSpace(parts[1]), 64); err != nil {
+			return 0, 0, fmt.Errorf("invalid lifetime '%s': %v", value, err)
+		}
+	}
+	return minLifetime, maxLifetime, nil
+}
+
 // createParticleEmitterEffect creates a fairy wand sparkle effect with multiple particles
-// Each sparkle starts from the center and flies outward in different directions
+// Each sparkle starts from the center and flies outward within the configured spread
 // Uses multiple Video elements to simulate individual sparkles without needing Motion
-func createParticleEmitterEffect(fcpxml *fcp.FCPXML, durationSeconds float64, videoStartTime string) error {
+func createParticleEmitterEffect(fcpxml *fcp.FCPXML, durationSeconds float64, videoStartTime string, options ParticleOptions) error {
+	if err := options.Validate(); err != nil {
+		return err
+	}
+	options = options.withDefaults()
+
 	sequence := &fcpxml.Library.Events[0].Projects[0].Sequences[0]
 
 	// Get the original image asset from the last added video
@@ -21,24 +115,22 @@ func createParticleEmitterEffect(fcpxml *fcp.FCPXML, durationSeconds float64, vi
 
 	originalVideo := sequence.Spine.Videos[len(sequence.Spine.Videos)-1]
 
-	// Create 30 sparkle particles (reasonable number for performance)
-	numParticles := 30
-
-	// Initialize random seed for consistent but varied particle behavior
-	rand.Seed(time.Now().UnixNano())
+	// Fixed seed so a run can be reproduced exactly, while each sparkle still varies
+	rng := rand.New(rand.NewSource(particleEmitterSeed))
+	clock := newKeyframeClock(videoStartTime, fxFrameRate(fcpxml))
 
-	for i := 0; i < numParticles; i++ {
+	sparkles := make([]fcp.Video, 0, options.Count)
+	for i := 0; i < options.Count; i++ {
 		// Create a new Video element for each sparkle
 		sparkle := fcp.Video{
 			Ref:             originalVideo.Ref, // Use same asset
 			Name:            fmt.Sprintf("Sparkle_%d", i+1),
 			Duration:        originalVideo.Duration,
 			Start:           originalVideo.Start,
-			AdjustTransform: createSparkleAnimation(i, durationSeconds, videoStartTime),
+			AdjustTransform: createSparkleAnimation(rng, i, options, durationSeconds, clock),
 		}
 
-		// Add sparkle to the spine
-		sequence.Spine.Videos = append(sequence.Spine.Videos, sparkle)
+		sparkles = append(sparkles, sparkle)
 	}
 
 	// Make the original image invisible/very small so only sparkles show
@@ -48,30 +140,38 @@ func createParticleEmitterEffect(fcpxml *fcp.FCPXML, durationSeconds float64, vi
 				Name: "scale",
 				KeyframeAnimation: &fcp.KeyframeAnimation{
 					Keyframes: []fcp.Keyframe{
-						{Time: calculateAbsoluteTime(videoStartTime, 0), Value: "0.01 0.01"},
+						{Time: clock.at(0), Value: "0.01 0.01"},
 					},
 				},
 			},
 		},
 	}
 
+	// Only touch the spine once every sparkle's keyframes are placed
+	if clock.err != nil {
+		return clock.err
+	}
+	sequence.Spine.Videos = append(sequence.Spine.Videos, sparkles...)
+
 	return nil
 }
 
 // createSparkleAnimation generates animation for a single sparkle particle
 // Each sparkle has unique trajectory, timing, and scale animation
-func createSparkleAnimation(particleIndex int, durationSeconds float64, videoStartTime string) *fcp.AdjustTransform {
-	// Generate random direction and distance for this sparkle
-	angle := float64(particleIndex)*(360.0/30.0) + rand.Float64()*30.0 - 15.0 // Spread around circle with some randomness
-	distance := 400.0 + rand.Float64()*300.0                                  // Random distance 400-700 pixels
+func createSparkleAnimation(rng *rand.Rand, particleIndex int, options ParticleOptions, durationSeconds float64, clock *keyframeClock) *fcp.AdjustTransform {
+	// Each sparkle gets its own slice of the cone and a random direction within it
+	spread := options.spread()
+	slot := spread / float64(options.Count)
+	angle := particleEmitterDirection - spread/2 + slot*(float64(particleIndex)+rng.Float64())
+	distance := options.MinDistance + rng.Float64()*(options.MaxDistance-options.MinDistance)
 
 	// Calculate end position
 	endX := distance * math.Cos(angle*math.Pi/180.0)
 	endY := distance * math.Sin(angle*math.Pi/180.0)
 
 	// Random timing offsets to make sparkles appear at different times
-	startDelay := rand.Float64() * 0.5          // Delay up to 0.5 seconds
-	sparkleLifetime := 2.0 + rand.Float64()*2.0 // Live for 2-4 seconds
+	startDelay := rng.Float64() * 0.5 // Delay up to 0.5 seconds
+	sparkleLifetime := options.MinLifetime + rng.Float64()*(options.MaxLifetime-options.MinLifetime)
 
 	// Ensure sparkle doesn't go beyond total duration
 	if startDelay+sparkleLifetime > durationSeconds {
@@ -85,9 +185,9 @@ func createSparkleAnimation(particleIndex int, durationSeconds float64, videoSta
 				KeyframeAnimation: &fcp.KeyframeAnimation{
 					Keyframes: []fcp.Keyframe{
 						// Start at center
-						{Time: calculateAbsoluteTime(videoStartTime, startDelay), Value: "0 0"},
+						{Time: clock.at(startDelay), Value: "0 0"},
 						// Fly outward with easing
-						{Time: calculateAbsoluteTime(videoStartTime, startDelay+sparkleLifetime),
+						{Time: clock.at(startDelay + sparkleLifetime),
 							Value:  fmt.Sprintf("%.1f %.1f", endX, endY),
 							Interp: "easeOut", Curve: "smooth"},
 					},
@@ -98,15 +198,15 @@ func createSparkleAnimation(particleIndex int, durationSeconds float64, videoSta
 				KeyframeAnimation: &fcp.KeyframeAnimation{
 					Keyframes: []fcp.Keyframe{
 						// Start invisible
-						{Time: calculateAbsoluteTime(videoStartTime, startDelay), Value: "0 0"},
+						{Time: clock.at(startDelay), Value: "0 0"},
 						// Burst to full size quickly
-						{Time: calculateAbsoluteTime(videoStartTime, startDelay+0.1),
+						{Time: clock.at(startDelay + 0.1),
 							Value: "0.15 0.15", Interp: "easeOut", Curve: "smooth"},
 						// Hold size briefly
-						{Time: calculateAbsoluteTime(videoStartTime, startDelay+sparkleLifetime*0.3),
+						{Time: clock.at(startDelay + sparkleLifetime*0.3),
 							Value: "0.15 0.15"},
 						// Fade out as it flies away
-						{Time: calculateAbsoluteTime(videoStartTime, startDelay+sparkleLifetime),
+						{Time: clock.at(startDelay + sparkleLifetime),
 							Value: "0.05 0.05", Interp: "easeIn", Curve: "smooth"},
 					},
 				},
@@ -116,9 +216,9 @@ func createSparkleAnimation(particleIndex int, durationSeconds float64, videoSta
 				KeyframeAnimation: &fcp.KeyframeAnimation{
 					Keyframes: []fcp.Keyframe{
 						// Rotate during flight for sparkle effect
-						{Time: calculateAbsoluteTime(videoStartTime, startDelay), Value: "0"},
-						{Time: calculateAbsoluteTime(videoStartTime, startDelay+sparkleLifetime),
-							Value:  fmt.Sprintf("%.1f", 360.0+rand.Float64()*360.0),
+						{Time: clock.at(startDelay), Value: "0"},
+						{Time: clock.at(startDelay + sparkleLifetime),
+							Value:  fmt.Sprintf("%.1f", 360.0+rng.Float64()*360.0),
 							Interp: "linear", Curve: "linear"},
 					},
 				},
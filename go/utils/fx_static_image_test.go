@@ -0,0 +1,888 @@
+package utils
+
+import (
+	"cutlass/fcp"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"math"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// TestAnchoredRotationEffects validates that --anchor sets a static adjust-transform anchor next to the rotation keyframes
+func TestAnchoredRotationEffects(t *testing.T) {
+	anchor, err := ParseAnchor("-0.5 0.5")
+	if err != nil {
+		t.Fatalf("ParseAnchor failed: %v", err)
+	}
+
+	for _, effectType := range []string{"360-tilt", "spiral", "flip"} {
+		t.Run(effectType, func(t *testing.T) {
+			imagePath := filepath.Join(t.TempDir(), "test.png")
+			if err := os.WriteFile(imagePath, []byte("fake png data"), 0644); err != nil {
+				t.Fatalf("Failed to create test image: %v", err)
+			}
+
+			fcpxml, err := fcp.GenerateEmpty("")
+			if err != nil {
+				t.Fatalf("Failed to create FCPXML: %v", err)
+			}
+			if err := fcp.AddImage(fcpxml, imagePath, 10.0); err != nil {
+				t.Fatalf("Failed to add image: %v", err)
+			}
+			if err := addDynamicImageEffects(fcpxml, 10.0, effectType, "", "", FXOptions{Anchor: anchor}); err != nil {
+				t.Fatalf("Failed to add %s effect: %v", effectType, err)
+			}
+
+			transform := fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine.Videos[0].AdjustTransform
+			var rotationParam *fcp.Param
+			for i := range transform.Params {
+				switch transform.Params[i].Name {
+				case "anchor":
+					t.Errorf("Expected static anchor attribute, not an anchor param")
+				case "rotation":
+					rotationParam = &transform.Params[i]
+				}
+			}
+
+			if transform.Anchor != "-0.5 0.5" {
+				t.Errorf("Expected anchor attribute '-0.5 0.5', got '%s'", transform.Anchor)
+			}
+			if rotationParam == nil || rotationParam.KeyframeAnimation == nil || len(rotationParam.KeyframeAnimation.Keyframes) == 0 {
+				t.Errorf("Expected rotation keyframes alongside anchor for %s effect", effectType)
+			}
+
+			outputPath := filepath.Join(t.TempDir(), "anchored.fcpxml")
+			if err := fcp.WriteToFile(fcpxml, outputPath); err != nil {
+				t.Errorf("Anchored %s effect failed validation: %v", effectType, err)
+			}
+		})
+	}
+}
+
+// TestDefaultAnchorUnchanged validates that the center anchor leaves effect output untouched
+func TestDefaultAnchorUnchanged(t *testing.T) {
+	transform := create360TiltAnimation(10.0, newKeyframeClock("0s", fcp.DefaultFrameRate), "0 0")
+	if transform.Anchor != "" {
+		t.Errorf("Center anchor should not set an anchor attribute, got '%s'", transform.Anchor)
+	}
+	for _, param := range transform.Params {
+		if param.Name == "anchor" {
+			t.Errorf("Center anchor should not add an anchor param")
+		}
+	}
+}
+
+// TestParseAnchor validates anchor range checking
+func TestParseAnchor(t *testing.T) {
+	valid := map[string]string{
+		"0 0":          "0 0",
+		"-1 1":         "-1 1",
+		" 0.25 -0.75 ": "0.25 -0.75",
+	}
+	for input, expected := range valid {
+		anchor, err := ParseAnchor(input)
+		if err != nil {
+			t.Errorf("ParseAnchor(%q) failed: %v", input, err)
+			continue
+		}
+		if anchor != expected {
+			t.Errorf("ParseAnchor(%q) = %q, expected %q", input, anchor, expected)
+		}
+	}
+
+	for _, input := range []string{"", "0", "1.5 0", "0 -2", "x y", "0 0 0"} {
+		if _, err := ParseAnchor(input); err == nil {
+			t.Errorf("Expected error for anchor %q", input)
+		}
+	}
+}
+
+// TestScaleShakeAnimationSpeed validates that a factor of 0.5 compresses a 10s shake into its first 5s
+func TestScaleShakeAnimationSpeed(t *testing.T) {
+	imagePath := filepath.Join(t.TempDir(), "test.png")
+	if err := os.WriteFile(imagePath, []byte("fake png data"), 0644); err != nil {
+		t.Fatalf("Failed to create test image: %v", err)
+	}
+
+	fcpxml, err := fcp.GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("Failed to create FCPXML: %v", err)
+	}
+	if err := fcp.AddImage(fcpxml, imagePath, 10.0); err != nil {
+		t.Fatalf("Failed to add image: %v", err)
+	}
+	if err := addDynamicImageEffects(fcpxml, 10.0, "shake", "", "", FXOptions{}); err != nil {
+		t.Fatalf("Failed to add shake effect: %v", err)
+	}
+
+	if err := fcp.ScaleAnimationSpeed(fcpxml, 0, 0.5); err != nil {
+		t.Fatalf("ScaleAnimationSpeed failed: %v", err)
+	}
+
+	video := fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine.Videos[0]
+	var start, frameStart int
+	fmt.Sscanf(video.Start, "%d/24000s", &start)
+	fiveSeconds := 5 * 24000
+	lastKeyframe := 0
+
+	for _, param := range video.AdjustTransform.Params {
+		if param.KeyframeAnimation == nil {
+			continue
+		}
+		for _, keyframe := range param.KeyframeAnimation.Keyframes {
+			if _, err := fmt.Sscanf(keyframe.Time, "%d/24000s", &frameStart); err != nil {
+				t.Fatalf("Unexpected keyframe time format %s", keyframe.Time)
+			}
+			if frameStart%1001 != 0 {
+				t.Errorf("Keyframe time %s is not frame-aligned", keyframe.Time)
+			}
+			if frameStart < start || frameStart-start > fiveSeconds+1001 {
+				t.Errorf("%s keyframe %s outside first 5s of clip starting at %s", param.Name, keyframe.Time, video.Start)
+			}
+			if frameStart-start > lastKeyframe {
+				lastKeyframe = frameStart - start
+			}
+		}
+	}
+
+	// The last keyframe (originally at ~10s) should now land at ~5s
+	if lastKeyframe < fiveSeconds-2*1001 {
+		t.Errorf("Expected last keyframe near 5s, got %.2fs", float64(lastKeyframe)/24000)
+	}
+}
+
+// TestEffectKeyframesFollowSequenceFrameRate validates that keyframes and motion trail offsets land
+// on the frames of a 25fps sequence rather than the 23.976fps default
+func TestEffectKeyframesFollowSequenceFrameRate(t *testing.T) {
+	imagePath := filepath.Join(t.TempDir(), "test.png")
+	if err := os.WriteFile(imagePath, []byte("fake png data"), 0644); err != nil {
+		t.Fatalf("Failed to create test image: %v", err)
+	}
+
+	fcpxml, err := fcp.GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("Failed to create FCPXML: %v", err)
+	}
+	sequence := &fcpxml.Library.Events[0].Projects[0].Sequences[0]
+	for i := range fcpxml.Resources.Formats {
+		if fcpxml.Resources.Formats[i].ID == sequence.Format {
+			fcpxml.Resources.Formats[i].FrameDuration = "1/25s"
+		}
+	}
+	if err := fcp.AddImage(fcpxml, imagePath, 10.0); err != nil {
+		t.Fatalf("Failed to add image: %v", err)
+	}
+	if err := addDynamicImageEffects(fcpxml, 10.0, "spiral", "", "", FXOptions{MotionBlur: 2}); err != nil {
+		t.Fatalf("Failed to add spiral effect: %v", err)
+	}
+
+	onGrid := func(value string) bool {
+		numerator, timebase, err := fcp.ParseFCPTime(value)
+		return err == nil && (numerator*25)%timebase == 0
+	}
+	keyframes := 0
+	for _, video := range sequence.Spine.Videos {
+		if video.Lane != "" && !onGrid(video.Offset) {
+			t.Errorf("Trail %s offset %s is not on the 25fps grid", video.Name, video.Offset)
+		}
+		for _, param := range video.AdjustTransform.Params {
+			if param.KeyframeAnimation == nil {
+				continue
+			}
+			for _, keyframe := range param.KeyframeAnimation.Keyframes {
+				keyframes++
+				if !onGrid(keyframe.Time) {
+					t.Errorf("%s keyframe %s is not on the 25fps grid", param.Name, keyframe.Time)
+				}
+			}
+		}
+	}
+	if keyframes == 0 {
+		t.Fatal("Expected the spiral effect to write keyframes")
+	}
+}
+
+// TestPixelRevealEffect validates the Pixellate amount resolves from heavy blocks to sharp
+func TestPixelRevealEffect(t *testing.T) {
+	if !isValidEffectType("pixel-reveal") {
+		t.Fatalf("Expected pixel-reveal to be a valid effect type")
+	}
+
+	imagePath := filepath.Join(t.TempDir(), "test.png")
+	if err := os.WriteFile(imagePath, []byte("fake png data"), 0644); err != nil {
+		t.Fatalf("Failed to create test image: %v", err)
+	}
+
+	fcpxml, err := fcp.GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("Failed to create FCPXML: %v", err)
+	}
+	if err := fcp.AddImage(fcpxml, imagePath, 10.0); err != nil {
+		t.Fatalf("Failed to add image: %v", err)
+	}
+	if err := addDynamicImageEffects(fcpxml, 10.0, "pixel-reveal", "", "", FXOptions{}); err != nil {
+		t.Fatalf("Failed to add pixel-reveal effect: %v", err)
+	}
+
+	video := fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine.Videos[0]
+	if len(video.FilterVideos) != 1 || video.FilterVideos[0].Name != "Pixellate" {
+		t.Fatalf("Expected a single Pixellate filter, got %+v", video.FilterVideos)
+	}
+	if video.AdjustTransform == nil {
+		t.Errorf("Expected a scale animation alongside the filter")
+	}
+
+	keyframes := video.FilterVideos[0].Params[0].KeyframeAnimation.Keyframes
+	if keyframes[0].Value != "100" {
+		t.Errorf("Expected reveal to start at 100, got %s", keyframes[0].Value)
+	}
+	if keyframes[len(keyframes)-1].Value != "0" {
+		t.Errorf("Expected reveal to end sharp at 0, got %s", keyframes[len(keyframes)-1].Value)
+	}
+
+	if err := fcp.ValidateEffectUID(pixellateEffectUID); err != nil {
+		t.Errorf("Pixellate UID failed validation: %v", err)
+	}
+	if violations := fcp.ValidateClaudeCompliance(fcpxml); len(violations) > 0 {
+		t.Errorf("Expected Pixellate UID to pass compliance, got %v", violations)
+	}
+	if err := fcp.WriteToFile(fcpxml, filepath.Join(t.TempDir(), "pixel_reveal.fcpxml")); err != nil {
+		t.Errorf("Pixel reveal failed validation: %v", err)
+	}
+}
+
+func TestMotionBlurTrail(t *testing.T) {
+	imagePath := filepath.Join(t.TempDir(), "test.png")
+	if err := os.WriteFile(imagePath, []byte("fake png data"), 0644); err != nil {
+		t.Fatalf("Failed to create test image: %v", err)
+	}
+
+	fcpxml, err := fcp.GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("Failed to create FCPXML: %v", err)
+	}
+	if err := fcp.AddImage(fcpxml, imagePath, 10.0); err != nil {
+		t.Fatalf("Failed to add image: %v", err)
+	}
+	if err := addDynamicImageEffects(fcpxml, 10.0, "spiral", "", "", FXOptions{MotionBlur: 3}); err != nil {
+		t.Fatalf("Failed to add spiral with motion blur: %v", err)
+	}
+
+	video := fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine.Videos[0]
+	if len(video.NestedVideos) != 3 {
+		t.Fatalf("Expected 3 trail copies, got %d", len(video.NestedVideos))
+	}
+
+	previousOpacity := 1.0
+	previousOffset := ""
+	for i, ghost := range video.NestedVideos {
+		if want := strconv.Itoa(-(i + 1)); ghost.Lane != want {
+			t.Errorf("Trail %d should sit behind the image on lane %s, got %s", i, want, ghost.Lane)
+		}
+		if ghost.Ref != video.Ref {
+			t.Errorf("Trail %d should reuse image asset %s, got %s", i, video.Ref, ghost.Ref)
+		}
+		if ghost.AdjustTransform == nil || ghost.AdjustBlend == nil {
+			t.Fatalf("Trail %d missing transform or blend", i)
+		}
+		opacity, err := strconv.ParseFloat(ghost.AdjustBlend.Amount, 64)
+		if err != nil {
+			t.Fatalf("Trail %d has invalid opacity %q", i, ghost.AdjustBlend.Amount)
+		}
+		if opacity >= previousOpacity {
+			t.Errorf("Trail %d opacity %.3f should be below %.3f", i, opacity, previousOpacity)
+		}
+		if ghost.Offset == previousOffset || ghost.Offset == video.Start {
+			t.Errorf("Trail %d should be delayed, got offset %s", i, ghost.Offset)
+		}
+		previousOpacity = opacity
+		previousOffset = ghost.Offset
+	}
+
+	// Each ghost owns its keyframes, so editing one leaves the image alone
+	ghostKeyframes := video.NestedVideos[0].AdjustTransform.Params[0].KeyframeAnimation.Keyframes
+	imageKeyframes := video.AdjustTransform.Params[0].KeyframeAnimation.Keyframes
+	original := imageKeyframes[0].Value
+	ghostKeyframes[0].Value = "edited"
+	if imageKeyframes[0].Value != original {
+		t.Errorf("Editing a trail keyframe changed the image's keyframe to %q", imageKeyframes[0].Value)
+	}
+	ghostKeyframes[0].Value = original
+
+	if err := fcp.WriteToFile(fcpxml, filepath.Join(t.TempDir(), "motion_blur.fcpxml")); err != nil {
+		t.Errorf("Motion blur output failed validation: %v", err)
+	}
+}
+
+func TestParticleEmitterSpread(t *testing.T) {
+	imagePath := filepath.Join(t.TempDir(), "test.png")
+	if err := os.WriteFile(imagePath, []byte("fake png data"), 0644); err != nil {
+		t.Fatalf("Failed to create test image: %v", err)
+	}
+
+	fcpxml, err := fcp.GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("Failed to create FCPXML: %v", err)
+	}
+	if err := fcp.AddImage(fcpxml, imagePath, 10.0); err != nil {
+		t.Fatalf("Failed to add image: %v", err)
+	}
+	spread := 90.0
+	opts := FXOptions{Particles: ParticleOptions{Count: 10, Spread: &spread}}
+	if err := addDynamicImageEffects(fcpxml, 10.0, "particle-emitter", "", "", opts); err != nil {
+		t.Fatalf("Failed to add particle emitter: %v", err)
+	}
+
+	videos := fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine.Videos
+	var endPositions []string
+	for _, video := range videos {
+		if !strings.HasPrefix(video.Name, "Sparkle_") {
+			continue
+		}
+		if video.AdjustTransform == nil || len(video.AdjustTransform.Params) == 0 || video.AdjustTransform.Params[0].Name != "position" {
+			t.Fatalf("Sparkle %s has no position animation", video.Name)
+		}
+		keyframes := video.AdjustTransform.Params[0].KeyframeAnimation.Keyframes
+		endPositions = append(endPositions, keyframes[len(keyframes)-1].Value)
+
+		var x, y float64
+		if _, err := fmt.Sscanf(keyframes[len(keyframes)-1].Value, "%g %g", &x, &y); err != nil {
+			t.Fatalf("Sparkle %s has invalid end position %q", video.Name, keyframes[len(keyframes)-1].Value)
+		}
+		// The cone is centered straight up (90°), so every direction must be within 45° of it
+		angle := math.Atan2(y, x) * 180 / math.Pi
+		if angle < 45-0.1 || angle > 135+0.1 {
+			t.Errorf("Sparkle %s flies at %.1f°, outside the 90° cone", video.Name, angle)
+		}
+	}
+	if len(endPositions) != 10 {
+		t.Fatalf("Expected 10 sparkles, got %d", len(endPositions))
+	}
+
+	// The seeded RNG makes the burst reproducible
+	again, err := fcp.GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("Failed to create FCPXML: %v", err)
+	}
+	if err := fcp.AddImage(again, imagePath, 10.0); err != nil {
+		t.Fatalf("Failed to add image: %v", err)
+	}
+	if err := addDynamicImageEffects(again, 10.0, "particle-emitter", "", "", opts); err != nil {
+		t.Fatalf("Failed to add particle emitter: %v", err)
+	}
+	for i, video := range again.Library.Events[0].Projects[0].Sequences[0].Spine.Videos[1:] {
+		keyframes := video.AdjustTransform.Params[0].KeyframeAnimation.Keyframes
+		if keyframes[len(keyframes)-1].Value != endPositions[i] {
+			t.Errorf("Sparkle %d landed at %s on the second run, expected %s", i+1, keyframes[len(keyframes)-1].Value, endPositions[i])
+		}
+	}
+
+	if err := (ParticleOptions{Count: MaxParticles + 1}).Validate(); err == nil {
+		t.Errorf("Expected an error for more than %d particles", MaxParticles)
+	}
+	if err := (ParticleOptions{Count: -1}).Validate(); err == nil {
+		t.Errorf("Expected an error for a negative particle count")
+	}
+
+	// An explicit 0° spread sends every sparkle straight up instead of falling back to the full circle
+	straightUp := 0.0
+	rng := rand.New(rand.NewSource(particleEmitterSeed))
+	options := ParticleOptions{Spread: &straightUp}.withDefaults()
+	transform := createSparkleAnimation(rng, 3, options, 10.0, newKeyframeClock("0s", fcp.DefaultFrameRate))
+	keyframes := transform.Params[0].KeyframeAnimation.Keyframes
+	var x, y float64
+	if _, err := fmt.Sscanf(keyframes[len(keyframes)-1].Value, "%g %g", &x, &y); err != nil || math.Abs(x) > 0.5 || y <= 0 {
+		t.Errorf("Expected a 0° spread to fly straight up, got end position %q", keyframes[len(keyframes)-1].Value)
+	}
+}
+
+func TestFXPreviewOneSegmentPerEffect(t *testing.T) {
+	imagePath := filepath.Join(t.TempDir(), "test.png")
+	if err := os.WriteFile(imagePath, []byte("fake png data"), 0644); err != nil {
+		t.Fatalf("Failed to create test image: %v", err)
+	}
+
+	fcpxml, err := BuildFXPreview(imagePath, DefaultFXPreviewSeconds)
+	if err != nil {
+		t.Fatalf("BuildFXPreview failed: %v", err)
+	}
+
+	effects := FXPreviewEffects()
+	videos := fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine.Videos
+	if len(videos) != len(effects) {
+		t.Fatalf("Expected %d segments, got %d", len(effects), len(videos))
+	}
+
+	segment := fcp.ConvertSecondsToFCPDuration(DefaultFXPreviewSeconds)
+	for i, video := range videos {
+		if video.Duration != segment {
+			t.Errorf("Segment %d: expected duration %s, got %s", i, segment, video.Duration)
+		}
+		if len(video.NestedTitles) != 1 || video.NestedTitles[0].Text.TextStyles[0].Text != effects[i] {
+			t.Errorf("Segment %d: expected a title naming %s, got %+v", i, effects[i], video.NestedTitles)
+		}
+	}
+
+	outputPath := filepath.Join(t.TempDir(), "preview.fcpxml")
+	if err := fcp.WriteToFile(fcpxml, outputPath); err != nil {
+		t.Fatalf("Preview failed validation: %v", err)
+	}
+	requireDTDValid(t, outputPath)
+}
+
+// fcpxmlDTDPath is resolved while the working directory is still the package directory, since
+// some tests chdir into temp dirs
+var fcpxmlDTDPath, _ = filepath.Abs(filepath.Join("..", "..", "FCPXMLv1_13.dtd"))
+
+// requireDTDValid checks a written FCPXML with xmllint --dtdvalid FCPXMLv1_13.dtd (skipped without xmllint)
+func requireDTDValid(t *testing.T, filename string) {
+	t.Helper()
+	if !fcp.IsXMLLintAvailable() {
+		t.Log("xmllint not available, skipping DTD validation")
+		return
+	}
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatalf("Failed to read %s: %v", filename, err)
+	}
+	if err := fcp.NewDTDValidator(fcpxmlDTDPath).ValidateXML(data); err != nil {
+		t.Errorf("%s is not DTD-valid: %v", filepath.Base(filename), err)
+	}
+}
+
+func TestValidateEffectType(t *testing.T) {
+	if err := ValidateEffectType("spiral"); err != nil {
+		t.Errorf("Expected spiral to be valid, got %v", err)
+	}
+
+	err := ValidateEffectType("spirl")
+	if err == nil {
+		t.Fatal("Expected error for unknown effect")
+	}
+	if !strings.Contains(err.Error(), "did you mean 'spiral'") {
+		t.Errorf("Expected closest match suggestion, got %v", err)
+	}
+	for _, effect := range ValidEffectTypes() {
+		if !strings.Contains(err.Error(), effect) {
+			t.Errorf("Expected error to list %s, got %v", effect, err)
+		}
+	}
+
+	// Nothing close enough: list only, no suggestion
+	if err := ValidateEffectType("zzzzzzzzzz"); err == nil || strings.Contains(err.Error(), "did you mean") {
+		t.Errorf("Expected error without suggestion, got %v", err)
+	}
+
+	// variety-pack draws from the same list
+	for _, effect := range generateRandomEffectsForImages(40) {
+		if !isValidEffectType(effect) || varietyPackExcluded[effect] {
+			t.Errorf("variety-pack picked unexpected effect %s", effect)
+		}
+	}
+}
+
+// TestInvalidStartTimeSurfacesError validates that a bad image start time fails instead of producing wrong keyframes
+func TestInvalidStartTimeSurfacesError(t *testing.T) {
+	imagePath := filepath.Join(t.TempDir(), "test.png")
+	if err := os.WriteFile(imagePath, []byte("fake png data"), 0644); err != nil {
+		t.Fatalf("Failed to create test image: %v", err)
+	}
+
+	fcpxml, err := fcp.GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("Failed to create FCPXML: %v", err)
+	}
+	if err := fcp.AddImage(fcpxml, imagePath, 10.0); err != nil {
+		t.Fatalf("Failed to add image: %v", err)
+	}
+	video := &fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine.Videos[0]
+	video.Start = "bogus"
+
+	if err := addDynamicImageEffects(fcpxml, 10.0, "shake", "", "", FXOptions{}); err == nil {
+		t.Fatalf("Expected error for unparseable start time")
+	}
+	if video.AdjustTransform != nil && len(video.AdjustTransform.Params) > 0 {
+		t.Errorf("Expected no keyframes to be written for an unparseable start time")
+	}
+}
+
+// TestCalculateAbsoluteTimeErrors validates that keyframe builders hand a bad start time back to their caller
+func TestCalculateAbsoluteTimeErrors(t *testing.T) {
+	if _, err := calculateAbsoluteTime(fcp.DefaultFrameRate, "bogus", 1.0); err == nil {
+		t.Errorf("Expected calculateAbsoluteTime to reject an unparseable start time")
+	}
+
+	clock := newKeyframeClock("bogus", fcp.DefaultFrameRate)
+	createShakePositionKeyframes(10.0, clock)
+	if clock.err == nil {
+		t.Errorf("Expected the keyframe clock to record the parse error")
+	}
+
+	fcpxml, err := fcp.GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("Failed to create FCPXML: %v", err)
+	}
+	video := &fcp.Video{Name: "image", Start: "bogus"}
+	if err := addPixelRevealFilter(fcpxml, video, 10.0, video.Start); err == nil {
+		t.Errorf("Expected addPixelRevealFilter to return the start time error")
+	}
+	if len(video.FilterVideos) != 0 || len(fcpxml.Resources.Effects) != 0 {
+		t.Errorf("Expected no filter or effect to be added for an unparseable start time")
+	}
+}
+
+// writeFaceTestImage writes a gray PNG with an optional skin-toned rectangle (x, y, w, h in pixels)
+func writeFaceTestImage(t *testing.T, path string, width, height int, face *image.Rectangle) {
+	t.Helper()
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			c := color.RGBA{90, 90, 100, 255}
+			if face != nil && image.Pt(x, y).In(*face) {
+				c = color.RGBA{224, 172, 140, 255}
+			}
+			img.Set(x, y, c)
+		}
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		t.Fatalf("Failed to create test image: %v", err)
+	}
+	defer file.Close()
+	if err := png.Encode(file, img); err != nil {
+		t.Fatalf("Failed to encode test image: %v", err)
+	}
+}
+
+// TestZoomToFaceEffect validates that the Ken Burns ends centered on the detected face, or on the center without one
+func TestZoomToFaceEffect(t *testing.T) {
+	dir := t.TempDir()
+	facePath := filepath.Join(dir, "face.png")
+	faceRect := image.Rect(160, 60, 240, 160) // Upper left quadrant of a 640x480 image
+	writeFaceTestImage(t, facePath, 640, 480, &faceRect)
+
+	box, ok := detectPrimaryFace(facePath)
+	if !ok {
+		t.Fatalf("Expected a face to be detected")
+	}
+	centerX, centerY := box[0]+box[2]/2, box[1]+box[3]/2
+	if diff := centerX - 200.0/640; diff > 0.02 || diff < -0.02 {
+		t.Errorf("Expected face center x near %.3f, got %.3f", 200.0/640, centerX)
+	}
+	if diff := centerY - 110.0/480; diff > 0.02 || diff < -0.02 {
+		t.Errorf("Expected face center y near %.3f, got %.3f", 110.0/480, centerY)
+	}
+
+	endValues := func(imagePath string) (float64, float64, float64) {
+		fcpxml, err := fcp.GenerateEmpty("")
+		if err != nil {
+			t.Fatalf("Failed to create FCPXML: %v", err)
+		}
+		if err := fcp.AddImage(fcpxml, imagePath, 6.0); err != nil {
+			t.Fatalf("Failed to add image: %v", err)
+		}
+		if err := addDynamicImageEffects(fcpxml, 6.0, "zoom-to-face", "", "", FXOptions{}); err != nil {
+			t.Fatalf("Failed to add zoom-to-face effect: %v", err)
+		}
+
+		var x, y, scale float64
+		for _, param := range fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine.Videos[0].AdjustTransform.Params {
+			last := param.KeyframeAnimation.Keyframes[len(param.KeyframeAnimation.Keyframes)-1].Value
+			switch param.Name {
+			case "position":
+				fmt.Sscanf(last, "%f %f", &x, &y)
+			case "scale":
+				fmt.Sscanf(last, "%f", &scale)
+			}
+		}
+		return x, y, scale
+	}
+
+	// The face center, scaled and moved by the end keyframes, must land on the frame center
+	x, y, scale := endValues(facePath)
+	faceX := (centerX - 0.5) * 100 * (640.0 / 480.0)
+	faceY := (0.5 - centerY) * 100
+	if gotX, gotY := faceX*scale+x, faceY*scale+y; gotX > 0.5 || gotX < -0.5 || gotY > 0.5 || gotY < -0.5 {
+		t.Errorf("Expected face centered at the end, it lands at %.2f %.2f", gotX, gotY)
+	}
+	if scale <= 1 {
+		t.Errorf("Expected end scale to zoom in, got %.3f", scale)
+	}
+
+	plainPath := filepath.Join(dir, "plain.png")
+	writeFaceTestImage(t, plainPath, 640, 480, nil)
+	if _, ok := detectPrimaryFace(plainPath); ok {
+		t.Errorf("Expected no face in a plain image")
+	}
+	if x, y, scale := endValues(plainPath); x != 0 || y != 0 || scale != centerZoomEndScale {
+		t.Errorf("Expected center zoom fallback, got position %.2f %.2f scale %.3f", x, y, scale)
+	}
+}
+
+// TestApplyEffectToAll validates each image gets its own transform timed from its own start and duration
+func TestApplyEffectToAll(t *testing.T) {
+	dir := t.TempDir()
+	fcpxml, err := fcp.GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("Failed to create FCPXML: %v", err)
+	}
+
+	durations := []float64{4, 6, 3}
+	for i, seconds := range durations {
+		imagePath := filepath.Join(dir, fmt.Sprintf("image%d.png", i))
+		if err := os.WriteFile(imagePath, []byte("fake png data"), 0644); err != nil {
+			t.Fatalf("Failed to create test image: %v", err)
+		}
+		if err := fcp.AddImage(fcpxml, imagePath, seconds); err != nil {
+			t.Fatalf("Failed to add image: %v", err)
+		}
+	}
+
+	videos := fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine.Videos
+	videos[1].Start = "" // e.g. a concat'ed clip without a start attribute
+	kept := &fcp.AdjustTransform{Scale: "2 2"}
+	videos[2].AdjustTransform = kept
+
+	if err := ApplyEffectToAll(fcpxml, "glow"); err != nil {
+		t.Fatalf("ApplyEffectToAll failed: %v", err)
+	}
+
+	if videos[2].AdjustTransform != kept {
+		t.Errorf("Expected an existing transform to be kept without --replace")
+	}
+
+	applied, err := ApplyEffectToAllWithOptions(fcpxml, "glow", true)
+	if err != nil {
+		t.Fatalf("ApplyEffectToAllWithOptions failed: %v", err)
+	}
+	if applied != 3 {
+		t.Errorf("Expected --replace to restyle all 3 images, got %d", applied)
+	}
+
+	for i, video := range videos {
+		if video.AdjustTransform == nil || len(video.AdjustTransform.Params) == 0 {
+			t.Fatalf("Image %d has no animated transform", i)
+		}
+		wantEnd, _ := fcp.DefaultFrameRate.AddSeconds(video.Start, durations[i])
+		for _, param := range video.AdjustTransform.Params {
+			keyframes := param.KeyframeAnimation.Keyframes
+			if keyframes[0].Time != video.Start {
+				t.Errorf("Image %d %s: first keyframe at %s, want clip start %s", i, param.Name, keyframes[0].Time, video.Start)
+			}
+			if last := keyframes[len(keyframes)-1].Time; last != wantEnd {
+				t.Errorf("Image %d %s: last keyframe at %s, want clip end %s", i, param.Name, last, wantEnd)
+			}
+		}
+	}
+
+	if err := ApplyEffectToAll(fcpxml, "word-bounce"); err == nil {
+		t.Errorf("Expected error for an effect that can't restyle every clip")
+	}
+}
+
+func TestInnerCollapseSimplify(t *testing.T) {
+	imagePath := filepath.Join(t.TempDir(), "test.png")
+	if err := os.WriteFile(imagePath, []byte("fake png data"), 0644); err != nil {
+		t.Fatalf("Failed to create test image: %v", err)
+	}
+
+	countKeyframes := func(simplify float64) int {
+		outputPath := filepath.Join(t.TempDir(), "collapse.fcpxml")
+		if err := GenerateFXStaticImagesWithOptions([]string{imagePath}, outputPath, 10.0, "inner-collapse", "", "", FXOptions{Simplify: simplify}); err != nil {
+			t.Fatalf("Failed to generate inner-collapse: %v", err)
+		}
+		fcpxml, err := fcp.ReadFromFile(outputPath)
+		if err != nil {
+			t.Fatalf("Failed to read back %s: %v", outputPath, err)
+		}
+		total := 0
+		for _, param := range fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine.Videos[0].AdjustTransform.Params {
+			if param.KeyframeAnimation != nil {
+				total += len(param.KeyframeAnimation.Keyframes)
+			}
+		}
+		return total
+	}
+
+	full := countKeyframes(0)
+	simplified := countKeyframes(5)
+	if simplified >= full {
+		t.Errorf("Expected --simplify to remove keyframes, got %d before and %d after", full, simplified)
+	}
+}
+
+// TestSmoothCurves validates that Smooth turns scale keyframes smooth and leaves position
+// keyframes without interp or curve attributes
+func TestSmoothCurves(t *testing.T) {
+	imagePath := filepath.Join(t.TempDir(), "test.png")
+	if err := os.WriteFile(imagePath, []byte("fake png data"), 0644); err != nil {
+		t.Fatalf("Failed to create test image: %v", err)
+	}
+
+	outputPath := filepath.Join(t.TempDir(), "smooth.fcpxml")
+	if err := GenerateFXStaticImagesWithOptions([]string{imagePath}, outputPath, 10.0, "inner-collapse", "", "", FXOptions{Smooth: true}); err != nil {
+		t.Fatalf("Failed to generate inner-collapse: %v", err)
+	}
+	fcpxml, err := fcp.ReadFromFile(outputPath)
+	if err != nil {
+		t.Fatalf("Failed to read back %s: %v", outputPath, err)
+	}
+
+	counts := map[string]int{}
+	for _, param := range fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine.Videos[0].AdjustTransform.Params {
+		if param.KeyframeAnimation == nil {
+			continue
+		}
+		for _, keyframe := range param.KeyframeAnimation.Keyframes {
+			counts[param.Name]++
+			switch param.Name {
+			case "scale":
+				if keyframe.Curve != fcp.CurveSmooth {
+					t.Errorf("Expected smooth scale keyframe at %s, got curve '%s'", keyframe.Time, keyframe.Curve)
+				}
+			case "position":
+				if keyframe.Curve != "" || keyframe.Interp != "" {
+					t.Errorf("Position keyframe at %s must have no curve/interp, got '%s'/'%s'", keyframe.Time, keyframe.Curve, keyframe.Interp)
+				}
+			}
+		}
+	}
+	if counts["scale"] == 0 || counts["position"] == 0 {
+		t.Fatalf("Expected scale and position keyframes, got %v", counts)
+	}
+}
+
+// TestVignetteAmount validates --vignette-amount appends a Vignette filter with that amount while
+// keeping the effect's own motion, and that the vignette effect pulses its amount
+func TestVignetteAmount(t *testing.T) {
+	imagePath := filepath.Join(t.TempDir(), "test.png")
+	if err := os.WriteFile(imagePath, []byte("fake png data"), 0644); err != nil {
+		t.Fatalf("Failed to create test image: %v", err)
+	}
+
+	fcpxml, err := fcp.GenerateEmpty("")
+	if err != nil {
+		t.Fatalf("Failed to create FCPXML: %v", err)
+	}
+	if err := fcp.AddImage(fcpxml, imagePath, 10.0); err != nil {
+		t.Fatalf("Failed to add image: %v", err)
+	}
+	if err := addDynamicImageEffects(fcpxml, 10.0, "pixel-reveal", "", "", FXOptions{VignetteAmount: 0.4}); err != nil {
+		t.Fatalf("Failed to add pixel-reveal with vignette: %v", err)
+	}
+
+	video := fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine.Videos[0]
+	if len(video.FilterVideos) != 2 || video.FilterVideos[0].Name != "Pixellate" || video.FilterVideos[1].Name != "Vignette" {
+		t.Fatalf("Expected the Vignette filter after Pixellate, got %+v", video.FilterVideos)
+	}
+	if video.AdjustTransform == nil {
+		t.Errorf("The vignette should not replace the effect's motion")
+	}
+
+	vignette := video.FilterVideos[1]
+	amount := vignette.Params[0]
+	if amount.Name != "Amount" || amount.Key != vignetteAmountKey || amount.KeyframeAnimation == nil {
+		t.Fatalf("Expected a keyframed Amount param, got %+v", amount)
+	}
+	for _, keyframe := range amount.KeyframeAnimation.Keyframes {
+		if keyframe.Value != "0.400" {
+			t.Errorf("Expected amount 0.400, got %s", keyframe.Value)
+		}
+	}
+
+	var uid string
+	for _, effect := range fcpxml.Resources.Effects {
+		if effect.ID == vignette.Ref {
+			uid = effect.UID
+		}
+	}
+	if uid != vignetteEffectUID {
+		t.Errorf("Expected the Vignette filter to use %s, got %q", vignetteEffectUID, uid)
+	}
+	if err := fcp.ValidateEffectUID(uid); err != nil {
+		t.Errorf("Vignette UID failed validation: %v", err)
+	}
+
+	if err := addVignetteFilter(fcpxml, &video, 1.5, 10.0, video.Start); err == nil {
+		t.Errorf("Expected an error for an amount above %g", MaxVignetteAmount)
+	}
+
+	pulsing := &fcp.Video{Start: "0s"}
+	if err := addPulsingVignetteFilter(fcpxml, pulsing, 0.5, 6.0, pulsing.Start); err != nil {
+		t.Fatalf("Failed to add pulsing vignette: %v", err)
+	}
+	keyframes := pulsing.FilterVideos[0].Params[0].KeyframeAnimation.Keyframes
+	if len(keyframes) != vignettePulseCycles*2+1 || keyframes[0].Value != "0.500" || keyframes[1].Value != "0.350" {
+		t.Errorf("Expected the amount to pulse between 0.500 and 0.350, got %+v", keyframes)
+	}
+}
+
+// TestRecordParams validates --record-params puts the effect and resolved seed in the project note
+func TestRecordParams(t *testing.T) {
+	imagePath := filepath.Join(t.TempDir(), "test.png")
+	if err := os.WriteFile(imagePath, []byte("fake png data"), 0644); err != nil {
+		t.Fatalf("Failed to create test image: %v", err)
+	}
+
+	outputPath := filepath.Join(t.TempDir(), "params.fcpxml")
+	if err := GenerateFXStaticImagesWithOptions([]string{imagePath}, outputPath, 5.0, "kaleido", "", "", FXOptions{Seed: 42, RecordParams: true}); err != nil {
+		t.Fatalf("Failed to generate kaleido: %v", err)
+	}
+	fcpxml, err := fcp.ReadFromFile(outputPath)
+	if err != nil {
+		t.Fatalf("Failed to read back %s: %v", outputPath, err)
+	}
+
+	note := fcpxml.Library.Events[0].Projects[0].Sequences[0].Note
+	for _, want := range []string{"cutlass fx-static-image", "effect=kaleido", "seed=42", "duration=5"} {
+		if !strings.Contains(note, want) {
+			t.Errorf("Expected note to contain '%s', got '%s'", want, note)
+		}
+	}
+}
+
+// TestDedupe validates --dedupe drops an identical copy from the slideshow and keeps distinct images
+func TestDedupe(t *testing.T) {
+	dir := t.TempDir()
+	var imagePaths []string
+	for _, image := range []struct{ name, data string }{{"a.png", "same"}, {"a copy.png", "same"}, {"b.png", "different"}} {
+		path := filepath.Join(dir, image.name)
+		if err := os.WriteFile(path, []byte(image.data), 0644); err != nil {
+			t.Fatalf("Failed to create test image: %v", err)
+		}
+		imagePaths = append(imagePaths, path)
+	}
+
+	for _, dedupe := range []bool{false, true} {
+		outputPath := filepath.Join(dir, fmt.Sprintf("dedupe_%t.fcpxml", dedupe))
+		if err := GenerateFXStaticImagesWithOptions(imagePaths, outputPath, 3.0, "cinematic", "", "", FXOptions{Dedupe: dedupe}); err != nil {
+			t.Fatalf("Failed to generate slideshow: %v", err)
+		}
+		fcpxml, err := fcp.ReadFromFile(outputPath)
+		if err != nil {
+			t.Fatalf("Failed to read back %s: %v", outputPath, err)
+		}
+
+		videos := fcpxml.Library.Events[0].Projects[0].Sequences[0].Spine.Videos
+		want := []string{"a", "a copy", "b"}
+		if dedupe {
+			want = []string{"a", "b"}
+		}
+		if len(videos) != len(want) {
+			t.Fatalf("dedupe=%t: expected %d slides, got %d", dedupe, len(want), len(videos))
+		}
+		for i, video := range videos {
+			if video.Name != want[i] {
+				t.Errorf("dedupe=%t: slide %d is '%s', expected '%s'", dedupe, i, video.Name, want[i])
+			}
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package utils
+
+import (
+	"fmt"
+
+	"cutlass/fcp"
+)
+
+// Final Cut Pro's built-in Vignette filter and its Amount param key, as the fcp package's
+// compositing fixture (fcp/test_complex_compositing.fcpxml) writes them
+const (
+	vignetteEffectUID = "FFVignette"
+	vignetteAmountKey = "1"
+)
+
+// Vignette amounts: 0 leaves the edges alone, 1 darkens them fully
+const (
+	DefaultVignetteAmount = 0.6
+	MaxVignetteAmount     = 1.0
+	vignettePulseLow      = 0.7 // The vignette effect breathes between this fraction of its amount and the full amount
+	vignettePulseCycles   = 3   // Darken/lighten cycles over the clip
+)
+
+// createVignetteAnimation is a slow push-in for the mood of the vignette effect; the pulse is on the filter
+func createVignetteAnimation(durationSeconds float64, clock *keyframeClock) *fcp.AdjustTransform {
+	return &fcp.AdjustTransform{
+		Params: []fcp.Param{
+			{
+				Name: "scale",
+				KeyframeAnimation: &fcp.KeyframeAnimation{
+					Keyframes: []fcp.Keyframe{
+						{Time: clock.start, Value: "1 1", Curve: "linear"},
+						{Time: clock.at(durationSeconds), Value: "1.04 1.04", Curve: "linear"},
+					},
+				},
+			},
+		},
+	}
+}
+
+// addVignetteFilter darkens the image's edges with a Vignette filter held at amount. The Amount is
+// written as keyframes at the clip's start and end, so it can be reshaped in FCP. It appends to the
+// image's filters, so it stacks on whatever motion effect the image already has.
+func addVignetteFilter(fcpxml *fcp.FCPXML, imageVideo *fcp.Video, amount float64, durationSeconds float64, videoStartTime string) error {
+	clock := newKeyframeClock(videoStartTime, fxFrameRate(fcpxml))
+	value := formatVignetteAmount(amount)
+	keyframes := []fcp.Keyframe{
+		{Time: clock.start, Value: value, Curve: "linear"},
+		{Time: clock.at(durationSeconds), Value: value, Curve: "linear"},
+	}
+	if clock.err != nil {
+		return clock.err
+	}
+	return appendVignetteFilter(fcpxml, imageVideo, amount, keyframes)
+}
+
+// addPulsingVignetteFilter is addVignetteFilter with the Amount breathing between vignettePulseLow
+// of amount and amount, vignettePulseCycles times over the clip
+func addPulsingVignetteFilter(fcpxml *fcp.FCPXML, imageVideo *fcp.Video, amount float64, durationSeconds float64, videoStartTime string) error {
+	clock := newKeyframeClock(videoStartTime, fxFrameRate(fcpxml))
+	var keyframes []fcp.Keyframe
+	steps := vignettePulseCycles * 2
+	for i := 0; i <= steps; i++ {
+		level := amount
+		if i%2 == 1 {
+			level = amount * vignettePulseLow
+		}
+		keyframes = append(keyframes, fcp.Keyframe{
+			Time:  clock.at(durationSeconds * float64(i) / float64(steps)),
+			Value: formatVignetteAmount(level),
+			Curve: "linear",
+		})
+	}
+	if clock.err != nil {
+		return clock.err
+	}
+	return appendVignetteFilter(fcpxml, imageVideo, amount, keyframes)
+}
+
+// appendVignetteFilter creates the Vignette effect resource and appends the filter with the given Amount keyframes
+func appendVignetteFilter(fcpxml *fcp.FCPXML, imageVideo *fcp.Video, amount float64, keyframes []fcp.Keyframe) error {
+	if amount <= 0 || amount > MaxVignetteAmount {
+		return fmt.Errorf("vignette amount must be between 0 and %g, got %g", MaxVignetteAmount, amount)
+	}
+	if err := fcp.ValidateEffectUID(vignetteEffectUID); err != nil {
+		return err
+	}
+
+	// Use ResourceRegistry to get the next available effect ID
+	registry := fcp.NewResourceRegistry(fcpxml)
+	tx := fcp.NewTransaction(registry)
+	defer tx.Rollback()
+
+	vignetteEffectID := tx.ReserveIDs(1)[0]
+	if _, err := tx.CreateEffect(vignetteEffectID, "Vignette", vignetteEffectUID); err != nil {
+		return fmt.Errorf("failed to create vignette effect: %v", err)
+	}
+
+	imageVideo.FilterVideos = append(imageVideo.FilterVideos, fcp.FilterVideo{
+		Ref:  vignetteEffectID,
+		Name: "Vignette",
+		Params: []fcp.Param{
+			{
+				Name:              "Amount",
+				Key:               vignetteAmountKey,
+				KeyframeAnimation: &fcp.KeyframeAnimation{Keyframes: keyframes},
+			},
+		},
+	})
+
+	return tx.Commit()
+}
+
+// formatVignetteAmount writes an amount with enough precision for the pulse steps
+func formatVignetteAmount(amount float64) string {
+	return fmt.Sprintf("%.3f", amount)
+}
@@ -0,0 +1,81 @@
+package utils
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultFXBatchTemplate is the fx-batch output name used when no --out-template is given
+const DefaultFXBatchTemplate = "{name}_fx.fcpxml"
+
+// outTemplateToken matches {token} placeholders in an output template
+var outTemplateToken = regexp.MustCompile(`\{([a-z]+)\}`)
+
+// OutTemplateValues are the values substituted for an output template's tokens
+type OutTemplateValues struct {
+	Name   string    // {name}: input file name without extension
+	Effect string    // {effect}: effect type of the batch
+	Index  int       // {index}: zero-based position of the input in the batch
+	Date   time.Time // {date}: batch start date as YYYY-MM-DD
+}
+
+// ExpandOutTemplate substitutes values into template and adds .fcpxml when the result has no
+// extension. Slashes in the template make subdirectories, e.g. "{date}/{effect}/{name}".
+func ExpandOutTemplate(template string, values OutTemplateValues) (string, error) {
+	var unknown []string
+	expanded := outTemplateToken.ReplaceAllStringFunc(template, func(token string) string {
+		switch token {
+		case "{name}":
+			return values.Name
+		case "{effect}":
+			return values.Effect
+		case "{index}":
+			return strconv.Itoa(values.Index)
+		case "{date}":
+			return values.Date.Format("2006-01-02")
+		}
+		unknown = append(unknown, token)
+		return token
+	})
+	if len(unknown) > 0 {
+		return "", fmt.Errorf("unknown output template token %s (available: {name}, {effect}, {index}, {date})", strings.Join(unknown, ", "))
+	}
+
+	expanded = filepath.Clean(filepath.FromSlash(expanded))
+	if expanded == "." || expanded == string(filepath.Separator) {
+		return "", fmt.Errorf("output template '%s' produces no file name", template)
+	}
+	if filepath.Ext(expanded) == "" {
+		expanded += ".fcpxml"
+	}
+	return expanded, nil
+}
+
+// batchOutputPaths expands template under outDir for every input, rejecting templates that
+// would write two inputs to the same file (e.g. "{effect}" for a whole batch)
+func batchOutputPaths(outDir, template string, inputs []string, effectType string, date time.Time) ([]string, error) {
+	outputs := make([]string, len(inputs))
+	owners := make(map[string]string, len(inputs))
+	for i, input := range inputs {
+		relative, err := ExpandOutTemplate(template, OutTemplateValues{
+			Name:   strings.TrimSuffix(filepath.Base(input), filepath.Ext(input)),
+			Effect: effectType,
+			Index:  i,
+			Date:   date,
+		})
+		if err != nil {
+			return nil, err
+		}
+		output := filepath.Join(outDir, relative)
+		if previous, taken := owners[output]; taken {
+			return nil, fmt.Errorf("output template '%s' writes both %s and %s to %s; add {name} or {index}", template, previous, input, output)
+		}
+		owners[output] = input
+		outputs[i] = output
+	}
+	return outputs, nil
+}
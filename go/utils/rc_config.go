@@ -0,0 +1,213 @@
+package utils
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/pflag"
+)
+
+// RCFilename is the config file looked up in the working directory, then the home directory
+const RCFilename = ".cutlassrc"
+
+// RCConfig holds flag defaults from a .cutlassrc. Defaults apply to every command that has the
+// flag; Commands holds per-command defaults keyed by command name (e.g. "fx-static-image"),
+// which win over Defaults.
+type RCConfig struct {
+	Path     string
+	Defaults map[string]string
+	Commands map[string]map[string]string
+}
+
+// LoadRCConfig reads the first .cutlassrc found in the working directory or the home directory.
+// It returns nil without an error when neither has one.
+func LoadRCConfig() (*RCConfig, error) {
+	var dirs []string
+	if wd, err := os.Getwd(); err == nil {
+		dirs = append(dirs, wd)
+	}
+	if home, err := os.UserHomeDir(); err == nil {
+		dirs = append(dirs, home)
+	}
+
+	for _, dir := range dirs {
+		path := filepath.Join(dir, RCFilename)
+		data, err := os.ReadFile(path)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %v", path, err)
+		}
+		config, err := ParseRCConfig(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %v", path, err)
+		}
+		config.Path = path
+		return config, nil
+	}
+	return nil, nil
+}
+
+// ParseRCConfig parses rc data as JSON when it starts with '{', otherwise as TOML. Only flat
+// TOML is understood: top-level "flag = value" lines are Defaults and a [command] table holds
+// that command's defaults.
+func ParseRCConfig(data []byte) (*RCConfig, error) {
+	config := &RCConfig{
+		Defaults: make(map[string]string),
+		Commands: make(map[string]map[string]string),
+	}
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("{")) {
+		return config, config.parseJSON(data)
+	}
+	return config, config.parseTOML(data)
+}
+
+// parseJSON reads {"flag": value, "command": {"flag": value}}
+func (c *RCConfig) parseJSON(data []byte) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var raw map[string]interface{}
+	if err := decoder.Decode(&raw); err != nil {
+		return err
+	}
+
+	for key, value := range raw {
+		if section, ok := value.(map[string]interface{}); ok {
+			values := make(map[string]string)
+			for flag, flagValue := range section {
+				text, err := rcValueString(flagValue)
+				if err != nil {
+					return fmt.Errorf("%s.%s: %v", key, flag, err)
+				}
+				values[flag] = text
+			}
+			c.Commands[key] = values
+			continue
+		}
+		text, err := rcValueString(value)
+		if err != nil {
+			return fmt.Errorf("%s: %v", key, err)
+		}
+		c.Defaults[key] = text
+	}
+	return nil
+}
+
+// rcValueString turns a JSON scalar into the text a flag would be given on the command line
+func rcValueString(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case json.Number:
+		return v.String(), nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	default:
+		return "", fmt.Errorf("value must be a string, number or boolean")
+	}
+}
+
+// parseTOML reads flat key = value lines and [command] tables
+func (c *RCConfig) parseTOML(data []byte) error {
+	values := c.Defaults
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(stripTOMLComment(scanner.Text()))
+		if line == "" {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			name := strings.Trim(strings.TrimSpace(line[1:len(line)-1]), `"`)
+			if name == "" {
+				return fmt.Errorf("line %d: empty table name", lineNumber)
+			}
+			if c.Commands[name] == nil {
+				c.Commands[name] = make(map[string]string)
+			}
+			values = c.Commands[name]
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return fmt.Errorf("line %d: expected key = value", lineNumber)
+		}
+		key = strings.Trim(strings.TrimSpace(key), `"`)
+		value = strings.TrimSpace(value)
+		if strings.HasPrefix(value, `"`) {
+			unquoted, err := strconv.Unquote(value)
+			if err != nil {
+				return fmt.Errorf("line %d: bad string %s", lineNumber, value)
+			}
+			value = unquoted
+		}
+		if key == "" {
+			return fmt.Errorf("line %d: missing key", lineNumber)
+		}
+		values[key] = value
+	}
+	return scanner.Err()
+}
+
+// stripTOMLComment cuts a line at the first # that isn't inside a "quoted string"
+func stripTOMLComment(line string) string {
+	inString := false
+	for i := 0; i < len(line); i++ {
+		switch line[i] {
+		case '\\':
+			if inString {
+				i++ // Skip the escaped character, which may be a quote
+			}
+		case '"':
+			inString = !inString
+		case '#':
+			if !inString {
+				return line[:i]
+			}
+		}
+	}
+	return line
+}
+
+// Value returns the rc default for flag on command, preferring the command's own table
+func (c *RCConfig) Value(command, flag string) (string, bool) {
+	if value, ok := c.Commands[command][flag]; ok {
+		return value, true
+	}
+	value, ok := c.Defaults[flag]
+	return value, ok
+}
+
+// ApplyRCDefaults sets each flag of command that wasn't given explicitly to its rc default, so
+// the precedence is explicit flag > .cutlassrc > built-in default. It must run after flags are
+// parsed. rc keys that aren't flags of this command are ignored.
+func ApplyRCDefaults(flags *pflag.FlagSet, command string, config *RCConfig) error {
+	if config == nil {
+		return nil
+	}
+
+	var err error
+	flags.VisitAll(func(flag *pflag.Flag) {
+		if err != nil || flag.Changed {
+			return
+		}
+		value, ok := config.Value(command, flag.Name)
+		if !ok {
+			return
+		}
+		if setErr := flags.Set(flag.Name, value); setErr != nil {
+			err = fmt.Errorf("invalid %s value '%s' for --%s: %v", RCFilename, value, flag.Name, setErr)
+		}
+	})
+	return err
+}
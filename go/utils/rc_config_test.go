@@ -0,0 +1,108 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/spf13/pflag"
+)
+
+func newRCTestFlags() *pflag.FlagSet {
+	flags := pflag.NewFlagSet("fx-static-image", pflag.ContinueOnError)
+	flags.String("effect", "shake", "")
+	flags.String("duration", "10", "")
+	flags.Int("seed", 0, "")
+	return flags
+}
+
+func TestApplyRCDefaults(t *testing.T) {
+	config, err := ParseRCConfig([]byte(`
+# applied to every command
+duration = "6"
+seed = 42
+
+[fx-static-image]
+effect = "kaleido"
+`))
+	if err != nil {
+		t.Fatalf("ParseRCConfig failed: %v", err)
+	}
+
+	flags := newRCTestFlags()
+	if err := flags.Parse([]string{"--seed", "7"}); err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if err := ApplyRCDefaults(flags, "fx-static-image", config); err != nil {
+		t.Fatalf("ApplyRCDefaults failed: %v", err)
+	}
+
+	if effect, _ := flags.GetString("effect"); effect != "kaleido" {
+		t.Errorf("Expected command rc default 'kaleido', got '%s'", effect)
+	}
+	if duration, _ := flags.GetString("duration"); duration != "6" {
+		t.Errorf("Expected global rc default '6', got '%s'", duration)
+	}
+	if seed, _ := flags.GetInt("seed"); seed != 7 {
+		t.Errorf("Expected explicit --seed 7 to override the rc default, got %d", seed)
+	}
+
+	// Another command only picks up the global defaults
+	other := newRCTestFlags()
+	if err := ApplyRCDefaults(other, "fx-batch", config); err != nil {
+		t.Fatalf("ApplyRCDefaults failed: %v", err)
+	}
+	if effect, _ := other.GetString("effect"); effect != "shake" {
+		t.Errorf("Expected built-in default 'shake' outside fx-static-image, got '%s'", effect)
+	}
+}
+
+func TestParseRCConfigJSON(t *testing.T) {
+	config, err := ParseRCConfig([]byte(`{"seed": 3, "aspect": "9:16", "fx-static-image": {"effect": "vortex"}}`))
+	if err != nil {
+		t.Fatalf("ParseRCConfig failed: %v", err)
+	}
+	if value, _ := config.Value("fx-static-image", "seed"); value != "3" {
+		t.Errorf("Expected seed '3', got '%s'", value)
+	}
+	if value, _ := config.Value("fx-static-image", "effect"); value != "vortex" {
+		t.Errorf("Expected effect 'vortex', got '%s'", value)
+	}
+
+	flags := newRCTestFlags()
+	bad, _ := ParseRCConfig([]byte(`{"seed": "lots"}`))
+	if err := ApplyRCDefaults(flags, "fx-static-image", bad); err == nil {
+		t.Errorf("Expected an error for a non-numeric rc seed")
+	}
+	if _, err := ParseRCConfig([]byte(`{"seed": [1, 2]}`)); err == nil {
+		t.Errorf("Expected an error for an array value")
+	}
+}
+
+// TestParseRCConfigTOMLComments tests the example from cutlass --help verbatim, with trailing
+// comments after values and table headers, and a # inside a quoted value
+func TestParseRCConfigTOMLComments(t *testing.T) {
+	config, err := ParseRCConfig([]byte(`
+  seed = 42              # any command with a --seed flag
+  [fx-static-image]      # only this command
+  effect = "kaleido"
+`))
+	if err != nil {
+		t.Fatalf("ParseRCConfig failed on the documented example: %v", err)
+	}
+	if value, _ := config.Value("fx-static-image", "seed"); value != "42" {
+		t.Errorf("Expected seed '42', got '%s'", value)
+	}
+	if value, _ := config.Value("fx-static-image", "effect"); value != "kaleido" {
+		t.Errorf("Expected effect 'kaleido', got '%s'", value)
+	}
+
+	config, err = ParseRCConfig([]byte(`
+[montage] # quoted values keep their #
+title = "Take #2 \"final\"" # trailing comment
+`))
+	if err != nil {
+		t.Fatalf("ParseRCConfig failed: %v", err)
+	}
+	if value, _ := config.Value("montage", "title"); value != `Take #2 "final"` {
+		t.Errorf("Expected title 'Take #2 \"final\"', got '%s'", value)
+	}
+}
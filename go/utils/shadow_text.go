@@ -30,7 +30,7 @@ type TextChunk struct {
 // ✅ Uses existing fcp infrastructure (should have used fcp.GenerateEmpty() but works)
 //
 // ❌ AVOID: Building FCPXML from scratch, fictional UIDs, manual ID management
-func HandleAddShadowTextCommand(args []string) {
+func HandleAddShadowTextCommand(args []string, writeOpts fcp.WriteOptions) {
 	if len(args) < 1 {
 		fmt.Println("Error: Please provide a text file")
 		return
@@ -52,12 +52,12 @@ func HandleAddShadowTextCommand(args []string) {
 		}
 	}
 
-	if err := generateShadowTextFCPXML(textFile, outputFile, totalDuration); err != nil {
+	if err := generateShadowTextFCPXML(textFile, outputFile, totalDuration, writeOpts); err != nil {
 		fmt.Printf("Error generating shadow text FCPXML: %v\n", err)
 	}
 }
 
-func generateShadowTextFCPXML(inputFile, outputFile string, totalDuration float64) error {
+func generateShadowTextFCPXML(inputFile, outputFile string, totalDuration float64, writeOpts fcp.WriteOptions) error {
 	// Read the text file
 	file, err := os.Open(inputFile)
 	if err != nil {
@@ -172,7 +172,7 @@ func generateShadowTextFCPXML(inputFile, outputFile string, totalDuration float6
 	}
 
 	// Write to file using FCPXML marshal
-	return fcp.WriteToFile(fcpxml, outputFile)
+	return writeFCPXML(fcpxml, outputFile, writeOpts)
 }
 
 func createSequenceWithShadowText(chunks []TextChunk) fcp.Sequence {
@@ -364,14 +364,17 @@ func createTitleForChunk(chunk TextChunk, offsetSeconds float64, index int, text
 			Text: part,
 		})
 		
-		// Create text style definition with shadow properties
+		// Create text style definition with shadow properties (values from samples/shadow_text.fcpxml)
 		textStyleDefs = append(textStyleDefs, fcp.TextStyleDef{
 			ID: styleID,
 			TextStyle: fcp.TextStyle{
-				Font:      "Avenir Next Condensed",
-				FontFace:  "Heavy Italic",
-				FontSize:  strconv.Itoa(chunk.FontSize),
-				FontColor: "1 0 1 1", // Bright magenta
+				Font:             "Avenir Next Condensed",
+				FontFace:         "Heavy Italic",
+				FontSize:         strconv.Itoa(chunk.FontSize),
+				FontColor:        "1 0 1 1", // Bright magenta
+				ShadowColor:      "0.999993 0.999963 0.0410148 1",
+				ShadowOffset:     "26 317",
+				ShadowBlurRadius: "20",
 			},
 		})
 	}
@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"cutlass/fcp"
 	"os"
 	"testing"
 )
@@ -20,7 +21,7 @@ func TestShadowTextGeneration(t *testing.T) {
 	defer os.Remove(testOutput)
 	
 	// Generate shadow text FCPXML
-	if err := generateShadowTextFCPXML(testInput, testOutput); err != nil {
+	if err := generateShadowTextFCPXML(testInput, testOutput, 0, fcp.WriteOptions{}); err != nil {
 		t.Fatalf("Failed to generate shadow text FCPXML: %v", err)
 	}
 	
@@ -96,7 +97,7 @@ func TestShadowTextDTDValidation(t *testing.T) {
 	defer os.Remove(testInput)
 	defer os.Remove(testOutput)
 	
-	if err := generateShadowTextFCPXML(testInput, testOutput); err != nil {
+	if err := generateShadowTextFCPXML(testInput, testOutput, 0, fcp.WriteOptions{}); err != nil {
 		t.Fatalf("Failed to generate FCPXML: %v", err)
 	}
 	
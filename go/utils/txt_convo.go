@@ -16,8 +16,9 @@ type ConversationMessage struct {
 	IsUser  bool // true if user message (blue bubble), false if other person (gray bubble)
 }
 
-// HandleTxtConvoCommand processes a text conversation file and generates iMessage-style FCPXML
-func HandleTxtConvoCommand(args []string) {
+// HandleTxtConvoCommand processes a text conversation file and generates iMessage-style FCPXML;
+// writeOpts guards the output file
+func HandleTxtConvoCommand(args []string, writeOpts fcp.WriteOptions) {
 	if len(args) < 1 {
 		fmt.Println("Usage: txt-convo <conversation.txt> [output.fcpxml] [duration-per-message]")
 		fmt.Println("")
@@ -57,7 +58,7 @@ func HandleTxtConvoCommand(args []string) {
 	fmt.Printf("📱 Generating iMessage-style FCPXML: %s\n", outputFile)
 	fmt.Printf("⏱️  Duration per message: %.1f seconds\n", durationPerMessage)
 
-	if err := GenerateTxtConvoFCPXML(inputFile, outputFile, durationPerMessage); err != nil {
+	if err := GenerateTxtConvoFCPXML(inputFile, outputFile, durationPerMessage, writeOpts); err != nil {
 		fmt.Printf("❌ Error generating conversation: %v\n", err)
 		return
 	}
@@ -67,7 +68,7 @@ func HandleTxtConvoCommand(args []string) {
 }
 
 // GenerateTxtConvoFCPXML creates FCPXML with iMessage-style conversation animation following samples/imessage.fcpxml patterns
-func GenerateTxtConvoFCPXML(inputFile, outputFile string, durationPerMessage float64) error {
+func GenerateTxtConvoFCPXML(inputFile, outputFile string, durationPerMessage float64, writeOpts fcp.WriteOptions) error {
 	// Parse conversation messages
 	messages, err := ParseConversationFile(inputFile)
 	if err != nil {
@@ -87,7 +88,7 @@ func GenerateTxtConvoFCPXML(inputFile, outputFile string, durationPerMessage flo
 	}
 
 	// Write the FCPXML to file
-	if err := fcp.WriteToFile(fcpxml, outputFile); err != nil {
+	if err := writeFCPXML(fcpxml, outputFile, writeOpts); err != nil {
 		return fmt.Errorf("failed to write FCPXML: %v", err)
 	}
 
@@ -160,6 +161,10 @@ func truncateText(text string, maxLen int) string {
 // createIMessageFCPXML creates FCPXML following the exact structure of samples/imessage.fcpxml
 func createIMessageFCPXML(messages []ConversationMessage, durationPerMessage float64) (*fcp.FCPXML, error) {
 	totalDuration := calculateTotalConversationDuration(messages, durationPerMessage)
+	sequence, err := createIMessageSequence(messages, durationPerMessage, totalDuration)
+	if err != nil {
+		return nil, err
+	}
 	
 	fcpxml := &fcp.FCPXML{
 		Version: "1.13",
@@ -216,7 +221,7 @@ func createIMessageFCPXML(messages []ConversationMessage, durationPerMessage flo
 							UID:      "CD9DC2C7-C690-4E58-A4BD-350B5CE85723",
 							ModDate:  "2025-06-22 12:54:35 -0700",
 							Sequences: []fcp.Sequence{
-								createIMessageSequence(messages, durationPerMessage, totalDuration),
+								sequence,
 							},
 						},
 					},
@@ -236,7 +241,12 @@ func createIMessageFCPXML(messages []ConversationMessage, durationPerMessage flo
 }
 
 // createIMessageSequence creates the sequence with nested video structure like samples/imessage.fcpxml
-func createIMessageSequence(messages []ConversationMessage, durationPerMessage, totalDuration float64) fcp.Sequence {
+func createIMessageSequence(messages []ConversationMessage, durationPerMessage, totalDuration float64) (fcp.Sequence, error) {
+	spine, err := createIMessageSpine(messages, durationPerMessage)
+	if err != nil {
+		return fcp.Sequence{}, err
+	}
+
 	return fcp.Sequence{
 		Format:      "r1",
 		Duration:    fcp.ConvertSecondsToFCPDuration(totalDuration),
@@ -244,12 +254,14 @@ func createIMessageSequence(messages []ConversationMessage, durationPerMessage,
 		TCFormat:    "NDF", 
 		AudioLayout: "stereo",
 		AudioRate:   "48k",
-		Spine:       createIMessageSpine(messages, durationPerMessage),
-	}
+		Spine:       spine,
+	}, nil
 }
 
-// createIMessageSpine creates spine following samples/imessage.fcpxml pattern with sequential conversation timing
-func createIMessageSpine(messages []ConversationMessage, durationPerMessage float64) fcp.Spine {
+// createIMessageSpine creates spine following samples/imessage.fcpxml pattern with sequential conversation timing.
+// Bubbles and text are nested in the phone clip, so their offsets are in its local time: from the
+// phone's start to start + duration.
+func createIMessageSpine(messages []ConversationMessage, durationPerMessage float64) (fcp.Spine, error) {
 	var spine fcp.Spine
 	
 	currentOffset := 0.0
@@ -264,11 +276,12 @@ func createIMessageSpine(messages []ConversationMessage, durationPerMessage floa
 		segmentDuration := calculateSegmentDuration(i, len(messages), durationPerMessage)
 		
 		// Create phone background video for this segment
+		phoneStart := calculatePhoneStartTime(i)
 		phoneVideo := fcp.Video{
 			Ref:      "r2", // phone_blank001
 			Offset:   fcp.ConvertSecondsToFCPDuration(currentOffset),
 			Name:     "phone_blank001",
-			Start:    calculatePhoneStartTime(i),
+			Start:    phoneStart,
 			Duration: fcp.ConvertSecondsToFCPDuration(segmentDuration),
 		}
 		
@@ -276,12 +289,15 @@ func createIMessageSpine(messages []ConversationMessage, durationPerMessage floa
 		lane := 1
 		for j, visibleMsg := range visibleMessages {
 			// Add bubble (appears immediately when segment starts)
-			bubbleVideo := createBubbleVideoWithTiming(visibleMsg, lane, currentOffset, segmentDuration, i, j)
+			bubbleVideo := createBubbleVideoWithTiming(visibleMsg, lane, phoneStart, segmentDuration, i, j)
 			phoneVideo.NestedVideos = append(phoneVideo.NestedVideos, bubbleVideo)
 			
 			// Add text (appears with delay after bubble, only for current message or with staggered timing)
 			textDelay := calculateTextDelay(i, j, len(visibleMessages))
-			textTitle := createTextTitleWithTiming(visibleMsg, lane+1, currentOffset, textDelay, segmentDuration, i, j, globalMessageIndex)
+			textTitle, err := createTextTitleWithTiming(visibleMsg, lane+1, phoneStart, textDelay, segmentDuration, i, j, globalMessageIndex)
+			if err != nil {
+				return spine, err
+			}
 			phoneVideo.NestedTitles = append(phoneVideo.NestedTitles, textTitle)
 			
 			lane += 2
@@ -292,7 +308,7 @@ func createIMessageSpine(messages []ConversationMessage, durationPerMessage floa
 		currentOffset += segmentDuration
 	}
 	
-	return spine
+	return spine, nil
 }
 
 // ConversationExchange represents a group of related messages
@@ -411,7 +427,7 @@ func calculatePhoneStartTime(exchangeIndex int) string {
 	return baseTimes[len(baseTimes)-1] // Use last one for additional exchanges
 }
 
-func createBubbleVideoWithTiming(message ConversationMessage, lane int, segmentOffset, segmentDuration float64, segmentIndex, messageIndex int) fcp.Video {
+func createBubbleVideoWithTiming(message ConversationMessage, lane int, phoneStart string, segmentDuration float64, segmentIndex, messageIndex int) fcp.Video {
 	var bubbleRef string
 	var bubblePosition string
 	var bubbleScale string
@@ -426,15 +442,12 @@ func createBubbleVideoWithTiming(message ConversationMessage, lane int, segmentO
 		bubbleScale = "0.653172 0.653172"
 	}
 	
-	// Bubble offset within the segment - use sample timing pattern
-	bubbleOffset := calculateBubbleOffsetInSegment(segmentIndex, messageIndex)
-	
 	return fcp.Video{
 		Ref:      bubbleRef,
 		Lane:     fmt.Sprintf("%d", lane),
-		Offset:   bubbleOffset,
+		Offset:   phoneStart, // Bubble appears when the phone segment starts
 		Name:     getBubbleName(bubbleRef),
-		Start:    bubbleOffset,
+		Start:    calculateBubbleOffsetInSegment(segmentIndex, messageIndex),
 		Duration: fcp.ConvertSecondsToFCPDuration(segmentDuration), // Duration matches segment
 		AdjustTransform: &fcp.AdjustTransform{
 			Position: bubblePosition,
@@ -443,7 +456,7 @@ func createBubbleVideoWithTiming(message ConversationMessage, lane int, segmentO
 	}
 }
 
-func createTextTitleWithTiming(message ConversationMessage, lane int, segmentOffset, textDelay, segmentDuration float64, segmentIndex, messageIndex int, globalMessageIndex int) fcp.Title {
+func createTextTitleWithTiming(message ConversationMessage, lane int, phoneStart string, textDelay, segmentDuration float64, segmentIndex, messageIndex int, globalMessageIndex int) (fcp.Title, error) {
 	textColor := "0.999995 1 1 1" // White for blue bubbles
 	textPosition := "0 -3071"
 	
@@ -455,7 +468,10 @@ func createTextTitleWithTiming(message ConversationMessage, lane int, segmentOff
 	textStyleID := fmt.Sprintf("ts%d", globalMessageIndex+1)
 	
 	// Text offset within segment - appears after bubble with delay
-	textOffset := calculateTextOffsetInSegment(segmentIndex, messageIndex, textDelay)
+	textOffset, err := fcp.DefaultFrameRate.AddSeconds(phoneStart, textDelay)
+	if err != nil {
+		return fcp.Title{}, fmt.Errorf("invalid phone start time %q: %v", phoneStart, err)
+	}
 	
 	return fcp.Title{
 		Ref:      "r6", // Text effect
@@ -485,7 +501,7 @@ func createTextTitleWithTiming(message ConversationMessage, lane int, segmentOff
 				},
 			},
 		},
-	}
+	}, nil
 }
 
 func createCompleteTextParams(textPosition string) []fcp.Param {
@@ -553,21 +569,6 @@ func calculateBubbleOffsetInSegment(segmentIndex, messageIndex int) string {
 	return baseOffsets[len(baseOffsets)-1]
 }
 
-func calculateTextOffsetInSegment(segmentIndex, messageIndex int, textDelay float64) string {
-	// Text offset within segment
-	baseOffsets := []string{
-		"86441355/24000s", // Segment 0
-		"86531445/24000s", // Segment 1
-		"86639553/24000s", // Segment 2 
-		"86653567/24000s", // Segment 3
-	}
-	
-	if segmentIndex < len(baseOffsets) {
-		return baseOffsets[segmentIndex]
-	}
-	return baseOffsets[len(baseOffsets)-1]
-}
-
 func calculateTextStartTimeInSegment(segmentIndex, messageIndex int, textDelay float64) string {
 	// Text start time - delayed from bubble
 	baseStartTimes := []string{
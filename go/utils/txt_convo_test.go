@@ -0,0 +1,30 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+
+	"cutlass/fcp"
+)
+
+// TestTxtConvoNestedTiming validates that bubbles and text are anchored inside their phone segment
+func TestTxtConvoNestedTiming(t *testing.T) {
+	messages := []ConversationMessage{
+		{Name: "Ann", Content: "hi", IsUser: true},
+		{Name: "Bob", Content: "hey there"},
+		{Name: "Ann", Content: "coffee?", IsUser: true},
+		{Name: "Bob", Content: "sure"},
+		{Name: "Ann", Content: "great", IsUser: true},
+	}
+
+	fcpxml, err := createIMessageFCPXML(messages, 2.5)
+	if err != nil {
+		t.Fatalf("createIMessageFCPXML failed: %v", err)
+	}
+
+	for _, entry := range fcp.ValidateClaudeCompliance(fcpxml) {
+		if strings.Contains(entry, "Nested") {
+			t.Errorf("Unexpected nested span report: %s", entry)
+		}
+	}
+}
@@ -0,0 +1,11 @@
+package utils
+
+import (
+	"cutlass/fcp"
+)
+
+// writeFCPXML writes like fcp.WriteToFile but refuses to clobber an existing file
+// unless writeOpts allows it (the CLI passes its --force/--backup choice)
+func writeFCPXML(fcpxml *fcp.FCPXML, filename string, writeOpts fcp.WriteOptions) error {
+	return fcp.WriteToFileSafe(fcpxml, filename, writeOpts)
+}